@@ -0,0 +1,3579 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package fiattokenfactoryv1
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_SignerSet_3_list)(nil)
+
+type _SignerSet_3_list struct {
+	list *[]string
+}
+
+func (x *_SignerSet_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SignerSet_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_SignerSet_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SignerSet_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SignerSet_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message SignerSet at list field Signers as it is not of Message kind"))
+}
+
+func (x *_SignerSet_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SignerSet_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_SignerSet_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_SignerSet           protoreflect.MessageDescriptor
+	fd_SignerSet_denom     protoreflect.FieldDescriptor
+	fd_SignerSet_role      protoreflect.FieldDescriptor
+	fd_SignerSet_signers   protoreflect.FieldDescriptor
+	fd_SignerSet_threshold protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_circle_fiattokenfactory_v1_approval_proto_init()
+	md_SignerSet = File_circle_fiattokenfactory_v1_approval_proto.Messages().ByName("SignerSet")
+	fd_SignerSet_denom = md_SignerSet.Fields().ByName("denom")
+	fd_SignerSet_role = md_SignerSet.Fields().ByName("role")
+	fd_SignerSet_signers = md_SignerSet.Fields().ByName("signers")
+	fd_SignerSet_threshold = md_SignerSet.Fields().ByName("threshold")
+}
+
+var _ protoreflect.Message = (*fastReflection_SignerSet)(nil)
+
+type fastReflection_SignerSet SignerSet
+
+func (x *SignerSet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SignerSet)(x)
+}
+
+func (x *SignerSet) slowProtoReflect() protoreflect.Message {
+	mi := &file_circle_fiattokenfactory_v1_approval_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SignerSet_messageType fastReflection_SignerSet_messageType
+var _ protoreflect.MessageType = fastReflection_SignerSet_messageType{}
+
+type fastReflection_SignerSet_messageType struct{}
+
+func (x fastReflection_SignerSet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SignerSet)(nil)
+}
+func (x fastReflection_SignerSet_messageType) New() protoreflect.Message {
+	return new(fastReflection_SignerSet)
+}
+func (x fastReflection_SignerSet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignerSet
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SignerSet) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignerSet
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SignerSet) Type() protoreflect.MessageType {
+	return _fastReflection_SignerSet_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SignerSet) New() protoreflect.Message {
+	return new(fastReflection_SignerSet)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SignerSet) Interface() protoreflect.ProtoMessage {
+	return (*SignerSet)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SignerSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_SignerSet_denom, value) {
+			return
+		}
+	}
+	if x.Role != "" {
+		value := protoreflect.ValueOfString(x.Role)
+		if !f(fd_SignerSet_role, value) {
+			return
+		}
+	}
+	if len(x.Signers) != 0 {
+		value := protoreflect.ValueOfList(&_SignerSet_3_list{list: &x.Signers})
+		if !f(fd_SignerSet_signers, value) {
+			return
+		}
+	}
+	if x.Threshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Threshold)
+		if !f(fd_SignerSet_threshold, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SignerSet) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.SignerSet.denom":
+		return x.Denom != ""
+	case "circle.fiattokenfactory.v1.SignerSet.role":
+		return x.Role != ""
+	case "circle.fiattokenfactory.v1.SignerSet.signers":
+		return len(x.Signers) != 0
+	case "circle.fiattokenfactory.v1.SignerSet.threshold":
+		return x.Threshold != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.SignerSet"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.SignerSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignerSet) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.SignerSet.denom":
+		x.Denom = ""
+	case "circle.fiattokenfactory.v1.SignerSet.role":
+		x.Role = ""
+	case "circle.fiattokenfactory.v1.SignerSet.signers":
+		x.Signers = nil
+	case "circle.fiattokenfactory.v1.SignerSet.threshold":
+		x.Threshold = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.SignerSet"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.SignerSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SignerSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "circle.fiattokenfactory.v1.SignerSet.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "circle.fiattokenfactory.v1.SignerSet.role":
+		value := x.Role
+		return protoreflect.ValueOfString(value)
+	case "circle.fiattokenfactory.v1.SignerSet.signers":
+		if len(x.Signers) == 0 {
+			return protoreflect.ValueOfList(&_SignerSet_3_list{})
+		}
+		listValue := &_SignerSet_3_list{list: &x.Signers}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.SignerSet.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.SignerSet"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.SignerSet does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignerSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.SignerSet.denom":
+		x.Denom = value.Interface().(string)
+	case "circle.fiattokenfactory.v1.SignerSet.role":
+		x.Role = value.Interface().(string)
+	case "circle.fiattokenfactory.v1.SignerSet.signers":
+		lv := value.List()
+		clv := lv.(*_SignerSet_3_list)
+		x.Signers = *clv.list
+	case "circle.fiattokenfactory.v1.SignerSet.threshold":
+		x.Threshold = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.SignerSet"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.SignerSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignerSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.SignerSet.signers":
+		if x.Signers == nil {
+			x.Signers = []string{}
+		}
+		value := &_SignerSet_3_list{list: &x.Signers}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.SignerSet.denom":
+		panic(fmt.Errorf("field denom of message circle.fiattokenfactory.v1.SignerSet is not mutable"))
+	case "circle.fiattokenfactory.v1.SignerSet.role":
+		panic(fmt.Errorf("field role of message circle.fiattokenfactory.v1.SignerSet is not mutable"))
+	case "circle.fiattokenfactory.v1.SignerSet.threshold":
+		panic(fmt.Errorf("field threshold of message circle.fiattokenfactory.v1.SignerSet is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.SignerSet"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.SignerSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SignerSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.SignerSet.denom":
+		return protoreflect.ValueOfString("")
+	case "circle.fiattokenfactory.v1.SignerSet.role":
+		return protoreflect.ValueOfString("")
+	case "circle.fiattokenfactory.v1.SignerSet.signers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_SignerSet_3_list{list: &list})
+	case "circle.fiattokenfactory.v1.SignerSet.threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.SignerSet"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.SignerSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SignerSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in circle.fiattokenfactory.v1.SignerSet", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SignerSet) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignerSet) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SignerSet) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SignerSet) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SignerSet)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Role)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Signers) > 0 {
+			for _, s := range x.Signers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Threshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.Threshold))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SignerSet)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Threshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Threshold))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Signers) > 0 {
+			for iNdEx := len(x.Signers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Signers[iNdEx])
+				copy(dAtA[i:], x.Signers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signers[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Role) > 0 {
+			i -= len(x.Role)
+			copy(dAtA[i:], x.Role)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Role)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SignerSet)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignerSet: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignerSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Role = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signers = append(x.Signers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				x.Threshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Threshold |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ActionProposal_5_list)(nil)
+
+type _ActionProposal_5_list struct {
+	list *[]string
+}
+
+func (x *_ActionProposal_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ActionProposal_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ActionProposal_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ActionProposal_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ActionProposal_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ActionProposal at list field Approvals as it is not of Message kind"))
+}
+
+func (x *_ActionProposal_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ActionProposal_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ActionProposal_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ActionProposal                           protoreflect.MessageDescriptor
+	fd_ActionProposal_id                        protoreflect.FieldDescriptor
+	fd_ActionProposal_denom                     protoreflect.FieldDescriptor
+	fd_ActionProposal_role                      protoreflect.FieldDescriptor
+	fd_ActionProposal_proposer                  protoreflect.FieldDescriptor
+	fd_ActionProposal_approvals                 protoreflect.FieldDescriptor
+	fd_ActionProposal_expiry                    protoreflect.FieldDescriptor
+	fd_ActionProposal_updateOwner               protoreflect.FieldDescriptor
+	fd_ActionProposal_updateMasterMinter        protoreflect.FieldDescriptor
+	fd_ActionProposal_configureMinterController protoreflect.FieldDescriptor
+	fd_ActionProposal_unpause                   protoreflect.FieldDescriptor
+	fd_ActionProposal_setSignerSet              protoreflect.FieldDescriptor
+	fd_ActionProposal_updatePauser              protoreflect.FieldDescriptor
+	fd_ActionProposal_updateBlacklister         protoreflect.FieldDescriptor
+	fd_ActionProposal_updateAllowlister         protoreflect.FieldDescriptor
+	fd_ActionProposal_setChannelPolicy          protoreflect.FieldDescriptor
+	fd_ActionProposal_setChannelRateLimit       protoreflect.FieldDescriptor
+	fd_ActionProposal_setAllowlistMode          protoreflect.FieldDescriptor
+	fd_ActionProposal_cancelQueuedAction        protoreflect.FieldDescriptor
+	fd_ActionProposal_cancelOwnershipTransfer   protoreflect.FieldDescriptor
+	fd_ActionProposal_removeChannelPolicy       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_circle_fiattokenfactory_v1_approval_proto_init()
+	md_ActionProposal = File_circle_fiattokenfactory_v1_approval_proto.Messages().ByName("ActionProposal")
+	fd_ActionProposal_id = md_ActionProposal.Fields().ByName("id")
+	fd_ActionProposal_denom = md_ActionProposal.Fields().ByName("denom")
+	fd_ActionProposal_role = md_ActionProposal.Fields().ByName("role")
+	fd_ActionProposal_proposer = md_ActionProposal.Fields().ByName("proposer")
+	fd_ActionProposal_approvals = md_ActionProposal.Fields().ByName("approvals")
+	fd_ActionProposal_expiry = md_ActionProposal.Fields().ByName("expiry")
+	fd_ActionProposal_updateOwner = md_ActionProposal.Fields().ByName("updateOwner")
+	fd_ActionProposal_updateMasterMinter = md_ActionProposal.Fields().ByName("updateMasterMinter")
+	fd_ActionProposal_configureMinterController = md_ActionProposal.Fields().ByName("configureMinterController")
+	fd_ActionProposal_unpause = md_ActionProposal.Fields().ByName("unpause")
+	fd_ActionProposal_setSignerSet = md_ActionProposal.Fields().ByName("setSignerSet")
+	fd_ActionProposal_updatePauser = md_ActionProposal.Fields().ByName("updatePauser")
+	fd_ActionProposal_updateBlacklister = md_ActionProposal.Fields().ByName("updateBlacklister")
+	fd_ActionProposal_updateAllowlister = md_ActionProposal.Fields().ByName("updateAllowlister")
+	fd_ActionProposal_setChannelPolicy = md_ActionProposal.Fields().ByName("setChannelPolicy")
+	fd_ActionProposal_setChannelRateLimit = md_ActionProposal.Fields().ByName("setChannelRateLimit")
+	fd_ActionProposal_setAllowlistMode = md_ActionProposal.Fields().ByName("setAllowlistMode")
+	fd_ActionProposal_cancelQueuedAction = md_ActionProposal.Fields().ByName("cancelQueuedAction")
+	fd_ActionProposal_cancelOwnershipTransfer = md_ActionProposal.Fields().ByName("cancelOwnershipTransfer")
+	fd_ActionProposal_removeChannelPolicy = md_ActionProposal.Fields().ByName("removeChannelPolicy")
+}
+
+var _ protoreflect.Message = (*fastReflection_ActionProposal)(nil)
+
+type fastReflection_ActionProposal ActionProposal
+
+func (x *ActionProposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ActionProposal)(x)
+}
+
+func (x *ActionProposal) slowProtoReflect() protoreflect.Message {
+	mi := &file_circle_fiattokenfactory_v1_approval_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ActionProposal_messageType fastReflection_ActionProposal_messageType
+var _ protoreflect.MessageType = fastReflection_ActionProposal_messageType{}
+
+type fastReflection_ActionProposal_messageType struct{}
+
+func (x fastReflection_ActionProposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ActionProposal)(nil)
+}
+func (x fastReflection_ActionProposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_ActionProposal)
+}
+func (x fastReflection_ActionProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ActionProposal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ActionProposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_ActionProposal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ActionProposal) Type() protoreflect.MessageType {
+	return _fastReflection_ActionProposal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ActionProposal) New() protoreflect.Message {
+	return new(fastReflection_ActionProposal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ActionProposal) Interface() protoreflect.ProtoMessage {
+	return (*ActionProposal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ActionProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_ActionProposal_id, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_ActionProposal_denom, value) {
+			return
+		}
+	}
+	if x.Role != "" {
+		value := protoreflect.ValueOfString(x.Role)
+		if !f(fd_ActionProposal_role, value) {
+			return
+		}
+	}
+	if x.Proposer != "" {
+		value := protoreflect.ValueOfString(x.Proposer)
+		if !f(fd_ActionProposal_proposer, value) {
+			return
+		}
+	}
+	if len(x.Approvals) != 0 {
+		value := protoreflect.ValueOfList(&_ActionProposal_5_list{list: &x.Approvals})
+		if !f(fd_ActionProposal_approvals, value) {
+			return
+		}
+	}
+	if x.Expiry != nil {
+		value := protoreflect.ValueOfMessage(x.Expiry.ProtoReflect())
+		if !f(fd_ActionProposal_expiry, value) {
+			return
+		}
+	}
+	if x.Action != nil {
+		switch o := x.Action.(type) {
+		case *ActionProposal_UpdateOwner:
+			v := o.UpdateOwner
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_ActionProposal_updateOwner, value) {
+				return
+			}
+		case *ActionProposal_UpdateMasterMinter:
+			v := o.UpdateMasterMinter
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_ActionProposal_updateMasterMinter, value) {
+				return
+			}
+		case *ActionProposal_ConfigureMinterController:
+			v := o.ConfigureMinterController
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_ActionProposal_configureMinterController, value) {
+				return
+			}
+		case *ActionProposal_Unpause:
+			v := o.Unpause
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_ActionProposal_unpause, value) {
+				return
+			}
+		case *ActionProposal_SetSignerSet:
+			v := o.SetSignerSet
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_ActionProposal_setSignerSet, value) {
+				return
+			}
+		case *ActionProposal_UpdatePauser:
+			v := o.UpdatePauser
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_ActionProposal_updatePauser, value) {
+				return
+			}
+		case *ActionProposal_UpdateBlacklister:
+			v := o.UpdateBlacklister
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_ActionProposal_updateBlacklister, value) {
+				return
+			}
+		case *ActionProposal_UpdateAllowlister:
+			v := o.UpdateAllowlister
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_ActionProposal_updateAllowlister, value) {
+				return
+			}
+		case *ActionProposal_SetChannelPolicy:
+			v := o.SetChannelPolicy
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_ActionProposal_setChannelPolicy, value) {
+				return
+			}
+		case *ActionProposal_SetChannelRateLimit:
+			v := o.SetChannelRateLimit
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_ActionProposal_setChannelRateLimit, value) {
+				return
+			}
+		case *ActionProposal_SetAllowlistMode:
+			v := o.SetAllowlistMode
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_ActionProposal_setAllowlistMode, value) {
+				return
+			}
+		case *ActionProposal_CancelQueuedAction:
+			v := o.CancelQueuedAction
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_ActionProposal_cancelQueuedAction, value) {
+				return
+			}
+		case *ActionProposal_CancelOwnershipTransfer:
+			v := o.CancelOwnershipTransfer
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_ActionProposal_cancelOwnershipTransfer, value) {
+				return
+			}
+		case *ActionProposal_RemoveChannelPolicy:
+			v := o.RemoveChannelPolicy
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_ActionProposal_removeChannelPolicy, value) {
+				return
+			}
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ActionProposal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.ActionProposal.id":
+		return x.Id != uint64(0)
+	case "circle.fiattokenfactory.v1.ActionProposal.denom":
+		return x.Denom != ""
+	case "circle.fiattokenfactory.v1.ActionProposal.role":
+		return x.Role != ""
+	case "circle.fiattokenfactory.v1.ActionProposal.proposer":
+		return x.Proposer != ""
+	case "circle.fiattokenfactory.v1.ActionProposal.approvals":
+		return len(x.Approvals) != 0
+	case "circle.fiattokenfactory.v1.ActionProposal.expiry":
+		return x.Expiry != nil
+	case "circle.fiattokenfactory.v1.ActionProposal.updateOwner":
+		if x.Action == nil {
+			return false
+		} else if _, ok := x.Action.(*ActionProposal_UpdateOwner); ok {
+			return true
+		} else {
+			return false
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.updateMasterMinter":
+		if x.Action == nil {
+			return false
+		} else if _, ok := x.Action.(*ActionProposal_UpdateMasterMinter); ok {
+			return true
+		} else {
+			return false
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.configureMinterController":
+		if x.Action == nil {
+			return false
+		} else if _, ok := x.Action.(*ActionProposal_ConfigureMinterController); ok {
+			return true
+		} else {
+			return false
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.unpause":
+		if x.Action == nil {
+			return false
+		} else if _, ok := x.Action.(*ActionProposal_Unpause); ok {
+			return true
+		} else {
+			return false
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.setSignerSet":
+		if x.Action == nil {
+			return false
+		} else if _, ok := x.Action.(*ActionProposal_SetSignerSet); ok {
+			return true
+		} else {
+			return false
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.updatePauser":
+		if x.Action == nil {
+			return false
+		} else if _, ok := x.Action.(*ActionProposal_UpdatePauser); ok {
+			return true
+		} else {
+			return false
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.updateBlacklister":
+		if x.Action == nil {
+			return false
+		} else if _, ok := x.Action.(*ActionProposal_UpdateBlacklister); ok {
+			return true
+		} else {
+			return false
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.updateAllowlister":
+		if x.Action == nil {
+			return false
+		} else if _, ok := x.Action.(*ActionProposal_UpdateAllowlister); ok {
+			return true
+		} else {
+			return false
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.setChannelPolicy":
+		if x.Action == nil {
+			return false
+		} else if _, ok := x.Action.(*ActionProposal_SetChannelPolicy); ok {
+			return true
+		} else {
+			return false
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.setChannelRateLimit":
+		if x.Action == nil {
+			return false
+		} else if _, ok := x.Action.(*ActionProposal_SetChannelRateLimit); ok {
+			return true
+		} else {
+			return false
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.setAllowlistMode":
+		if x.Action == nil {
+			return false
+		} else if _, ok := x.Action.(*ActionProposal_SetAllowlistMode); ok {
+			return true
+		} else {
+			return false
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.cancelQueuedAction":
+		if x.Action == nil {
+			return false
+		} else if _, ok := x.Action.(*ActionProposal_CancelQueuedAction); ok {
+			return true
+		} else {
+			return false
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.cancelOwnershipTransfer":
+		if x.Action == nil {
+			return false
+		} else if _, ok := x.Action.(*ActionProposal_CancelOwnershipTransfer); ok {
+			return true
+		} else {
+			return false
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.removeChannelPolicy":
+		if x.Action == nil {
+			return false
+		} else if _, ok := x.Action.(*ActionProposal_RemoveChannelPolicy); ok {
+			return true
+		} else {
+			return false
+		}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.ActionProposal"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.ActionProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ActionProposal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.ActionProposal.id":
+		x.Id = uint64(0)
+	case "circle.fiattokenfactory.v1.ActionProposal.denom":
+		x.Denom = ""
+	case "circle.fiattokenfactory.v1.ActionProposal.role":
+		x.Role = ""
+	case "circle.fiattokenfactory.v1.ActionProposal.proposer":
+		x.Proposer = ""
+	case "circle.fiattokenfactory.v1.ActionProposal.approvals":
+		x.Approvals = nil
+	case "circle.fiattokenfactory.v1.ActionProposal.expiry":
+		x.Expiry = nil
+	case "circle.fiattokenfactory.v1.ActionProposal.updateOwner":
+		x.Action = nil
+	case "circle.fiattokenfactory.v1.ActionProposal.updateMasterMinter":
+		x.Action = nil
+	case "circle.fiattokenfactory.v1.ActionProposal.configureMinterController":
+		x.Action = nil
+	case "circle.fiattokenfactory.v1.ActionProposal.unpause":
+		x.Action = nil
+	case "circle.fiattokenfactory.v1.ActionProposal.setSignerSet":
+		x.Action = nil
+	case "circle.fiattokenfactory.v1.ActionProposal.updatePauser":
+		x.Action = nil
+	case "circle.fiattokenfactory.v1.ActionProposal.updateBlacklister":
+		x.Action = nil
+	case "circle.fiattokenfactory.v1.ActionProposal.updateAllowlister":
+		x.Action = nil
+	case "circle.fiattokenfactory.v1.ActionProposal.setChannelPolicy":
+		x.Action = nil
+	case "circle.fiattokenfactory.v1.ActionProposal.setChannelRateLimit":
+		x.Action = nil
+	case "circle.fiattokenfactory.v1.ActionProposal.setAllowlistMode":
+		x.Action = nil
+	case "circle.fiattokenfactory.v1.ActionProposal.cancelQueuedAction":
+		x.Action = nil
+	case "circle.fiattokenfactory.v1.ActionProposal.cancelOwnershipTransfer":
+		x.Action = nil
+	case "circle.fiattokenfactory.v1.ActionProposal.removeChannelPolicy":
+		x.Action = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.ActionProposal"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.ActionProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ActionProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "circle.fiattokenfactory.v1.ActionProposal.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "circle.fiattokenfactory.v1.ActionProposal.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "circle.fiattokenfactory.v1.ActionProposal.role":
+		value := x.Role
+		return protoreflect.ValueOfString(value)
+	case "circle.fiattokenfactory.v1.ActionProposal.proposer":
+		value := x.Proposer
+		return protoreflect.ValueOfString(value)
+	case "circle.fiattokenfactory.v1.ActionProposal.approvals":
+		if len(x.Approvals) == 0 {
+			return protoreflect.ValueOfList(&_ActionProposal_5_list{})
+		}
+		listValue := &_ActionProposal_5_list{list: &x.Approvals}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.ActionProposal.expiry":
+		value := x.Expiry
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.ActionProposal.updateOwner":
+		if x.Action == nil {
+			return protoreflect.ValueOfMessage((*MsgUpdateOwner)(nil).ProtoReflect())
+		} else if v, ok := x.Action.(*ActionProposal_UpdateOwner); ok {
+			return protoreflect.ValueOfMessage(v.UpdateOwner.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*MsgUpdateOwner)(nil).ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.updateMasterMinter":
+		if x.Action == nil {
+			return protoreflect.ValueOfMessage((*MsgUpdateMasterMinter)(nil).ProtoReflect())
+		} else if v, ok := x.Action.(*ActionProposal_UpdateMasterMinter); ok {
+			return protoreflect.ValueOfMessage(v.UpdateMasterMinter.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*MsgUpdateMasterMinter)(nil).ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.configureMinterController":
+		if x.Action == nil {
+			return protoreflect.ValueOfMessage((*MsgConfigureMinterController)(nil).ProtoReflect())
+		} else if v, ok := x.Action.(*ActionProposal_ConfigureMinterController); ok {
+			return protoreflect.ValueOfMessage(v.ConfigureMinterController.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*MsgConfigureMinterController)(nil).ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.unpause":
+		if x.Action == nil {
+			return protoreflect.ValueOfMessage((*MsgUnpause)(nil).ProtoReflect())
+		} else if v, ok := x.Action.(*ActionProposal_Unpause); ok {
+			return protoreflect.ValueOfMessage(v.Unpause.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*MsgUnpause)(nil).ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.setSignerSet":
+		if x.Action == nil {
+			return protoreflect.ValueOfMessage((*MsgSetSignerSet)(nil).ProtoReflect())
+		} else if v, ok := x.Action.(*ActionProposal_SetSignerSet); ok {
+			return protoreflect.ValueOfMessage(v.SetSignerSet.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*MsgSetSignerSet)(nil).ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.updatePauser":
+		if x.Action == nil {
+			return protoreflect.ValueOfMessage((*MsgUpdatePauser)(nil).ProtoReflect())
+		} else if v, ok := x.Action.(*ActionProposal_UpdatePauser); ok {
+			return protoreflect.ValueOfMessage(v.UpdatePauser.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*MsgUpdatePauser)(nil).ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.updateBlacklister":
+		if x.Action == nil {
+			return protoreflect.ValueOfMessage((*MsgUpdateBlacklister)(nil).ProtoReflect())
+		} else if v, ok := x.Action.(*ActionProposal_UpdateBlacklister); ok {
+			return protoreflect.ValueOfMessage(v.UpdateBlacklister.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*MsgUpdateBlacklister)(nil).ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.updateAllowlister":
+		if x.Action == nil {
+			return protoreflect.ValueOfMessage((*MsgUpdateAllowlister)(nil).ProtoReflect())
+		} else if v, ok := x.Action.(*ActionProposal_UpdateAllowlister); ok {
+			return protoreflect.ValueOfMessage(v.UpdateAllowlister.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*MsgUpdateAllowlister)(nil).ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.setChannelPolicy":
+		if x.Action == nil {
+			return protoreflect.ValueOfMessage((*MsgSetChannelPolicy)(nil).ProtoReflect())
+		} else if v, ok := x.Action.(*ActionProposal_SetChannelPolicy); ok {
+			return protoreflect.ValueOfMessage(v.SetChannelPolicy.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*MsgSetChannelPolicy)(nil).ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.setChannelRateLimit":
+		if x.Action == nil {
+			return protoreflect.ValueOfMessage((*MsgSetChannelRateLimit)(nil).ProtoReflect())
+		} else if v, ok := x.Action.(*ActionProposal_SetChannelRateLimit); ok {
+			return protoreflect.ValueOfMessage(v.SetChannelRateLimit.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*MsgSetChannelRateLimit)(nil).ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.setAllowlistMode":
+		if x.Action == nil {
+			return protoreflect.ValueOfMessage((*MsgSetAllowlistMode)(nil).ProtoReflect())
+		} else if v, ok := x.Action.(*ActionProposal_SetAllowlistMode); ok {
+			return protoreflect.ValueOfMessage(v.SetAllowlistMode.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*MsgSetAllowlistMode)(nil).ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.cancelQueuedAction":
+		if x.Action == nil {
+			return protoreflect.ValueOfMessage((*MsgCancelQueuedAction)(nil).ProtoReflect())
+		} else if v, ok := x.Action.(*ActionProposal_CancelQueuedAction); ok {
+			return protoreflect.ValueOfMessage(v.CancelQueuedAction.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*MsgCancelQueuedAction)(nil).ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.cancelOwnershipTransfer":
+		if x.Action == nil {
+			return protoreflect.ValueOfMessage((*MsgCancelOwnershipTransfer)(nil).ProtoReflect())
+		} else if v, ok := x.Action.(*ActionProposal_CancelOwnershipTransfer); ok {
+			return protoreflect.ValueOfMessage(v.CancelOwnershipTransfer.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*MsgCancelOwnershipTransfer)(nil).ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.removeChannelPolicy":
+		if x.Action == nil {
+			return protoreflect.ValueOfMessage((*MsgRemoveChannelPolicy)(nil).ProtoReflect())
+		} else if v, ok := x.Action.(*ActionProposal_RemoveChannelPolicy); ok {
+			return protoreflect.ValueOfMessage(v.RemoveChannelPolicy.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*MsgRemoveChannelPolicy)(nil).ProtoReflect())
+		}
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.ActionProposal"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.ActionProposal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ActionProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.ActionProposal.id":
+		x.Id = value.Uint()
+	case "circle.fiattokenfactory.v1.ActionProposal.denom":
+		x.Denom = value.Interface().(string)
+	case "circle.fiattokenfactory.v1.ActionProposal.role":
+		x.Role = value.Interface().(string)
+	case "circle.fiattokenfactory.v1.ActionProposal.proposer":
+		x.Proposer = value.Interface().(string)
+	case "circle.fiattokenfactory.v1.ActionProposal.approvals":
+		lv := value.List()
+		clv := lv.(*_ActionProposal_5_list)
+		x.Approvals = *clv.list
+	case "circle.fiattokenfactory.v1.ActionProposal.expiry":
+		x.Expiry = value.Message().Interface().(*timestamppb.Timestamp)
+	case "circle.fiattokenfactory.v1.ActionProposal.updateOwner":
+		cv := value.Message().Interface().(*MsgUpdateOwner)
+		x.Action = &ActionProposal_UpdateOwner{UpdateOwner: cv}
+	case "circle.fiattokenfactory.v1.ActionProposal.updateMasterMinter":
+		cv := value.Message().Interface().(*MsgUpdateMasterMinter)
+		x.Action = &ActionProposal_UpdateMasterMinter{UpdateMasterMinter: cv}
+	case "circle.fiattokenfactory.v1.ActionProposal.configureMinterController":
+		cv := value.Message().Interface().(*MsgConfigureMinterController)
+		x.Action = &ActionProposal_ConfigureMinterController{ConfigureMinterController: cv}
+	case "circle.fiattokenfactory.v1.ActionProposal.unpause":
+		cv := value.Message().Interface().(*MsgUnpause)
+		x.Action = &ActionProposal_Unpause{Unpause: cv}
+	case "circle.fiattokenfactory.v1.ActionProposal.setSignerSet":
+		cv := value.Message().Interface().(*MsgSetSignerSet)
+		x.Action = &ActionProposal_SetSignerSet{SetSignerSet: cv}
+	case "circle.fiattokenfactory.v1.ActionProposal.updatePauser":
+		cv := value.Message().Interface().(*MsgUpdatePauser)
+		x.Action = &ActionProposal_UpdatePauser{UpdatePauser: cv}
+	case "circle.fiattokenfactory.v1.ActionProposal.updateBlacklister":
+		cv := value.Message().Interface().(*MsgUpdateBlacklister)
+		x.Action = &ActionProposal_UpdateBlacklister{UpdateBlacklister: cv}
+	case "circle.fiattokenfactory.v1.ActionProposal.updateAllowlister":
+		cv := value.Message().Interface().(*MsgUpdateAllowlister)
+		x.Action = &ActionProposal_UpdateAllowlister{UpdateAllowlister: cv}
+	case "circle.fiattokenfactory.v1.ActionProposal.setChannelPolicy":
+		cv := value.Message().Interface().(*MsgSetChannelPolicy)
+		x.Action = &ActionProposal_SetChannelPolicy{SetChannelPolicy: cv}
+	case "circle.fiattokenfactory.v1.ActionProposal.setChannelRateLimit":
+		cv := value.Message().Interface().(*MsgSetChannelRateLimit)
+		x.Action = &ActionProposal_SetChannelRateLimit{SetChannelRateLimit: cv}
+	case "circle.fiattokenfactory.v1.ActionProposal.setAllowlistMode":
+		cv := value.Message().Interface().(*MsgSetAllowlistMode)
+		x.Action = &ActionProposal_SetAllowlistMode{SetAllowlistMode: cv}
+	case "circle.fiattokenfactory.v1.ActionProposal.cancelQueuedAction":
+		cv := value.Message().Interface().(*MsgCancelQueuedAction)
+		x.Action = &ActionProposal_CancelQueuedAction{CancelQueuedAction: cv}
+	case "circle.fiattokenfactory.v1.ActionProposal.cancelOwnershipTransfer":
+		cv := value.Message().Interface().(*MsgCancelOwnershipTransfer)
+		x.Action = &ActionProposal_CancelOwnershipTransfer{CancelOwnershipTransfer: cv}
+	case "circle.fiattokenfactory.v1.ActionProposal.removeChannelPolicy":
+		cv := value.Message().Interface().(*MsgRemoveChannelPolicy)
+		x.Action = &ActionProposal_RemoveChannelPolicy{RemoveChannelPolicy: cv}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.ActionProposal"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.ActionProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ActionProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.ActionProposal.approvals":
+		if x.Approvals == nil {
+			x.Approvals = []string{}
+		}
+		value := &_ActionProposal_5_list{list: &x.Approvals}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.ActionProposal.expiry":
+		if x.Expiry == nil {
+			x.Expiry = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Expiry.ProtoReflect())
+	case "circle.fiattokenfactory.v1.ActionProposal.updateOwner":
+		if x.Action == nil {
+			value := &MsgUpdateOwner{}
+			oneofValue := &ActionProposal_UpdateOwner{UpdateOwner: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Action.(type) {
+		case *ActionProposal_UpdateOwner:
+			return protoreflect.ValueOfMessage(m.UpdateOwner.ProtoReflect())
+		default:
+			value := &MsgUpdateOwner{}
+			oneofValue := &ActionProposal_UpdateOwner{UpdateOwner: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.updateMasterMinter":
+		if x.Action == nil {
+			value := &MsgUpdateMasterMinter{}
+			oneofValue := &ActionProposal_UpdateMasterMinter{UpdateMasterMinter: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Action.(type) {
+		case *ActionProposal_UpdateMasterMinter:
+			return protoreflect.ValueOfMessage(m.UpdateMasterMinter.ProtoReflect())
+		default:
+			value := &MsgUpdateMasterMinter{}
+			oneofValue := &ActionProposal_UpdateMasterMinter{UpdateMasterMinter: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.configureMinterController":
+		if x.Action == nil {
+			value := &MsgConfigureMinterController{}
+			oneofValue := &ActionProposal_ConfigureMinterController{ConfigureMinterController: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Action.(type) {
+		case *ActionProposal_ConfigureMinterController:
+			return protoreflect.ValueOfMessage(m.ConfigureMinterController.ProtoReflect())
+		default:
+			value := &MsgConfigureMinterController{}
+			oneofValue := &ActionProposal_ConfigureMinterController{ConfigureMinterController: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.unpause":
+		if x.Action == nil {
+			value := &MsgUnpause{}
+			oneofValue := &ActionProposal_Unpause{Unpause: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Action.(type) {
+		case *ActionProposal_Unpause:
+			return protoreflect.ValueOfMessage(m.Unpause.ProtoReflect())
+		default:
+			value := &MsgUnpause{}
+			oneofValue := &ActionProposal_Unpause{Unpause: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.setSignerSet":
+		if x.Action == nil {
+			value := &MsgSetSignerSet{}
+			oneofValue := &ActionProposal_SetSignerSet{SetSignerSet: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Action.(type) {
+		case *ActionProposal_SetSignerSet:
+			return protoreflect.ValueOfMessage(m.SetSignerSet.ProtoReflect())
+		default:
+			value := &MsgSetSignerSet{}
+			oneofValue := &ActionProposal_SetSignerSet{SetSignerSet: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.updatePauser":
+		if x.Action == nil {
+			value := &MsgUpdatePauser{}
+			oneofValue := &ActionProposal_UpdatePauser{UpdatePauser: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Action.(type) {
+		case *ActionProposal_UpdatePauser:
+			return protoreflect.ValueOfMessage(m.UpdatePauser.ProtoReflect())
+		default:
+			value := &MsgUpdatePauser{}
+			oneofValue := &ActionProposal_UpdatePauser{UpdatePauser: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.updateBlacklister":
+		if x.Action == nil {
+			value := &MsgUpdateBlacklister{}
+			oneofValue := &ActionProposal_UpdateBlacklister{UpdateBlacklister: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Action.(type) {
+		case *ActionProposal_UpdateBlacklister:
+			return protoreflect.ValueOfMessage(m.UpdateBlacklister.ProtoReflect())
+		default:
+			value := &MsgUpdateBlacklister{}
+			oneofValue := &ActionProposal_UpdateBlacklister{UpdateBlacklister: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.updateAllowlister":
+		if x.Action == nil {
+			value := &MsgUpdateAllowlister{}
+			oneofValue := &ActionProposal_UpdateAllowlister{UpdateAllowlister: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Action.(type) {
+		case *ActionProposal_UpdateAllowlister:
+			return protoreflect.ValueOfMessage(m.UpdateAllowlister.ProtoReflect())
+		default:
+			value := &MsgUpdateAllowlister{}
+			oneofValue := &ActionProposal_UpdateAllowlister{UpdateAllowlister: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.setChannelPolicy":
+		if x.Action == nil {
+			value := &MsgSetChannelPolicy{}
+			oneofValue := &ActionProposal_SetChannelPolicy{SetChannelPolicy: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Action.(type) {
+		case *ActionProposal_SetChannelPolicy:
+			return protoreflect.ValueOfMessage(m.SetChannelPolicy.ProtoReflect())
+		default:
+			value := &MsgSetChannelPolicy{}
+			oneofValue := &ActionProposal_SetChannelPolicy{SetChannelPolicy: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.setChannelRateLimit":
+		if x.Action == nil {
+			value := &MsgSetChannelRateLimit{}
+			oneofValue := &ActionProposal_SetChannelRateLimit{SetChannelRateLimit: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Action.(type) {
+		case *ActionProposal_SetChannelRateLimit:
+			return protoreflect.ValueOfMessage(m.SetChannelRateLimit.ProtoReflect())
+		default:
+			value := &MsgSetChannelRateLimit{}
+			oneofValue := &ActionProposal_SetChannelRateLimit{SetChannelRateLimit: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.setAllowlistMode":
+		if x.Action == nil {
+			value := &MsgSetAllowlistMode{}
+			oneofValue := &ActionProposal_SetAllowlistMode{SetAllowlistMode: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Action.(type) {
+		case *ActionProposal_SetAllowlistMode:
+			return protoreflect.ValueOfMessage(m.SetAllowlistMode.ProtoReflect())
+		default:
+			value := &MsgSetAllowlistMode{}
+			oneofValue := &ActionProposal_SetAllowlistMode{SetAllowlistMode: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.cancelQueuedAction":
+		if x.Action == nil {
+			value := &MsgCancelQueuedAction{}
+			oneofValue := &ActionProposal_CancelQueuedAction{CancelQueuedAction: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Action.(type) {
+		case *ActionProposal_CancelQueuedAction:
+			return protoreflect.ValueOfMessage(m.CancelQueuedAction.ProtoReflect())
+		default:
+			value := &MsgCancelQueuedAction{}
+			oneofValue := &ActionProposal_CancelQueuedAction{CancelQueuedAction: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.cancelOwnershipTransfer":
+		if x.Action == nil {
+			value := &MsgCancelOwnershipTransfer{}
+			oneofValue := &ActionProposal_CancelOwnershipTransfer{CancelOwnershipTransfer: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Action.(type) {
+		case *ActionProposal_CancelOwnershipTransfer:
+			return protoreflect.ValueOfMessage(m.CancelOwnershipTransfer.ProtoReflect())
+		default:
+			value := &MsgCancelOwnershipTransfer{}
+			oneofValue := &ActionProposal_CancelOwnershipTransfer{CancelOwnershipTransfer: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.removeChannelPolicy":
+		if x.Action == nil {
+			value := &MsgRemoveChannelPolicy{}
+			oneofValue := &ActionProposal_RemoveChannelPolicy{RemoveChannelPolicy: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Action.(type) {
+		case *ActionProposal_RemoveChannelPolicy:
+			return protoreflect.ValueOfMessage(m.RemoveChannelPolicy.ProtoReflect())
+		default:
+			value := &MsgRemoveChannelPolicy{}
+			oneofValue := &ActionProposal_RemoveChannelPolicy{RemoveChannelPolicy: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.ActionProposal.id":
+		panic(fmt.Errorf("field id of message circle.fiattokenfactory.v1.ActionProposal is not mutable"))
+	case "circle.fiattokenfactory.v1.ActionProposal.denom":
+		panic(fmt.Errorf("field denom of message circle.fiattokenfactory.v1.ActionProposal is not mutable"))
+	case "circle.fiattokenfactory.v1.ActionProposal.role":
+		panic(fmt.Errorf("field role of message circle.fiattokenfactory.v1.ActionProposal is not mutable"))
+	case "circle.fiattokenfactory.v1.ActionProposal.proposer":
+		panic(fmt.Errorf("field proposer of message circle.fiattokenfactory.v1.ActionProposal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.ActionProposal"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.ActionProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ActionProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.ActionProposal.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "circle.fiattokenfactory.v1.ActionProposal.denom":
+		return protoreflect.ValueOfString("")
+	case "circle.fiattokenfactory.v1.ActionProposal.role":
+		return protoreflect.ValueOfString("")
+	case "circle.fiattokenfactory.v1.ActionProposal.proposer":
+		return protoreflect.ValueOfString("")
+	case "circle.fiattokenfactory.v1.ActionProposal.approvals":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ActionProposal_5_list{list: &list})
+	case "circle.fiattokenfactory.v1.ActionProposal.expiry":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "circle.fiattokenfactory.v1.ActionProposal.updateOwner":
+		value := &MsgUpdateOwner{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.ActionProposal.updateMasterMinter":
+		value := &MsgUpdateMasterMinter{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.ActionProposal.configureMinterController":
+		value := &MsgConfigureMinterController{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.ActionProposal.unpause":
+		value := &MsgUnpause{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.ActionProposal.setSignerSet":
+		value := &MsgSetSignerSet{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.ActionProposal.updatePauser":
+		value := &MsgUpdatePauser{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.ActionProposal.updateBlacklister":
+		value := &MsgUpdateBlacklister{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.ActionProposal.updateAllowlister":
+		value := &MsgUpdateAllowlister{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.ActionProposal.setChannelPolicy":
+		value := &MsgSetChannelPolicy{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.ActionProposal.setChannelRateLimit":
+		value := &MsgSetChannelRateLimit{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.ActionProposal.setAllowlistMode":
+		value := &MsgSetAllowlistMode{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.ActionProposal.cancelQueuedAction":
+		value := &MsgCancelQueuedAction{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.ActionProposal.cancelOwnershipTransfer":
+		value := &MsgCancelOwnershipTransfer{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.ActionProposal.removeChannelPolicy":
+		value := &MsgRemoveChannelPolicy{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.ActionProposal"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.ActionProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ActionProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	case "circle.fiattokenfactory.v1.ActionProposal.action":
+		if x.Action == nil {
+			return nil
+		}
+		switch x.Action.(type) {
+		case *ActionProposal_UpdateOwner:
+			return x.Descriptor().Fields().ByName("updateOwner")
+		case *ActionProposal_UpdateMasterMinter:
+			return x.Descriptor().Fields().ByName("updateMasterMinter")
+		case *ActionProposal_ConfigureMinterController:
+			return x.Descriptor().Fields().ByName("configureMinterController")
+		case *ActionProposal_Unpause:
+			return x.Descriptor().Fields().ByName("unpause")
+		case *ActionProposal_SetSignerSet:
+			return x.Descriptor().Fields().ByName("setSignerSet")
+		case *ActionProposal_UpdatePauser:
+			return x.Descriptor().Fields().ByName("updatePauser")
+		case *ActionProposal_UpdateBlacklister:
+			return x.Descriptor().Fields().ByName("updateBlacklister")
+		case *ActionProposal_UpdateAllowlister:
+			return x.Descriptor().Fields().ByName("updateAllowlister")
+		case *ActionProposal_SetChannelPolicy:
+			return x.Descriptor().Fields().ByName("setChannelPolicy")
+		case *ActionProposal_SetChannelRateLimit:
+			return x.Descriptor().Fields().ByName("setChannelRateLimit")
+		case *ActionProposal_SetAllowlistMode:
+			return x.Descriptor().Fields().ByName("setAllowlistMode")
+		case *ActionProposal_CancelQueuedAction:
+			return x.Descriptor().Fields().ByName("cancelQueuedAction")
+		case *ActionProposal_CancelOwnershipTransfer:
+			return x.Descriptor().Fields().ByName("cancelOwnershipTransfer")
+		case *ActionProposal_RemoveChannelPolicy:
+			return x.Descriptor().Fields().ByName("removeChannelPolicy")
+		}
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in circle.fiattokenfactory.v1.ActionProposal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ActionProposal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ActionProposal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ActionProposal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ActionProposal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ActionProposal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Role)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Proposer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Approvals) > 0 {
+			for _, s := range x.Approvals {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Expiry != nil {
+			l = options.Size(x.Expiry)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		switch x := x.Action.(type) {
+		case *ActionProposal_UpdateOwner:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.UpdateOwner)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *ActionProposal_UpdateMasterMinter:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.UpdateMasterMinter)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *ActionProposal_ConfigureMinterController:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.ConfigureMinterController)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *ActionProposal_Unpause:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.Unpause)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *ActionProposal_SetSignerSet:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.SetSignerSet)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *ActionProposal_UpdatePauser:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.UpdatePauser)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *ActionProposal_UpdateBlacklister:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.UpdateBlacklister)
+			n += 2 + l + runtime.Sov(uint64(l))
+		case *ActionProposal_UpdateAllowlister:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.UpdateAllowlister)
+			n += 2 + l + runtime.Sov(uint64(l))
+		case *ActionProposal_SetChannelPolicy:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.SetChannelPolicy)
+			n += 2 + l + runtime.Sov(uint64(l))
+		case *ActionProposal_SetChannelRateLimit:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.SetChannelRateLimit)
+			n += 2 + l + runtime.Sov(uint64(l))
+		case *ActionProposal_SetAllowlistMode:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.SetAllowlistMode)
+			n += 2 + l + runtime.Sov(uint64(l))
+		case *ActionProposal_CancelQueuedAction:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.CancelQueuedAction)
+			n += 2 + l + runtime.Sov(uint64(l))
+		case *ActionProposal_CancelOwnershipTransfer:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.CancelOwnershipTransfer)
+			n += 2 + l + runtime.Sov(uint64(l))
+		case *ActionProposal_RemoveChannelPolicy:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.RemoveChannelPolicy)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ActionProposal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		switch x := x.Action.(type) {
+		case *ActionProposal_UpdateOwner:
+			encoded, err := options.Marshal(x.UpdateOwner)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		case *ActionProposal_UpdateMasterMinter:
+			encoded, err := options.Marshal(x.UpdateMasterMinter)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		case *ActionProposal_ConfigureMinterController:
+			encoded, err := options.Marshal(x.ConfigureMinterController)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		case *ActionProposal_Unpause:
+			encoded, err := options.Marshal(x.Unpause)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x6a
+		case *ActionProposal_SetSignerSet:
+			encoded, err := options.Marshal(x.SetSignerSet)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x72
+		case *ActionProposal_UpdatePauser:
+			encoded, err := options.Marshal(x.UpdatePauser)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x7a
+		case *ActionProposal_UpdateBlacklister:
+			encoded, err := options.Marshal(x.UpdateBlacklister)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		case *ActionProposal_UpdateAllowlister:
+			encoded, err := options.Marshal(x.UpdateAllowlister)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		case *ActionProposal_SetChannelPolicy:
+			encoded, err := options.Marshal(x.SetChannelPolicy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		case *ActionProposal_SetChannelRateLimit:
+			encoded, err := options.Marshal(x.SetChannelRateLimit)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		case *ActionProposal_SetAllowlistMode:
+			encoded, err := options.Marshal(x.SetAllowlistMode)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		case *ActionProposal_CancelQueuedAction:
+			encoded, err := options.Marshal(x.CancelQueuedAction)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		case *ActionProposal_CancelOwnershipTransfer:
+			encoded, err := options.Marshal(x.CancelOwnershipTransfer)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		case *ActionProposal_RemoveChannelPolicy:
+			encoded, err := options.Marshal(x.RemoveChannelPolicy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+		if x.Expiry != nil {
+			encoded, err := options.Marshal(x.Expiry)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Approvals) > 0 {
+			for iNdEx := len(x.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Approvals[iNdEx])
+				copy(dAtA[i:], x.Approvals[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Approvals[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Proposer) > 0 {
+			i -= len(x.Proposer)
+			copy(dAtA[i:], x.Proposer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proposer)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Role) > 0 {
+			i -= len(x.Role)
+			copy(dAtA[i:], x.Role)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Role)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ActionProposal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ActionProposal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ActionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Role = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proposer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Approvals = append(x.Approvals, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expiry == nil {
+					x.Expiry = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiry); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdateOwner", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &MsgUpdateOwner{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Action = &ActionProposal_UpdateOwner{v}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdateMasterMinter", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &MsgUpdateMasterMinter{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Action = &ActionProposal_UpdateMasterMinter{v}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConfigureMinterController", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &MsgConfigureMinterController{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Action = &ActionProposal_ConfigureMinterController{v}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Unpause", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &MsgUnpause{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Action = &ActionProposal_Unpause{v}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SetSignerSet", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &MsgSetSignerSet{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Action = &ActionProposal_SetSignerSet{v}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdatePauser", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &MsgUpdatePauser{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Action = &ActionProposal_UpdatePauser{v}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdateBlacklister", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &MsgUpdateBlacklister{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Action = &ActionProposal_UpdateBlacklister{v}
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdateAllowlister", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &MsgUpdateAllowlister{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Action = &ActionProposal_UpdateAllowlister{v}
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SetChannelPolicy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &MsgSetChannelPolicy{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Action = &ActionProposal_SetChannelPolicy{v}
+				iNdEx = postIndex
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SetChannelRateLimit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &MsgSetChannelRateLimit{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Action = &ActionProposal_SetChannelRateLimit{v}
+				iNdEx = postIndex
+			case 20:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SetAllowlistMode", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &MsgSetAllowlistMode{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Action = &ActionProposal_SetAllowlistMode{v}
+				iNdEx = postIndex
+			case 21:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CancelQueuedAction", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &MsgCancelQueuedAction{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Action = &ActionProposal_CancelQueuedAction{v}
+				iNdEx = postIndex
+			case 22:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CancelOwnershipTransfer", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &MsgCancelOwnershipTransfer{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Action = &ActionProposal_CancelOwnershipTransfer{v}
+				iNdEx = postIndex
+			case 23:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemoveChannelPolicy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &MsgRemoveChannelPolicy{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Action = &ActionProposal_RemoveChannelPolicy{v}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: circle/fiattokenfactory/v1/approval.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SignerSet configures a role of a minting denom as an M-of-N set of signers.
+// Sensitive actions of the role are then executed through an ActionProposal
+// once threshold signers approved it. Once the owner is a signer set, every
+// Msg of the owner goes through an ActionProposal and the owner address can
+// no longer act alone.
+type SignerSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Denom     string   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Role      string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Signers   []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+	Threshold uint32   `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (x *SignerSet) Reset() {
+	*x = SignerSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circle_fiattokenfactory_v1_approval_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignerSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignerSet) ProtoMessage() {}
+
+// Deprecated: Use SignerSet.ProtoReflect.Descriptor instead.
+func (*SignerSet) Descriptor() ([]byte, []int) {
+	return file_circle_fiattokenfactory_v1_approval_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SignerSet) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *SignerSet) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *SignerSet) GetSigners() []string {
+	if x != nil {
+		return x.Signers
+	}
+	return nil
+}
+
+func (x *SignerSet) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+// ActionProposal is a sensitive action awaiting the approval of a signer set.
+type ActionProposal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Denom     string                 `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Role      string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Proposer  string                 `protobuf:"bytes,4,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Approvals []string               `protobuf:"bytes,5,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	Expiry    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// Types that are assignable to Action:
+	//	*ActionProposal_UpdateOwner
+	//	*ActionProposal_UpdateMasterMinter
+	//	*ActionProposal_ConfigureMinterController
+	//	*ActionProposal_Unpause
+	//	*ActionProposal_SetSignerSet
+	//	*ActionProposal_UpdatePauser
+	//	*ActionProposal_UpdateBlacklister
+	//	*ActionProposal_UpdateAllowlister
+	//	*ActionProposal_SetChannelPolicy
+	//	*ActionProposal_SetChannelRateLimit
+	//	*ActionProposal_SetAllowlistMode
+	//	*ActionProposal_CancelQueuedAction
+	//	*ActionProposal_CancelOwnershipTransfer
+	//	*ActionProposal_RemoveChannelPolicy
+	Action isActionProposal_Action `protobuf_oneof:"action"`
+}
+
+func (x *ActionProposal) Reset() {
+	*x = ActionProposal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circle_fiattokenfactory_v1_approval_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActionProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionProposal) ProtoMessage() {}
+
+// Deprecated: Use ActionProposal.ProtoReflect.Descriptor instead.
+func (*ActionProposal) Descriptor() ([]byte, []int) {
+	return file_circle_fiattokenfactory_v1_approval_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ActionProposal) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ActionProposal) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *ActionProposal) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ActionProposal) GetProposer() string {
+	if x != nil {
+		return x.Proposer
+	}
+	return ""
+}
+
+func (x *ActionProposal) GetApprovals() []string {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+func (x *ActionProposal) GetExpiry() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiry
+	}
+	return nil
+}
+
+func (x *ActionProposal) GetAction() isActionProposal_Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+func (x *ActionProposal) GetUpdateOwner() *MsgUpdateOwner {
+	if x, ok := x.GetAction().(*ActionProposal_UpdateOwner); ok {
+		return x.UpdateOwner
+	}
+	return nil
+}
+
+func (x *ActionProposal) GetUpdateMasterMinter() *MsgUpdateMasterMinter {
+	if x, ok := x.GetAction().(*ActionProposal_UpdateMasterMinter); ok {
+		return x.UpdateMasterMinter
+	}
+	return nil
+}
+
+func (x *ActionProposal) GetConfigureMinterController() *MsgConfigureMinterController {
+	if x, ok := x.GetAction().(*ActionProposal_ConfigureMinterController); ok {
+		return x.ConfigureMinterController
+	}
+	return nil
+}
+
+func (x *ActionProposal) GetUnpause() *MsgUnpause {
+	if x, ok := x.GetAction().(*ActionProposal_Unpause); ok {
+		return x.Unpause
+	}
+	return nil
+}
+
+func (x *ActionProposal) GetSetSignerSet() *MsgSetSignerSet {
+	if x, ok := x.GetAction().(*ActionProposal_SetSignerSet); ok {
+		return x.SetSignerSet
+	}
+	return nil
+}
+
+func (x *ActionProposal) GetUpdatePauser() *MsgUpdatePauser {
+	if x, ok := x.GetAction().(*ActionProposal_UpdatePauser); ok {
+		return x.UpdatePauser
+	}
+	return nil
+}
+
+func (x *ActionProposal) GetUpdateBlacklister() *MsgUpdateBlacklister {
+	if x, ok := x.GetAction().(*ActionProposal_UpdateBlacklister); ok {
+		return x.UpdateBlacklister
+	}
+	return nil
+}
+
+func (x *ActionProposal) GetUpdateAllowlister() *MsgUpdateAllowlister {
+	if x, ok := x.GetAction().(*ActionProposal_UpdateAllowlister); ok {
+		return x.UpdateAllowlister
+	}
+	return nil
+}
+
+func (x *ActionProposal) GetSetChannelPolicy() *MsgSetChannelPolicy {
+	if x, ok := x.GetAction().(*ActionProposal_SetChannelPolicy); ok {
+		return x.SetChannelPolicy
+	}
+	return nil
+}
+
+func (x *ActionProposal) GetSetChannelRateLimit() *MsgSetChannelRateLimit {
+	if x, ok := x.GetAction().(*ActionProposal_SetChannelRateLimit); ok {
+		return x.SetChannelRateLimit
+	}
+	return nil
+}
+
+func (x *ActionProposal) GetSetAllowlistMode() *MsgSetAllowlistMode {
+	if x, ok := x.GetAction().(*ActionProposal_SetAllowlistMode); ok {
+		return x.SetAllowlistMode
+	}
+	return nil
+}
+
+func (x *ActionProposal) GetCancelQueuedAction() *MsgCancelQueuedAction {
+	if x, ok := x.GetAction().(*ActionProposal_CancelQueuedAction); ok {
+		return x.CancelQueuedAction
+	}
+	return nil
+}
+
+func (x *ActionProposal) GetCancelOwnershipTransfer() *MsgCancelOwnershipTransfer {
+	if x, ok := x.GetAction().(*ActionProposal_CancelOwnershipTransfer); ok {
+		return x.CancelOwnershipTransfer
+	}
+	return nil
+}
+
+func (x *ActionProposal) GetRemoveChannelPolicy() *MsgRemoveChannelPolicy {
+	if x, ok := x.GetAction().(*ActionProposal_RemoveChannelPolicy); ok {
+		return x.RemoveChannelPolicy
+	}
+	return nil
+}
+
+type isActionProposal_Action interface {
+	isActionProposal_Action()
+}
+
+type ActionProposal_UpdateOwner struct {
+	UpdateOwner *MsgUpdateOwner `protobuf:"bytes,10,opt,name=updateOwner,proto3,oneof"`
+}
+
+type ActionProposal_UpdateMasterMinter struct {
+	UpdateMasterMinter *MsgUpdateMasterMinter `protobuf:"bytes,11,opt,name=updateMasterMinter,proto3,oneof"`
+}
+
+type ActionProposal_ConfigureMinterController struct {
+	ConfigureMinterController *MsgConfigureMinterController `protobuf:"bytes,12,opt,name=configureMinterController,proto3,oneof"`
+}
+
+type ActionProposal_Unpause struct {
+	Unpause *MsgUnpause `protobuf:"bytes,13,opt,name=unpause,proto3,oneof"`
+}
+
+type ActionProposal_SetSignerSet struct {
+	SetSignerSet *MsgSetSignerSet `protobuf:"bytes,14,opt,name=setSignerSet,proto3,oneof"`
+}
+
+type ActionProposal_UpdatePauser struct {
+	UpdatePauser *MsgUpdatePauser `protobuf:"bytes,15,opt,name=updatePauser,proto3,oneof"`
+}
+
+type ActionProposal_UpdateBlacklister struct {
+	UpdateBlacklister *MsgUpdateBlacklister `protobuf:"bytes,16,opt,name=updateBlacklister,proto3,oneof"`
+}
+
+type ActionProposal_UpdateAllowlister struct {
+	UpdateAllowlister *MsgUpdateAllowlister `protobuf:"bytes,17,opt,name=updateAllowlister,proto3,oneof"`
+}
+
+type ActionProposal_SetChannelPolicy struct {
+	SetChannelPolicy *MsgSetChannelPolicy `protobuf:"bytes,18,opt,name=setChannelPolicy,proto3,oneof"`
+}
+
+type ActionProposal_SetChannelRateLimit struct {
+	SetChannelRateLimit *MsgSetChannelRateLimit `protobuf:"bytes,19,opt,name=setChannelRateLimit,proto3,oneof"`
+}
+
+type ActionProposal_SetAllowlistMode struct {
+	SetAllowlistMode *MsgSetAllowlistMode `protobuf:"bytes,20,opt,name=setAllowlistMode,proto3,oneof"`
+}
+
+type ActionProposal_CancelQueuedAction struct {
+	CancelQueuedAction *MsgCancelQueuedAction `protobuf:"bytes,21,opt,name=cancelQueuedAction,proto3,oneof"`
+}
+
+type ActionProposal_CancelOwnershipTransfer struct {
+	CancelOwnershipTransfer *MsgCancelOwnershipTransfer `protobuf:"bytes,22,opt,name=cancelOwnershipTransfer,proto3,oneof"`
+}
+
+type ActionProposal_RemoveChannelPolicy struct {
+	RemoveChannelPolicy *MsgRemoveChannelPolicy `protobuf:"bytes,23,opt,name=removeChannelPolicy,proto3,oneof"`
+}
+
+func (*ActionProposal_UpdateOwner) isActionProposal_Action() {}
+
+func (*ActionProposal_UpdateMasterMinter) isActionProposal_Action() {}
+
+func (*ActionProposal_ConfigureMinterController) isActionProposal_Action() {}
+
+func (*ActionProposal_Unpause) isActionProposal_Action() {}
+
+func (*ActionProposal_SetSignerSet) isActionProposal_Action() {}
+
+func (*ActionProposal_UpdatePauser) isActionProposal_Action() {}
+
+func (*ActionProposal_UpdateBlacklister) isActionProposal_Action() {}
+
+func (*ActionProposal_UpdateAllowlister) isActionProposal_Action() {}
+
+func (*ActionProposal_SetChannelPolicy) isActionProposal_Action() {}
+
+func (*ActionProposal_SetChannelRateLimit) isActionProposal_Action() {}
+
+func (*ActionProposal_SetAllowlistMode) isActionProposal_Action() {}
+
+func (*ActionProposal_CancelQueuedAction) isActionProposal_Action() {}
+
+func (*ActionProposal_CancelOwnershipTransfer) isActionProposal_Action() {}
+
+func (*ActionProposal_RemoveChannelPolicy) isActionProposal_Action() {}
+
+var File_circle_fiattokenfactory_v1_approval_proto protoreflect.FileDescriptor
+
+var file_circle_fiattokenfactory_v1_approval_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1a, 0x63, 0x69, 0x72,
+	0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x1a, 0x23, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f,
+	0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x2f, 0x76, 0x31, 0x2f, 0x74, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f,
+	0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x6d, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x22, 0x90, 0x0c, 0x0a, 0x0e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x3c, 0x0a, 0x06, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52,
+	0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x4e, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63,
+	0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x63, 0x0a, 0x12, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61,
+	0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x12, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x78, 0x0a, 0x19,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x38, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x48, 0x00, 0x52, 0x19, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x07, 0x75, 0x6e, 0x70, 0x61, 0x75, 0x73,
+	0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65,
+	0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x6e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x48,
+	0x00, 0x52, 0x07, 0x75, 0x6e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x73, 0x65,
+	0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x53, 0x65, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x48, 0x00, 0x52,
+	0x0c, 0x73, 0x65, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x12, 0x51, 0x0a,
+	0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x75, 0x73, 0x65, 0x72, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61,
+	0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x75, 0x73, 0x65, 0x72,
+	0x48, 0x00, 0x52, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x75, 0x73, 0x65, 0x72,
+	0x12, 0x60, 0x0a, 0x11, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x69,
+	0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52,
+	0x11, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x12, 0x60, 0x0a, 0x11, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e,
+	0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x48,
+	0x00, 0x52, 0x11, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x12, 0x5d, 0x0a, 0x10, 0x73, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f,
+	0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53,
+	0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x48,
+	0x00, 0x52, 0x10, 0x73, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x66, 0x0a, 0x13, 0x73, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x32, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x48, 0x00, 0x52, 0x13, 0x73, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x5d, 0x0a, 0x10, 0x73,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66,
+	0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69,
+	0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x74, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x63, 0x0a, 0x12, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e,
+	0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x12, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x72, 0x0a, 0x17, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x36, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x48, 0x00, 0x52, 0x17, 0x63, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x12, 0x66, 0x0a, 0x13, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x32, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x48, 0x00, 0x52, 0x13, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x08, 0x0a, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x98, 0x02, 0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x69,
+	0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x42, 0x0d, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x5d, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x66, 0x69, 0x6e, 0x2f,
+	0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x6c,
+	0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x46, 0x58, 0xaa, 0x02,
+	0x1a, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1a, 0x43, 0x69,
+	0x72, 0x63, 0x6c, 0x65, 0x5c, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x26, 0x43, 0x69, 0x72, 0x63, 0x6c,
+	0x65, 0x5c, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x1c, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x46, 0x69, 0x61, 0x74,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x3a, 0x3a, 0x56, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_circle_fiattokenfactory_v1_approval_proto_rawDescOnce sync.Once
+	file_circle_fiattokenfactory_v1_approval_proto_rawDescData = file_circle_fiattokenfactory_v1_approval_proto_rawDesc
+)
+
+func file_circle_fiattokenfactory_v1_approval_proto_rawDescGZIP() []byte {
+	file_circle_fiattokenfactory_v1_approval_proto_rawDescOnce.Do(func() {
+		file_circle_fiattokenfactory_v1_approval_proto_rawDescData = protoimpl.X.CompressGZIP(file_circle_fiattokenfactory_v1_approval_proto_rawDescData)
+	})
+	return file_circle_fiattokenfactory_v1_approval_proto_rawDescData
+}
+
+var file_circle_fiattokenfactory_v1_approval_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_circle_fiattokenfactory_v1_approval_proto_goTypes = []interface{}{
+	(*SignerSet)(nil),                    // 0: circle.fiattokenfactory.v1.SignerSet
+	(*ActionProposal)(nil),               // 1: circle.fiattokenfactory.v1.ActionProposal
+	(*timestamppb.Timestamp)(nil),        // 2: google.protobuf.Timestamp
+	(*MsgUpdateOwner)(nil),               // 3: circle.fiattokenfactory.v1.MsgUpdateOwner
+	(*MsgUpdateMasterMinter)(nil),        // 4: circle.fiattokenfactory.v1.MsgUpdateMasterMinter
+	(*MsgConfigureMinterController)(nil), // 5: circle.fiattokenfactory.v1.MsgConfigureMinterController
+	(*MsgUnpause)(nil),                   // 6: circle.fiattokenfactory.v1.MsgUnpause
+	(*MsgSetSignerSet)(nil),              // 7: circle.fiattokenfactory.v1.MsgSetSignerSet
+	(*MsgUpdatePauser)(nil),              // 8: circle.fiattokenfactory.v1.MsgUpdatePauser
+	(*MsgUpdateBlacklister)(nil),         // 9: circle.fiattokenfactory.v1.MsgUpdateBlacklister
+	(*MsgUpdateAllowlister)(nil),         // 10: circle.fiattokenfactory.v1.MsgUpdateAllowlister
+	(*MsgSetChannelPolicy)(nil),          // 11: circle.fiattokenfactory.v1.MsgSetChannelPolicy
+	(*MsgSetChannelRateLimit)(nil),       // 12: circle.fiattokenfactory.v1.MsgSetChannelRateLimit
+	(*MsgSetAllowlistMode)(nil),          // 13: circle.fiattokenfactory.v1.MsgSetAllowlistMode
+	(*MsgCancelQueuedAction)(nil),        // 14: circle.fiattokenfactory.v1.MsgCancelQueuedAction
+	(*MsgCancelOwnershipTransfer)(nil),   // 15: circle.fiattokenfactory.v1.MsgCancelOwnershipTransfer
+	(*MsgRemoveChannelPolicy)(nil),       // 16: circle.fiattokenfactory.v1.MsgRemoveChannelPolicy
+}
+var file_circle_fiattokenfactory_v1_approval_proto_depIdxs = []int32{
+	2,  // 0: circle.fiattokenfactory.v1.ActionProposal.expiry:type_name -> google.protobuf.Timestamp
+	3,  // 1: circle.fiattokenfactory.v1.ActionProposal.updateOwner:type_name -> circle.fiattokenfactory.v1.MsgUpdateOwner
+	4,  // 2: circle.fiattokenfactory.v1.ActionProposal.updateMasterMinter:type_name -> circle.fiattokenfactory.v1.MsgUpdateMasterMinter
+	5,  // 3: circle.fiattokenfactory.v1.ActionProposal.configureMinterController:type_name -> circle.fiattokenfactory.v1.MsgConfigureMinterController
+	6,  // 4: circle.fiattokenfactory.v1.ActionProposal.unpause:type_name -> circle.fiattokenfactory.v1.MsgUnpause
+	7,  // 5: circle.fiattokenfactory.v1.ActionProposal.setSignerSet:type_name -> circle.fiattokenfactory.v1.MsgSetSignerSet
+	8,  // 6: circle.fiattokenfactory.v1.ActionProposal.updatePauser:type_name -> circle.fiattokenfactory.v1.MsgUpdatePauser
+	9,  // 7: circle.fiattokenfactory.v1.ActionProposal.updateBlacklister:type_name -> circle.fiattokenfactory.v1.MsgUpdateBlacklister
+	10, // 8: circle.fiattokenfactory.v1.ActionProposal.updateAllowlister:type_name -> circle.fiattokenfactory.v1.MsgUpdateAllowlister
+	11, // 9: circle.fiattokenfactory.v1.ActionProposal.setChannelPolicy:type_name -> circle.fiattokenfactory.v1.MsgSetChannelPolicy
+	12, // 10: circle.fiattokenfactory.v1.ActionProposal.setChannelRateLimit:type_name -> circle.fiattokenfactory.v1.MsgSetChannelRateLimit
+	13, // 11: circle.fiattokenfactory.v1.ActionProposal.setAllowlistMode:type_name -> circle.fiattokenfactory.v1.MsgSetAllowlistMode
+	14, // 12: circle.fiattokenfactory.v1.ActionProposal.cancelQueuedAction:type_name -> circle.fiattokenfactory.v1.MsgCancelQueuedAction
+	15, // 13: circle.fiattokenfactory.v1.ActionProposal.cancelOwnershipTransfer:type_name -> circle.fiattokenfactory.v1.MsgCancelOwnershipTransfer
+	16, // 14: circle.fiattokenfactory.v1.ActionProposal.removeChannelPolicy:type_name -> circle.fiattokenfactory.v1.MsgRemoveChannelPolicy
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_circle_fiattokenfactory_v1_approval_proto_init() }
+func file_circle_fiattokenfactory_v1_approval_proto_init() {
+	if File_circle_fiattokenfactory_v1_approval_proto != nil {
+		return
+	}
+	file_circle_fiattokenfactory_v1_tx_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_circle_fiattokenfactory_v1_approval_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignerSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circle_fiattokenfactory_v1_approval_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionProposal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_circle_fiattokenfactory_v1_approval_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*ActionProposal_UpdateOwner)(nil),
+		(*ActionProposal_UpdateMasterMinter)(nil),
+		(*ActionProposal_ConfigureMinterController)(nil),
+		(*ActionProposal_Unpause)(nil),
+		(*ActionProposal_SetSignerSet)(nil),
+		(*ActionProposal_UpdatePauser)(nil),
+		(*ActionProposal_UpdateBlacklister)(nil),
+		(*ActionProposal_UpdateAllowlister)(nil),
+		(*ActionProposal_SetChannelPolicy)(nil),
+		(*ActionProposal_SetChannelRateLimit)(nil),
+		(*ActionProposal_SetAllowlistMode)(nil),
+		(*ActionProposal_CancelQueuedAction)(nil),
+		(*ActionProposal_CancelOwnershipTransfer)(nil),
+		(*ActionProposal_RemoveChannelPolicy)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_circle_fiattokenfactory_v1_approval_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_circle_fiattokenfactory_v1_approval_proto_goTypes,
+		DependencyIndexes: file_circle_fiattokenfactory_v1_approval_proto_depIdxs,
+		MessageInfos:      file_circle_fiattokenfactory_v1_approval_proto_msgTypes,
+	}.Build()
+	File_circle_fiattokenfactory_v1_approval_proto = out.File
+	file_circle_fiattokenfactory_v1_approval_proto_rawDesc = nil
+	file_circle_fiattokenfactory_v1_approval_proto_goTypes = nil
+	file_circle_fiattokenfactory_v1_approval_proto_depIdxs = nil
+}
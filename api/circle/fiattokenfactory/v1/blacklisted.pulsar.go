@@ -4,23 +4,39 @@ package fiattokenfactoryv1
 import (
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
 )
 
 var (
-	md_Blacklisted           protoreflect.MessageDescriptor
-	fd_Blacklisted_addressBz protoreflect.FieldDescriptor
+	md_Blacklisted                     protoreflect.MessageDescriptor
+	fd_Blacklisted_addressBz           protoreflect.FieldDescriptor
+	fd_Blacklisted_denom               protoreflect.FieldDescriptor
+	fd_Blacklisted_reason              protoreflect.FieldDescriptor
+	fd_Blacklisted_referenceId         protoreflect.FieldDescriptor
+	fd_Blacklisted_blacklistedAtHeight protoreflect.FieldDescriptor
+	fd_Blacklisted_blacklistedAtTime   protoreflect.FieldDescriptor
+	fd_Blacklisted_expiresAtHeight     protoreflect.FieldDescriptor
+	fd_Blacklisted_expiresAtTime       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_circle_fiattokenfactory_v1_blacklisted_proto_init()
 	md_Blacklisted = File_circle_fiattokenfactory_v1_blacklisted_proto.Messages().ByName("Blacklisted")
 	fd_Blacklisted_addressBz = md_Blacklisted.Fields().ByName("addressBz")
+	fd_Blacklisted_denom = md_Blacklisted.Fields().ByName("denom")
+	fd_Blacklisted_reason = md_Blacklisted.Fields().ByName("reason")
+	fd_Blacklisted_referenceId = md_Blacklisted.Fields().ByName("referenceId")
+	fd_Blacklisted_blacklistedAtHeight = md_Blacklisted.Fields().ByName("blacklistedAtHeight")
+	fd_Blacklisted_blacklistedAtTime = md_Blacklisted.Fields().ByName("blacklistedAtTime")
+	fd_Blacklisted_expiresAtHeight = md_Blacklisted.Fields().ByName("expiresAtHeight")
+	fd_Blacklisted_expiresAtTime = md_Blacklisted.Fields().ByName("expiresAtTime")
 }
 
 var _ protoreflect.Message = (*fastReflection_Blacklisted)(nil)
@@ -94,6 +110,914 @@ func (x *fastReflection_Blacklisted) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_Blacklisted_denom, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_Blacklisted_reason, value) {
+			return
+		}
+	}
+	if x.ReferenceId != "" {
+		value := protoreflect.ValueOfString(x.ReferenceId)
+		if !f(fd_Blacklisted_referenceId, value) {
+			return
+		}
+	}
+	if x.BlacklistedAtHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlacklistedAtHeight)
+		if !f(fd_Blacklisted_blacklistedAtHeight, value) {
+			return
+		}
+	}
+	if x.BlacklistedAtTime != nil {
+		value := protoreflect.ValueOfMessage(x.BlacklistedAtTime.ProtoReflect())
+		if !f(fd_Blacklisted_blacklistedAtTime, value) {
+			return
+		}
+	}
+	if x.ExpiresAtHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExpiresAtHeight)
+		if !f(fd_Blacklisted_expiresAtHeight, value) {
+			return
+		}
+	}
+	if x.ExpiresAtTime != nil {
+		value := protoreflect.ValueOfMessage(x.ExpiresAtTime.ProtoReflect())
+		if !f(fd_Blacklisted_expiresAtTime, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Blacklisted) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.Blacklisted.addressBz":
+		return len(x.AddressBz) != 0
+	case "circle.fiattokenfactory.v1.Blacklisted.denom":
+		return x.Denom != ""
+	case "circle.fiattokenfactory.v1.Blacklisted.reason":
+		return x.Reason != ""
+	case "circle.fiattokenfactory.v1.Blacklisted.referenceId":
+		return x.ReferenceId != ""
+	case "circle.fiattokenfactory.v1.Blacklisted.blacklistedAtHeight":
+		return x.BlacklistedAtHeight != int64(0)
+	case "circle.fiattokenfactory.v1.Blacklisted.blacklistedAtTime":
+		return x.BlacklistedAtTime != nil
+	case "circle.fiattokenfactory.v1.Blacklisted.expiresAtHeight":
+		return x.ExpiresAtHeight != int64(0)
+	case "circle.fiattokenfactory.v1.Blacklisted.expiresAtTime":
+		return x.ExpiresAtTime != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.Blacklisted"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.Blacklisted does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Blacklisted) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.Blacklisted.addressBz":
+		x.AddressBz = nil
+	case "circle.fiattokenfactory.v1.Blacklisted.denom":
+		x.Denom = ""
+	case "circle.fiattokenfactory.v1.Blacklisted.reason":
+		x.Reason = ""
+	case "circle.fiattokenfactory.v1.Blacklisted.referenceId":
+		x.ReferenceId = ""
+	case "circle.fiattokenfactory.v1.Blacklisted.blacklistedAtHeight":
+		x.BlacklistedAtHeight = int64(0)
+	case "circle.fiattokenfactory.v1.Blacklisted.blacklistedAtTime":
+		x.BlacklistedAtTime = nil
+	case "circle.fiattokenfactory.v1.Blacklisted.expiresAtHeight":
+		x.ExpiresAtHeight = int64(0)
+	case "circle.fiattokenfactory.v1.Blacklisted.expiresAtTime":
+		x.ExpiresAtTime = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.Blacklisted"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.Blacklisted does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Blacklisted) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "circle.fiattokenfactory.v1.Blacklisted.addressBz":
+		value := x.AddressBz
+		return protoreflect.ValueOfBytes(value)
+	case "circle.fiattokenfactory.v1.Blacklisted.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "circle.fiattokenfactory.v1.Blacklisted.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "circle.fiattokenfactory.v1.Blacklisted.referenceId":
+		value := x.ReferenceId
+		return protoreflect.ValueOfString(value)
+	case "circle.fiattokenfactory.v1.Blacklisted.blacklistedAtHeight":
+		value := x.BlacklistedAtHeight
+		return protoreflect.ValueOfInt64(value)
+	case "circle.fiattokenfactory.v1.Blacklisted.blacklistedAtTime":
+		value := x.BlacklistedAtTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.Blacklisted.expiresAtHeight":
+		value := x.ExpiresAtHeight
+		return protoreflect.ValueOfInt64(value)
+	case "circle.fiattokenfactory.v1.Blacklisted.expiresAtTime":
+		value := x.ExpiresAtTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.Blacklisted"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.Blacklisted does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Blacklisted) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.Blacklisted.addressBz":
+		x.AddressBz = value.Bytes()
+	case "circle.fiattokenfactory.v1.Blacklisted.denom":
+		x.Denom = value.Interface().(string)
+	case "circle.fiattokenfactory.v1.Blacklisted.reason":
+		x.Reason = value.Interface().(string)
+	case "circle.fiattokenfactory.v1.Blacklisted.referenceId":
+		x.ReferenceId = value.Interface().(string)
+	case "circle.fiattokenfactory.v1.Blacklisted.blacklistedAtHeight":
+		x.BlacklistedAtHeight = value.Int()
+	case "circle.fiattokenfactory.v1.Blacklisted.blacklistedAtTime":
+		x.BlacklistedAtTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "circle.fiattokenfactory.v1.Blacklisted.expiresAtHeight":
+		x.ExpiresAtHeight = value.Int()
+	case "circle.fiattokenfactory.v1.Blacklisted.expiresAtTime":
+		x.ExpiresAtTime = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.Blacklisted"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.Blacklisted does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Blacklisted) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.Blacklisted.blacklistedAtTime":
+		if x.BlacklistedAtTime == nil {
+			x.BlacklistedAtTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.BlacklistedAtTime.ProtoReflect())
+	case "circle.fiattokenfactory.v1.Blacklisted.expiresAtTime":
+		if x.ExpiresAtTime == nil {
+			x.ExpiresAtTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExpiresAtTime.ProtoReflect())
+	case "circle.fiattokenfactory.v1.Blacklisted.addressBz":
+		panic(fmt.Errorf("field addressBz of message circle.fiattokenfactory.v1.Blacklisted is not mutable"))
+	case "circle.fiattokenfactory.v1.Blacklisted.denom":
+		panic(fmt.Errorf("field denom of message circle.fiattokenfactory.v1.Blacklisted is not mutable"))
+	case "circle.fiattokenfactory.v1.Blacklisted.reason":
+		panic(fmt.Errorf("field reason of message circle.fiattokenfactory.v1.Blacklisted is not mutable"))
+	case "circle.fiattokenfactory.v1.Blacklisted.referenceId":
+		panic(fmt.Errorf("field referenceId of message circle.fiattokenfactory.v1.Blacklisted is not mutable"))
+	case "circle.fiattokenfactory.v1.Blacklisted.blacklistedAtHeight":
+		panic(fmt.Errorf("field blacklistedAtHeight of message circle.fiattokenfactory.v1.Blacklisted is not mutable"))
+	case "circle.fiattokenfactory.v1.Blacklisted.expiresAtHeight":
+		panic(fmt.Errorf("field expiresAtHeight of message circle.fiattokenfactory.v1.Blacklisted is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.Blacklisted"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.Blacklisted does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Blacklisted) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.Blacklisted.addressBz":
+		return protoreflect.ValueOfBytes(nil)
+	case "circle.fiattokenfactory.v1.Blacklisted.denom":
+		return protoreflect.ValueOfString("")
+	case "circle.fiattokenfactory.v1.Blacklisted.reason":
+		return protoreflect.ValueOfString("")
+	case "circle.fiattokenfactory.v1.Blacklisted.referenceId":
+		return protoreflect.ValueOfString("")
+	case "circle.fiattokenfactory.v1.Blacklisted.blacklistedAtHeight":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "circle.fiattokenfactory.v1.Blacklisted.blacklistedAtTime":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "circle.fiattokenfactory.v1.Blacklisted.expiresAtHeight":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "circle.fiattokenfactory.v1.Blacklisted.expiresAtTime":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.Blacklisted"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.Blacklisted does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Blacklisted) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in circle.fiattokenfactory.v1.Blacklisted", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Blacklisted) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Blacklisted) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Blacklisted) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Blacklisted) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Blacklisted)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.AddressBz)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReferenceId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlacklistedAtHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlacklistedAtHeight))
+		}
+		if x.BlacklistedAtTime != nil {
+			l = options.Size(x.BlacklistedAtTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExpiresAtHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiresAtHeight))
+		}
+		if x.ExpiresAtTime != nil {
+			l = options.Size(x.ExpiresAtTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Blacklisted)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ExpiresAtTime != nil {
+			encoded, err := options.Marshal(x.ExpiresAtTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.ExpiresAtHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiresAtHeight))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.BlacklistedAtTime != nil {
+			encoded, err := options.Marshal(x.BlacklistedAtTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.BlacklistedAtHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlacklistedAtHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.ReferenceId) > 0 {
+			i -= len(x.ReferenceId)
+			copy(dAtA[i:], x.ReferenceId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReferenceId)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.AddressBz) > 0 {
+			i -= len(x.AddressBz)
+			copy(dAtA[i:], x.AddressBz)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AddressBz)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Blacklisted)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Blacklisted: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Blacklisted: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddressBz", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AddressBz = append(x.AddressBz[:0], dAtA[iNdEx:postIndex]...)
+				if x.AddressBz == nil {
+					x.AddressBz = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReferenceId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReferenceId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlacklistedAtHeight", wireType)
+				}
+				x.BlacklistedAtHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlacklistedAtHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlacklistedAtTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BlacklistedAtTime == nil {
+					x.BlacklistedAtTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlacklistedAtTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+				}
+				x.ExpiresAtHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiresAtHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExpiresAtTime == nil {
+					x.ExpiresAtTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpiresAtTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_AddressRendering_2_list)(nil)
+
+type _AddressRendering_2_list struct {
+	list *[]string
+}
+
+func (x *_AddressRendering_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AddressRendering_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_AddressRendering_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AddressRendering_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AddressRendering_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message AddressRendering at list field Bech32 as it is not of Message kind"))
+}
+
+func (x *_AddressRendering_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AddressRendering_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_AddressRendering_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_AddressRendering        protoreflect.MessageDescriptor
+	fd_AddressRendering_hex    protoreflect.FieldDescriptor
+	fd_AddressRendering_bech32 protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_circle_fiattokenfactory_v1_blacklisted_proto_init()
+	md_AddressRendering = File_circle_fiattokenfactory_v1_blacklisted_proto.Messages().ByName("AddressRendering")
+	fd_AddressRendering_hex = md_AddressRendering.Fields().ByName("hex")
+	fd_AddressRendering_bech32 = md_AddressRendering.Fields().ByName("bech32")
+}
+
+var _ protoreflect.Message = (*fastReflection_AddressRendering)(nil)
+
+type fastReflection_AddressRendering AddressRendering
+
+func (x *AddressRendering) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AddressRendering)(x)
+}
+
+func (x *AddressRendering) slowProtoReflect() protoreflect.Message {
+	mi := &file_circle_fiattokenfactory_v1_blacklisted_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AddressRendering_messageType fastReflection_AddressRendering_messageType
+var _ protoreflect.MessageType = fastReflection_AddressRendering_messageType{}
+
+type fastReflection_AddressRendering_messageType struct{}
+
+func (x fastReflection_AddressRendering_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AddressRendering)(nil)
+}
+func (x fastReflection_AddressRendering_messageType) New() protoreflect.Message {
+	return new(fastReflection_AddressRendering)
+}
+func (x fastReflection_AddressRendering_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AddressRendering
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AddressRendering) Descriptor() protoreflect.MessageDescriptor {
+	return md_AddressRendering
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AddressRendering) Type() protoreflect.MessageType {
+	return _fastReflection_AddressRendering_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AddressRendering) New() protoreflect.Message {
+	return new(fastReflection_AddressRendering)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AddressRendering) Interface() protoreflect.ProtoMessage {
+	return (*AddressRendering)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AddressRendering) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Hex != "" {
+		value := protoreflect.ValueOfString(x.Hex)
+		if !f(fd_AddressRendering_hex, value) {
+			return
+		}
+	}
+	if len(x.Bech32) != 0 {
+		value := protoreflect.ValueOfList(&_AddressRendering_2_list{list: &x.Bech32})
+		if !f(fd_AddressRendering_bech32, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -107,15 +1031,17 @@ func (x *fastReflection_Blacklisted) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Blacklisted) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_AddressRendering) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "circle.fiattokenfactory.v1.Blacklisted.addressBz":
-		return len(x.AddressBz) != 0
+	case "circle.fiattokenfactory.v1.AddressRendering.hex":
+		return x.Hex != ""
+	case "circle.fiattokenfactory.v1.AddressRendering.bech32":
+		return len(x.Bech32) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.Blacklisted"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.AddressRendering"))
 		}
-		panic(fmt.Errorf("message circle.fiattokenfactory.v1.Blacklisted does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.AddressRendering does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -125,15 +1051,17 @@ func (x *fastReflection_Blacklisted) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Blacklisted) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_AddressRendering) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "circle.fiattokenfactory.v1.Blacklisted.addressBz":
-		x.AddressBz = nil
+	case "circle.fiattokenfactory.v1.AddressRendering.hex":
+		x.Hex = ""
+	case "circle.fiattokenfactory.v1.AddressRendering.bech32":
+		x.Bech32 = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.Blacklisted"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.AddressRendering"))
 		}
-		panic(fmt.Errorf("message circle.fiattokenfactory.v1.Blacklisted does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.AddressRendering does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -143,16 +1071,22 @@ func (x *fastReflection_Blacklisted) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Blacklisted) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AddressRendering) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "circle.fiattokenfactory.v1.Blacklisted.addressBz":
-		value := x.AddressBz
-		return protoreflect.ValueOfBytes(value)
+	case "circle.fiattokenfactory.v1.AddressRendering.hex":
+		value := x.Hex
+		return protoreflect.ValueOfString(value)
+	case "circle.fiattokenfactory.v1.AddressRendering.bech32":
+		if len(x.Bech32) == 0 {
+			return protoreflect.ValueOfList(&_AddressRendering_2_list{})
+		}
+		listValue := &_AddressRendering_2_list{list: &x.Bech32}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.Blacklisted"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.AddressRendering"))
 		}
-		panic(fmt.Errorf("message circle.fiattokenfactory.v1.Blacklisted does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.AddressRendering does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -166,15 +1100,19 @@ func (x *fastReflection_Blacklisted) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Blacklisted) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_AddressRendering) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "circle.fiattokenfactory.v1.Blacklisted.addressBz":
-		x.AddressBz = value.Bytes()
+	case "circle.fiattokenfactory.v1.AddressRendering.hex":
+		x.Hex = value.Interface().(string)
+	case "circle.fiattokenfactory.v1.AddressRendering.bech32":
+		lv := value.List()
+		clv := lv.(*_AddressRendering_2_list)
+		x.Bech32 = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.Blacklisted"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.AddressRendering"))
 		}
-		panic(fmt.Errorf("message circle.fiattokenfactory.v1.Blacklisted does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.AddressRendering does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -188,40 +1126,49 @@ func (x *fastReflection_Blacklisted) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Blacklisted) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AddressRendering) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "circle.fiattokenfactory.v1.Blacklisted.addressBz":
-		panic(fmt.Errorf("field addressBz of message circle.fiattokenfactory.v1.Blacklisted is not mutable"))
+	case "circle.fiattokenfactory.v1.AddressRendering.bech32":
+		if x.Bech32 == nil {
+			x.Bech32 = []string{}
+		}
+		value := &_AddressRendering_2_list{list: &x.Bech32}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.AddressRendering.hex":
+		panic(fmt.Errorf("field hex of message circle.fiattokenfactory.v1.AddressRendering is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.Blacklisted"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.AddressRendering"))
 		}
-		panic(fmt.Errorf("message circle.fiattokenfactory.v1.Blacklisted does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.AddressRendering does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Blacklisted) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AddressRendering) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "circle.fiattokenfactory.v1.Blacklisted.addressBz":
-		return protoreflect.ValueOfBytes(nil)
+	case "circle.fiattokenfactory.v1.AddressRendering.hex":
+		return protoreflect.ValueOfString("")
+	case "circle.fiattokenfactory.v1.AddressRendering.bech32":
+		list := []string{}
+		return protoreflect.ValueOfList(&_AddressRendering_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.Blacklisted"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.AddressRendering"))
 		}
-		panic(fmt.Errorf("message circle.fiattokenfactory.v1.Blacklisted does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.AddressRendering does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Blacklisted) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_AddressRendering) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in circle.fiattokenfactory.v1.Blacklisted", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in circle.fiattokenfactory.v1.AddressRendering", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -229,7 +1176,7 @@ func (x *fastReflection_Blacklisted) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Blacklisted) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_AddressRendering) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -240,7 +1187,7 @@ func (x *fastReflection_Blacklisted) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Blacklisted) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_AddressRendering) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -252,7 +1199,7 @@ func (x *fastReflection_Blacklisted) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Blacklisted) IsValid() bool {
+func (x *fastReflection_AddressRendering) IsValid() bool {
 	return x != nil
 }
 
@@ -262,9 +1209,9 @@ func (x *fastReflection_Blacklisted) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Blacklisted) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_AddressRendering) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Blacklisted)
+		x := input.Message.Interface().(*AddressRendering)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -276,10 +1223,16 @@ func (x *fastReflection_Blacklisted) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.AddressBz)
+		l = len(x.Hex)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Bech32) > 0 {
+			for _, s := range x.Bech32 {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -290,7 +1243,7 @@ func (x *fastReflection_Blacklisted) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Blacklisted)
+		x := input.Message.Interface().(*AddressRendering)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -309,10 +1262,19 @@ func (x *fastReflection_Blacklisted) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.AddressBz) > 0 {
-			i -= len(x.AddressBz)
-			copy(dAtA[i:], x.AddressBz)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AddressBz)))
+		if len(x.Bech32) > 0 {
+			for iNdEx := len(x.Bech32) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Bech32[iNdEx])
+				copy(dAtA[i:], x.Bech32[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bech32[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Hex) > 0 {
+			i -= len(x.Hex)
+			copy(dAtA[i:], x.Hex)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hex)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -327,7 +1289,7 @@ func (x *fastReflection_Blacklisted) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Blacklisted)
+		x := input.Message.Interface().(*AddressRendering)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -359,17 +1321,17 @@ func (x *fastReflection_Blacklisted) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Blacklisted: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AddressRendering: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Blacklisted: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AddressRendering: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddressBz", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hex", wireType)
 				}
-				var byteLen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -379,25 +1341,55 @@ func (x *fastReflection_Blacklisted) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AddressBz = append(x.AddressBz[:0], dAtA[iNdEx:postIndex]...)
-				if x.AddressBz == nil {
-					x.AddressBz = []byte{}
+				x.Hex = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bech32", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
+				x.Bech32 = append(x.Bech32, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -453,6 +1445,23 @@ type Blacklisted struct {
 	unknownFields protoimpl.UnknownFields
 
 	AddressBz []byte `protobuf:"bytes,1,opt,name=addressBz,proto3" json:"addressBz,omitempty"`
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// reason is an optional reason code given when the address was
+	// blacklisted.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// referenceId optionally refers to the case the address was blacklisted
+	// for.
+	ReferenceId string `protobuf:"bytes,4,opt,name=referenceId,proto3" json:"referenceId,omitempty"`
+	// blacklistedAtHeight and blacklistedAtTime are the block the address was
+	// blacklisted at. They are empty for entries listed before they were
+	// recorded.
+	BlacklistedAtHeight int64                  `protobuf:"varint,5,opt,name=blacklistedAtHeight,proto3" json:"blacklistedAtHeight,omitempty"`
+	BlacklistedAtTime   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=blacklistedAtTime,proto3" json:"blacklistedAtTime,omitempty"`
+	// expiresAtHeight and expiresAtTime optionally bound how long the address
+	// stays blacklisted. The entry is removed at the end of the first block
+	// reaching either of them.
+	ExpiresAtHeight int64                  `protobuf:"varint,7,opt,name=expiresAtHeight,proto3" json:"expiresAtHeight,omitempty"`
+	ExpiresAtTime   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expiresAtTime,proto3" json:"expiresAtTime,omitempty"`
 }
 
 func (x *Blacklisted) Reset() {
@@ -482,6 +1491,102 @@ func (x *Blacklisted) GetAddressBz() []byte {
 	return nil
 }
 
+func (x *Blacklisted) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *Blacklisted) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Blacklisted) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *Blacklisted) GetBlacklistedAtHeight() int64 {
+	if x != nil {
+		return x.BlacklistedAtHeight
+	}
+	return 0
+}
+
+func (x *Blacklisted) GetBlacklistedAtTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BlacklistedAtTime
+	}
+	return nil
+}
+
+func (x *Blacklisted) GetExpiresAtHeight() int64 {
+	if x != nil {
+		return x.ExpiresAtHeight
+	}
+	return 0
+}
+
+func (x *Blacklisted) GetExpiresAtTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAtTime
+	}
+	return nil
+}
+
+// AddressRendering holds human readable encodings of address bytes.
+type AddressRendering struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// hex is the 0x prefixed hex encoding of the address bytes.
+	Hex string `protobuf:"bytes,1,opt,name=hex,proto3" json:"hex,omitempty"`
+	// bech32 are the bech32 encodings of the address bytes, one per rendered
+	// human readable part.
+	Bech32 []string `protobuf:"bytes,2,rep,name=bech32,proto3" json:"bech32,omitempty"`
+}
+
+func (x *AddressRendering) Reset() {
+	*x = AddressRendering{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circle_fiattokenfactory_v1_blacklisted_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressRendering) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressRendering) ProtoMessage() {}
+
+// Deprecated: Use AddressRendering.ProtoReflect.Descriptor instead.
+func (*AddressRendering) Descriptor() ([]byte, []int) {
+	return file_circle_fiattokenfactory_v1_blacklisted_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddressRendering) GetHex() string {
+	if x != nil {
+		return x.Hex
+	}
+	return ""
+}
+
+func (x *AddressRendering) GetBech32() []string {
+	if x != nil {
+		return x.Bech32
+	}
+	return nil
+}
+
 var File_circle_fiattokenfactory_v1_blacklisted_proto protoreflect.FileDescriptor
 
 var file_circle_fiattokenfactory_v1_blacklisted_proto_rawDesc = []byte{
@@ -489,28 +1594,56 @@ var file_circle_fiattokenfactory_v1_blacklisted_proto_rawDesc = []byte{
 	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6c, 0x61,
 	0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1a,
 	0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x22, 0x2b, 0x0a, 0x0b, 0x42, 0x6c,
-	0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x42, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x7a, 0x42, 0x9b, 0x02, 0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e,
-	0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x42, 0x10, 0x42, 0x6c, 0x61, 0x63,
-	0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x5d,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x6c,
-	0x65, 0x66, 0x69, 0x6e, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x66, 0x69, 0x61, 0x74, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x69, 0x61, 0x74, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x76, 0x31, 0xa2, 0x02, 0x03,
-	0x43, 0x46, 0x58, 0xaa, 0x02, 0x1a, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x46, 0x69, 0x61,
-	0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x1a, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x5c, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x26,
-	0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x5c, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1c, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x3a,
-	0x3a, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xef, 0x02, 0x0a, 0x0b, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x7a, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x7a, 0x12,
+	0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x20, 0x0a,
+	0x0b, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x30, 0x0a, 0x13, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x62, 0x6c,
+	0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x41, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x4e, 0x0a, 0x11, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x11,
+	0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x41, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x46, 0x0a, 0x0d, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04,
+	0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0x3c, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x68, 0x65, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x68, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x63,
+	0x68, 0x33, 0x32, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x62, 0x65, 0x63, 0x68, 0x33,
+	0x32, 0x42, 0x9b, 0x02, 0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65,
+	0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x42, 0x10, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65,
+	0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x5d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x66, 0x69, 0x6e, 0x2f, 0x6e,
+	0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65,
+	0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x46, 0x58, 0xaa, 0x02, 0x1a,
+	0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1a, 0x43, 0x69, 0x72,
+	0x63, 0x6c, 0x65, 0x5c, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x26, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65,
+	0x5c, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x1c, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x46, 0x69, 0x61, 0x74, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -525,16 +1658,20 @@ func file_circle_fiattokenfactory_v1_blacklisted_proto_rawDescGZIP() []byte {
 	return file_circle_fiattokenfactory_v1_blacklisted_proto_rawDescData
 }
 
-var file_circle_fiattokenfactory_v1_blacklisted_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_circle_fiattokenfactory_v1_blacklisted_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_circle_fiattokenfactory_v1_blacklisted_proto_goTypes = []interface{}{
-	(*Blacklisted)(nil), // 0: circle.fiattokenfactory.v1.Blacklisted
+	(*Blacklisted)(nil),           // 0: circle.fiattokenfactory.v1.Blacklisted
+	(*AddressRendering)(nil),      // 1: circle.fiattokenfactory.v1.AddressRendering
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
 }
 var file_circle_fiattokenfactory_v1_blacklisted_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2, // 0: circle.fiattokenfactory.v1.Blacklisted.blacklistedAtTime:type_name -> google.protobuf.Timestamp
+	2, // 1: circle.fiattokenfactory.v1.Blacklisted.expiresAtTime:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_circle_fiattokenfactory_v1_blacklisted_proto_init() }
@@ -555,6 +1692,18 @@ func file_circle_fiattokenfactory_v1_blacklisted_proto_init() {
 				return nil
 			}
 		}
+		file_circle_fiattokenfactory_v1_blacklisted_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressRendering); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -562,7 +1711,7 @@ func file_circle_fiattokenfactory_v1_blacklisted_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_circle_fiattokenfactory_v1_blacklisted_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
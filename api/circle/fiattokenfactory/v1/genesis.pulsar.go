@@ -166,747 +166,3268 @@ func (x *_GenesisState_9_list) IsValid() bool {
 	return x.list != nil
 }
 
-var (
-	md_GenesisState                      protoreflect.MessageDescriptor
-	fd_GenesisState_blacklistedList      protoreflect.FieldDescriptor
-	fd_GenesisState_paused               protoreflect.FieldDescriptor
-	fd_GenesisState_masterMinter         protoreflect.FieldDescriptor
-	fd_GenesisState_mintersList          protoreflect.FieldDescriptor
-	fd_GenesisState_pauser               protoreflect.FieldDescriptor
-	fd_GenesisState_blacklister          protoreflect.FieldDescriptor
-	fd_GenesisState_owner                protoreflect.FieldDescriptor
-	fd_GenesisState_minterControllerList protoreflect.FieldDescriptor
-	fd_GenesisState_mintingDenom         protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
 
-func init() {
-	file_circle_fiattokenfactory_v1_genesis_proto_init()
-	md_GenesisState = File_circle_fiattokenfactory_v1_genesis_proto.Messages().ByName("GenesisState")
-	fd_GenesisState_blacklistedList = md_GenesisState.Fields().ByName("blacklistedList")
-	fd_GenesisState_paused = md_GenesisState.Fields().ByName("paused")
-	fd_GenesisState_masterMinter = md_GenesisState.Fields().ByName("masterMinter")
-	fd_GenesisState_mintersList = md_GenesisState.Fields().ByName("mintersList")
-	fd_GenesisState_pauser = md_GenesisState.Fields().ByName("pauser")
-	fd_GenesisState_blacklister = md_GenesisState.Fields().ByName("blacklister")
-	fd_GenesisState_owner = md_GenesisState.Fields().ByName("owner")
-	fd_GenesisState_minterControllerList = md_GenesisState.Fields().ByName("minterControllerList")
-	fd_GenesisState_mintingDenom = md_GenesisState.Fields().ByName("mintingDenom")
+type _GenesisState_11_list struct {
+	list *[]*MintingDenom
 }
 
-var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-type fastReflection_GenesisState GenesisState
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
 
-func (x *GenesisState) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GenesisState)(x)
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintingDenom)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *GenesisState) slowProtoReflect() protoreflect.Message {
-	mi := &file_circle_fiattokenfactory_v1_genesis_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintingDenom)
+	*x.list = append(*x.list, concreteValue)
 }
 
-var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
-var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(MintingDenom)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
 
-type fastReflection_GenesisState_messageType struct{}
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
 
-func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(MintingDenom)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
-func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
 }
-func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*Paused
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
-	return _fastReflection_GenesisState_messageType
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GenesisState) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Paused)
+	(*x.list)[i] = concreteValue
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
-	return (*GenesisState)(x)
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Paused)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.BlacklistedList) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.BlacklistedList})
-		if !f(fd_GenesisState_blacklistedList, value) {
-			return
-		}
-	}
-	if x.Paused != nil {
-		value := protoreflect.ValueOfMessage(x.Paused.ProtoReflect())
-		if !f(fd_GenesisState_paused, value) {
-			return
-		}
-	}
-	if x.MasterMinter != nil {
-		value := protoreflect.ValueOfMessage(x.MasterMinter.ProtoReflect())
-		if !f(fd_GenesisState_masterMinter, value) {
-			return
-		}
-	}
-	if len(x.MintersList) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.MintersList})
-		if !f(fd_GenesisState_mintersList, value) {
-			return
-		}
-	}
-	if x.Pauser != nil {
-		value := protoreflect.ValueOfMessage(x.Pauser.ProtoReflect())
-		if !f(fd_GenesisState_pauser, value) {
-			return
-		}
-	}
-	if x.Blacklister != nil {
-		value := protoreflect.ValueOfMessage(x.Blacklister.ProtoReflect())
-		if !f(fd_GenesisState_blacklister, value) {
-			return
-		}
-	}
-	if x.Owner != nil {
-		value := protoreflect.ValueOfMessage(x.Owner.ProtoReflect())
-		if !f(fd_GenesisState_owner, value) {
-			return
-		}
-	}
-	if len(x.MinterControllerList) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.MinterControllerList})
-		if !f(fd_GenesisState_minterControllerList, value) {
-			return
-		}
-	}
-	if x.MintingDenom != nil {
-		value := protoreflect.ValueOfMessage(x.MintingDenom.ProtoReflect())
-		if !f(fd_GenesisState_mintingDenom, value) {
-			return
-		}
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(Paused)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "circle.fiattokenfactory.v1.GenesisState.blacklistedList":
-		return len(x.BlacklistedList) != 0
-	case "circle.fiattokenfactory.v1.GenesisState.paused":
-		return x.Paused != nil
-	case "circle.fiattokenfactory.v1.GenesisState.masterMinter":
-		return x.MasterMinter != nil
-	case "circle.fiattokenfactory.v1.GenesisState.mintersList":
-		return len(x.MintersList) != 0
-	case "circle.fiattokenfactory.v1.GenesisState.pauser":
-		return x.Pauser != nil
-	case "circle.fiattokenfactory.v1.GenesisState.blacklister":
-		return x.Blacklister != nil
-	case "circle.fiattokenfactory.v1.GenesisState.owner":
-		return x.Owner != nil
-	case "circle.fiattokenfactory.v1.GenesisState.minterControllerList":
-		return len(x.MinterControllerList) != 0
-	case "circle.fiattokenfactory.v1.GenesisState.mintingDenom":
-		return x.MintingDenom != nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message circle.fiattokenfactory.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(Paused)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_13_list)(nil)
+
+type _GenesisState_13_list struct {
+	list *[]*MasterMinter
+}
+
+func (x *_GenesisState_13_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "circle.fiattokenfactory.v1.GenesisState.blacklistedList":
-		x.BlacklistedList = nil
-	case "circle.fiattokenfactory.v1.GenesisState.paused":
-		x.Paused = nil
-	case "circle.fiattokenfactory.v1.GenesisState.masterMinter":
-		x.MasterMinter = nil
-	case "circle.fiattokenfactory.v1.GenesisState.mintersList":
-		x.MintersList = nil
-	case "circle.fiattokenfactory.v1.GenesisState.pauser":
-		x.Pauser = nil
-	case "circle.fiattokenfactory.v1.GenesisState.blacklister":
-		x.Blacklister = nil
-	case "circle.fiattokenfactory.v1.GenesisState.owner":
-		x.Owner = nil
-	case "circle.fiattokenfactory.v1.GenesisState.minterControllerList":
-		x.MinterControllerList = nil
-	case "circle.fiattokenfactory.v1.GenesisState.mintingDenom":
-		x.MintingDenom = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message circle.fiattokenfactory.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MasterMinter)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MasterMinter)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_13_list) AppendMutable() protoreflect.Value {
+	v := new(MasterMinter)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "circle.fiattokenfactory.v1.GenesisState.blacklistedList":
-		if len(x.BlacklistedList) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_2_list{})
-		}
-		listValue := &_GenesisState_2_list{list: &x.BlacklistedList}
-		return protoreflect.ValueOfList(listValue)
-	case "circle.fiattokenfactory.v1.GenesisState.paused":
-		value := x.Paused
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "circle.fiattokenfactory.v1.GenesisState.masterMinter":
-		value := x.MasterMinter
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "circle.fiattokenfactory.v1.GenesisState.mintersList":
-		if len(x.MintersList) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_5_list{})
-		}
-		listValue := &_GenesisState_5_list{list: &x.MintersList}
-		return protoreflect.ValueOfList(listValue)
-	case "circle.fiattokenfactory.v1.GenesisState.pauser":
-		value := x.Pauser
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "circle.fiattokenfactory.v1.GenesisState.blacklister":
-		value := x.Blacklister
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "circle.fiattokenfactory.v1.GenesisState.owner":
-		value := x.Owner
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "circle.fiattokenfactory.v1.GenesisState.minterControllerList":
-		if len(x.MinterControllerList) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_9_list{})
-		}
-		listValue := &_GenesisState_9_list{list: &x.MinterControllerList}
-		return protoreflect.ValueOfList(listValue)
-	case "circle.fiattokenfactory.v1.GenesisState.mintingDenom":
-		value := x.MintingDenom
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message circle.fiattokenfactory.v1.GenesisState does not contain field %s", descriptor.FullName()))
-	}
+func (x *_GenesisState_13_list) NewElement() protoreflect.Value {
+	v := new(MasterMinter)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "circle.fiattokenfactory.v1.GenesisState.blacklistedList":
-		lv := value.List()
-		clv := lv.(*_GenesisState_2_list)
-		x.BlacklistedList = *clv.list
-	case "circle.fiattokenfactory.v1.GenesisState.paused":
-		x.Paused = value.Message().Interface().(*Paused)
-	case "circle.fiattokenfactory.v1.GenesisState.masterMinter":
-		x.MasterMinter = value.Message().Interface().(*MasterMinter)
-	case "circle.fiattokenfactory.v1.GenesisState.mintersList":
-		lv := value.List()
-		clv := lv.(*_GenesisState_5_list)
-		x.MintersList = *clv.list
-	case "circle.fiattokenfactory.v1.GenesisState.pauser":
-		x.Pauser = value.Message().Interface().(*Pauser)
-	case "circle.fiattokenfactory.v1.GenesisState.blacklister":
-		x.Blacklister = value.Message().Interface().(*Blacklister)
-	case "circle.fiattokenfactory.v1.GenesisState.owner":
-		x.Owner = value.Message().Interface().(*Owner)
-	case "circle.fiattokenfactory.v1.GenesisState.minterControllerList":
-		lv := value.List()
-		clv := lv.(*_GenesisState_9_list)
-		x.MinterControllerList = *clv.list
-	case "circle.fiattokenfactory.v1.GenesisState.mintingDenom":
-		x.MintingDenom = value.Message().Interface().(*MintingDenom)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message circle.fiattokenfactory.v1.GenesisState does not contain field %s", fd.FullName()))
-	}
+func (x *_GenesisState_13_list) IsValid() bool {
+	return x.list != nil
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "circle.fiattokenfactory.v1.GenesisState.blacklistedList":
-		if x.BlacklistedList == nil {
-			x.BlacklistedList = []*Blacklisted{}
-		}
-		value := &_GenesisState_2_list{list: &x.BlacklistedList}
-		return protoreflect.ValueOfList(value)
-	case "circle.fiattokenfactory.v1.GenesisState.paused":
-		if x.Paused == nil {
-			x.Paused = new(Paused)
-		}
-		return protoreflect.ValueOfMessage(x.Paused.ProtoReflect())
-	case "circle.fiattokenfactory.v1.GenesisState.masterMinter":
-		if x.MasterMinter == nil {
-			x.MasterMinter = new(MasterMinter)
-		}
-		return protoreflect.ValueOfMessage(x.MasterMinter.ProtoReflect())
-	case "circle.fiattokenfactory.v1.GenesisState.mintersList":
-		if x.MintersList == nil {
-			x.MintersList = []*Minters{}
-		}
-		value := &_GenesisState_5_list{list: &x.MintersList}
-		return protoreflect.ValueOfList(value)
-	case "circle.fiattokenfactory.v1.GenesisState.pauser":
-		if x.Pauser == nil {
-			x.Pauser = new(Pauser)
-		}
-		return protoreflect.ValueOfMessage(x.Pauser.ProtoReflect())
-	case "circle.fiattokenfactory.v1.GenesisState.blacklister":
-		if x.Blacklister == nil {
-			x.Blacklister = new(Blacklister)
-		}
-		return protoreflect.ValueOfMessage(x.Blacklister.ProtoReflect())
-	case "circle.fiattokenfactory.v1.GenesisState.owner":
-		if x.Owner == nil {
-			x.Owner = new(Owner)
-		}
-		return protoreflect.ValueOfMessage(x.Owner.ProtoReflect())
-	case "circle.fiattokenfactory.v1.GenesisState.minterControllerList":
-		if x.MinterControllerList == nil {
-			x.MinterControllerList = []*MinterController{}
-		}
-		value := &_GenesisState_9_list{list: &x.MinterControllerList}
-		return protoreflect.ValueOfList(value)
-	case "circle.fiattokenfactory.v1.GenesisState.mintingDenom":
-		if x.MintingDenom == nil {
-			x.MintingDenom = new(MintingDenom)
-		}
-		return protoreflect.ValueOfMessage(x.MintingDenom.ProtoReflect())
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message circle.fiattokenfactory.v1.GenesisState does not contain field %s", fd.FullName()))
-	}
+var _ protoreflect.List = (*_GenesisState_14_list)(nil)
+
+type _GenesisState_14_list struct {
+	list *[]*Pauser
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "circle.fiattokenfactory.v1.GenesisState.blacklistedList":
-		list := []*Blacklisted{}
-		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
-	case "circle.fiattokenfactory.v1.GenesisState.paused":
-		m := new(Paused)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "circle.fiattokenfactory.v1.GenesisState.masterMinter":
-		m := new(MasterMinter)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "circle.fiattokenfactory.v1.GenesisState.mintersList":
-		list := []*Minters{}
-		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
-	case "circle.fiattokenfactory.v1.GenesisState.pauser":
-		m := new(Pauser)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "circle.fiattokenfactory.v1.GenesisState.blacklister":
-		m := new(Blacklister)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "circle.fiattokenfactory.v1.GenesisState.owner":
-		m := new(Owner)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "circle.fiattokenfactory.v1.GenesisState.minterControllerList":
-		list := []*MinterController{}
-		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
-	case "circle.fiattokenfactory.v1.GenesisState.mintingDenom":
-		m := new(MintingDenom)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message circle.fiattokenfactory.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_14_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in circle.fiattokenfactory.v1.GenesisState", d.FullName()))
+func (x *_GenesisState_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Pauser)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Pauser)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_14_list) AppendMutable() protoreflect.Value {
+	v := new(Pauser)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	panic("unreachable")
+	*x.list = (*x.list)[:n]
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *_GenesisState_14_list) NewElement() protoreflect.Value {
+	v := new(Pauser)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *_GenesisState_14_list) IsValid() bool {
+	return x.list != nil
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_GenesisState) IsValid() bool {
-	return x != nil
+var _ protoreflect.List = (*_GenesisState_15_list)(nil)
+
+type _GenesisState_15_list struct {
+	list *[]*Blacklister
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if len(x.BlacklistedList) > 0 {
-			for _, e := range x.BlacklistedList {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Paused != nil {
-			l = options.Size(x.Paused)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.MasterMinter != nil {
-			l = options.Size(x.MasterMinter)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.MintersList) > 0 {
-			for _, e := range x.MintersList {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Pauser != nil {
-			l = options.Size(x.Pauser)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Blacklister != nil {
-			l = options.Size(x.Blacklister)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Owner != nil {
-			l = options.Size(x.Owner)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.MinterControllerList) > 0 {
-			for _, e := range x.MinterControllerList {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.MintingDenom != nil {
-			l = options.Size(x.MintingDenom)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+func (x *_GenesisState_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Blacklister)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Blacklister)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_15_list) AppendMutable() protoreflect.Value {
+	v := new(Blacklister)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if x.MintingDenom != nil {
-			encoded, err := options.Marshal(x.MintingDenom)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x52
-		}
-		if len(x.MinterControllerList) > 0 {
-			for iNdEx := len(x.MinterControllerList) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.MinterControllerList[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+func (x *_GenesisState_15_list) NewElement() protoreflect.Value {
+	v := new(Blacklister)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_16_list)(nil)
+
+type _GenesisState_16_list struct {
+	list *[]*Owner
+}
+
+func (x *_GenesisState_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Owner)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Owner)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_16_list) AppendMutable() protoreflect.Value {
+	v := new(Owner)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_16_list) NewElement() protoreflect.Value {
+	v := new(Owner)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_17_list)(nil)
+
+type _GenesisState_17_list struct {
+	list *[]*AuditEntry
+}
+
+func (x *_GenesisState_17_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_17_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AuditEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_17_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AuditEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_17_list) AppendMutable() protoreflect.Value {
+	v := new(AuditEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_17_list) NewElement() protoreflect.Value {
+	v := new(AuditEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_18_list)(nil)
+
+type _GenesisState_18_list struct {
+	list *[]*SignerSet
+}
+
+func (x *_GenesisState_18_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_18_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SignerSet)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_18_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SignerSet)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_18_list) AppendMutable() protoreflect.Value {
+	v := new(SignerSet)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_18_list) NewElement() protoreflect.Value {
+	v := new(SignerSet)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_19_list)(nil)
+
+type _GenesisState_19_list struct {
+	list *[]*ActionProposal
+}
+
+func (x *_GenesisState_19_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_19_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ActionProposal)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_19_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ActionProposal)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_19_list) AppendMutable() protoreflect.Value {
+	v := new(ActionProposal)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_19_list) NewElement() protoreflect.Value {
+	v := new(ActionProposal)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_20_list)(nil)
+
+type _GenesisState_20_list struct {
+	list *[]*QueuedAction
+}
+
+func (x *_GenesisState_20_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_20_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_20_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QueuedAction)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_20_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QueuedAction)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_20_list) AppendMutable() protoreflect.Value {
+	v := new(QueuedAction)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_20_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_20_list) NewElement() protoreflect.Value {
+	v := new(QueuedAction)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_20_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_21_list)(nil)
+
+type _GenesisState_21_list struct {
+	list *[]*ChannelPolicy
+}
+
+func (x *_GenesisState_21_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_21_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_21_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChannelPolicy)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_21_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChannelPolicy)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_21_list) AppendMutable() protoreflect.Value {
+	v := new(ChannelPolicy)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_21_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_21_list) NewElement() protoreflect.Value {
+	v := new(ChannelPolicy)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_21_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_22_list)(nil)
+
+type _GenesisState_22_list struct {
+	list *[]*ChannelRateLimit
+}
+
+func (x *_GenesisState_22_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_22_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_22_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChannelRateLimit)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_22_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChannelRateLimit)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_22_list) AppendMutable() protoreflect.Value {
+	v := new(ChannelRateLimit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_22_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_22_list) NewElement() protoreflect.Value {
+	v := new(ChannelRateLimit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_22_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_23_list)(nil)
+
+type _GenesisState_23_list struct {
+	list *[]*PendingSendPacket
+}
+
+func (x *_GenesisState_23_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_23_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_23_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingSendPacket)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_23_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingSendPacket)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_23_list) AppendMutable() protoreflect.Value {
+	v := new(PendingSendPacket)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_23_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_23_list) NewElement() protoreflect.Value {
+	v := new(PendingSendPacket)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_23_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_24_list)(nil)
+
+type _GenesisState_24_list struct {
+	list *[]*Allowlister
+}
+
+func (x *_GenesisState_24_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_24_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_24_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Allowlister)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_24_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Allowlister)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_24_list) AppendMutable() protoreflect.Value {
+	v := new(Allowlister)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_24_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_24_list) NewElement() protoreflect.Value {
+	v := new(Allowlister)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_24_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_25_list)(nil)
+
+type _GenesisState_25_list struct {
+	list *[]*Allowlisted
+}
+
+func (x *_GenesisState_25_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_25_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_25_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Allowlisted)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_25_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Allowlisted)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_25_list) AppendMutable() protoreflect.Value {
+	v := new(Allowlisted)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_25_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_25_list) NewElement() protoreflect.Value {
+	v := new(Allowlisted)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_25_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_26_list)(nil)
+
+type _GenesisState_26_list struct {
+	list *[]string
+}
+
+func (x *_GenesisState_26_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_26_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_GenesisState_26_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_26_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_26_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field AllowlistEnabledDenoms as it is not of Message kind"))
+}
+
+func (x *_GenesisState_26_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_26_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_26_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_27_list)(nil)
+
+type _GenesisState_27_list struct {
+	list *[]*Owner
+}
+
+func (x *_GenesisState_27_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_27_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_27_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Owner)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_27_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Owner)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_27_list) AppendMutable() protoreflect.Value {
+	v := new(Owner)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_27_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_27_list) NewElement() protoreflect.Value {
+	v := new(Owner)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_27_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_28_list)(nil)
+
+type _GenesisState_28_list struct {
+	list *[]*PendingRole
+}
+
+func (x *_GenesisState_28_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_28_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_28_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingRole)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_28_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingRole)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_28_list) AppendMutable() protoreflect.Value {
+	v := new(PendingRole)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_28_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_28_list) NewElement() protoreflect.Value {
+	v := new(PendingRole)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_28_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GenesisState                        protoreflect.MessageDescriptor
+	fd_GenesisState_params                 protoreflect.FieldDescriptor
+	fd_GenesisState_blacklistedList        protoreflect.FieldDescriptor
+	fd_GenesisState_mintersList            protoreflect.FieldDescriptor
+	fd_GenesisState_minterControllerList   protoreflect.FieldDescriptor
+	fd_GenesisState_paused                 protoreflect.FieldDescriptor
+	fd_GenesisState_masterMinter           protoreflect.FieldDescriptor
+	fd_GenesisState_pauser                 protoreflect.FieldDescriptor
+	fd_GenesisState_blacklister            protoreflect.FieldDescriptor
+	fd_GenesisState_owner                  protoreflect.FieldDescriptor
+	fd_GenesisState_mintingDenom           protoreflect.FieldDescriptor
+	fd_GenesisState_mintingDenomList       protoreflect.FieldDescriptor
+	fd_GenesisState_pausedList             protoreflect.FieldDescriptor
+	fd_GenesisState_masterMinterList       protoreflect.FieldDescriptor
+	fd_GenesisState_pauserList             protoreflect.FieldDescriptor
+	fd_GenesisState_blacklisterList        protoreflect.FieldDescriptor
+	fd_GenesisState_ownerList              protoreflect.FieldDescriptor
+	fd_GenesisState_auditLog               protoreflect.FieldDescriptor
+	fd_GenesisState_signerSets             protoreflect.FieldDescriptor
+	fd_GenesisState_actionProposals        protoreflect.FieldDescriptor
+	fd_GenesisState_queuedActions          protoreflect.FieldDescriptor
+	fd_GenesisState_channelPolicies        protoreflect.FieldDescriptor
+	fd_GenesisState_channelRateLimits      protoreflect.FieldDescriptor
+	fd_GenesisState_pendingSendPackets     protoreflect.FieldDescriptor
+	fd_GenesisState_allowlisterList        protoreflect.FieldDescriptor
+	fd_GenesisState_allowlistedList        protoreflect.FieldDescriptor
+	fd_GenesisState_allowlistEnabledDenoms protoreflect.FieldDescriptor
+	fd_GenesisState_pendingOwnerList       protoreflect.FieldDescriptor
+	fd_GenesisState_pendingRoles           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_circle_fiattokenfactory_v1_genesis_proto_init()
+	md_GenesisState = File_circle_fiattokenfactory_v1_genesis_proto.Messages().ByName("GenesisState")
+	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_blacklistedList = md_GenesisState.Fields().ByName("blacklistedList")
+	fd_GenesisState_mintersList = md_GenesisState.Fields().ByName("mintersList")
+	fd_GenesisState_minterControllerList = md_GenesisState.Fields().ByName("minterControllerList")
+	fd_GenesisState_paused = md_GenesisState.Fields().ByName("paused")
+	fd_GenesisState_masterMinter = md_GenesisState.Fields().ByName("masterMinter")
+	fd_GenesisState_pauser = md_GenesisState.Fields().ByName("pauser")
+	fd_GenesisState_blacklister = md_GenesisState.Fields().ByName("blacklister")
+	fd_GenesisState_owner = md_GenesisState.Fields().ByName("owner")
+	fd_GenesisState_mintingDenom = md_GenesisState.Fields().ByName("mintingDenom")
+	fd_GenesisState_mintingDenomList = md_GenesisState.Fields().ByName("mintingDenomList")
+	fd_GenesisState_pausedList = md_GenesisState.Fields().ByName("pausedList")
+	fd_GenesisState_masterMinterList = md_GenesisState.Fields().ByName("masterMinterList")
+	fd_GenesisState_pauserList = md_GenesisState.Fields().ByName("pauserList")
+	fd_GenesisState_blacklisterList = md_GenesisState.Fields().ByName("blacklisterList")
+	fd_GenesisState_ownerList = md_GenesisState.Fields().ByName("ownerList")
+	fd_GenesisState_auditLog = md_GenesisState.Fields().ByName("auditLog")
+	fd_GenesisState_signerSets = md_GenesisState.Fields().ByName("signerSets")
+	fd_GenesisState_actionProposals = md_GenesisState.Fields().ByName("actionProposals")
+	fd_GenesisState_queuedActions = md_GenesisState.Fields().ByName("queuedActions")
+	fd_GenesisState_channelPolicies = md_GenesisState.Fields().ByName("channelPolicies")
+	fd_GenesisState_channelRateLimits = md_GenesisState.Fields().ByName("channelRateLimits")
+	fd_GenesisState_pendingSendPackets = md_GenesisState.Fields().ByName("pendingSendPackets")
+	fd_GenesisState_allowlisterList = md_GenesisState.Fields().ByName("allowlisterList")
+	fd_GenesisState_allowlistedList = md_GenesisState.Fields().ByName("allowlistedList")
+	fd_GenesisState_allowlistEnabledDenoms = md_GenesisState.Fields().ByName("allowlistEnabledDenoms")
+	fd_GenesisState_pendingOwnerList = md_GenesisState.Fields().ByName("pendingOwnerList")
+	fd_GenesisState_pendingRoles = md_GenesisState.Fields().ByName("pendingRoles")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+
+type fastReflection_GenesisState GenesisState
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisState)(x)
+}
+
+func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+	mi := &file_circle_fiattokenfactory_v1_genesis_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+
+type fastReflection_GenesisState_messageType struct{}
+
+func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisState)(nil)
+}
+func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisState) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
+	return (*GenesisState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_GenesisState_params, value) {
+			return
+		}
+	}
+	if len(x.BlacklistedList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.BlacklistedList})
+		if !f(fd_GenesisState_blacklistedList, value) {
+			return
+		}
+	}
+	if len(x.MintersList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.MintersList})
+		if !f(fd_GenesisState_mintersList, value) {
+			return
+		}
+	}
+	if len(x.MinterControllerList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.MinterControllerList})
+		if !f(fd_GenesisState_minterControllerList, value) {
+			return
+		}
+	}
+	if x.Paused != nil {
+		value := protoreflect.ValueOfMessage(x.Paused.ProtoReflect())
+		if !f(fd_GenesisState_paused, value) {
+			return
+		}
+	}
+	if x.MasterMinter != nil {
+		value := protoreflect.ValueOfMessage(x.MasterMinter.ProtoReflect())
+		if !f(fd_GenesisState_masterMinter, value) {
+			return
+		}
+	}
+	if x.Pauser != nil {
+		value := protoreflect.ValueOfMessage(x.Pauser.ProtoReflect())
+		if !f(fd_GenesisState_pauser, value) {
+			return
+		}
+	}
+	if x.Blacklister != nil {
+		value := protoreflect.ValueOfMessage(x.Blacklister.ProtoReflect())
+		if !f(fd_GenesisState_blacklister, value) {
+			return
+		}
+	}
+	if x.Owner != nil {
+		value := protoreflect.ValueOfMessage(x.Owner.ProtoReflect())
+		if !f(fd_GenesisState_owner, value) {
+			return
+		}
+	}
+	if x.MintingDenom != nil {
+		value := protoreflect.ValueOfMessage(x.MintingDenom.ProtoReflect())
+		if !f(fd_GenesisState_mintingDenom, value) {
+			return
+		}
+	}
+	if len(x.MintingDenomList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.MintingDenomList})
+		if !f(fd_GenesisState_mintingDenomList, value) {
+			return
+		}
+	}
+	if len(x.PausedList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.PausedList})
+		if !f(fd_GenesisState_pausedList, value) {
+			return
+		}
+	}
+	if len(x.MasterMinterList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_13_list{list: &x.MasterMinterList})
+		if !f(fd_GenesisState_masterMinterList, value) {
+			return
+		}
+	}
+	if len(x.PauserList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_14_list{list: &x.PauserList})
+		if !f(fd_GenesisState_pauserList, value) {
+			return
+		}
+	}
+	if len(x.BlacklisterList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_15_list{list: &x.BlacklisterList})
+		if !f(fd_GenesisState_blacklisterList, value) {
+			return
+		}
+	}
+	if len(x.OwnerList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_16_list{list: &x.OwnerList})
+		if !f(fd_GenesisState_ownerList, value) {
+			return
+		}
+	}
+	if len(x.AuditLog) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_17_list{list: &x.AuditLog})
+		if !f(fd_GenesisState_auditLog, value) {
+			return
+		}
+	}
+	if len(x.SignerSets) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_18_list{list: &x.SignerSets})
+		if !f(fd_GenesisState_signerSets, value) {
+			return
+		}
+	}
+	if len(x.ActionProposals) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_19_list{list: &x.ActionProposals})
+		if !f(fd_GenesisState_actionProposals, value) {
+			return
+		}
+	}
+	if len(x.QueuedActions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_20_list{list: &x.QueuedActions})
+		if !f(fd_GenesisState_queuedActions, value) {
+			return
+		}
+	}
+	if len(x.ChannelPolicies) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_21_list{list: &x.ChannelPolicies})
+		if !f(fd_GenesisState_channelPolicies, value) {
+			return
+		}
+	}
+	if len(x.ChannelRateLimits) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_22_list{list: &x.ChannelRateLimits})
+		if !f(fd_GenesisState_channelRateLimits, value) {
+			return
+		}
+	}
+	if len(x.PendingSendPackets) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_23_list{list: &x.PendingSendPackets})
+		if !f(fd_GenesisState_pendingSendPackets, value) {
+			return
+		}
+	}
+	if len(x.AllowlisterList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_24_list{list: &x.AllowlisterList})
+		if !f(fd_GenesisState_allowlisterList, value) {
+			return
+		}
+	}
+	if len(x.AllowlistedList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_25_list{list: &x.AllowlistedList})
+		if !f(fd_GenesisState_allowlistedList, value) {
+			return
+		}
+	}
+	if len(x.AllowlistEnabledDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_26_list{list: &x.AllowlistEnabledDenoms})
+		if !f(fd_GenesisState_allowlistEnabledDenoms, value) {
+			return
+		}
+	}
+	if len(x.PendingOwnerList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_27_list{list: &x.PendingOwnerList})
+		if !f(fd_GenesisState_pendingOwnerList, value) {
+			return
+		}
+	}
+	if len(x.PendingRoles) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_28_list{list: &x.PendingRoles})
+		if !f(fd_GenesisState_pendingRoles, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.GenesisState.params":
+		return x.Params != nil
+	case "circle.fiattokenfactory.v1.GenesisState.blacklistedList":
+		return len(x.BlacklistedList) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.mintersList":
+		return len(x.MintersList) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.minterControllerList":
+		return len(x.MinterControllerList) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.paused":
+		return x.Paused != nil
+	case "circle.fiattokenfactory.v1.GenesisState.masterMinter":
+		return x.MasterMinter != nil
+	case "circle.fiattokenfactory.v1.GenesisState.pauser":
+		return x.Pauser != nil
+	case "circle.fiattokenfactory.v1.GenesisState.blacklister":
+		return x.Blacklister != nil
+	case "circle.fiattokenfactory.v1.GenesisState.owner":
+		return x.Owner != nil
+	case "circle.fiattokenfactory.v1.GenesisState.mintingDenom":
+		return x.MintingDenom != nil
+	case "circle.fiattokenfactory.v1.GenesisState.mintingDenomList":
+		return len(x.MintingDenomList) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.pausedList":
+		return len(x.PausedList) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.masterMinterList":
+		return len(x.MasterMinterList) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.pauserList":
+		return len(x.PauserList) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.blacklisterList":
+		return len(x.BlacklisterList) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.ownerList":
+		return len(x.OwnerList) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.auditLog":
+		return len(x.AuditLog) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.signerSets":
+		return len(x.SignerSets) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.actionProposals":
+		return len(x.ActionProposals) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.queuedActions":
+		return len(x.QueuedActions) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.channelPolicies":
+		return len(x.ChannelPolicies) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.channelRateLimits":
+		return len(x.ChannelRateLimits) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.pendingSendPackets":
+		return len(x.PendingSendPackets) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.allowlisterList":
+		return len(x.AllowlisterList) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.allowlistedList":
+		return len(x.AllowlistedList) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.allowlistEnabledDenoms":
+		return len(x.AllowlistEnabledDenoms) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.pendingOwnerList":
+		return len(x.PendingOwnerList) != 0
+	case "circle.fiattokenfactory.v1.GenesisState.pendingRoles":
+		return len(x.PendingRoles) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.GenesisState.params":
+		x.Params = nil
+	case "circle.fiattokenfactory.v1.GenesisState.blacklistedList":
+		x.BlacklistedList = nil
+	case "circle.fiattokenfactory.v1.GenesisState.mintersList":
+		x.MintersList = nil
+	case "circle.fiattokenfactory.v1.GenesisState.minterControllerList":
+		x.MinterControllerList = nil
+	case "circle.fiattokenfactory.v1.GenesisState.paused":
+		x.Paused = nil
+	case "circle.fiattokenfactory.v1.GenesisState.masterMinter":
+		x.MasterMinter = nil
+	case "circle.fiattokenfactory.v1.GenesisState.pauser":
+		x.Pauser = nil
+	case "circle.fiattokenfactory.v1.GenesisState.blacklister":
+		x.Blacklister = nil
+	case "circle.fiattokenfactory.v1.GenesisState.owner":
+		x.Owner = nil
+	case "circle.fiattokenfactory.v1.GenesisState.mintingDenom":
+		x.MintingDenom = nil
+	case "circle.fiattokenfactory.v1.GenesisState.mintingDenomList":
+		x.MintingDenomList = nil
+	case "circle.fiattokenfactory.v1.GenesisState.pausedList":
+		x.PausedList = nil
+	case "circle.fiattokenfactory.v1.GenesisState.masterMinterList":
+		x.MasterMinterList = nil
+	case "circle.fiattokenfactory.v1.GenesisState.pauserList":
+		x.PauserList = nil
+	case "circle.fiattokenfactory.v1.GenesisState.blacklisterList":
+		x.BlacklisterList = nil
+	case "circle.fiattokenfactory.v1.GenesisState.ownerList":
+		x.OwnerList = nil
+	case "circle.fiattokenfactory.v1.GenesisState.auditLog":
+		x.AuditLog = nil
+	case "circle.fiattokenfactory.v1.GenesisState.signerSets":
+		x.SignerSets = nil
+	case "circle.fiattokenfactory.v1.GenesisState.actionProposals":
+		x.ActionProposals = nil
+	case "circle.fiattokenfactory.v1.GenesisState.queuedActions":
+		x.QueuedActions = nil
+	case "circle.fiattokenfactory.v1.GenesisState.channelPolicies":
+		x.ChannelPolicies = nil
+	case "circle.fiattokenfactory.v1.GenesisState.channelRateLimits":
+		x.ChannelRateLimits = nil
+	case "circle.fiattokenfactory.v1.GenesisState.pendingSendPackets":
+		x.PendingSendPackets = nil
+	case "circle.fiattokenfactory.v1.GenesisState.allowlisterList":
+		x.AllowlisterList = nil
+	case "circle.fiattokenfactory.v1.GenesisState.allowlistedList":
+		x.AllowlistedList = nil
+	case "circle.fiattokenfactory.v1.GenesisState.allowlistEnabledDenoms":
+		x.AllowlistEnabledDenoms = nil
+	case "circle.fiattokenfactory.v1.GenesisState.pendingOwnerList":
+		x.PendingOwnerList = nil
+	case "circle.fiattokenfactory.v1.GenesisState.pendingRoles":
+		x.PendingRoles = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "circle.fiattokenfactory.v1.GenesisState.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.blacklistedList":
+		if len(x.BlacklistedList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_2_list{})
+		}
+		listValue := &_GenesisState_2_list{list: &x.BlacklistedList}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.mintersList":
+		if len(x.MintersList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.MintersList}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.minterControllerList":
+		if len(x.MinterControllerList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.MinterControllerList}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.paused":
+		value := x.Paused
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.masterMinter":
+		value := x.MasterMinter
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.pauser":
+		value := x.Pauser
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.blacklister":
+		value := x.Blacklister
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.owner":
+		value := x.Owner
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.mintingDenom":
+		value := x.MintingDenom
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.mintingDenomList":
+		if len(x.MintingDenomList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.MintingDenomList}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.pausedList":
+		if len(x.PausedList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.PausedList}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.masterMinterList":
+		if len(x.MasterMinterList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_13_list{})
+		}
+		listValue := &_GenesisState_13_list{list: &x.MasterMinterList}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.pauserList":
+		if len(x.PauserList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_14_list{})
+		}
+		listValue := &_GenesisState_14_list{list: &x.PauserList}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.blacklisterList":
+		if len(x.BlacklisterList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_15_list{})
+		}
+		listValue := &_GenesisState_15_list{list: &x.BlacklisterList}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.ownerList":
+		if len(x.OwnerList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_16_list{})
+		}
+		listValue := &_GenesisState_16_list{list: &x.OwnerList}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.auditLog":
+		if len(x.AuditLog) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_17_list{})
+		}
+		listValue := &_GenesisState_17_list{list: &x.AuditLog}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.signerSets":
+		if len(x.SignerSets) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_18_list{})
+		}
+		listValue := &_GenesisState_18_list{list: &x.SignerSets}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.actionProposals":
+		if len(x.ActionProposals) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_19_list{})
+		}
+		listValue := &_GenesisState_19_list{list: &x.ActionProposals}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.queuedActions":
+		if len(x.QueuedActions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_20_list{})
+		}
+		listValue := &_GenesisState_20_list{list: &x.QueuedActions}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.channelPolicies":
+		if len(x.ChannelPolicies) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_21_list{})
+		}
+		listValue := &_GenesisState_21_list{list: &x.ChannelPolicies}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.channelRateLimits":
+		if len(x.ChannelRateLimits) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_22_list{})
+		}
+		listValue := &_GenesisState_22_list{list: &x.ChannelRateLimits}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.pendingSendPackets":
+		if len(x.PendingSendPackets) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_23_list{})
+		}
+		listValue := &_GenesisState_23_list{list: &x.PendingSendPackets}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.allowlisterList":
+		if len(x.AllowlisterList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_24_list{})
+		}
+		listValue := &_GenesisState_24_list{list: &x.AllowlisterList}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.allowlistedList":
+		if len(x.AllowlistedList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_25_list{})
+		}
+		listValue := &_GenesisState_25_list{list: &x.AllowlistedList}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.allowlistEnabledDenoms":
+		if len(x.AllowlistEnabledDenoms) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_26_list{})
+		}
+		listValue := &_GenesisState_26_list{list: &x.AllowlistEnabledDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.pendingOwnerList":
+		if len(x.PendingOwnerList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_27_list{})
+		}
+		listValue := &_GenesisState_27_list{list: &x.PendingOwnerList}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.GenesisState.pendingRoles":
+		if len(x.PendingRoles) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_28_list{})
+		}
+		listValue := &_GenesisState_28_list{list: &x.PendingRoles}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.GenesisState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.GenesisState.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "circle.fiattokenfactory.v1.GenesisState.blacklistedList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_2_list)
+		x.BlacklistedList = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.mintersList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.MintersList = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.minterControllerList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.MinterControllerList = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.paused":
+		x.Paused = value.Message().Interface().(*Paused)
+	case "circle.fiattokenfactory.v1.GenesisState.masterMinter":
+		x.MasterMinter = value.Message().Interface().(*MasterMinter)
+	case "circle.fiattokenfactory.v1.GenesisState.pauser":
+		x.Pauser = value.Message().Interface().(*Pauser)
+	case "circle.fiattokenfactory.v1.GenesisState.blacklister":
+		x.Blacklister = value.Message().Interface().(*Blacklister)
+	case "circle.fiattokenfactory.v1.GenesisState.owner":
+		x.Owner = value.Message().Interface().(*Owner)
+	case "circle.fiattokenfactory.v1.GenesisState.mintingDenom":
+		x.MintingDenom = value.Message().Interface().(*MintingDenom)
+	case "circle.fiattokenfactory.v1.GenesisState.mintingDenomList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.MintingDenomList = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.pausedList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.PausedList = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.masterMinterList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_13_list)
+		x.MasterMinterList = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.pauserList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_14_list)
+		x.PauserList = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.blacklisterList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_15_list)
+		x.BlacklisterList = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.ownerList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_16_list)
+		x.OwnerList = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.auditLog":
+		lv := value.List()
+		clv := lv.(*_GenesisState_17_list)
+		x.AuditLog = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.signerSets":
+		lv := value.List()
+		clv := lv.(*_GenesisState_18_list)
+		x.SignerSets = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.actionProposals":
+		lv := value.List()
+		clv := lv.(*_GenesisState_19_list)
+		x.ActionProposals = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.queuedActions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_20_list)
+		x.QueuedActions = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.channelPolicies":
+		lv := value.List()
+		clv := lv.(*_GenesisState_21_list)
+		x.ChannelPolicies = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.channelRateLimits":
+		lv := value.List()
+		clv := lv.(*_GenesisState_22_list)
+		x.ChannelRateLimits = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.pendingSendPackets":
+		lv := value.List()
+		clv := lv.(*_GenesisState_23_list)
+		x.PendingSendPackets = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.allowlisterList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_24_list)
+		x.AllowlisterList = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.allowlistedList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_25_list)
+		x.AllowlistedList = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.allowlistEnabledDenoms":
+		lv := value.List()
+		clv := lv.(*_GenesisState_26_list)
+		x.AllowlistEnabledDenoms = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.pendingOwnerList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_27_list)
+		x.PendingOwnerList = *clv.list
+	case "circle.fiattokenfactory.v1.GenesisState.pendingRoles":
+		lv := value.List()
+		clv := lv.(*_GenesisState_28_list)
+		x.PendingRoles = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.GenesisState.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.blacklistedList":
+		if x.BlacklistedList == nil {
+			x.BlacklistedList = []*Blacklisted{}
+		}
+		value := &_GenesisState_2_list{list: &x.BlacklistedList}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.mintersList":
+		if x.MintersList == nil {
+			x.MintersList = []*Minters{}
+		}
+		value := &_GenesisState_5_list{list: &x.MintersList}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.minterControllerList":
+		if x.MinterControllerList == nil {
+			x.MinterControllerList = []*MinterController{}
+		}
+		value := &_GenesisState_9_list{list: &x.MinterControllerList}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.paused":
+		if x.Paused == nil {
+			x.Paused = new(Paused)
+		}
+		return protoreflect.ValueOfMessage(x.Paused.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.masterMinter":
+		if x.MasterMinter == nil {
+			x.MasterMinter = new(MasterMinter)
+		}
+		return protoreflect.ValueOfMessage(x.MasterMinter.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.pauser":
+		if x.Pauser == nil {
+			x.Pauser = new(Pauser)
+		}
+		return protoreflect.ValueOfMessage(x.Pauser.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.blacklister":
+		if x.Blacklister == nil {
+			x.Blacklister = new(Blacklister)
+		}
+		return protoreflect.ValueOfMessage(x.Blacklister.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.owner":
+		if x.Owner == nil {
+			x.Owner = new(Owner)
+		}
+		return protoreflect.ValueOfMessage(x.Owner.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.mintingDenom":
+		if x.MintingDenom == nil {
+			x.MintingDenom = new(MintingDenom)
+		}
+		return protoreflect.ValueOfMessage(x.MintingDenom.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.mintingDenomList":
+		if x.MintingDenomList == nil {
+			x.MintingDenomList = []*MintingDenom{}
+		}
+		value := &_GenesisState_11_list{list: &x.MintingDenomList}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.pausedList":
+		if x.PausedList == nil {
+			x.PausedList = []*Paused{}
+		}
+		value := &_GenesisState_12_list{list: &x.PausedList}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.masterMinterList":
+		if x.MasterMinterList == nil {
+			x.MasterMinterList = []*MasterMinter{}
+		}
+		value := &_GenesisState_13_list{list: &x.MasterMinterList}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.pauserList":
+		if x.PauserList == nil {
+			x.PauserList = []*Pauser{}
+		}
+		value := &_GenesisState_14_list{list: &x.PauserList}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.blacklisterList":
+		if x.BlacklisterList == nil {
+			x.BlacklisterList = []*Blacklister{}
+		}
+		value := &_GenesisState_15_list{list: &x.BlacklisterList}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.ownerList":
+		if x.OwnerList == nil {
+			x.OwnerList = []*Owner{}
+		}
+		value := &_GenesisState_16_list{list: &x.OwnerList}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.auditLog":
+		if x.AuditLog == nil {
+			x.AuditLog = []*AuditEntry{}
+		}
+		value := &_GenesisState_17_list{list: &x.AuditLog}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.signerSets":
+		if x.SignerSets == nil {
+			x.SignerSets = []*SignerSet{}
+		}
+		value := &_GenesisState_18_list{list: &x.SignerSets}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.actionProposals":
+		if x.ActionProposals == nil {
+			x.ActionProposals = []*ActionProposal{}
+		}
+		value := &_GenesisState_19_list{list: &x.ActionProposals}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.queuedActions":
+		if x.QueuedActions == nil {
+			x.QueuedActions = []*QueuedAction{}
+		}
+		value := &_GenesisState_20_list{list: &x.QueuedActions}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.channelPolicies":
+		if x.ChannelPolicies == nil {
+			x.ChannelPolicies = []*ChannelPolicy{}
+		}
+		value := &_GenesisState_21_list{list: &x.ChannelPolicies}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.channelRateLimits":
+		if x.ChannelRateLimits == nil {
+			x.ChannelRateLimits = []*ChannelRateLimit{}
+		}
+		value := &_GenesisState_22_list{list: &x.ChannelRateLimits}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.pendingSendPackets":
+		if x.PendingSendPackets == nil {
+			x.PendingSendPackets = []*PendingSendPacket{}
+		}
+		value := &_GenesisState_23_list{list: &x.PendingSendPackets}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.allowlisterList":
+		if x.AllowlisterList == nil {
+			x.AllowlisterList = []*Allowlister{}
+		}
+		value := &_GenesisState_24_list{list: &x.AllowlisterList}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.allowlistedList":
+		if x.AllowlistedList == nil {
+			x.AllowlistedList = []*Allowlisted{}
+		}
+		value := &_GenesisState_25_list{list: &x.AllowlistedList}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.allowlistEnabledDenoms":
+		if x.AllowlistEnabledDenoms == nil {
+			x.AllowlistEnabledDenoms = []string{}
+		}
+		value := &_GenesisState_26_list{list: &x.AllowlistEnabledDenoms}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.pendingOwnerList":
+		if x.PendingOwnerList == nil {
+			x.PendingOwnerList = []*Owner{}
+		}
+		value := &_GenesisState_27_list{list: &x.PendingOwnerList}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.GenesisState.pendingRoles":
+		if x.PendingRoles == nil {
+			x.PendingRoles = []*PendingRole{}
+		}
+		value := &_GenesisState_28_list{list: &x.PendingRoles}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.GenesisState.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.blacklistedList":
+		list := []*Blacklisted{}
+		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.mintersList":
+		list := []*Minters{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.minterControllerList":
+		list := []*MinterController{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.paused":
+		m := new(Paused)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.masterMinter":
+		m := new(MasterMinter)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.pauser":
+		m := new(Pauser)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.blacklister":
+		m := new(Blacklister)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.owner":
+		m := new(Owner)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.mintingDenom":
+		m := new(MintingDenom)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "circle.fiattokenfactory.v1.GenesisState.mintingDenomList":
+		list := []*MintingDenom{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.pausedList":
+		list := []*Paused{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.masterMinterList":
+		list := []*MasterMinter{}
+		return protoreflect.ValueOfList(&_GenesisState_13_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.pauserList":
+		list := []*Pauser{}
+		return protoreflect.ValueOfList(&_GenesisState_14_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.blacklisterList":
+		list := []*Blacklister{}
+		return protoreflect.ValueOfList(&_GenesisState_15_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.ownerList":
+		list := []*Owner{}
+		return protoreflect.ValueOfList(&_GenesisState_16_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.auditLog":
+		list := []*AuditEntry{}
+		return protoreflect.ValueOfList(&_GenesisState_17_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.signerSets":
+		list := []*SignerSet{}
+		return protoreflect.ValueOfList(&_GenesisState_18_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.actionProposals":
+		list := []*ActionProposal{}
+		return protoreflect.ValueOfList(&_GenesisState_19_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.queuedActions":
+		list := []*QueuedAction{}
+		return protoreflect.ValueOfList(&_GenesisState_20_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.channelPolicies":
+		list := []*ChannelPolicy{}
+		return protoreflect.ValueOfList(&_GenesisState_21_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.channelRateLimits":
+		list := []*ChannelRateLimit{}
+		return protoreflect.ValueOfList(&_GenesisState_22_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.pendingSendPackets":
+		list := []*PendingSendPacket{}
+		return protoreflect.ValueOfList(&_GenesisState_23_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.allowlisterList":
+		list := []*Allowlister{}
+		return protoreflect.ValueOfList(&_GenesisState_24_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.allowlistedList":
+		list := []*Allowlisted{}
+		return protoreflect.ValueOfList(&_GenesisState_25_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.allowlistEnabledDenoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_26_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.pendingOwnerList":
+		list := []*Owner{}
+		return protoreflect.ValueOfList(&_GenesisState_27_list{list: &list})
+	case "circle.fiattokenfactory.v1.GenesisState.pendingRoles":
+		list := []*PendingRole{}
+		return protoreflect.ValueOfList(&_GenesisState_28_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in circle.fiattokenfactory.v1.GenesisState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.BlacklistedList) > 0 {
+			for _, e := range x.BlacklistedList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MintersList) > 0 {
+			for _, e := range x.MintersList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MinterControllerList) > 0 {
+			for _, e := range x.MinterControllerList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Paused != nil {
+			l = options.Size(x.Paused)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MasterMinter != nil {
+			l = options.Size(x.MasterMinter)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pauser != nil {
+			l = options.Size(x.Pauser)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Blacklister != nil {
+			l = options.Size(x.Blacklister)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Owner != nil {
+			l = options.Size(x.Owner)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MintingDenom != nil {
+			l = options.Size(x.MintingDenom)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.MintingDenomList) > 0 {
+			for _, e := range x.MintingDenomList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PausedList) > 0 {
+			for _, e := range x.PausedList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MasterMinterList) > 0 {
+			for _, e := range x.MasterMinterList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PauserList) > 0 {
+			for _, e := range x.PauserList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.BlacklisterList) > 0 {
+			for _, e := range x.BlacklisterList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.OwnerList) > 0 {
+			for _, e := range x.OwnerList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AuditLog) > 0 {
+			for _, e := range x.AuditLog {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.SignerSets) > 0 {
+			for _, e := range x.SignerSets {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ActionProposals) > 0 {
+			for _, e := range x.ActionProposals {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.QueuedActions) > 0 {
+			for _, e := range x.QueuedActions {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ChannelPolicies) > 0 {
+			for _, e := range x.ChannelPolicies {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ChannelRateLimits) > 0 {
+			for _, e := range x.ChannelRateLimits {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PendingSendPackets) > 0 {
+			for _, e := range x.PendingSendPackets {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AllowlisterList) > 0 {
+			for _, e := range x.AllowlisterList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AllowlistedList) > 0 {
+			for _, e := range x.AllowlistedList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AllowlistEnabledDenoms) > 0 {
+			for _, s := range x.AllowlistEnabledDenoms {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PendingOwnerList) > 0 {
+			for _, e := range x.PendingOwnerList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PendingRoles) > 0 {
+			for _, e := range x.PendingRoles {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.PendingRoles) > 0 {
+			for iNdEx := len(x.PendingRoles) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PendingRoles[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xe2
+			}
+		}
+		if len(x.PendingOwnerList) > 0 {
+			for iNdEx := len(x.PendingOwnerList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PendingOwnerList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xda
+			}
+		}
+		if len(x.AllowlistEnabledDenoms) > 0 {
+			for iNdEx := len(x.AllowlistEnabledDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowlistEnabledDenoms[iNdEx])
+				copy(dAtA[i:], x.AllowlistEnabledDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowlistEnabledDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xd2
+			}
+		}
+		if len(x.AllowlistedList) > 0 {
+			for iNdEx := len(x.AllowlistedList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AllowlistedList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xca
+			}
+		}
+		if len(x.AllowlisterList) > 0 {
+			for iNdEx := len(x.AllowlisterList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AllowlisterList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xc2
+			}
+		}
+		if len(x.PendingSendPackets) > 0 {
+			for iNdEx := len(x.PendingSendPackets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PendingSendPackets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xba
+			}
+		}
+		if len(x.ChannelRateLimits) > 0 {
+			for iNdEx := len(x.ChannelRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ChannelRateLimits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xb2
+			}
+		}
+		if len(x.ChannelPolicies) > 0 {
+			for iNdEx := len(x.ChannelPolicies) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ChannelPolicies[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xaa
+			}
+		}
+		if len(x.QueuedActions) > 0 {
+			for iNdEx := len(x.QueuedActions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.QueuedActions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xa2
+			}
+		}
+		if len(x.ActionProposals) > 0 {
+			for iNdEx := len(x.ActionProposals) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ActionProposals[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x9a
+			}
+		}
+		if len(x.SignerSets) > 0 {
+			for iNdEx := len(x.SignerSets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SignerSets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if len(x.AuditLog) > 0 {
+			for iNdEx := len(x.AuditLog) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AuditLog[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x8a
+			}
+		}
+		if len(x.OwnerList) > 0 {
+			for iNdEx := len(x.OwnerList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.OwnerList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if len(x.BlacklisterList) > 0 {
+			for iNdEx := len(x.BlacklisterList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BlacklisterList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if len(x.PauserList) > 0 {
+			for iNdEx := len(x.PauserList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PauserList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if len(x.MasterMinterList) > 0 {
+			for iNdEx := len(x.MasterMinterList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MasterMinterList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.PausedList) > 0 {
+			for iNdEx := len(x.PausedList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PausedList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.MintingDenomList) > 0 {
+			for iNdEx := len(x.MintingDenomList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MintingDenomList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if x.MintingDenom != nil {
+			encoded, err := options.Marshal(x.MintingDenom)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.MinterControllerList) > 0 {
+			for iNdEx := len(x.MinterControllerList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MinterControllerList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if x.Owner != nil {
+			encoded, err := options.Marshal(x.Owner)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.Blacklister != nil {
+			encoded, err := options.Marshal(x.Blacklister)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Pauser != nil {
+			encoded, err := options.Marshal(x.Pauser)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.MintersList) > 0 {
+			for iNdEx := len(x.MintersList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MintersList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.MasterMinter != nil {
+			encoded, err := options.Marshal(x.MasterMinter)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Paused != nil {
+			encoded, err := options.Marshal(x.Paused)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.BlacklistedList) > 0 {
+			for iNdEx := len(x.BlacklistedList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BlacklistedList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlacklistedList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlacklistedList = append(x.BlacklistedList, &Blacklisted{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlacklistedList[len(x.BlacklistedList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintersList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MintersList = append(x.MintersList, &Minters{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MintersList[len(x.MintersList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinterControllerList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinterControllerList = append(x.MinterControllerList, &MinterController{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinterControllerList[len(x.MinterControllerList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Paused == nil {
+					x.Paused = &Paused{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Paused); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MasterMinter", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MasterMinter == nil {
+					x.MasterMinter = &MasterMinter{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MasterMinter); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pauser", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pauser == nil {
+					x.Pauser = &Pauser{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pauser); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Blacklister", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Blacklister == nil {
+					x.Blacklister = &Blacklister{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Blacklister); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Owner == nil {
+					x.Owner = &Owner{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Owner); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintingDenom", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MintingDenom == nil {
+					x.MintingDenom = &MintingDenom{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MintingDenom); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintingDenomList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MintingDenomList = append(x.MintingDenomList, &MintingDenom{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MintingDenomList[len(x.MintingDenomList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PausedList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PausedList = append(x.PausedList, &Paused{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PausedList[len(x.PausedList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MasterMinterList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x4a
-			}
-		}
-		if x.Owner != nil {
-			encoded, err := options.Marshal(x.Owner)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x42
-		}
-		if x.Blacklister != nil {
-			encoded, err := options.Marshal(x.Blacklister)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if x.Pauser != nil {
-			encoded, err := options.Marshal(x.Pauser)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.MintersList) > 0 {
-			for iNdEx := len(x.MintersList) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.MintersList[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x2a
-			}
-		}
-		if x.MasterMinter != nil {
-			encoded, err := options.Marshal(x.MasterMinter)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.Paused != nil {
-			encoded, err := options.Marshal(x.Paused)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.BlacklistedList) > 0 {
-			for iNdEx := len(x.BlacklistedList) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.BlacklistedList[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				x.MasterMinterList = append(x.MasterMinterList, &MasterMinter{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MasterMinterList[len(x.MasterMinterList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PauserList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PauserList = append(x.PauserList, &Pauser{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PauserList[len(x.PauserList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlacklisterList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlacklisterList = append(x.BlacklisterList, &Blacklister{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlacklisterList[len(x.BlacklisterList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OwnerList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OwnerList = append(x.OwnerList, &Owner{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OwnerList[len(x.OwnerList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuditLog", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.AuditLog = append(x.AuditLog, &AuditEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AuditLog[len(x.AuditLog)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 2:
+				iNdEx = postIndex
+			case 18:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlacklistedList", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignerSets", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -933,14 +3454,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BlacklistedList = append(x.BlacklistedList, &Blacklisted{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlacklistedList[len(x.BlacklistedList)-1]); err != nil {
+				x.SignerSets = append(x.SignerSets, &SignerSet{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SignerSets[len(x.SignerSets)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
+			case 19:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActionProposals", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -967,16 +3488,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Paused == nil {
-					x.Paused = &Paused{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Paused); err != nil {
+				x.ActionProposals = append(x.ActionProposals, &ActionProposal{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ActionProposals[len(x.ActionProposals)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 4:
+			case 20:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MasterMinter", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QueuedActions", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1003,16 +3522,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.MasterMinter == nil {
-					x.MasterMinter = &MasterMinter{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MasterMinter); err != nil {
+				x.QueuedActions = append(x.QueuedActions, &QueuedAction{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.QueuedActions[len(x.QueuedActions)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 5:
+			case 21:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintersList", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelPolicies", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1039,14 +3556,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MintersList = append(x.MintersList, &Minters{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MintersList[len(x.MintersList)-1]); err != nil {
+				x.ChannelPolicies = append(x.ChannelPolicies, &ChannelPolicy{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ChannelPolicies[len(x.ChannelPolicies)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 6:
+			case 22:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pauser", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelRateLimits", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1073,16 +3590,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pauser == nil {
-					x.Pauser = &Pauser{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pauser); err != nil {
+				x.ChannelRateLimits = append(x.ChannelRateLimits, &ChannelRateLimit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ChannelRateLimits[len(x.ChannelRateLimits)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 7:
+			case 23:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Blacklister", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingSendPackets", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1109,16 +3624,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Blacklister == nil {
-					x.Blacklister = &Blacklister{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Blacklister); err != nil {
+				x.PendingSendPackets = append(x.PendingSendPackets, &PendingSendPacket{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingSendPackets[len(x.PendingSendPackets)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 8:
+			case 24:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowlisterList", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1145,16 +3658,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Owner == nil {
-					x.Owner = &Owner{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Owner); err != nil {
+				x.AllowlisterList = append(x.AllowlisterList, &Allowlister{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AllowlisterList[len(x.AllowlisterList)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 9:
+			case 25:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinterControllerList", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowlistedList", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1181,14 +3692,46 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MinterControllerList = append(x.MinterControllerList, &MinterController{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinterControllerList[len(x.MinterControllerList)-1]); err != nil {
+				x.AllowlistedList = append(x.AllowlistedList, &Allowlisted{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AllowlistedList[len(x.AllowlistedList)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 10:
+			case 26:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintingDenom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowlistEnabledDenoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowlistEnabledDenoms = append(x.AllowlistEnabledDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 27:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingOwnerList", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1215,10 +3758,42 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.MintingDenom == nil {
-					x.MintingDenom = &MintingDenom{}
+				x.PendingOwnerList = append(x.PendingOwnerList, &Owner{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingOwnerList[len(x.PendingOwnerList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MintingDenom); err != nil {
+				iNdEx = postIndex
+			case 28:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingRoles", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PendingRoles = append(x.PendingRoles, &PendingRole{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingRoles[len(x.PendingRoles)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -1276,15 +3851,38 @@ type GenesisState struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	Params               *Params             `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
 	BlacklistedList      []*Blacklisted      `protobuf:"bytes,2,rep,name=blacklistedList,proto3" json:"blacklistedList,omitempty"`
-	Paused               *Paused             `protobuf:"bytes,3,opt,name=paused,proto3" json:"paused,omitempty"`
-	MasterMinter         *MasterMinter       `protobuf:"bytes,4,opt,name=masterMinter,proto3" json:"masterMinter,omitempty"`
 	MintersList          []*Minters          `protobuf:"bytes,5,rep,name=mintersList,proto3" json:"mintersList,omitempty"`
-	Pauser               *Pauser             `protobuf:"bytes,6,opt,name=pauser,proto3" json:"pauser,omitempty"`
-	Blacklister          *Blacklister        `protobuf:"bytes,7,opt,name=blacklister,proto3" json:"blacklister,omitempty"`
-	Owner                *Owner              `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
 	MinterControllerList []*MinterController `protobuf:"bytes,9,rep,name=minterControllerList,proto3" json:"minterControllerList,omitempty"`
-	MintingDenom         *MintingDenom       `protobuf:"bytes,10,opt,name=mintingDenom,proto3" json:"mintingDenom,omitempty"`
+	// The following fields describe the legacy single-denom layout. They are
+	// only read on import, where they are assigned to mintingDenom.
+	Paused             *Paused              `protobuf:"bytes,3,opt,name=paused,proto3" json:"paused,omitempty"`
+	MasterMinter       *MasterMinter        `protobuf:"bytes,4,opt,name=masterMinter,proto3" json:"masterMinter,omitempty"`
+	Pauser             *Pauser              `protobuf:"bytes,6,opt,name=pauser,proto3" json:"pauser,omitempty"`
+	Blacklister        *Blacklister         `protobuf:"bytes,7,opt,name=blacklister,proto3" json:"blacklister,omitempty"`
+	Owner              *Owner               `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
+	MintingDenom       *MintingDenom        `protobuf:"bytes,10,opt,name=mintingDenom,proto3" json:"mintingDenom,omitempty"`
+	MintingDenomList   []*MintingDenom      `protobuf:"bytes,11,rep,name=mintingDenomList,proto3" json:"mintingDenomList,omitempty"`
+	PausedList         []*Paused            `protobuf:"bytes,12,rep,name=pausedList,proto3" json:"pausedList,omitempty"`
+	MasterMinterList   []*MasterMinter      `protobuf:"bytes,13,rep,name=masterMinterList,proto3" json:"masterMinterList,omitempty"`
+	PauserList         []*Pauser            `protobuf:"bytes,14,rep,name=pauserList,proto3" json:"pauserList,omitempty"`
+	BlacklisterList    []*Blacklister       `protobuf:"bytes,15,rep,name=blacklisterList,proto3" json:"blacklisterList,omitempty"`
+	OwnerList          []*Owner             `protobuf:"bytes,16,rep,name=ownerList,proto3" json:"ownerList,omitempty"`
+	AuditLog           []*AuditEntry        `protobuf:"bytes,17,rep,name=auditLog,proto3" json:"auditLog,omitempty"`
+	SignerSets         []*SignerSet         `protobuf:"bytes,18,rep,name=signerSets,proto3" json:"signerSets,omitempty"`
+	ActionProposals    []*ActionProposal    `protobuf:"bytes,19,rep,name=actionProposals,proto3" json:"actionProposals,omitempty"`
+	QueuedActions      []*QueuedAction      `protobuf:"bytes,20,rep,name=queuedActions,proto3" json:"queuedActions,omitempty"`
+	ChannelPolicies    []*ChannelPolicy     `protobuf:"bytes,21,rep,name=channelPolicies,proto3" json:"channelPolicies,omitempty"`
+	ChannelRateLimits  []*ChannelRateLimit  `protobuf:"bytes,22,rep,name=channelRateLimits,proto3" json:"channelRateLimits,omitempty"`
+	PendingSendPackets []*PendingSendPacket `protobuf:"bytes,23,rep,name=pendingSendPackets,proto3" json:"pendingSendPackets,omitempty"`
+	AllowlisterList    []*Allowlister       `protobuf:"bytes,24,rep,name=allowlisterList,proto3" json:"allowlisterList,omitempty"`
+	AllowlistedList    []*Allowlisted       `protobuf:"bytes,25,rep,name=allowlistedList,proto3" json:"allowlistedList,omitempty"`
+	// allowlistEnabledDenoms are the minting denoms whose allowlist mode is
+	// enabled.
+	AllowlistEnabledDenoms []string       `protobuf:"bytes,26,rep,name=allowlistEnabledDenoms,proto3" json:"allowlistEnabledDenoms,omitempty"`
+	PendingOwnerList       []*Owner       `protobuf:"bytes,27,rep,name=pendingOwnerList,proto3" json:"pendingOwnerList,omitempty"`
+	PendingRoles           []*PendingRole `protobuf:"bytes,28,rep,name=pendingRoles,proto3" json:"pendingRoles,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1307,6 +3905,13 @@ func (*GenesisState) Descriptor() ([]byte, []int) {
 	return file_circle_fiattokenfactory_v1_genesis_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *GenesisState) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
 func (x *GenesisState) GetBlacklistedList() []*Blacklisted {
 	if x != nil {
 		return x.BlacklistedList
@@ -1314,23 +3919,30 @@ func (x *GenesisState) GetBlacklistedList() []*Blacklisted {
 	return nil
 }
 
-func (x *GenesisState) GetPaused() *Paused {
+func (x *GenesisState) GetMintersList() []*Minters {
 	if x != nil {
-		return x.Paused
+		return x.MintersList
 	}
 	return nil
 }
 
-func (x *GenesisState) GetMasterMinter() *MasterMinter {
+func (x *GenesisState) GetMinterControllerList() []*MinterController {
 	if x != nil {
-		return x.MasterMinter
+		return x.MinterControllerList
 	}
 	return nil
 }
 
-func (x *GenesisState) GetMintersList() []*Minters {
+func (x *GenesisState) GetPaused() *Paused {
 	if x != nil {
-		return x.MintersList
+		return x.Paused
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMasterMinter() *MasterMinter {
+	if x != nil {
+		return x.MasterMinter
 	}
 	return nil
 }
@@ -1356,16 +3968,135 @@ func (x *GenesisState) GetOwner() *Owner {
 	return nil
 }
 
-func (x *GenesisState) GetMinterControllerList() []*MinterController {
+func (x *GenesisState) GetMintingDenom() *MintingDenom {
 	if x != nil {
-		return x.MinterControllerList
+		return x.MintingDenom
 	}
 	return nil
 }
 
-func (x *GenesisState) GetMintingDenom() *MintingDenom {
+func (x *GenesisState) GetMintingDenomList() []*MintingDenom {
 	if x != nil {
-		return x.MintingDenom
+		return x.MintingDenomList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPausedList() []*Paused {
+	if x != nil {
+		return x.PausedList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMasterMinterList() []*MasterMinter {
+	if x != nil {
+		return x.MasterMinterList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPauserList() []*Pauser {
+	if x != nil {
+		return x.PauserList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetBlacklisterList() []*Blacklister {
+	if x != nil {
+		return x.BlacklisterList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetOwnerList() []*Owner {
+	if x != nil {
+		return x.OwnerList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAuditLog() []*AuditEntry {
+	if x != nil {
+		return x.AuditLog
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSignerSets() []*SignerSet {
+	if x != nil {
+		return x.SignerSets
+	}
+	return nil
+}
+
+func (x *GenesisState) GetActionProposals() []*ActionProposal {
+	if x != nil {
+		return x.ActionProposals
+	}
+	return nil
+}
+
+func (x *GenesisState) GetQueuedActions() []*QueuedAction {
+	if x != nil {
+		return x.QueuedActions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetChannelPolicies() []*ChannelPolicy {
+	if x != nil {
+		return x.ChannelPolicies
+	}
+	return nil
+}
+
+func (x *GenesisState) GetChannelRateLimits() []*ChannelRateLimit {
+	if x != nil {
+		return x.ChannelRateLimits
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPendingSendPackets() []*PendingSendPacket {
+	if x != nil {
+		return x.PendingSendPackets
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAllowlisterList() []*Allowlister {
+	if x != nil {
+		return x.AllowlisterList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAllowlistedList() []*Allowlisted {
+	if x != nil {
+		return x.AllowlistedList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAllowlistEnabledDenoms() []string {
+	if x != nil {
+		return x.AllowlistEnabledDenoms
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPendingOwnerList() []*Owner {
+	if x != nil {
+		return x.PendingOwnerList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPendingRoles() []*PendingRole {
+	if x != nil {
+		return x.PendingRoles
 	}
 	return nil
 }
@@ -1379,94 +4110,217 @@ var file_circle_fiattokenfactory_v1_genesis_proto_rawDesc = []byte{
 	0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74,
 	0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x1a, 0x2c, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66,
 	0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f,
-	0x76, 0x31, 0x2f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x2e, 0x70,
+	0x76, 0x31, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2c, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61,
 	0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31,
-	0x2f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x6d,
-	0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x32, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x6d,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x28, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66,
+	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x29, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x26, 0x63,
+	0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2c, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69,
+	0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76,
+	0x31, 0x2f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x2c, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f,
+	0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x33, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f,
+	0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x32, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f,
+	0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x28, 0x63, 0x69, 0x72,
+	0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69,
+	0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76,
+	0x31, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x26, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69,
+	0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76,
+	0x31, 0x2f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27, 0x63,
+	0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66,
 	0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f,
-	0x76, 0x31, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x69, 0x6e,
-	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x26, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x77, 0x6e,
-	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65,
+	0x76, 0x31, 0x2f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x27, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2d, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65,
 	0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x27, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61,
-	0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xc2, 0x05, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x57, 0x0a, 0x0f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64,
-	0x4c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x69, 0x72,
-	0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63,
-	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73,
-	0x74, 0x65, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x62, 0x6c, 0x61, 0x63, 0x6b,
-	0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x06, 0x70, 0x61,
-	0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x69, 0x72,
-	0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63,
-	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x52, 0x06,
-	0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x0c, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
-	0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63,
-	0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66,
-	0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72,
-	0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x0c, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x4c,
+	0x79, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x6f, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x29, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f,
+	0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x2f, 0x76, 0x31, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f,
+	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdf, 0x11, 0x0a, 0x0c, 0x47, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x69, 0x72, 0x63,
+	0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x57, 0x0a, 0x0f, 0x62,
+	0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69,
+	0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x4c,
 	0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x69, 0x72, 0x63,
 	0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74,
 	0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x42, 0x04,
 	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x3a, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x22, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x61, 0x75, 0x73, 0x65, 0x72, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x72, 0x12, 0x49, 0x0a,
-	0x0b, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
-	0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x62, 0x6c, 0x61,
-	0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
-	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65,
-	0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
-	0x72, 0x12, 0x66, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x74, 0x12, 0x66, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x72,
 	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x2c, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b,
 	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e,
 	0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x42, 0x04, 0xc8,
 	0xde, 0x1f, 0x00, 0x52, 0x14, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x0c, 0x6d, 0x69, 0x6e,
-	0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x28, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e,
-	0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x74, 0x69,
-	0x6e, 0x67, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x52, 0x06, 0x70,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x97, 0x02, 0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x69,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x06, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x69, 0x72, 0x63,
+	0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x52, 0x06, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x0c, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4d,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x69,
 	0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61,
-	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
-	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x5d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x66, 0x69, 0x6e, 0x2f, 0x6e,
-	0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61,
-	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65,
-	0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61,
-	0x63, 0x74, 0x6f, 0x72, 0x79, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x46, 0x58, 0xaa, 0x02, 0x1a,
-	0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1a, 0x43, 0x69, 0x72,
-	0x63, 0x6c, 0x65, 0x5c, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63,
-	0x74, 0x6f, 0x72, 0x79, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x26, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65,
-	0x5c, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0xea, 0x02, 0x1c, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x46, 0x69, 0x61, 0x74, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x3a, 0x3a, 0x56, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4d,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x0c, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61,
+	0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x72, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x72, 0x12,
+	0x49, 0x0a, 0x0b, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69,
+	0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x62,
+	0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x69, 0x72, 0x63,
+	0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x69, 0x72, 0x63,
+	0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x12, 0x5a, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x69,
+	0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67,
+	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x6d, 0x69, 0x6e,
+	0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x48, 0x0a,
+	0x0a, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0c, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x5a, 0x0a, 0x10, 0x6d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0d, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x10, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x0a, 0x70, 0x61, 0x75, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73,
+	0x74, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65,
+	0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0a, 0x70, 0x61, 0x75, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x57, 0x0a,
+	0x0f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e,
+	0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x69, 0x72, 0x63,
+	0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x48, 0x0a,
+	0x08, 0x61, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x61,
+	0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x4b, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x53, 0x65, 0x74, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x69,
+	0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x53,
+	0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x53, 0x65, 0x74, 0x73, 0x12, 0x5a, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x0f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73,
+	0x12, 0x54, 0x0a, 0x0d, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65,
+	0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x59, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
+	0x73, 0x12, 0x60, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63,
+	0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x73, 0x12, 0x63, 0x0a, 0x12, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65,
+	0x6e, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x6e, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x6e,
+	0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x57, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x18, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x57, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x18, 0x19, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x69, 0x72,
+	0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73,
+	0x74, 0x65, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x16, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x73, 0x12, 0x53, 0x0a, 0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x1b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63,
+	0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x1c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x42, 0x97, 0x02, 0x0a, 0x1e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x5d, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65,
+	0x66, 0x69, 0x6e, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63,
+	0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43,
+	0x46, 0x58, 0xaa, 0x02, 0x1a, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x46, 0x69, 0x61, 0x74,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x56, 0x31, 0xca,
+	0x02, 0x1a, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x5c, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x26, 0x43,
+	0x69, 0x72, 0x63, 0x6c, 0x65, 0x5c, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1c, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x3a, 0x3a,
+	0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1483,32 +4337,61 @@ func file_circle_fiattokenfactory_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_circle_fiattokenfactory_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_circle_fiattokenfactory_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),     // 0: circle.fiattokenfactory.v1.GenesisState
-	(*Blacklisted)(nil),      // 1: circle.fiattokenfactory.v1.Blacklisted
-	(*Paused)(nil),           // 2: circle.fiattokenfactory.v1.Paused
-	(*MasterMinter)(nil),     // 3: circle.fiattokenfactory.v1.MasterMinter
-	(*Minters)(nil),          // 4: circle.fiattokenfactory.v1.Minters
-	(*Pauser)(nil),           // 5: circle.fiattokenfactory.v1.Pauser
-	(*Blacklister)(nil),      // 6: circle.fiattokenfactory.v1.Blacklister
-	(*Owner)(nil),            // 7: circle.fiattokenfactory.v1.Owner
-	(*MinterController)(nil), // 8: circle.fiattokenfactory.v1.MinterController
-	(*MintingDenom)(nil),     // 9: circle.fiattokenfactory.v1.MintingDenom
+	(*GenesisState)(nil),      // 0: circle.fiattokenfactory.v1.GenesisState
+	(*Params)(nil),            // 1: circle.fiattokenfactory.v1.Params
+	(*Blacklisted)(nil),       // 2: circle.fiattokenfactory.v1.Blacklisted
+	(*Minters)(nil),           // 3: circle.fiattokenfactory.v1.Minters
+	(*MinterController)(nil),  // 4: circle.fiattokenfactory.v1.MinterController
+	(*Paused)(nil),            // 5: circle.fiattokenfactory.v1.Paused
+	(*MasterMinter)(nil),      // 6: circle.fiattokenfactory.v1.MasterMinter
+	(*Pauser)(nil),            // 7: circle.fiattokenfactory.v1.Pauser
+	(*Blacklister)(nil),       // 8: circle.fiattokenfactory.v1.Blacklister
+	(*Owner)(nil),             // 9: circle.fiattokenfactory.v1.Owner
+	(*MintingDenom)(nil),      // 10: circle.fiattokenfactory.v1.MintingDenom
+	(*AuditEntry)(nil),        // 11: circle.fiattokenfactory.v1.AuditEntry
+	(*SignerSet)(nil),         // 12: circle.fiattokenfactory.v1.SignerSet
+	(*ActionProposal)(nil),    // 13: circle.fiattokenfactory.v1.ActionProposal
+	(*QueuedAction)(nil),      // 14: circle.fiattokenfactory.v1.QueuedAction
+	(*ChannelPolicy)(nil),     // 15: circle.fiattokenfactory.v1.ChannelPolicy
+	(*ChannelRateLimit)(nil),  // 16: circle.fiattokenfactory.v1.ChannelRateLimit
+	(*PendingSendPacket)(nil), // 17: circle.fiattokenfactory.v1.PendingSendPacket
+	(*Allowlister)(nil),       // 18: circle.fiattokenfactory.v1.Allowlister
+	(*Allowlisted)(nil),       // 19: circle.fiattokenfactory.v1.Allowlisted
+	(*PendingRole)(nil),       // 20: circle.fiattokenfactory.v1.PendingRole
 }
 var file_circle_fiattokenfactory_v1_genesis_proto_depIdxs = []int32{
-	1, // 0: circle.fiattokenfactory.v1.GenesisState.blacklistedList:type_name -> circle.fiattokenfactory.v1.Blacklisted
-	2, // 1: circle.fiattokenfactory.v1.GenesisState.paused:type_name -> circle.fiattokenfactory.v1.Paused
-	3, // 2: circle.fiattokenfactory.v1.GenesisState.masterMinter:type_name -> circle.fiattokenfactory.v1.MasterMinter
-	4, // 3: circle.fiattokenfactory.v1.GenesisState.mintersList:type_name -> circle.fiattokenfactory.v1.Minters
-	5, // 4: circle.fiattokenfactory.v1.GenesisState.pauser:type_name -> circle.fiattokenfactory.v1.Pauser
-	6, // 5: circle.fiattokenfactory.v1.GenesisState.blacklister:type_name -> circle.fiattokenfactory.v1.Blacklister
-	7, // 6: circle.fiattokenfactory.v1.GenesisState.owner:type_name -> circle.fiattokenfactory.v1.Owner
-	8, // 7: circle.fiattokenfactory.v1.GenesisState.minterControllerList:type_name -> circle.fiattokenfactory.v1.MinterController
-	9, // 8: circle.fiattokenfactory.v1.GenesisState.mintingDenom:type_name -> circle.fiattokenfactory.v1.MintingDenom
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	1,  // 0: circle.fiattokenfactory.v1.GenesisState.params:type_name -> circle.fiattokenfactory.v1.Params
+	2,  // 1: circle.fiattokenfactory.v1.GenesisState.blacklistedList:type_name -> circle.fiattokenfactory.v1.Blacklisted
+	3,  // 2: circle.fiattokenfactory.v1.GenesisState.mintersList:type_name -> circle.fiattokenfactory.v1.Minters
+	4,  // 3: circle.fiattokenfactory.v1.GenesisState.minterControllerList:type_name -> circle.fiattokenfactory.v1.MinterController
+	5,  // 4: circle.fiattokenfactory.v1.GenesisState.paused:type_name -> circle.fiattokenfactory.v1.Paused
+	6,  // 5: circle.fiattokenfactory.v1.GenesisState.masterMinter:type_name -> circle.fiattokenfactory.v1.MasterMinter
+	7,  // 6: circle.fiattokenfactory.v1.GenesisState.pauser:type_name -> circle.fiattokenfactory.v1.Pauser
+	8,  // 7: circle.fiattokenfactory.v1.GenesisState.blacklister:type_name -> circle.fiattokenfactory.v1.Blacklister
+	9,  // 8: circle.fiattokenfactory.v1.GenesisState.owner:type_name -> circle.fiattokenfactory.v1.Owner
+	10, // 9: circle.fiattokenfactory.v1.GenesisState.mintingDenom:type_name -> circle.fiattokenfactory.v1.MintingDenom
+	10, // 10: circle.fiattokenfactory.v1.GenesisState.mintingDenomList:type_name -> circle.fiattokenfactory.v1.MintingDenom
+	5,  // 11: circle.fiattokenfactory.v1.GenesisState.pausedList:type_name -> circle.fiattokenfactory.v1.Paused
+	6,  // 12: circle.fiattokenfactory.v1.GenesisState.masterMinterList:type_name -> circle.fiattokenfactory.v1.MasterMinter
+	7,  // 13: circle.fiattokenfactory.v1.GenesisState.pauserList:type_name -> circle.fiattokenfactory.v1.Pauser
+	8,  // 14: circle.fiattokenfactory.v1.GenesisState.blacklisterList:type_name -> circle.fiattokenfactory.v1.Blacklister
+	9,  // 15: circle.fiattokenfactory.v1.GenesisState.ownerList:type_name -> circle.fiattokenfactory.v1.Owner
+	11, // 16: circle.fiattokenfactory.v1.GenesisState.auditLog:type_name -> circle.fiattokenfactory.v1.AuditEntry
+	12, // 17: circle.fiattokenfactory.v1.GenesisState.signerSets:type_name -> circle.fiattokenfactory.v1.SignerSet
+	13, // 18: circle.fiattokenfactory.v1.GenesisState.actionProposals:type_name -> circle.fiattokenfactory.v1.ActionProposal
+	14, // 19: circle.fiattokenfactory.v1.GenesisState.queuedActions:type_name -> circle.fiattokenfactory.v1.QueuedAction
+	15, // 20: circle.fiattokenfactory.v1.GenesisState.channelPolicies:type_name -> circle.fiattokenfactory.v1.ChannelPolicy
+	16, // 21: circle.fiattokenfactory.v1.GenesisState.channelRateLimits:type_name -> circle.fiattokenfactory.v1.ChannelRateLimit
+	17, // 22: circle.fiattokenfactory.v1.GenesisState.pendingSendPackets:type_name -> circle.fiattokenfactory.v1.PendingSendPacket
+	18, // 23: circle.fiattokenfactory.v1.GenesisState.allowlisterList:type_name -> circle.fiattokenfactory.v1.Allowlister
+	19, // 24: circle.fiattokenfactory.v1.GenesisState.allowlistedList:type_name -> circle.fiattokenfactory.v1.Allowlisted
+	9,  // 25: circle.fiattokenfactory.v1.GenesisState.pendingOwnerList:type_name -> circle.fiattokenfactory.v1.Owner
+	20, // 26: circle.fiattokenfactory.v1.GenesisState.pendingRoles:type_name -> circle.fiattokenfactory.v1.PendingRole
+	27, // [27:27] is the sub-list for method output_type
+	27, // [27:27] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_circle_fiattokenfactory_v1_genesis_proto_init() }
@@ -1516,15 +4399,24 @@ func file_circle_fiattokenfactory_v1_genesis_proto_init() {
 	if File_circle_fiattokenfactory_v1_genesis_proto != nil {
 		return
 	}
+	file_circle_fiattokenfactory_v1_allowlisted_proto_init()
+	file_circle_fiattokenfactory_v1_allowlister_proto_init()
+	file_circle_fiattokenfactory_v1_approval_proto_init()
+	file_circle_fiattokenfactory_v1_audit_proto_init()
 	file_circle_fiattokenfactory_v1_blacklisted_proto_init()
 	file_circle_fiattokenfactory_v1_blacklister_proto_init()
+	file_circle_fiattokenfactory_v1_channel_policy_proto_init()
+	file_circle_fiattokenfactory_v1_channel_rate_limit_proto_init()
 	file_circle_fiattokenfactory_v1_master_minter_proto_init()
 	file_circle_fiattokenfactory_v1_minter_controller_proto_init()
 	file_circle_fiattokenfactory_v1_minters_proto_init()
 	file_circle_fiattokenfactory_v1_minting_denom_proto_init()
 	file_circle_fiattokenfactory_v1_owner_proto_init()
+	file_circle_fiattokenfactory_v1_params_proto_init()
 	file_circle_fiattokenfactory_v1_paused_proto_init()
 	file_circle_fiattokenfactory_v1_pauser_proto_init()
+	file_circle_fiattokenfactory_v1_pending_role_proto_init()
+	file_circle_fiattokenfactory_v1_timelock_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_circle_fiattokenfactory_v1_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
@@ -0,0 +1,2064 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package fiattokenfactoryv1
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_Params_7_list)(nil)
+
+type _Params_7_list struct {
+	list *[]string
+}
+
+func (x *_Params_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field MemoRecipientPaths as it is not of Message kind"))
+}
+
+func (x *_Params_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_8_list)(nil)
+
+type _Params_8_list struct {
+	list *[]string
+}
+
+func (x *_Params_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_8_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field AddressRenderHrps as it is not of Message kind"))
+}
+
+func (x *_Params_8_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_8_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_11_list)(nil)
+
+type _Params_11_list struct {
+	list *[]*ModuleExemption
+}
+
+func (x *_Params_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ModuleExemption)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ModuleExemption)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_11_list) AppendMutable() protoreflect.Value {
+	v := new(ModuleExemption)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_11_list) NewElement() protoreflect.Value {
+	v := new(ModuleExemption)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Params                        protoreflect.MessageDescriptor
+	fd_Params_auditLogRetention      protoreflect.FieldDescriptor
+	fd_Params_actionProposalLifetime protoreflect.FieldDescriptor
+	fd_Params_roleChangeDelay        protoreflect.FieldDescriptor
+	fd_Params_authzGranteeCheck      protoreflect.FieldDescriptor
+	fd_Params_maxBlacklistBatchSize  protoreflect.FieldDescriptor
+	fd_Params_memoRecipientPaths     protoreflect.FieldDescriptor
+	fd_Params_addressRenderHrps      protoreflect.FieldDescriptor
+	fd_Params_moduleExemptions       protoreflect.FieldDescriptor
+	fd_Params_twoStepRoleTransfers   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_circle_fiattokenfactory_v1_params_proto_init()
+	md_Params = File_circle_fiattokenfactory_v1_params_proto.Messages().ByName("Params")
+	fd_Params_auditLogRetention = md_Params.Fields().ByName("auditLogRetention")
+	fd_Params_actionProposalLifetime = md_Params.Fields().ByName("actionProposalLifetime")
+	fd_Params_roleChangeDelay = md_Params.Fields().ByName("roleChangeDelay")
+	fd_Params_authzGranteeCheck = md_Params.Fields().ByName("authzGranteeCheck")
+	fd_Params_maxBlacklistBatchSize = md_Params.Fields().ByName("maxBlacklistBatchSize")
+	fd_Params_memoRecipientPaths = md_Params.Fields().ByName("memoRecipientPaths")
+	fd_Params_addressRenderHrps = md_Params.Fields().ByName("addressRenderHrps")
+	fd_Params_moduleExemptions = md_Params.Fields().ByName("moduleExemptions")
+	fd_Params_twoStepRoleTransfers = md_Params.Fields().ByName("twoStepRoleTransfers")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_circle_fiattokenfactory_v1_params_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AuditLogRetention != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AuditLogRetention)
+		if !f(fd_Params_auditLogRetention, value) {
+			return
+		}
+	}
+	if x.ActionProposalLifetime != nil {
+		value := protoreflect.ValueOfMessage(x.ActionProposalLifetime.ProtoReflect())
+		if !f(fd_Params_actionProposalLifetime, value) {
+			return
+		}
+	}
+	if x.RoleChangeDelay != nil {
+		value := protoreflect.ValueOfMessage(x.RoleChangeDelay.ProtoReflect())
+		if !f(fd_Params_roleChangeDelay, value) {
+			return
+		}
+	}
+	if x.AuthzGranteeCheck != false {
+		value := protoreflect.ValueOfBool(x.AuthzGranteeCheck)
+		if !f(fd_Params_authzGranteeCheck, value) {
+			return
+		}
+	}
+	if x.MaxBlacklistBatchSize != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxBlacklistBatchSize)
+		if !f(fd_Params_maxBlacklistBatchSize, value) {
+			return
+		}
+	}
+	if len(x.MemoRecipientPaths) != 0 {
+		value := protoreflect.ValueOfList(&_Params_7_list{list: &x.MemoRecipientPaths})
+		if !f(fd_Params_memoRecipientPaths, value) {
+			return
+		}
+	}
+	if len(x.AddressRenderHrps) != 0 {
+		value := protoreflect.ValueOfList(&_Params_8_list{list: &x.AddressRenderHrps})
+		if !f(fd_Params_addressRenderHrps, value) {
+			return
+		}
+	}
+	if len(x.ModuleExemptions) != 0 {
+		value := protoreflect.ValueOfList(&_Params_11_list{list: &x.ModuleExemptions})
+		if !f(fd_Params_moduleExemptions, value) {
+			return
+		}
+	}
+	if x.TwoStepRoleTransfers != false {
+		value := protoreflect.ValueOfBool(x.TwoStepRoleTransfers)
+		if !f(fd_Params_twoStepRoleTransfers, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.Params.auditLogRetention":
+		return x.AuditLogRetention != uint64(0)
+	case "circle.fiattokenfactory.v1.Params.actionProposalLifetime":
+		return x.ActionProposalLifetime != nil
+	case "circle.fiattokenfactory.v1.Params.roleChangeDelay":
+		return x.RoleChangeDelay != nil
+	case "circle.fiattokenfactory.v1.Params.authzGranteeCheck":
+		return x.AuthzGranteeCheck != false
+	case "circle.fiattokenfactory.v1.Params.maxBlacklistBatchSize":
+		return x.MaxBlacklistBatchSize != uint32(0)
+	case "circle.fiattokenfactory.v1.Params.memoRecipientPaths":
+		return len(x.MemoRecipientPaths) != 0
+	case "circle.fiattokenfactory.v1.Params.addressRenderHrps":
+		return len(x.AddressRenderHrps) != 0
+	case "circle.fiattokenfactory.v1.Params.moduleExemptions":
+		return len(x.ModuleExemptions) != 0
+	case "circle.fiattokenfactory.v1.Params.twoStepRoleTransfers":
+		return x.TwoStepRoleTransfers != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.Params"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.Params.auditLogRetention":
+		x.AuditLogRetention = uint64(0)
+	case "circle.fiattokenfactory.v1.Params.actionProposalLifetime":
+		x.ActionProposalLifetime = nil
+	case "circle.fiattokenfactory.v1.Params.roleChangeDelay":
+		x.RoleChangeDelay = nil
+	case "circle.fiattokenfactory.v1.Params.authzGranteeCheck":
+		x.AuthzGranteeCheck = false
+	case "circle.fiattokenfactory.v1.Params.maxBlacklistBatchSize":
+		x.MaxBlacklistBatchSize = uint32(0)
+	case "circle.fiattokenfactory.v1.Params.memoRecipientPaths":
+		x.MemoRecipientPaths = nil
+	case "circle.fiattokenfactory.v1.Params.addressRenderHrps":
+		x.AddressRenderHrps = nil
+	case "circle.fiattokenfactory.v1.Params.moduleExemptions":
+		x.ModuleExemptions = nil
+	case "circle.fiattokenfactory.v1.Params.twoStepRoleTransfers":
+		x.TwoStepRoleTransfers = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.Params"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "circle.fiattokenfactory.v1.Params.auditLogRetention":
+		value := x.AuditLogRetention
+		return protoreflect.ValueOfUint64(value)
+	case "circle.fiattokenfactory.v1.Params.actionProposalLifetime":
+		value := x.ActionProposalLifetime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.Params.roleChangeDelay":
+		value := x.RoleChangeDelay
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.Params.authzGranteeCheck":
+		value := x.AuthzGranteeCheck
+		return protoreflect.ValueOfBool(value)
+	case "circle.fiattokenfactory.v1.Params.maxBlacklistBatchSize":
+		value := x.MaxBlacklistBatchSize
+		return protoreflect.ValueOfUint32(value)
+	case "circle.fiattokenfactory.v1.Params.memoRecipientPaths":
+		if len(x.MemoRecipientPaths) == 0 {
+			return protoreflect.ValueOfList(&_Params_7_list{})
+		}
+		listValue := &_Params_7_list{list: &x.MemoRecipientPaths}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.Params.addressRenderHrps":
+		if len(x.AddressRenderHrps) == 0 {
+			return protoreflect.ValueOfList(&_Params_8_list{})
+		}
+		listValue := &_Params_8_list{list: &x.AddressRenderHrps}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.Params.moduleExemptions":
+		if len(x.ModuleExemptions) == 0 {
+			return protoreflect.ValueOfList(&_Params_11_list{})
+		}
+		listValue := &_Params_11_list{list: &x.ModuleExemptions}
+		return protoreflect.ValueOfList(listValue)
+	case "circle.fiattokenfactory.v1.Params.twoStepRoleTransfers":
+		value := x.TwoStepRoleTransfers
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.Params"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.Params.auditLogRetention":
+		x.AuditLogRetention = value.Uint()
+	case "circle.fiattokenfactory.v1.Params.actionProposalLifetime":
+		x.ActionProposalLifetime = value.Message().Interface().(*durationpb.Duration)
+	case "circle.fiattokenfactory.v1.Params.roleChangeDelay":
+		x.RoleChangeDelay = value.Message().Interface().(*durationpb.Duration)
+	case "circle.fiattokenfactory.v1.Params.authzGranteeCheck":
+		x.AuthzGranteeCheck = value.Bool()
+	case "circle.fiattokenfactory.v1.Params.maxBlacklistBatchSize":
+		x.MaxBlacklistBatchSize = uint32(value.Uint())
+	case "circle.fiattokenfactory.v1.Params.memoRecipientPaths":
+		lv := value.List()
+		clv := lv.(*_Params_7_list)
+		x.MemoRecipientPaths = *clv.list
+	case "circle.fiattokenfactory.v1.Params.addressRenderHrps":
+		lv := value.List()
+		clv := lv.(*_Params_8_list)
+		x.AddressRenderHrps = *clv.list
+	case "circle.fiattokenfactory.v1.Params.moduleExemptions":
+		lv := value.List()
+		clv := lv.(*_Params_11_list)
+		x.ModuleExemptions = *clv.list
+	case "circle.fiattokenfactory.v1.Params.twoStepRoleTransfers":
+		x.TwoStepRoleTransfers = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.Params"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.Params.actionProposalLifetime":
+		if x.ActionProposalLifetime == nil {
+			x.ActionProposalLifetime = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.ActionProposalLifetime.ProtoReflect())
+	case "circle.fiattokenfactory.v1.Params.roleChangeDelay":
+		if x.RoleChangeDelay == nil {
+			x.RoleChangeDelay = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.RoleChangeDelay.ProtoReflect())
+	case "circle.fiattokenfactory.v1.Params.memoRecipientPaths":
+		if x.MemoRecipientPaths == nil {
+			x.MemoRecipientPaths = []string{}
+		}
+		value := &_Params_7_list{list: &x.MemoRecipientPaths}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.Params.addressRenderHrps":
+		if x.AddressRenderHrps == nil {
+			x.AddressRenderHrps = []string{}
+		}
+		value := &_Params_8_list{list: &x.AddressRenderHrps}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.Params.moduleExemptions":
+		if x.ModuleExemptions == nil {
+			x.ModuleExemptions = []*ModuleExemption{}
+		}
+		value := &_Params_11_list{list: &x.ModuleExemptions}
+		return protoreflect.ValueOfList(value)
+	case "circle.fiattokenfactory.v1.Params.auditLogRetention":
+		panic(fmt.Errorf("field auditLogRetention of message circle.fiattokenfactory.v1.Params is not mutable"))
+	case "circle.fiattokenfactory.v1.Params.authzGranteeCheck":
+		panic(fmt.Errorf("field authzGranteeCheck of message circle.fiattokenfactory.v1.Params is not mutable"))
+	case "circle.fiattokenfactory.v1.Params.maxBlacklistBatchSize":
+		panic(fmt.Errorf("field maxBlacklistBatchSize of message circle.fiattokenfactory.v1.Params is not mutable"))
+	case "circle.fiattokenfactory.v1.Params.twoStepRoleTransfers":
+		panic(fmt.Errorf("field twoStepRoleTransfers of message circle.fiattokenfactory.v1.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.Params"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.Params.auditLogRetention":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "circle.fiattokenfactory.v1.Params.actionProposalLifetime":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "circle.fiattokenfactory.v1.Params.roleChangeDelay":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "circle.fiattokenfactory.v1.Params.authzGranteeCheck":
+		return protoreflect.ValueOfBool(false)
+	case "circle.fiattokenfactory.v1.Params.maxBlacklistBatchSize":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "circle.fiattokenfactory.v1.Params.memoRecipientPaths":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_7_list{list: &list})
+	case "circle.fiattokenfactory.v1.Params.addressRenderHrps":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_8_list{list: &list})
+	case "circle.fiattokenfactory.v1.Params.moduleExemptions":
+		list := []*ModuleExemption{}
+		return protoreflect.ValueOfList(&_Params_11_list{list: &list})
+	case "circle.fiattokenfactory.v1.Params.twoStepRoleTransfers":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.Params"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in circle.fiattokenfactory.v1.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.AuditLogRetention != 0 {
+			n += 1 + runtime.Sov(uint64(x.AuditLogRetention))
+		}
+		if x.ActionProposalLifetime != nil {
+			l = options.Size(x.ActionProposalLifetime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RoleChangeDelay != nil {
+			l = options.Size(x.RoleChangeDelay)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AuthzGranteeCheck {
+			n += 2
+		}
+		if x.MaxBlacklistBatchSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxBlacklistBatchSize))
+		}
+		if len(x.MemoRecipientPaths) > 0 {
+			for _, s := range x.MemoRecipientPaths {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AddressRenderHrps) > 0 {
+			for _, s := range x.AddressRenderHrps {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ModuleExemptions) > 0 {
+			for _, e := range x.ModuleExemptions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.TwoStepRoleTransfers {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ModuleExemptions) > 0 {
+			for iNdEx := len(x.ModuleExemptions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ModuleExemptions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if x.TwoStepRoleTransfers {
+			i--
+			if x.TwoStepRoleTransfers {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.AddressRenderHrps) > 0 {
+			for iNdEx := len(x.AddressRenderHrps) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AddressRenderHrps[iNdEx])
+				copy(dAtA[i:], x.AddressRenderHrps[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AddressRenderHrps[iNdEx])))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.MemoRecipientPaths) > 0 {
+			for iNdEx := len(x.MemoRecipientPaths) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.MemoRecipientPaths[iNdEx])
+				copy(dAtA[i:], x.MemoRecipientPaths[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MemoRecipientPaths[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.MaxBlacklistBatchSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxBlacklistBatchSize))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.AuthzGranteeCheck {
+			i--
+			if x.AuthzGranteeCheck {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.RoleChangeDelay != nil {
+			encoded, err := options.Marshal(x.RoleChangeDelay)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ActionProposalLifetime != nil {
+			encoded, err := options.Marshal(x.ActionProposalLifetime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.AuditLogRetention != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AuditLogRetention))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuditLogRetention", wireType)
+				}
+				x.AuditLogRetention = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AuditLogRetention |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActionProposalLifetime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ActionProposalLifetime == nil {
+					x.ActionProposalLifetime = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ActionProposalLifetime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RoleChangeDelay", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RoleChangeDelay == nil {
+					x.RoleChangeDelay = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RoleChangeDelay); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuthzGranteeCheck", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AuthzGranteeCheck = bool(v != 0)
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBlacklistBatchSize", wireType)
+				}
+				x.MaxBlacklistBatchSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxBlacklistBatchSize |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MemoRecipientPaths", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MemoRecipientPaths = append(x.MemoRecipientPaths, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddressRenderHrps", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AddressRenderHrps = append(x.AddressRenderHrps, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleExemptions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleExemptions = append(x.ModuleExemptions, &ModuleExemption{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ModuleExemptions[len(x.ModuleExemptions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TwoStepRoleTransfers", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.TwoStepRoleTransfers = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ModuleExemption           protoreflect.MessageDescriptor
+	fd_ModuleExemption_module    protoreflect.FieldDescriptor
+	fd_ModuleExemption_pause     protoreflect.FieldDescriptor
+	fd_ModuleExemption_blacklist protoreflect.FieldDescriptor
+	fd_ModuleExemption_allowlist protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_circle_fiattokenfactory_v1_params_proto_init()
+	md_ModuleExemption = File_circle_fiattokenfactory_v1_params_proto.Messages().ByName("ModuleExemption")
+	fd_ModuleExemption_module = md_ModuleExemption.Fields().ByName("module")
+	fd_ModuleExemption_pause = md_ModuleExemption.Fields().ByName("pause")
+	fd_ModuleExemption_blacklist = md_ModuleExemption.Fields().ByName("blacklist")
+	fd_ModuleExemption_allowlist = md_ModuleExemption.Fields().ByName("allowlist")
+}
+
+var _ protoreflect.Message = (*fastReflection_ModuleExemption)(nil)
+
+type fastReflection_ModuleExemption ModuleExemption
+
+func (x *ModuleExemption) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ModuleExemption)(x)
+}
+
+func (x *ModuleExemption) slowProtoReflect() protoreflect.Message {
+	mi := &file_circle_fiattokenfactory_v1_params_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ModuleExemption_messageType fastReflection_ModuleExemption_messageType
+var _ protoreflect.MessageType = fastReflection_ModuleExemption_messageType{}
+
+type fastReflection_ModuleExemption_messageType struct{}
+
+func (x fastReflection_ModuleExemption_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ModuleExemption)(nil)
+}
+func (x fastReflection_ModuleExemption_messageType) New() protoreflect.Message {
+	return new(fastReflection_ModuleExemption)
+}
+func (x fastReflection_ModuleExemption_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ModuleExemption
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ModuleExemption) Descriptor() protoreflect.MessageDescriptor {
+	return md_ModuleExemption
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ModuleExemption) Type() protoreflect.MessageType {
+	return _fastReflection_ModuleExemption_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ModuleExemption) New() protoreflect.Message {
+	return new(fastReflection_ModuleExemption)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ModuleExemption) Interface() protoreflect.ProtoMessage {
+	return (*ModuleExemption)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ModuleExemption) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Module != "" {
+		value := protoreflect.ValueOfString(x.Module)
+		if !f(fd_ModuleExemption_module, value) {
+			return
+		}
+	}
+	if x.Pause != false {
+		value := protoreflect.ValueOfBool(x.Pause)
+		if !f(fd_ModuleExemption_pause, value) {
+			return
+		}
+	}
+	if x.Blacklist != false {
+		value := protoreflect.ValueOfBool(x.Blacklist)
+		if !f(fd_ModuleExemption_blacklist, value) {
+			return
+		}
+	}
+	if x.Allowlist != false {
+		value := protoreflect.ValueOfBool(x.Allowlist)
+		if !f(fd_ModuleExemption_allowlist, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ModuleExemption) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.ModuleExemption.module":
+		return x.Module != ""
+	case "circle.fiattokenfactory.v1.ModuleExemption.pause":
+		return x.Pause != false
+	case "circle.fiattokenfactory.v1.ModuleExemption.blacklist":
+		return x.Blacklist != false
+	case "circle.fiattokenfactory.v1.ModuleExemption.allowlist":
+		return x.Allowlist != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.ModuleExemption"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.ModuleExemption does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleExemption) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.ModuleExemption.module":
+		x.Module = ""
+	case "circle.fiattokenfactory.v1.ModuleExemption.pause":
+		x.Pause = false
+	case "circle.fiattokenfactory.v1.ModuleExemption.blacklist":
+		x.Blacklist = false
+	case "circle.fiattokenfactory.v1.ModuleExemption.allowlist":
+		x.Allowlist = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.ModuleExemption"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.ModuleExemption does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ModuleExemption) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "circle.fiattokenfactory.v1.ModuleExemption.module":
+		value := x.Module
+		return protoreflect.ValueOfString(value)
+	case "circle.fiattokenfactory.v1.ModuleExemption.pause":
+		value := x.Pause
+		return protoreflect.ValueOfBool(value)
+	case "circle.fiattokenfactory.v1.ModuleExemption.blacklist":
+		value := x.Blacklist
+		return protoreflect.ValueOfBool(value)
+	case "circle.fiattokenfactory.v1.ModuleExemption.allowlist":
+		value := x.Allowlist
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.ModuleExemption"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.ModuleExemption does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleExemption) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.ModuleExemption.module":
+		x.Module = value.Interface().(string)
+	case "circle.fiattokenfactory.v1.ModuleExemption.pause":
+		x.Pause = value.Bool()
+	case "circle.fiattokenfactory.v1.ModuleExemption.blacklist":
+		x.Blacklist = value.Bool()
+	case "circle.fiattokenfactory.v1.ModuleExemption.allowlist":
+		x.Allowlist = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.ModuleExemption"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.ModuleExemption does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleExemption) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.ModuleExemption.module":
+		panic(fmt.Errorf("field module of message circle.fiattokenfactory.v1.ModuleExemption is not mutable"))
+	case "circle.fiattokenfactory.v1.ModuleExemption.pause":
+		panic(fmt.Errorf("field pause of message circle.fiattokenfactory.v1.ModuleExemption is not mutable"))
+	case "circle.fiattokenfactory.v1.ModuleExemption.blacklist":
+		panic(fmt.Errorf("field blacklist of message circle.fiattokenfactory.v1.ModuleExemption is not mutable"))
+	case "circle.fiattokenfactory.v1.ModuleExemption.allowlist":
+		panic(fmt.Errorf("field allowlist of message circle.fiattokenfactory.v1.ModuleExemption is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.ModuleExemption"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.ModuleExemption does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ModuleExemption) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.ModuleExemption.module":
+		return protoreflect.ValueOfString("")
+	case "circle.fiattokenfactory.v1.ModuleExemption.pause":
+		return protoreflect.ValueOfBool(false)
+	case "circle.fiattokenfactory.v1.ModuleExemption.blacklist":
+		return protoreflect.ValueOfBool(false)
+	case "circle.fiattokenfactory.v1.ModuleExemption.allowlist":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.ModuleExemption"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.ModuleExemption does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ModuleExemption) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in circle.fiattokenfactory.v1.ModuleExemption", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ModuleExemption) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleExemption) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ModuleExemption) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ModuleExemption) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ModuleExemption)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Module)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pause {
+			n += 2
+		}
+		if x.Blacklist {
+			n += 2
+		}
+		if x.Allowlist {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ModuleExemption)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Allowlist {
+			i--
+			if x.Allowlist {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Blacklist {
+			i--
+			if x.Blacklist {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Pause {
+			i--
+			if x.Pause {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Module) > 0 {
+			i -= len(x.Module)
+			copy(dAtA[i:], x.Module)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Module)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ModuleExemption)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ModuleExemption: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ModuleExemption: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Module = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Pause = bool(v != 0)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Blacklist", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Blacklist = bool(v != 0)
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Allowlist = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: circle/fiattokenfactory/v1/params.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Params defines the parameters of the fiattokenfactory module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// auditLogRetention is the number of blocks an audit log entry is kept
+	// for. Zero keeps entries indefinitely.
+	AuditLogRetention uint64 `protobuf:"varint,1,opt,name=auditLogRetention,proto3" json:"auditLogRetention,omitempty"`
+	// actionProposalLifetime is the time an action proposal can collect
+	// approvals before it expires.
+	ActionProposalLifetime *durationpb.Duration `protobuf:"bytes,2,opt,name=actionProposalLifetime,proto3" json:"actionProposalLifetime,omitempty"`
+	// roleChangeDelay is the time role changes and minter controller assignments
+	// are queued for before they are executed. Zero executes them immediately.
+	RoleChangeDelay *durationpb.Duration `protobuf:"bytes,3,opt,name=roleChangeDelay,proto3" json:"roleChangeDelay,omitempty"`
+	// authzGranteeCheck enables checking the grantees of an authz execution
+	// against the blacklist.
+	AuthzGranteeCheck bool `protobuf:"varint,4,opt,name=authzGranteeCheck,proto3" json:"authzGranteeCheck,omitempty"`
+	// maxBlacklistBatchSize is the maximum number of addresses a single
+	// blacklist message can carry.
+	MaxBlacklistBatchSize uint32 `protobuf:"varint,6,opt,name=maxBlacklistBatchSize,proto3" json:"maxBlacklistBatchSize,omitempty"`
+	// memoRecipientPaths are dot separated paths of the ICS-20 memo fields that
+	// hold the eventual recipient of an inbound transfer, such as
+	// "wasm.msg.recipient". They are checked against the blacklist along with
+	// the receivers of packet forward middleware forwards.
+	MemoRecipientPaths []string `protobuf:"bytes,7,rep,name=memoRecipientPaths,proto3" json:"memoRecipientPaths,omitempty"`
+	// addressRenderHrps are the human readable parts blacklisted addresses are
+	// rendered with in query responses, unless the query requests others.
+	AddressRenderHrps []string `protobuf:"bytes,8,rep,name=addressRenderHrps,proto3" json:"addressRenderHrps,omitempty"`
+	// moduleExemptions relax the send restrictions of the minting denoms for
+	// module accounts, so that module to module flows such as fee collection
+	// and distribution keep a predictable behaviour.
+	ModuleExemptions []*ModuleExemption `protobuf:"bytes,11,rep,name=moduleExemptions,proto3" json:"moduleExemptions,omitempty"`
+	// twoStepRoleTransfers makes the master minter, pauser, blacklister and
+	// allowlister updates of the owner take effect only once the new address
+	// accepts the role with MsgAcceptRole, as the ownership does.
+	TwoStepRoleTransfers bool `protobuf:"varint,10,opt,name=twoStepRoleTransfers,proto3" json:"twoStepRoleTransfers,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circle_fiattokenfactory_v1_params_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_circle_fiattokenfactory_v1_params_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetAuditLogRetention() uint64 {
+	if x != nil {
+		return x.AuditLogRetention
+	}
+	return 0
+}
+
+func (x *Params) GetActionProposalLifetime() *durationpb.Duration {
+	if x != nil {
+		return x.ActionProposalLifetime
+	}
+	return nil
+}
+
+func (x *Params) GetRoleChangeDelay() *durationpb.Duration {
+	if x != nil {
+		return x.RoleChangeDelay
+	}
+	return nil
+}
+
+func (x *Params) GetAuthzGranteeCheck() bool {
+	if x != nil {
+		return x.AuthzGranteeCheck
+	}
+	return false
+}
+
+func (x *Params) GetMaxBlacklistBatchSize() uint32 {
+	if x != nil {
+		return x.MaxBlacklistBatchSize
+	}
+	return 0
+}
+
+func (x *Params) GetMemoRecipientPaths() []string {
+	if x != nil {
+		return x.MemoRecipientPaths
+	}
+	return nil
+}
+
+func (x *Params) GetAddressRenderHrps() []string {
+	if x != nil {
+		return x.AddressRenderHrps
+	}
+	return nil
+}
+
+func (x *Params) GetModuleExemptions() []*ModuleExemption {
+	if x != nil {
+		return x.ModuleExemptions
+	}
+	return nil
+}
+
+func (x *Params) GetTwoStepRoleTransfers() bool {
+	if x != nil {
+		return x.TwoStepRoleTransfers
+	}
+	return false
+}
+
+// ModuleExemption relaxes the send restrictions of the minting denoms for the
+// account of a module.
+type ModuleExemption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// module is the name of the module whose account is exempted.
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// pause lets transfers between two module accounts that are both exempted
+	// from the pause go through while the transfers of a minting denom are
+	// paused. Transfers involving any other account stay paused.
+	Pause bool `protobuf:"varint,2,opt,name=pause,proto3" json:"pause,omitempty"`
+	// blacklist stops the module account from being treated as blacklisted.
+	// The other party of a transfer is still checked against the blacklist.
+	Blacklist bool `protobuf:"varint,3,opt,name=blacklist,proto3" json:"blacklist,omitempty"`
+	// allowlist treats the module account as allowlisted while the allowlist
+	// mode of a minting denom is enabled.
+	Allowlist bool `protobuf:"varint,4,opt,name=allowlist,proto3" json:"allowlist,omitempty"`
+}
+
+func (x *ModuleExemption) Reset() {
+	*x = ModuleExemption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circle_fiattokenfactory_v1_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModuleExemption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleExemption) ProtoMessage() {}
+
+// Deprecated: Use ModuleExemption.ProtoReflect.Descriptor instead.
+func (*ModuleExemption) Descriptor() ([]byte, []int) {
+	return file_circle_fiattokenfactory_v1_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ModuleExemption) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *ModuleExemption) GetPause() bool {
+	if x != nil {
+		return x.Pause
+	}
+	return false
+}
+
+func (x *ModuleExemption) GetBlacklist() bool {
+	if x != nil {
+		return x.Blacklist
+	}
+	return false
+}
+
+func (x *ModuleExemption) GetAllowlist() bool {
+	if x != nil {
+		return x.Allowlist
+	}
+	return false
+}
+
+var File_circle_fiattokenfactory_v1_params_proto protoreflect.FileDescriptor
+
+var file_circle_fiattokenfactory_v1_params_proto_rawDesc = []byte{
+	0x0a, 0x27, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1a, 0x63, 0x69, 0x72, 0x63, 0x6c,
+	0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf6, 0x04, 0x0a, 0x06,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x75, 0x64, 0x69, 0x74, 0x4c,
+	0x6f, 0x67, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x11, 0x61, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5b, 0x0a, 0x16, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x4c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x16, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x4c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x4d, 0x0a, 0x0f, 0x72, 0x6f, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44,
+	0x65, 0x6c, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52,
+	0x0f, 0x72, 0x6f, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79,
+	0x12, 0x2c, 0x0a, 0x11, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x75, 0x74,
+	0x68, 0x7a, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x34,
+	0x0a, 0x15, 0x6d, 0x61, 0x78, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x6d,
+	0x61, 0x78, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x6d, 0x65, 0x6d, 0x6f, 0x52, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x12, 0x6d, 0x65, 0x6d, 0x6f, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x50,
+	0x61, 0x74, 0x68, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x48, 0x72, 0x70, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x11, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x48, 0x72,
+	0x70, 0x73, 0x12, 0x5d, 0x0a, 0x10, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x65, 0x6d,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63,
+	0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x10, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x32, 0x0a, 0x14, 0x74, 0x77, 0x6f, 0x53, 0x74, 0x65, 0x70, 0x52, 0x6f, 0x6c, 0x65,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x14, 0x74, 0x77, 0x6f, 0x53, 0x74, 0x65, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x73, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04, 0x08, 0x09, 0x10,
+	0x0a, 0x52, 0x19, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x62, 0x63, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x57, 0x68, 0x65, 0x6e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x52, 0x16, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x73, 0x22, 0x7b, 0x0a, 0x0f, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78,
+	0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x61, 0x75, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x70, 0x61, 0x75, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c,
+	0x69, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73,
+	0x74, 0x42, 0x96, 0x02, 0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65,
+	0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x5d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x66, 0x69, 0x6e, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2d,
+	0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x3b,
+	0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x46, 0x58, 0xaa, 0x02, 0x1a, 0x43, 0x69, 0x72, 0x63, 0x6c,
+	0x65, 0x2e, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1a, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x5c, 0x46,
+	0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5c,
+	0x56, 0x31, 0xe2, 0x02, 0x26, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x5c, 0x46, 0x69, 0x61, 0x74,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5c, 0x56, 0x31, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1c, 0x43, 0x69,
+	0x72, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_circle_fiattokenfactory_v1_params_proto_rawDescOnce sync.Once
+	file_circle_fiattokenfactory_v1_params_proto_rawDescData = file_circle_fiattokenfactory_v1_params_proto_rawDesc
+)
+
+func file_circle_fiattokenfactory_v1_params_proto_rawDescGZIP() []byte {
+	file_circle_fiattokenfactory_v1_params_proto_rawDescOnce.Do(func() {
+		file_circle_fiattokenfactory_v1_params_proto_rawDescData = protoimpl.X.CompressGZIP(file_circle_fiattokenfactory_v1_params_proto_rawDescData)
+	})
+	return file_circle_fiattokenfactory_v1_params_proto_rawDescData
+}
+
+var file_circle_fiattokenfactory_v1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_circle_fiattokenfactory_v1_params_proto_goTypes = []interface{}{
+	(*Params)(nil),              // 0: circle.fiattokenfactory.v1.Params
+	(*ModuleExemption)(nil),     // 1: circle.fiattokenfactory.v1.ModuleExemption
+	(*durationpb.Duration)(nil), // 2: google.protobuf.Duration
+}
+var file_circle_fiattokenfactory_v1_params_proto_depIdxs = []int32{
+	2, // 0: circle.fiattokenfactory.v1.Params.actionProposalLifetime:type_name -> google.protobuf.Duration
+	2, // 1: circle.fiattokenfactory.v1.Params.roleChangeDelay:type_name -> google.protobuf.Duration
+	1, // 2: circle.fiattokenfactory.v1.Params.moduleExemptions:type_name -> circle.fiattokenfactory.v1.ModuleExemption
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_circle_fiattokenfactory_v1_params_proto_init() }
+func file_circle_fiattokenfactory_v1_params_proto_init() {
+	if File_circle_fiattokenfactory_v1_params_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_circle_fiattokenfactory_v1_params_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Params); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circle_fiattokenfactory_v1_params_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModuleExemption); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_circle_fiattokenfactory_v1_params_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_circle_fiattokenfactory_v1_params_proto_goTypes,
+		DependencyIndexes: file_circle_fiattokenfactory_v1_params_proto_depIdxs,
+		MessageInfos:      file_circle_fiattokenfactory_v1_params_proto_msgTypes,
+	}.Build()
+	File_circle_fiattokenfactory_v1_params_proto = out.File
+	file_circle_fiattokenfactory_v1_params_proto_rawDesc = nil
+	file_circle_fiattokenfactory_v1_params_proto_goTypes = nil
+	file_circle_fiattokenfactory_v1_params_proto_depIdxs = nil
+}
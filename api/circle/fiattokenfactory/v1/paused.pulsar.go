@@ -4,23 +4,33 @@ package fiattokenfactoryv1
 import (
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
 )
 
 var (
-	md_Paused        protoreflect.MessageDescriptor
-	fd_Paused_paused protoreflect.FieldDescriptor
+	md_Paused                 protoreflect.MessageDescriptor
+	fd_Paused_paused          protoreflect.FieldDescriptor
+	fd_Paused_denom           protoreflect.FieldDescriptor
+	fd_Paused_scopes          protoreflect.FieldDescriptor
+	fd_Paused_unpauseAtHeight protoreflect.FieldDescriptor
+	fd_Paused_unpauseAtTime   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_circle_fiattokenfactory_v1_paused_proto_init()
 	md_Paused = File_circle_fiattokenfactory_v1_paused_proto.Messages().ByName("Paused")
 	fd_Paused_paused = md_Paused.Fields().ByName("paused")
+	fd_Paused_denom = md_Paused.Fields().ByName("denom")
+	fd_Paused_scopes = md_Paused.Fields().ByName("scopes")
+	fd_Paused_unpauseAtHeight = md_Paused.Fields().ByName("unpauseAtHeight")
+	fd_Paused_unpauseAtTime = md_Paused.Fields().ByName("unpauseAtTime")
 }
 
 var _ protoreflect.Message = (*fastReflection_Paused)(nil)
@@ -94,6 +104,30 @@ func (x *fastReflection_Paused) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_Paused_denom, value) {
+			return
+		}
+	}
+	if x.Scopes != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Scopes)
+		if !f(fd_Paused_scopes, value) {
+			return
+		}
+	}
+	if x.UnpauseAtHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.UnpauseAtHeight)
+		if !f(fd_Paused_unpauseAtHeight, value) {
+			return
+		}
+	}
+	if x.UnpauseAtTime != nil {
+		value := protoreflect.ValueOfMessage(x.UnpauseAtTime.ProtoReflect())
+		if !f(fd_Paused_unpauseAtTime, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -111,6 +145,14 @@ func (x *fastReflection_Paused) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "circle.fiattokenfactory.v1.Paused.paused":
 		return x.Paused != false
+	case "circle.fiattokenfactory.v1.Paused.denom":
+		return x.Denom != ""
+	case "circle.fiattokenfactory.v1.Paused.scopes":
+		return x.Scopes != uint32(0)
+	case "circle.fiattokenfactory.v1.Paused.unpauseAtHeight":
+		return x.UnpauseAtHeight != int64(0)
+	case "circle.fiattokenfactory.v1.Paused.unpauseAtTime":
+		return x.UnpauseAtTime != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.Paused"))
@@ -129,6 +171,14 @@ func (x *fastReflection_Paused) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "circle.fiattokenfactory.v1.Paused.paused":
 		x.Paused = false
+	case "circle.fiattokenfactory.v1.Paused.denom":
+		x.Denom = ""
+	case "circle.fiattokenfactory.v1.Paused.scopes":
+		x.Scopes = uint32(0)
+	case "circle.fiattokenfactory.v1.Paused.unpauseAtHeight":
+		x.UnpauseAtHeight = int64(0)
+	case "circle.fiattokenfactory.v1.Paused.unpauseAtTime":
+		x.UnpauseAtTime = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.Paused"))
@@ -148,6 +198,18 @@ func (x *fastReflection_Paused) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "circle.fiattokenfactory.v1.Paused.paused":
 		value := x.Paused
 		return protoreflect.ValueOfBool(value)
+	case "circle.fiattokenfactory.v1.Paused.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "circle.fiattokenfactory.v1.Paused.scopes":
+		value := x.Scopes
+		return protoreflect.ValueOfUint32(value)
+	case "circle.fiattokenfactory.v1.Paused.unpauseAtHeight":
+		value := x.UnpauseAtHeight
+		return protoreflect.ValueOfInt64(value)
+	case "circle.fiattokenfactory.v1.Paused.unpauseAtTime":
+		value := x.UnpauseAtTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.Paused"))
@@ -170,6 +232,14 @@ func (x *fastReflection_Paused) Set(fd protoreflect.FieldDescriptor, value proto
 	switch fd.FullName() {
 	case "circle.fiattokenfactory.v1.Paused.paused":
 		x.Paused = value.Bool()
+	case "circle.fiattokenfactory.v1.Paused.denom":
+		x.Denom = value.Interface().(string)
+	case "circle.fiattokenfactory.v1.Paused.scopes":
+		x.Scopes = uint32(value.Uint())
+	case "circle.fiattokenfactory.v1.Paused.unpauseAtHeight":
+		x.UnpauseAtHeight = value.Int()
+	case "circle.fiattokenfactory.v1.Paused.unpauseAtTime":
+		x.UnpauseAtTime = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.Paused"))
@@ -190,8 +260,19 @@ func (x *fastReflection_Paused) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Paused) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.Paused.unpauseAtTime":
+		if x.UnpauseAtTime == nil {
+			x.UnpauseAtTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.UnpauseAtTime.ProtoReflect())
 	case "circle.fiattokenfactory.v1.Paused.paused":
 		panic(fmt.Errorf("field paused of message circle.fiattokenfactory.v1.Paused is not mutable"))
+	case "circle.fiattokenfactory.v1.Paused.denom":
+		panic(fmt.Errorf("field denom of message circle.fiattokenfactory.v1.Paused is not mutable"))
+	case "circle.fiattokenfactory.v1.Paused.scopes":
+		panic(fmt.Errorf("field scopes of message circle.fiattokenfactory.v1.Paused is not mutable"))
+	case "circle.fiattokenfactory.v1.Paused.unpauseAtHeight":
+		panic(fmt.Errorf("field unpauseAtHeight of message circle.fiattokenfactory.v1.Paused is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.Paused"))
@@ -207,6 +288,15 @@ func (x *fastReflection_Paused) NewField(fd protoreflect.FieldDescriptor) protor
 	switch fd.FullName() {
 	case "circle.fiattokenfactory.v1.Paused.paused":
 		return protoreflect.ValueOfBool(false)
+	case "circle.fiattokenfactory.v1.Paused.denom":
+		return protoreflect.ValueOfString("")
+	case "circle.fiattokenfactory.v1.Paused.scopes":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "circle.fiattokenfactory.v1.Paused.unpauseAtHeight":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "circle.fiattokenfactory.v1.Paused.unpauseAtTime":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.Paused"))
@@ -279,6 +369,20 @@ func (x *fastReflection_Paused) ProtoMethods() *protoiface.Methods {
 		if x.Paused {
 			n += 2
 		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Scopes != 0 {
+			n += 1 + runtime.Sov(uint64(x.Scopes))
+		}
+		if x.UnpauseAtHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.UnpauseAtHeight))
+		}
+		if x.UnpauseAtTime != nil {
+			l = options.Size(x.UnpauseAtTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -308,6 +412,37 @@ func (x *fastReflection_Paused) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.UnpauseAtTime != nil {
+			encoded, err := options.Marshal(x.UnpauseAtTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.UnpauseAtHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UnpauseAtHeight))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Scopes != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Scopes))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x12
+		}
 		if x.Paused {
 			i--
 			if x.Paused {
@@ -387,6 +522,112 @@ func (x *fastReflection_Paused) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.Paused = bool(v != 0)
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+				}
+				x.Scopes = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Scopes |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnpauseAtHeight", wireType)
+				}
+				x.UnpauseAtHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UnpauseAtHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnpauseAtTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.UnpauseAtTime == nil {
+					x.UnpauseAtTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnpauseAtTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -435,12 +676,90 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// PauseScope is an operation of a minting denom that can be paused on its own.
+type PauseScope int32
+
+const (
+	PauseScope_PAUSE_SCOPE_UNSPECIFIED PauseScope = 0
+	// PAUSE_SCOPE_MINT pauses minting and minter configuration.
+	PauseScope_PAUSE_SCOPE_MINT PauseScope = 1
+	// PAUSE_SCOPE_BURN pauses burning.
+	PauseScope_PAUSE_SCOPE_BURN PauseScope = 2
+	// PAUSE_SCOPE_TRANSFER pauses every bank transfer of the denom.
+	PauseScope_PAUSE_SCOPE_TRANSFER PauseScope = 3
+	// PAUSE_SCOPE_IBC_IN pauses receiving the denom over IBC.
+	PauseScope_PAUSE_SCOPE_IBC_IN PauseScope = 4
+	// PAUSE_SCOPE_IBC_OUT pauses sending the denom over IBC.
+	PauseScope_PAUSE_SCOPE_IBC_OUT PauseScope = 5
+	// PAUSE_SCOPE_AUTHZ pauses granting send authorizations of the denom.
+	PauseScope_PAUSE_SCOPE_AUTHZ PauseScope = 6
+)
+
+// Enum value maps for PauseScope.
+var (
+	PauseScope_name = map[int32]string{
+		0: "PAUSE_SCOPE_UNSPECIFIED",
+		1: "PAUSE_SCOPE_MINT",
+		2: "PAUSE_SCOPE_BURN",
+		3: "PAUSE_SCOPE_TRANSFER",
+		4: "PAUSE_SCOPE_IBC_IN",
+		5: "PAUSE_SCOPE_IBC_OUT",
+		6: "PAUSE_SCOPE_AUTHZ",
+	}
+	PauseScope_value = map[string]int32{
+		"PAUSE_SCOPE_UNSPECIFIED": 0,
+		"PAUSE_SCOPE_MINT":        1,
+		"PAUSE_SCOPE_BURN":        2,
+		"PAUSE_SCOPE_TRANSFER":    3,
+		"PAUSE_SCOPE_IBC_IN":      4,
+		"PAUSE_SCOPE_IBC_OUT":     5,
+		"PAUSE_SCOPE_AUTHZ":       6,
+	}
+)
+
+func (x PauseScope) Enum() *PauseScope {
+	p := new(PauseScope)
+	*p = x
+	return p
+}
+
+func (x PauseScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PauseScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_circle_fiattokenfactory_v1_paused_proto_enumTypes[0].Descriptor()
+}
+
+func (PauseScope) Type() protoreflect.EnumType {
+	return &file_circle_fiattokenfactory_v1_paused_proto_enumTypes[0]
+}
+
+func (x PauseScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PauseScope.Descriptor instead.
+func (PauseScope) EnumDescriptor() ([]byte, []int) {
+	return file_circle_fiattokenfactory_v1_paused_proto_rawDescGZIP(), []int{0}
+}
+
 type Paused struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	// paused is set when at least one scope is paused.
+	Paused bool   `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// scopes is the bitset of the paused scopes, where a scope is represented
+	// by the bit 1 << (scope - 1).
+	Scopes uint32 `protobuf:"varint,3,opt,name=scopes,proto3" json:"scopes,omitempty"`
+	// unpauseAtHeight and unpauseAtTime optionally schedule the lifting of the
+	// pause. All scopes are unpaused at the end of the first block reaching
+	// either of them.
+	UnpauseAtHeight int64                  `protobuf:"varint,4,opt,name=unpauseAtHeight,proto3" json:"unpauseAtHeight,omitempty"`
+	UnpauseAtTime   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=unpauseAtTime,proto3" json:"unpauseAtTime,omitempty"`
 }
 
 func (x *Paused) Reset() {
@@ -470,6 +789,34 @@ func (x *Paused) GetPaused() bool {
 	return false
 }
 
+func (x *Paused) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *Paused) GetScopes() uint32 {
+	if x != nil {
+		return x.Scopes
+	}
+	return 0
+}
+
+func (x *Paused) GetUnpauseAtHeight() int64 {
+	if x != nil {
+		return x.UnpauseAtHeight
+	}
+	return 0
+}
+
+func (x *Paused) GetUnpauseAtTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnpauseAtTime
+	}
+	return nil
+}
+
 var File_circle_fiattokenfactory_v1_paused_proto protoreflect.FileDescriptor
 
 var file_circle_fiattokenfactory_v1_paused_proto_rawDesc = []byte{
@@ -477,27 +824,52 @@ var file_circle_fiattokenfactory_v1_paused_proto_rawDesc = []byte{
 	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x75,
 	0x73, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1a, 0x63, 0x69, 0x72, 0x63, 0x6c,
 	0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x79, 0x2e, 0x76, 0x31, 0x22, 0x20, 0x0a, 0x06, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12,
-	0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x42, 0x96, 0x02, 0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e,
-	0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x75, 0x73,
-	0x65, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x5d, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x66, 0x69, 0x6e, 0x2f,
-	0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66,
-	0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x6c,
-	0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66,
-	0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x46, 0x58, 0xaa, 0x02,
-	0x1a, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1a, 0x43, 0x69,
-	0x72, 0x63, 0x6c, 0x65, 0x5c, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61,
-	0x63, 0x74, 0x6f, 0x72, 0x79, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x26, 0x43, 0x69, 0x72, 0x63, 0x6c,
-	0x65, 0x5c, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x79, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x1c, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x46, 0x69, 0x61, 0x74,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc0, 0x01, 0x0a,
+	0x06, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x28, 0x0a,
+	0x0f, 0x75, 0x6e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x41, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x75, 0x6e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x41,
+	0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x46, 0x0a, 0x0d, 0x75, 0x6e, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01,
+	0x52, 0x0d, 0x75, 0x6e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x2a,
+	0xb7, 0x01, 0x0a, 0x0a, 0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x1b,
+	0x0a, 0x17, 0x50, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x50,
+	0x41, 0x55, 0x53, 0x45, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x4d, 0x49, 0x4e, 0x54, 0x10,
+	0x01, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45,
+	0x5f, 0x42, 0x55, 0x52, 0x4e, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x41, 0x55, 0x53, 0x45,
+	0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x10,
+	0x03, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45,
+	0x5f, 0x49, 0x42, 0x43, 0x5f, 0x49, 0x4e, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x41, 0x55,
+	0x53, 0x45, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x49, 0x42, 0x43, 0x5f, 0x4f, 0x55, 0x54,
+	0x10, 0x05, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x53, 0x43, 0x4f, 0x50,
+	0x45, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5a, 0x10, 0x06, 0x42, 0x96, 0x02, 0x0a, 0x1e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61,
+	0x75, 0x73, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x5d, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x66, 0x69,
+	0x6e, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x69, 0x72,
+	0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x46, 0x58,
+	0xaa, 0x02, 0x1a, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1a,
+	0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x5c, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x26, 0x43, 0x69, 0x72,
+	0x63, 0x6c, 0x65, 0x5c, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x1c, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x46, 0x69,
+	0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x3a, 0x3a,
+	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -512,16 +884,20 @@ func file_circle_fiattokenfactory_v1_paused_proto_rawDescGZIP() []byte {
 	return file_circle_fiattokenfactory_v1_paused_proto_rawDescData
 }
 
+var file_circle_fiattokenfactory_v1_paused_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_circle_fiattokenfactory_v1_paused_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_circle_fiattokenfactory_v1_paused_proto_goTypes = []interface{}{
-	(*Paused)(nil), // 0: circle.fiattokenfactory.v1.Paused
+	(PauseScope)(0),               // 0: circle.fiattokenfactory.v1.PauseScope
+	(*Paused)(nil),                // 1: circle.fiattokenfactory.v1.Paused
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
 }
 var file_circle_fiattokenfactory_v1_paused_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2, // 0: circle.fiattokenfactory.v1.Paused.unpauseAtTime:type_name -> google.protobuf.Timestamp
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_circle_fiattokenfactory_v1_paused_proto_init() }
@@ -548,13 +924,14 @@ func file_circle_fiattokenfactory_v1_paused_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_circle_fiattokenfactory_v1_paused_proto_rawDesc,
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   1,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_circle_fiattokenfactory_v1_paused_proto_goTypes,
 		DependencyIndexes: file_circle_fiattokenfactory_v1_paused_proto_depIdxs,
+		EnumInfos:         file_circle_fiattokenfactory_v1_paused_proto_enumTypes,
 		MessageInfos:      file_circle_fiattokenfactory_v1_paused_proto_msgTypes,
 	}.Build()
 	File_circle_fiattokenfactory_v1_paused_proto = out.File
@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Query_Blacklisted_FullMethodName         = "/circle.fiattokenfactory.v1.Query/Blacklisted"
 	Query_BlacklistedAll_FullMethodName      = "/circle.fiattokenfactory.v1.Query/BlacklistedAll"
+	Query_BlacklistedByBytes_FullMethodName  = "/circle.fiattokenfactory.v1.Query/BlacklistedByBytes"
 	Query_Paused_FullMethodName              = "/circle.fiattokenfactory.v1.Query/Paused"
 	Query_MasterMinter_FullMethodName        = "/circle.fiattokenfactory.v1.Query/MasterMinter"
 	Query_Minters_FullMethodName             = "/circle.fiattokenfactory.v1.Query/Minters"
@@ -28,9 +29,27 @@ const (
 	Query_Pauser_FullMethodName              = "/circle.fiattokenfactory.v1.Query/Pauser"
 	Query_Blacklister_FullMethodName         = "/circle.fiattokenfactory.v1.Query/Blacklister"
 	Query_Owner_FullMethodName               = "/circle.fiattokenfactory.v1.Query/Owner"
+	Query_PendingOwner_FullMethodName        = "/circle.fiattokenfactory.v1.Query/PendingOwner"
+	Query_PendingRoles_FullMethodName        = "/circle.fiattokenfactory.v1.Query/PendingRoles"
 	Query_MinterController_FullMethodName    = "/circle.fiattokenfactory.v1.Query/MinterController"
 	Query_MinterControllerAll_FullMethodName = "/circle.fiattokenfactory.v1.Query/MinterControllerAll"
 	Query_MintingDenom_FullMethodName        = "/circle.fiattokenfactory.v1.Query/MintingDenom"
+	Query_MintingDenomAll_FullMethodName     = "/circle.fiattokenfactory.v1.Query/MintingDenomAll"
+	Query_Params_FullMethodName              = "/circle.fiattokenfactory.v1.Query/Params"
+	Query_AuditLog_FullMethodName            = "/circle.fiattokenfactory.v1.Query/AuditLog"
+	Query_SignerSet_FullMethodName           = "/circle.fiattokenfactory.v1.Query/SignerSet"
+	Query_ActionProposal_FullMethodName      = "/circle.fiattokenfactory.v1.Query/ActionProposal"
+	Query_ActionProposalAll_FullMethodName   = "/circle.fiattokenfactory.v1.Query/ActionProposalAll"
+	Query_QueuedAction_FullMethodName        = "/circle.fiattokenfactory.v1.Query/QueuedAction"
+	Query_QueuedActionAll_FullMethodName     = "/circle.fiattokenfactory.v1.Query/QueuedActionAll"
+	Query_EscrowBalance_FullMethodName       = "/circle.fiattokenfactory.v1.Query/EscrowBalance"
+	Query_ChannelPolicies_FullMethodName     = "/circle.fiattokenfactory.v1.Query/ChannelPolicies"
+	Query_ChannelRateLimits_FullMethodName   = "/circle.fiattokenfactory.v1.Query/ChannelRateLimits"
+	Query_Allowlister_FullMethodName         = "/circle.fiattokenfactory.v1.Query/Allowlister"
+	Query_Allowlisted_FullMethodName         = "/circle.fiattokenfactory.v1.Query/Allowlisted"
+	Query_AllowlistedAll_FullMethodName      = "/circle.fiattokenfactory.v1.Query/AllowlistedAll"
+	Query_AllowlistMode_FullMethodName       = "/circle.fiattokenfactory.v1.Query/AllowlistMode"
+	Query_ModuleExemptions_FullMethodName    = "/circle.fiattokenfactory.v1.Query/ModuleExemptions"
 )
 
 // QueryClient is the client API for Query service.
@@ -41,6 +60,8 @@ type QueryClient interface {
 	Blacklisted(ctx context.Context, in *QueryGetBlacklistedRequest, opts ...grpc.CallOption) (*QueryGetBlacklistedResponse, error)
 	// Queries a list of Blacklisted items.
 	BlacklistedAll(ctx context.Context, in *QueryAllBlacklistedRequest, opts ...grpc.CallOption) (*QueryAllBlacklistedResponse, error)
+	// Queries a Blacklisted by its hex or base64 encoded address bytes.
+	BlacklistedByBytes(ctx context.Context, in *QueryBlacklistedByBytesRequest, opts ...grpc.CallOption) (*QueryBlacklistedByBytesResponse, error)
 	// Queries a Paused by index.
 	Paused(ctx context.Context, in *QueryGetPausedRequest, opts ...grpc.CallOption) (*QueryGetPausedResponse, error)
 	// Queries a MasterMinter by index.
@@ -55,12 +76,48 @@ type QueryClient interface {
 	Blacklister(ctx context.Context, in *QueryGetBlacklisterRequest, opts ...grpc.CallOption) (*QueryGetBlacklisterResponse, error)
 	// Queries a Owner by index.
 	Owner(ctx context.Context, in *QueryGetOwnerRequest, opts ...grpc.CallOption) (*QueryGetOwnerResponse, error)
+	// Queries the pending owner of a minting denom.
+	PendingOwner(ctx context.Context, in *QueryGetPendingOwnerRequest, opts ...grpc.CallOption) (*QueryGetPendingOwnerResponse, error)
+	// Queries the pending roles of a minting denom.
+	PendingRoles(ctx context.Context, in *QueryPendingRolesRequest, opts ...grpc.CallOption) (*QueryPendingRolesResponse, error)
 	// Queries a MinterController by index.
 	MinterController(ctx context.Context, in *QueryGetMinterControllerRequest, opts ...grpc.CallOption) (*QueryGetMinterControllerResponse, error)
 	// Queries a list of MinterController items.
 	MinterControllerAll(ctx context.Context, in *QueryAllMinterControllerRequest, opts ...grpc.CallOption) (*QueryAllMinterControllerResponse, error)
 	// Queries a MintingDenom by index.
 	MintingDenom(ctx context.Context, in *QueryGetMintingDenomRequest, opts ...grpc.CallOption) (*QueryGetMintingDenomResponse, error)
+	// Queries a list of MintingDenom items.
+	MintingDenomAll(ctx context.Context, in *QueryAllMintingDenomRequest, opts ...grpc.CallOption) (*QueryAllMintingDenomResponse, error)
+	// Queries the module params.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries the audit log of privileged role actions.
+	AuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error)
+	// Queries the signer set of a role.
+	SignerSet(ctx context.Context, in *QueryGetSignerSetRequest, opts ...grpc.CallOption) (*QueryGetSignerSetResponse, error)
+	// Queries an ActionProposal by id.
+	ActionProposal(ctx context.Context, in *QueryGetActionProposalRequest, opts ...grpc.CallOption) (*QueryGetActionProposalResponse, error)
+	// Queries a list of ActionProposal items.
+	ActionProposalAll(ctx context.Context, in *QueryAllActionProposalRequest, opts ...grpc.CallOption) (*QueryAllActionProposalResponse, error)
+	// Queries a QueuedAction by id.
+	QueuedAction(ctx context.Context, in *QueryGetQueuedActionRequest, opts ...grpc.CallOption) (*QueryGetQueuedActionResponse, error)
+	// Queries a list of QueuedAction items.
+	QueuedActionAll(ctx context.Context, in *QueryAllQueuedActionRequest, opts ...grpc.CallOption) (*QueryAllQueuedActionResponse, error)
+	// Queries the seized funds held in the escrow account for a minting denom.
+	EscrowBalance(ctx context.Context, in *QueryEscrowBalanceRequest, opts ...grpc.CallOption) (*QueryEscrowBalanceResponse, error)
+	// Queries the IBC channel policies of a minting denom.
+	ChannelPolicies(ctx context.Context, in *QueryChannelPoliciesRequest, opts ...grpc.CallOption) (*QueryChannelPoliciesResponse, error)
+	// Queries the IBC channel rate limits of a minting denom.
+	ChannelRateLimits(ctx context.Context, in *QueryChannelRateLimitsRequest, opts ...grpc.CallOption) (*QueryChannelRateLimitsResponse, error)
+	// Queries an Allowlister by index.
+	Allowlister(ctx context.Context, in *QueryGetAllowlisterRequest, opts ...grpc.CallOption) (*QueryGetAllowlisterResponse, error)
+	// Queries an Allowlisted by index.
+	Allowlisted(ctx context.Context, in *QueryGetAllowlistedRequest, opts ...grpc.CallOption) (*QueryGetAllowlistedResponse, error)
+	// Queries a list of Allowlisted items.
+	AllowlistedAll(ctx context.Context, in *QueryAllAllowlistedRequest, opts ...grpc.CallOption) (*QueryAllAllowlistedResponse, error)
+	// Queries whether the allowlist mode of a minting denom is enabled.
+	AllowlistMode(ctx context.Context, in *QueryAllowlistModeRequest, opts ...grpc.CallOption) (*QueryAllowlistModeResponse, error)
+	// Queries the module accounts exempted from the send restrictions.
+	ModuleExemptions(ctx context.Context, in *QueryModuleExemptionsRequest, opts ...grpc.CallOption) (*QueryModuleExemptionsResponse, error)
 }
 
 type queryClient struct {
@@ -89,6 +146,15 @@ func (c *queryClient) BlacklistedAll(ctx context.Context, in *QueryAllBlackliste
 	return out, nil
 }
 
+func (c *queryClient) BlacklistedByBytes(ctx context.Context, in *QueryBlacklistedByBytesRequest, opts ...grpc.CallOption) (*QueryBlacklistedByBytesResponse, error) {
+	out := new(QueryBlacklistedByBytesResponse)
+	err := c.cc.Invoke(ctx, Query_BlacklistedByBytes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Paused(ctx context.Context, in *QueryGetPausedRequest, opts ...grpc.CallOption) (*QueryGetPausedResponse, error) {
 	out := new(QueryGetPausedResponse)
 	err := c.cc.Invoke(ctx, Query_Paused_FullMethodName, in, out, opts...)
@@ -152,6 +218,24 @@ func (c *queryClient) Owner(ctx context.Context, in *QueryGetOwnerRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) PendingOwner(ctx context.Context, in *QueryGetPendingOwnerRequest, opts ...grpc.CallOption) (*QueryGetPendingOwnerResponse, error) {
+	out := new(QueryGetPendingOwnerResponse)
+	err := c.cc.Invoke(ctx, Query_PendingOwner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingRoles(ctx context.Context, in *QueryPendingRolesRequest, opts ...grpc.CallOption) (*QueryPendingRolesResponse, error) {
+	out := new(QueryPendingRolesResponse)
+	err := c.cc.Invoke(ctx, Query_PendingRoles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) MinterController(ctx context.Context, in *QueryGetMinterControllerRequest, opts ...grpc.CallOption) (*QueryGetMinterControllerResponse, error) {
 	out := new(QueryGetMinterControllerResponse)
 	err := c.cc.Invoke(ctx, Query_MinterController_FullMethodName, in, out, opts...)
@@ -179,6 +263,150 @@ func (c *queryClient) MintingDenom(ctx context.Context, in *QueryGetMintingDenom
 	return out, nil
 }
 
+func (c *queryClient) MintingDenomAll(ctx context.Context, in *QueryAllMintingDenomRequest, opts ...grpc.CallOption) (*QueryAllMintingDenomResponse, error) {
+	out := new(QueryAllMintingDenomResponse)
+	err := c.cc.Invoke(ctx, Query_MintingDenomAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error) {
+	out := new(QueryAuditLogResponse)
+	err := c.cc.Invoke(ctx, Query_AuditLog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SignerSet(ctx context.Context, in *QueryGetSignerSetRequest, opts ...grpc.CallOption) (*QueryGetSignerSetResponse, error) {
+	out := new(QueryGetSignerSetResponse)
+	err := c.cc.Invoke(ctx, Query_SignerSet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ActionProposal(ctx context.Context, in *QueryGetActionProposalRequest, opts ...grpc.CallOption) (*QueryGetActionProposalResponse, error) {
+	out := new(QueryGetActionProposalResponse)
+	err := c.cc.Invoke(ctx, Query_ActionProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ActionProposalAll(ctx context.Context, in *QueryAllActionProposalRequest, opts ...grpc.CallOption) (*QueryAllActionProposalResponse, error) {
+	out := new(QueryAllActionProposalResponse)
+	err := c.cc.Invoke(ctx, Query_ActionProposalAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedAction(ctx context.Context, in *QueryGetQueuedActionRequest, opts ...grpc.CallOption) (*QueryGetQueuedActionResponse, error) {
+	out := new(QueryGetQueuedActionResponse)
+	err := c.cc.Invoke(ctx, Query_QueuedAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedActionAll(ctx context.Context, in *QueryAllQueuedActionRequest, opts ...grpc.CallOption) (*QueryAllQueuedActionResponse, error) {
+	out := new(QueryAllQueuedActionResponse)
+	err := c.cc.Invoke(ctx, Query_QueuedActionAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EscrowBalance(ctx context.Context, in *QueryEscrowBalanceRequest, opts ...grpc.CallOption) (*QueryEscrowBalanceResponse, error) {
+	out := new(QueryEscrowBalanceResponse)
+	err := c.cc.Invoke(ctx, Query_EscrowBalance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelPolicies(ctx context.Context, in *QueryChannelPoliciesRequest, opts ...grpc.CallOption) (*QueryChannelPoliciesResponse, error) {
+	out := new(QueryChannelPoliciesResponse)
+	err := c.cc.Invoke(ctx, Query_ChannelPolicies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelRateLimits(ctx context.Context, in *QueryChannelRateLimitsRequest, opts ...grpc.CallOption) (*QueryChannelRateLimitsResponse, error) {
+	out := new(QueryChannelRateLimitsResponse)
+	err := c.cc.Invoke(ctx, Query_ChannelRateLimits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Allowlister(ctx context.Context, in *QueryGetAllowlisterRequest, opts ...grpc.CallOption) (*QueryGetAllowlisterResponse, error) {
+	out := new(QueryGetAllowlisterResponse)
+	err := c.cc.Invoke(ctx, Query_Allowlister_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Allowlisted(ctx context.Context, in *QueryGetAllowlistedRequest, opts ...grpc.CallOption) (*QueryGetAllowlistedResponse, error) {
+	out := new(QueryGetAllowlistedResponse)
+	err := c.cc.Invoke(ctx, Query_Allowlisted_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowlistedAll(ctx context.Context, in *QueryAllAllowlistedRequest, opts ...grpc.CallOption) (*QueryAllAllowlistedResponse, error) {
+	out := new(QueryAllAllowlistedResponse)
+	err := c.cc.Invoke(ctx, Query_AllowlistedAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowlistMode(ctx context.Context, in *QueryAllowlistModeRequest, opts ...grpc.CallOption) (*QueryAllowlistModeResponse, error) {
+	out := new(QueryAllowlistModeResponse)
+	err := c.cc.Invoke(ctx, Query_AllowlistMode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModuleExemptions(ctx context.Context, in *QueryModuleExemptionsRequest, opts ...grpc.CallOption) (*QueryModuleExemptionsResponse, error) {
+	out := new(QueryModuleExemptionsResponse)
+	err := c.cc.Invoke(ctx, Query_ModuleExemptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -187,6 +415,8 @@ type QueryServer interface {
 	Blacklisted(context.Context, *QueryGetBlacklistedRequest) (*QueryGetBlacklistedResponse, error)
 	// Queries a list of Blacklisted items.
 	BlacklistedAll(context.Context, *QueryAllBlacklistedRequest) (*QueryAllBlacklistedResponse, error)
+	// Queries a Blacklisted by its hex or base64 encoded address bytes.
+	BlacklistedByBytes(context.Context, *QueryBlacklistedByBytesRequest) (*QueryBlacklistedByBytesResponse, error)
 	// Queries a Paused by index.
 	Paused(context.Context, *QueryGetPausedRequest) (*QueryGetPausedResponse, error)
 	// Queries a MasterMinter by index.
@@ -201,12 +431,48 @@ type QueryServer interface {
 	Blacklister(context.Context, *QueryGetBlacklisterRequest) (*QueryGetBlacklisterResponse, error)
 	// Queries a Owner by index.
 	Owner(context.Context, *QueryGetOwnerRequest) (*QueryGetOwnerResponse, error)
+	// Queries the pending owner of a minting denom.
+	PendingOwner(context.Context, *QueryGetPendingOwnerRequest) (*QueryGetPendingOwnerResponse, error)
+	// Queries the pending roles of a minting denom.
+	PendingRoles(context.Context, *QueryPendingRolesRequest) (*QueryPendingRolesResponse, error)
 	// Queries a MinterController by index.
 	MinterController(context.Context, *QueryGetMinterControllerRequest) (*QueryGetMinterControllerResponse, error)
 	// Queries a list of MinterController items.
 	MinterControllerAll(context.Context, *QueryAllMinterControllerRequest) (*QueryAllMinterControllerResponse, error)
 	// Queries a MintingDenom by index.
 	MintingDenom(context.Context, *QueryGetMintingDenomRequest) (*QueryGetMintingDenomResponse, error)
+	// Queries a list of MintingDenom items.
+	MintingDenomAll(context.Context, *QueryAllMintingDenomRequest) (*QueryAllMintingDenomResponse, error)
+	// Queries the module params.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries the audit log of privileged role actions.
+	AuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error)
+	// Queries the signer set of a role.
+	SignerSet(context.Context, *QueryGetSignerSetRequest) (*QueryGetSignerSetResponse, error)
+	// Queries an ActionProposal by id.
+	ActionProposal(context.Context, *QueryGetActionProposalRequest) (*QueryGetActionProposalResponse, error)
+	// Queries a list of ActionProposal items.
+	ActionProposalAll(context.Context, *QueryAllActionProposalRequest) (*QueryAllActionProposalResponse, error)
+	// Queries a QueuedAction by id.
+	QueuedAction(context.Context, *QueryGetQueuedActionRequest) (*QueryGetQueuedActionResponse, error)
+	// Queries a list of QueuedAction items.
+	QueuedActionAll(context.Context, *QueryAllQueuedActionRequest) (*QueryAllQueuedActionResponse, error)
+	// Queries the seized funds held in the escrow account for a minting denom.
+	EscrowBalance(context.Context, *QueryEscrowBalanceRequest) (*QueryEscrowBalanceResponse, error)
+	// Queries the IBC channel policies of a minting denom.
+	ChannelPolicies(context.Context, *QueryChannelPoliciesRequest) (*QueryChannelPoliciesResponse, error)
+	// Queries the IBC channel rate limits of a minting denom.
+	ChannelRateLimits(context.Context, *QueryChannelRateLimitsRequest) (*QueryChannelRateLimitsResponse, error)
+	// Queries an Allowlister by index.
+	Allowlister(context.Context, *QueryGetAllowlisterRequest) (*QueryGetAllowlisterResponse, error)
+	// Queries an Allowlisted by index.
+	Allowlisted(context.Context, *QueryGetAllowlistedRequest) (*QueryGetAllowlistedResponse, error)
+	// Queries a list of Allowlisted items.
+	AllowlistedAll(context.Context, *QueryAllAllowlistedRequest) (*QueryAllAllowlistedResponse, error)
+	// Queries whether the allowlist mode of a minting denom is enabled.
+	AllowlistMode(context.Context, *QueryAllowlistModeRequest) (*QueryAllowlistModeResponse, error)
+	// Queries the module accounts exempted from the send restrictions.
+	ModuleExemptions(context.Context, *QueryModuleExemptionsRequest) (*QueryModuleExemptionsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -220,6 +486,9 @@ func (UnimplementedQueryServer) Blacklisted(context.Context, *QueryGetBlackliste
 func (UnimplementedQueryServer) BlacklistedAll(context.Context, *QueryAllBlacklistedRequest) (*QueryAllBlacklistedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlacklistedAll not implemented")
 }
+func (UnimplementedQueryServer) BlacklistedByBytes(context.Context, *QueryBlacklistedByBytesRequest) (*QueryBlacklistedByBytesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlacklistedByBytes not implemented")
+}
 func (UnimplementedQueryServer) Paused(context.Context, *QueryGetPausedRequest) (*QueryGetPausedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Paused not implemented")
 }
@@ -241,6 +510,12 @@ func (UnimplementedQueryServer) Blacklister(context.Context, *QueryGetBlackliste
 func (UnimplementedQueryServer) Owner(context.Context, *QueryGetOwnerRequest) (*QueryGetOwnerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Owner not implemented")
 }
+func (UnimplementedQueryServer) PendingOwner(context.Context, *QueryGetPendingOwnerRequest) (*QueryGetPendingOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingOwner not implemented")
+}
+func (UnimplementedQueryServer) PendingRoles(context.Context, *QueryPendingRolesRequest) (*QueryPendingRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRoles not implemented")
+}
 func (UnimplementedQueryServer) MinterController(context.Context, *QueryGetMinterControllerRequest) (*QueryGetMinterControllerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MinterController not implemented")
 }
@@ -250,6 +525,54 @@ func (UnimplementedQueryServer) MinterControllerAll(context.Context, *QueryAllMi
 func (UnimplementedQueryServer) MintingDenom(context.Context, *QueryGetMintingDenomRequest) (*QueryGetMintingDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MintingDenom not implemented")
 }
+func (UnimplementedQueryServer) MintingDenomAll(context.Context, *QueryAllMintingDenomRequest) (*QueryAllMintingDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintingDenomAll not implemented")
+}
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) AuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditLog not implemented")
+}
+func (UnimplementedQueryServer) SignerSet(context.Context, *QueryGetSignerSetRequest) (*QueryGetSignerSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignerSet not implemented")
+}
+func (UnimplementedQueryServer) ActionProposal(context.Context, *QueryGetActionProposalRequest) (*QueryGetActionProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActionProposal not implemented")
+}
+func (UnimplementedQueryServer) ActionProposalAll(context.Context, *QueryAllActionProposalRequest) (*QueryAllActionProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActionProposalAll not implemented")
+}
+func (UnimplementedQueryServer) QueuedAction(context.Context, *QueryGetQueuedActionRequest) (*QueryGetQueuedActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedAction not implemented")
+}
+func (UnimplementedQueryServer) QueuedActionAll(context.Context, *QueryAllQueuedActionRequest) (*QueryAllQueuedActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedActionAll not implemented")
+}
+func (UnimplementedQueryServer) EscrowBalance(context.Context, *QueryEscrowBalanceRequest) (*QueryEscrowBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowBalance not implemented")
+}
+func (UnimplementedQueryServer) ChannelPolicies(context.Context, *QueryChannelPoliciesRequest) (*QueryChannelPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelPolicies not implemented")
+}
+func (UnimplementedQueryServer) ChannelRateLimits(context.Context, *QueryChannelRateLimitsRequest) (*QueryChannelRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelRateLimits not implemented")
+}
+func (UnimplementedQueryServer) Allowlister(context.Context, *QueryGetAllowlisterRequest) (*QueryGetAllowlisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Allowlister not implemented")
+}
+func (UnimplementedQueryServer) Allowlisted(context.Context, *QueryGetAllowlistedRequest) (*QueryGetAllowlistedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Allowlisted not implemented")
+}
+func (UnimplementedQueryServer) AllowlistedAll(context.Context, *QueryAllAllowlistedRequest) (*QueryAllAllowlistedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowlistedAll not implemented")
+}
+func (UnimplementedQueryServer) AllowlistMode(context.Context, *QueryAllowlistModeRequest) (*QueryAllowlistModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowlistMode not implemented")
+}
+func (UnimplementedQueryServer) ModuleExemptions(context.Context, *QueryModuleExemptionsRequest) (*QueryModuleExemptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleExemptions not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -299,6 +622,24 @@ func _Query_BlacklistedAll_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BlacklistedByBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlacklistedByBytesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlacklistedByBytes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BlacklistedByBytes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlacklistedByBytes(ctx, req.(*QueryBlacklistedByBytesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Paused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGetPausedRequest)
 	if err := dec(in); err != nil {
@@ -425,6 +766,42 @@ func _Query_Owner_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPendingOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingOwner(ctx, req.(*QueryGetPendingOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingRoles(ctx, req.(*QueryPendingRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_MinterController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGetMinterControllerRequest)
 	if err := dec(in); err != nil {
@@ -479,6 +856,294 @@ func _Query_MintingDenom_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MintingDenomAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMintingDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintingDenomAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MintingDenomAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintingDenomAll(ctx, req.(*QueryAllMintingDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuditLog(ctx, req.(*QueryAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignerSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSignerSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignerSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SignerSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignerSet(ctx, req.(*QueryGetSignerSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ActionProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetActionProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActionProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ActionProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActionProposal(ctx, req.(*QueryGetActionProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ActionProposalAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllActionProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActionProposalAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ActionProposalAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActionProposalAll(ctx, req.(*QueryAllActionProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetQueuedActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueuedAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedAction(ctx, req.(*QueryGetQueuedActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedActionAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllQueuedActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedActionAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueuedActionAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedActionAll(ctx, req.(*QueryAllQueuedActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EscrowBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EscrowBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowBalance(ctx, req.(*QueryEscrowBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ChannelPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelPolicies(ctx, req.(*QueryChannelPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelRateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelRateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ChannelRateLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelRateLimits(ctx, req.(*QueryChannelRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Allowlister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAllowlisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Allowlister(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Allowlister_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Allowlister(ctx, req.(*QueryGetAllowlisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Allowlisted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAllowlistedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Allowlisted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Allowlisted_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Allowlisted(ctx, req.(*QueryGetAllowlistedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowlistedAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAllowlistedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowlistedAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllowlistedAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowlistedAll(ctx, req.(*QueryAllAllowlistedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowlistMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowlistModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowlistMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllowlistMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowlistMode(ctx, req.(*QueryAllowlistModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModuleExemptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleExemptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleExemptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ModuleExemptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleExemptions(ctx, req.(*QueryModuleExemptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -494,6 +1159,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BlacklistedAll",
 			Handler:    _Query_BlacklistedAll_Handler,
 		},
+		{
+			MethodName: "BlacklistedByBytes",
+			Handler:    _Query_BlacklistedByBytes_Handler,
+		},
 		{
 			MethodName: "Paused",
 			Handler:    _Query_Paused_Handler,
@@ -522,6 +1191,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Owner",
 			Handler:    _Query_Owner_Handler,
 		},
+		{
+			MethodName: "PendingOwner",
+			Handler:    _Query_PendingOwner_Handler,
+		},
+		{
+			MethodName: "PendingRoles",
+			Handler:    _Query_PendingRoles_Handler,
+		},
 		{
 			MethodName: "MinterController",
 			Handler:    _Query_MinterController_Handler,
@@ -534,6 +1211,70 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MintingDenom",
 			Handler:    _Query_MintingDenom_Handler,
 		},
+		{
+			MethodName: "MintingDenomAll",
+			Handler:    _Query_MintingDenomAll_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "AuditLog",
+			Handler:    _Query_AuditLog_Handler,
+		},
+		{
+			MethodName: "SignerSet",
+			Handler:    _Query_SignerSet_Handler,
+		},
+		{
+			MethodName: "ActionProposal",
+			Handler:    _Query_ActionProposal_Handler,
+		},
+		{
+			MethodName: "ActionProposalAll",
+			Handler:    _Query_ActionProposalAll_Handler,
+		},
+		{
+			MethodName: "QueuedAction",
+			Handler:    _Query_QueuedAction_Handler,
+		},
+		{
+			MethodName: "QueuedActionAll",
+			Handler:    _Query_QueuedActionAll_Handler,
+		},
+		{
+			MethodName: "EscrowBalance",
+			Handler:    _Query_EscrowBalance_Handler,
+		},
+		{
+			MethodName: "ChannelPolicies",
+			Handler:    _Query_ChannelPolicies_Handler,
+		},
+		{
+			MethodName: "ChannelRateLimits",
+			Handler:    _Query_ChannelRateLimits_Handler,
+		},
+		{
+			MethodName: "Allowlister",
+			Handler:    _Query_Allowlister_Handler,
+		},
+		{
+			MethodName: "Allowlisted",
+			Handler:    _Query_Allowlisted_Handler,
+		},
+		{
+			MethodName: "AllowlistedAll",
+			Handler:    _Query_AllowlistedAll_Handler,
+		},
+		{
+			MethodName: "AllowlistMode",
+			Handler:    _Query_AllowlistMode_Handler,
+		},
+		{
+			MethodName: "ModuleExemptions",
+			Handler:    _Query_ModuleExemptions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "circle/fiattokenfactory/v1/query.proto",
@@ -0,0 +1,1789 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package fiattokenfactoryv1
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_QueuedAction                           protoreflect.MessageDescriptor
+	fd_QueuedAction_id                        protoreflect.FieldDescriptor
+	fd_QueuedAction_denom                     protoreflect.FieldDescriptor
+	fd_QueuedAction_executeAt                 protoreflect.FieldDescriptor
+	fd_QueuedAction_updateOwner               protoreflect.FieldDescriptor
+	fd_QueuedAction_updateMasterMinter        protoreflect.FieldDescriptor
+	fd_QueuedAction_updatePauser              protoreflect.FieldDescriptor
+	fd_QueuedAction_updateBlacklister         protoreflect.FieldDescriptor
+	fd_QueuedAction_configureMinterController protoreflect.FieldDescriptor
+	fd_QueuedAction_removeMinterController    protoreflect.FieldDescriptor
+	fd_QueuedAction_updateAllowlister         protoreflect.FieldDescriptor
+	fd_QueuedAction_setSignerSet              protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_circle_fiattokenfactory_v1_timelock_proto_init()
+	md_QueuedAction = File_circle_fiattokenfactory_v1_timelock_proto.Messages().ByName("QueuedAction")
+	fd_QueuedAction_id = md_QueuedAction.Fields().ByName("id")
+	fd_QueuedAction_denom = md_QueuedAction.Fields().ByName("denom")
+	fd_QueuedAction_executeAt = md_QueuedAction.Fields().ByName("executeAt")
+	fd_QueuedAction_updateOwner = md_QueuedAction.Fields().ByName("updateOwner")
+	fd_QueuedAction_updateMasterMinter = md_QueuedAction.Fields().ByName("updateMasterMinter")
+	fd_QueuedAction_updatePauser = md_QueuedAction.Fields().ByName("updatePauser")
+	fd_QueuedAction_updateBlacklister = md_QueuedAction.Fields().ByName("updateBlacklister")
+	fd_QueuedAction_configureMinterController = md_QueuedAction.Fields().ByName("configureMinterController")
+	fd_QueuedAction_removeMinterController = md_QueuedAction.Fields().ByName("removeMinterController")
+	fd_QueuedAction_updateAllowlister = md_QueuedAction.Fields().ByName("updateAllowlister")
+	fd_QueuedAction_setSignerSet = md_QueuedAction.Fields().ByName("setSignerSet")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueuedAction)(nil)
+
+type fastReflection_QueuedAction QueuedAction
+
+func (x *QueuedAction) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueuedAction)(x)
+}
+
+func (x *QueuedAction) slowProtoReflect() protoreflect.Message {
+	mi := &file_circle_fiattokenfactory_v1_timelock_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueuedAction_messageType fastReflection_QueuedAction_messageType
+var _ protoreflect.MessageType = fastReflection_QueuedAction_messageType{}
+
+type fastReflection_QueuedAction_messageType struct{}
+
+func (x fastReflection_QueuedAction_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueuedAction)(nil)
+}
+func (x fastReflection_QueuedAction_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueuedAction)
+}
+func (x fastReflection_QueuedAction_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueuedAction
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueuedAction) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueuedAction
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueuedAction) Type() protoreflect.MessageType {
+	return _fastReflection_QueuedAction_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueuedAction) New() protoreflect.Message {
+	return new(fastReflection_QueuedAction)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueuedAction) Interface() protoreflect.ProtoMessage {
+	return (*QueuedAction)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueuedAction) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_QueuedAction_id, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_QueuedAction_denom, value) {
+			return
+		}
+	}
+	if x.ExecuteAt != nil {
+		value := protoreflect.ValueOfMessage(x.ExecuteAt.ProtoReflect())
+		if !f(fd_QueuedAction_executeAt, value) {
+			return
+		}
+	}
+	if x.Action != nil {
+		switch o := x.Action.(type) {
+		case *QueuedAction_UpdateOwner:
+			v := o.UpdateOwner
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_QueuedAction_updateOwner, value) {
+				return
+			}
+		case *QueuedAction_UpdateMasterMinter:
+			v := o.UpdateMasterMinter
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_QueuedAction_updateMasterMinter, value) {
+				return
+			}
+		case *QueuedAction_UpdatePauser:
+			v := o.UpdatePauser
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_QueuedAction_updatePauser, value) {
+				return
+			}
+		case *QueuedAction_UpdateBlacklister:
+			v := o.UpdateBlacklister
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_QueuedAction_updateBlacklister, value) {
+				return
+			}
+		case *QueuedAction_ConfigureMinterController:
+			v := o.ConfigureMinterController
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_QueuedAction_configureMinterController, value) {
+				return
+			}
+		case *QueuedAction_RemoveMinterController:
+			v := o.RemoveMinterController
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_QueuedAction_removeMinterController, value) {
+				return
+			}
+		case *QueuedAction_UpdateAllowlister:
+			v := o.UpdateAllowlister
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_QueuedAction_updateAllowlister, value) {
+				return
+			}
+		case *QueuedAction_SetSignerSet:
+			v := o.SetSignerSet
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_QueuedAction_setSignerSet, value) {
+				return
+			}
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueuedAction) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.QueuedAction.id":
+		return x.Id != uint64(0)
+	case "circle.fiattokenfactory.v1.QueuedAction.denom":
+		return x.Denom != ""
+	case "circle.fiattokenfactory.v1.QueuedAction.executeAt":
+		return x.ExecuteAt != nil
+	case "circle.fiattokenfactory.v1.QueuedAction.updateOwner":
+		if x.Action == nil {
+			return false
+		} else if _, ok := x.Action.(*QueuedAction_UpdateOwner); ok {
+			return true
+		} else {
+			return false
+		}
+	case "circle.fiattokenfactory.v1.QueuedAction.updateMasterMinter":
+		if x.Action == nil {
+			return false
+		} else if _, ok := x.Action.(*QueuedAction_UpdateMasterMinter); ok {
+			return true
+		} else {
+			return false
+		}
+	case "circle.fiattokenfactory.v1.QueuedAction.updatePauser":
+		if x.Action == nil {
+			return false
+		} else if _, ok := x.Action.(*QueuedAction_UpdatePauser); ok {
+			return true
+		} else {
+			return false
+		}
+	case "circle.fiattokenfactory.v1.QueuedAction.updateBlacklister":
+		if x.Action == nil {
+			return false
+		} else if _, ok := x.Action.(*QueuedAction_UpdateBlacklister); ok {
+			return true
+		} else {
+			return false
+		}
+	case "circle.fiattokenfactory.v1.QueuedAction.configureMinterController":
+		if x.Action == nil {
+			return false
+		} else if _, ok := x.Action.(*QueuedAction_ConfigureMinterController); ok {
+			return true
+		} else {
+			return false
+		}
+	case "circle.fiattokenfactory.v1.QueuedAction.removeMinterController":
+		if x.Action == nil {
+			return false
+		} else if _, ok := x.Action.(*QueuedAction_RemoveMinterController); ok {
+			return true
+		} else {
+			return false
+		}
+	case "circle.fiattokenfactory.v1.QueuedAction.updateAllowlister":
+		if x.Action == nil {
+			return false
+		} else if _, ok := x.Action.(*QueuedAction_UpdateAllowlister); ok {
+			return true
+		} else {
+			return false
+		}
+	case "circle.fiattokenfactory.v1.QueuedAction.setSignerSet":
+		if x.Action == nil {
+			return false
+		} else if _, ok := x.Action.(*QueuedAction_SetSignerSet); ok {
+			return true
+		} else {
+			return false
+		}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.QueuedAction"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.QueuedAction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueuedAction) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.QueuedAction.id":
+		x.Id = uint64(0)
+	case "circle.fiattokenfactory.v1.QueuedAction.denom":
+		x.Denom = ""
+	case "circle.fiattokenfactory.v1.QueuedAction.executeAt":
+		x.ExecuteAt = nil
+	case "circle.fiattokenfactory.v1.QueuedAction.updateOwner":
+		x.Action = nil
+	case "circle.fiattokenfactory.v1.QueuedAction.updateMasterMinter":
+		x.Action = nil
+	case "circle.fiattokenfactory.v1.QueuedAction.updatePauser":
+		x.Action = nil
+	case "circle.fiattokenfactory.v1.QueuedAction.updateBlacklister":
+		x.Action = nil
+	case "circle.fiattokenfactory.v1.QueuedAction.configureMinterController":
+		x.Action = nil
+	case "circle.fiattokenfactory.v1.QueuedAction.removeMinterController":
+		x.Action = nil
+	case "circle.fiattokenfactory.v1.QueuedAction.updateAllowlister":
+		x.Action = nil
+	case "circle.fiattokenfactory.v1.QueuedAction.setSignerSet":
+		x.Action = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.QueuedAction"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.QueuedAction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueuedAction) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "circle.fiattokenfactory.v1.QueuedAction.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "circle.fiattokenfactory.v1.QueuedAction.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "circle.fiattokenfactory.v1.QueuedAction.executeAt":
+		value := x.ExecuteAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.QueuedAction.updateOwner":
+		if x.Action == nil {
+			return protoreflect.ValueOfMessage((*MsgUpdateOwner)(nil).ProtoReflect())
+		} else if v, ok := x.Action.(*QueuedAction_UpdateOwner); ok {
+			return protoreflect.ValueOfMessage(v.UpdateOwner.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*MsgUpdateOwner)(nil).ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.QueuedAction.updateMasterMinter":
+		if x.Action == nil {
+			return protoreflect.ValueOfMessage((*MsgUpdateMasterMinter)(nil).ProtoReflect())
+		} else if v, ok := x.Action.(*QueuedAction_UpdateMasterMinter); ok {
+			return protoreflect.ValueOfMessage(v.UpdateMasterMinter.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*MsgUpdateMasterMinter)(nil).ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.QueuedAction.updatePauser":
+		if x.Action == nil {
+			return protoreflect.ValueOfMessage((*MsgUpdatePauser)(nil).ProtoReflect())
+		} else if v, ok := x.Action.(*QueuedAction_UpdatePauser); ok {
+			return protoreflect.ValueOfMessage(v.UpdatePauser.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*MsgUpdatePauser)(nil).ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.QueuedAction.updateBlacklister":
+		if x.Action == nil {
+			return protoreflect.ValueOfMessage((*MsgUpdateBlacklister)(nil).ProtoReflect())
+		} else if v, ok := x.Action.(*QueuedAction_UpdateBlacklister); ok {
+			return protoreflect.ValueOfMessage(v.UpdateBlacklister.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*MsgUpdateBlacklister)(nil).ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.QueuedAction.configureMinterController":
+		if x.Action == nil {
+			return protoreflect.ValueOfMessage((*MsgConfigureMinterController)(nil).ProtoReflect())
+		} else if v, ok := x.Action.(*QueuedAction_ConfigureMinterController); ok {
+			return protoreflect.ValueOfMessage(v.ConfigureMinterController.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*MsgConfigureMinterController)(nil).ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.QueuedAction.removeMinterController":
+		if x.Action == nil {
+			return protoreflect.ValueOfMessage((*MsgRemoveMinterController)(nil).ProtoReflect())
+		} else if v, ok := x.Action.(*QueuedAction_RemoveMinterController); ok {
+			return protoreflect.ValueOfMessage(v.RemoveMinterController.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*MsgRemoveMinterController)(nil).ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.QueuedAction.updateAllowlister":
+		if x.Action == nil {
+			return protoreflect.ValueOfMessage((*MsgUpdateAllowlister)(nil).ProtoReflect())
+		} else if v, ok := x.Action.(*QueuedAction_UpdateAllowlister); ok {
+			return protoreflect.ValueOfMessage(v.UpdateAllowlister.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*MsgUpdateAllowlister)(nil).ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.QueuedAction.setSignerSet":
+		if x.Action == nil {
+			return protoreflect.ValueOfMessage((*MsgSetSignerSet)(nil).ProtoReflect())
+		} else if v, ok := x.Action.(*QueuedAction_SetSignerSet); ok {
+			return protoreflect.ValueOfMessage(v.SetSignerSet.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*MsgSetSignerSet)(nil).ProtoReflect())
+		}
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.QueuedAction"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.QueuedAction does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueuedAction) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.QueuedAction.id":
+		x.Id = value.Uint()
+	case "circle.fiattokenfactory.v1.QueuedAction.denom":
+		x.Denom = value.Interface().(string)
+	case "circle.fiattokenfactory.v1.QueuedAction.executeAt":
+		x.ExecuteAt = value.Message().Interface().(*timestamppb.Timestamp)
+	case "circle.fiattokenfactory.v1.QueuedAction.updateOwner":
+		cv := value.Message().Interface().(*MsgUpdateOwner)
+		x.Action = &QueuedAction_UpdateOwner{UpdateOwner: cv}
+	case "circle.fiattokenfactory.v1.QueuedAction.updateMasterMinter":
+		cv := value.Message().Interface().(*MsgUpdateMasterMinter)
+		x.Action = &QueuedAction_UpdateMasterMinter{UpdateMasterMinter: cv}
+	case "circle.fiattokenfactory.v1.QueuedAction.updatePauser":
+		cv := value.Message().Interface().(*MsgUpdatePauser)
+		x.Action = &QueuedAction_UpdatePauser{UpdatePauser: cv}
+	case "circle.fiattokenfactory.v1.QueuedAction.updateBlacklister":
+		cv := value.Message().Interface().(*MsgUpdateBlacklister)
+		x.Action = &QueuedAction_UpdateBlacklister{UpdateBlacklister: cv}
+	case "circle.fiattokenfactory.v1.QueuedAction.configureMinterController":
+		cv := value.Message().Interface().(*MsgConfigureMinterController)
+		x.Action = &QueuedAction_ConfigureMinterController{ConfigureMinterController: cv}
+	case "circle.fiattokenfactory.v1.QueuedAction.removeMinterController":
+		cv := value.Message().Interface().(*MsgRemoveMinterController)
+		x.Action = &QueuedAction_RemoveMinterController{RemoveMinterController: cv}
+	case "circle.fiattokenfactory.v1.QueuedAction.updateAllowlister":
+		cv := value.Message().Interface().(*MsgUpdateAllowlister)
+		x.Action = &QueuedAction_UpdateAllowlister{UpdateAllowlister: cv}
+	case "circle.fiattokenfactory.v1.QueuedAction.setSignerSet":
+		cv := value.Message().Interface().(*MsgSetSignerSet)
+		x.Action = &QueuedAction_SetSignerSet{SetSignerSet: cv}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.QueuedAction"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.QueuedAction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueuedAction) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.QueuedAction.executeAt":
+		if x.ExecuteAt == nil {
+			x.ExecuteAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExecuteAt.ProtoReflect())
+	case "circle.fiattokenfactory.v1.QueuedAction.updateOwner":
+		if x.Action == nil {
+			value := &MsgUpdateOwner{}
+			oneofValue := &QueuedAction_UpdateOwner{UpdateOwner: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Action.(type) {
+		case *QueuedAction_UpdateOwner:
+			return protoreflect.ValueOfMessage(m.UpdateOwner.ProtoReflect())
+		default:
+			value := &MsgUpdateOwner{}
+			oneofValue := &QueuedAction_UpdateOwner{UpdateOwner: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.QueuedAction.updateMasterMinter":
+		if x.Action == nil {
+			value := &MsgUpdateMasterMinter{}
+			oneofValue := &QueuedAction_UpdateMasterMinter{UpdateMasterMinter: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Action.(type) {
+		case *QueuedAction_UpdateMasterMinter:
+			return protoreflect.ValueOfMessage(m.UpdateMasterMinter.ProtoReflect())
+		default:
+			value := &MsgUpdateMasterMinter{}
+			oneofValue := &QueuedAction_UpdateMasterMinter{UpdateMasterMinter: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.QueuedAction.updatePauser":
+		if x.Action == nil {
+			value := &MsgUpdatePauser{}
+			oneofValue := &QueuedAction_UpdatePauser{UpdatePauser: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Action.(type) {
+		case *QueuedAction_UpdatePauser:
+			return protoreflect.ValueOfMessage(m.UpdatePauser.ProtoReflect())
+		default:
+			value := &MsgUpdatePauser{}
+			oneofValue := &QueuedAction_UpdatePauser{UpdatePauser: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.QueuedAction.updateBlacklister":
+		if x.Action == nil {
+			value := &MsgUpdateBlacklister{}
+			oneofValue := &QueuedAction_UpdateBlacklister{UpdateBlacklister: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Action.(type) {
+		case *QueuedAction_UpdateBlacklister:
+			return protoreflect.ValueOfMessage(m.UpdateBlacklister.ProtoReflect())
+		default:
+			value := &MsgUpdateBlacklister{}
+			oneofValue := &QueuedAction_UpdateBlacklister{UpdateBlacklister: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.QueuedAction.configureMinterController":
+		if x.Action == nil {
+			value := &MsgConfigureMinterController{}
+			oneofValue := &QueuedAction_ConfigureMinterController{ConfigureMinterController: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Action.(type) {
+		case *QueuedAction_ConfigureMinterController:
+			return protoreflect.ValueOfMessage(m.ConfigureMinterController.ProtoReflect())
+		default:
+			value := &MsgConfigureMinterController{}
+			oneofValue := &QueuedAction_ConfigureMinterController{ConfigureMinterController: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.QueuedAction.removeMinterController":
+		if x.Action == nil {
+			value := &MsgRemoveMinterController{}
+			oneofValue := &QueuedAction_RemoveMinterController{RemoveMinterController: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Action.(type) {
+		case *QueuedAction_RemoveMinterController:
+			return protoreflect.ValueOfMessage(m.RemoveMinterController.ProtoReflect())
+		default:
+			value := &MsgRemoveMinterController{}
+			oneofValue := &QueuedAction_RemoveMinterController{RemoveMinterController: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.QueuedAction.updateAllowlister":
+		if x.Action == nil {
+			value := &MsgUpdateAllowlister{}
+			oneofValue := &QueuedAction_UpdateAllowlister{UpdateAllowlister: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Action.(type) {
+		case *QueuedAction_UpdateAllowlister:
+			return protoreflect.ValueOfMessage(m.UpdateAllowlister.ProtoReflect())
+		default:
+			value := &MsgUpdateAllowlister{}
+			oneofValue := &QueuedAction_UpdateAllowlister{UpdateAllowlister: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.QueuedAction.setSignerSet":
+		if x.Action == nil {
+			value := &MsgSetSignerSet{}
+			oneofValue := &QueuedAction_SetSignerSet{SetSignerSet: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Action.(type) {
+		case *QueuedAction_SetSignerSet:
+			return protoreflect.ValueOfMessage(m.SetSignerSet.ProtoReflect())
+		default:
+			value := &MsgSetSignerSet{}
+			oneofValue := &QueuedAction_SetSignerSet{SetSignerSet: value}
+			x.Action = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "circle.fiattokenfactory.v1.QueuedAction.id":
+		panic(fmt.Errorf("field id of message circle.fiattokenfactory.v1.QueuedAction is not mutable"))
+	case "circle.fiattokenfactory.v1.QueuedAction.denom":
+		panic(fmt.Errorf("field denom of message circle.fiattokenfactory.v1.QueuedAction is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.QueuedAction"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.QueuedAction does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueuedAction) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.fiattokenfactory.v1.QueuedAction.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "circle.fiattokenfactory.v1.QueuedAction.denom":
+		return protoreflect.ValueOfString("")
+	case "circle.fiattokenfactory.v1.QueuedAction.executeAt":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "circle.fiattokenfactory.v1.QueuedAction.updateOwner":
+		value := &MsgUpdateOwner{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.QueuedAction.updateMasterMinter":
+		value := &MsgUpdateMasterMinter{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.QueuedAction.updatePauser":
+		value := &MsgUpdatePauser{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.QueuedAction.updateBlacklister":
+		value := &MsgUpdateBlacklister{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.QueuedAction.configureMinterController":
+		value := &MsgConfigureMinterController{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.QueuedAction.removeMinterController":
+		value := &MsgRemoveMinterController{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.QueuedAction.updateAllowlister":
+		value := &MsgUpdateAllowlister{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "circle.fiattokenfactory.v1.QueuedAction.setSignerSet":
+		value := &MsgSetSignerSet{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.fiattokenfactory.v1.QueuedAction"))
+		}
+		panic(fmt.Errorf("message circle.fiattokenfactory.v1.QueuedAction does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueuedAction) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	case "circle.fiattokenfactory.v1.QueuedAction.action":
+		if x.Action == nil {
+			return nil
+		}
+		switch x.Action.(type) {
+		case *QueuedAction_UpdateOwner:
+			return x.Descriptor().Fields().ByName("updateOwner")
+		case *QueuedAction_UpdateMasterMinter:
+			return x.Descriptor().Fields().ByName("updateMasterMinter")
+		case *QueuedAction_UpdatePauser:
+			return x.Descriptor().Fields().ByName("updatePauser")
+		case *QueuedAction_UpdateBlacklister:
+			return x.Descriptor().Fields().ByName("updateBlacklister")
+		case *QueuedAction_ConfigureMinterController:
+			return x.Descriptor().Fields().ByName("configureMinterController")
+		case *QueuedAction_RemoveMinterController:
+			return x.Descriptor().Fields().ByName("removeMinterController")
+		case *QueuedAction_UpdateAllowlister:
+			return x.Descriptor().Fields().ByName("updateAllowlister")
+		case *QueuedAction_SetSignerSet:
+			return x.Descriptor().Fields().ByName("setSignerSet")
+		}
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in circle.fiattokenfactory.v1.QueuedAction", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueuedAction) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueuedAction) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueuedAction) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueuedAction) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueuedAction)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExecuteAt != nil {
+			l = options.Size(x.ExecuteAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		switch x := x.Action.(type) {
+		case *QueuedAction_UpdateOwner:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.UpdateOwner)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *QueuedAction_UpdateMasterMinter:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.UpdateMasterMinter)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *QueuedAction_UpdatePauser:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.UpdatePauser)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *QueuedAction_UpdateBlacklister:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.UpdateBlacklister)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *QueuedAction_ConfigureMinterController:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.ConfigureMinterController)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *QueuedAction_RemoveMinterController:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.RemoveMinterController)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *QueuedAction_UpdateAllowlister:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.UpdateAllowlister)
+			n += 2 + l + runtime.Sov(uint64(l))
+		case *QueuedAction_SetSignerSet:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.SetSignerSet)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueuedAction)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		switch x := x.Action.(type) {
+		case *QueuedAction_UpdateOwner:
+			encoded, err := options.Marshal(x.UpdateOwner)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		case *QueuedAction_UpdateMasterMinter:
+			encoded, err := options.Marshal(x.UpdateMasterMinter)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		case *QueuedAction_UpdatePauser:
+			encoded, err := options.Marshal(x.UpdatePauser)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		case *QueuedAction_UpdateBlacklister:
+			encoded, err := options.Marshal(x.UpdateBlacklister)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x6a
+		case *QueuedAction_ConfigureMinterController:
+			encoded, err := options.Marshal(x.ConfigureMinterController)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x72
+		case *QueuedAction_RemoveMinterController:
+			encoded, err := options.Marshal(x.RemoveMinterController)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x7a
+		case *QueuedAction_UpdateAllowlister:
+			encoded, err := options.Marshal(x.UpdateAllowlister)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		case *QueuedAction_SetSignerSet:
+			encoded, err := options.Marshal(x.SetSignerSet)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if x.ExecuteAt != nil {
+			encoded, err := options.Marshal(x.ExecuteAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueuedAction)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueuedAction: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueuedAction: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExecuteAt == nil {
+					x.ExecuteAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExecuteAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdateOwner", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &MsgUpdateOwner{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Action = &QueuedAction_UpdateOwner{v}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdateMasterMinter", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &MsgUpdateMasterMinter{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Action = &QueuedAction_UpdateMasterMinter{v}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdatePauser", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &MsgUpdatePauser{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Action = &QueuedAction_UpdatePauser{v}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdateBlacklister", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &MsgUpdateBlacklister{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Action = &QueuedAction_UpdateBlacklister{v}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConfigureMinterController", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &MsgConfigureMinterController{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Action = &QueuedAction_ConfigureMinterController{v}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemoveMinterController", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &MsgRemoveMinterController{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Action = &QueuedAction_RemoveMinterController{v}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdateAllowlister", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &MsgUpdateAllowlister{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Action = &QueuedAction_UpdateAllowlister{v}
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SetSignerSet", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &MsgSetSignerSet{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Action = &QueuedAction_SetSignerSet{v}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: circle/fiattokenfactory/v1/timelock.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueuedAction is a role change or signer set change of a minting denom
+// awaiting the role change delay before it is executed. The owner can cancel
+// it in the meantime.
+type QueuedAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Denom     string                 `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	ExecuteAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=executeAt,proto3" json:"executeAt,omitempty"`
+	// Types that are assignable to Action:
+	//	*QueuedAction_UpdateOwner
+	//	*QueuedAction_UpdateMasterMinter
+	//	*QueuedAction_UpdatePauser
+	//	*QueuedAction_UpdateBlacklister
+	//	*QueuedAction_ConfigureMinterController
+	//	*QueuedAction_RemoveMinterController
+	//	*QueuedAction_UpdateAllowlister
+	//	*QueuedAction_SetSignerSet
+	Action isQueuedAction_Action `protobuf_oneof:"action"`
+}
+
+func (x *QueuedAction) Reset() {
+	*x = QueuedAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circle_fiattokenfactory_v1_timelock_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueuedAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueuedAction) ProtoMessage() {}
+
+// Deprecated: Use QueuedAction.ProtoReflect.Descriptor instead.
+func (*QueuedAction) Descriptor() ([]byte, []int) {
+	return file_circle_fiattokenfactory_v1_timelock_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QueuedAction) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *QueuedAction) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *QueuedAction) GetExecuteAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExecuteAt
+	}
+	return nil
+}
+
+func (x *QueuedAction) GetAction() isQueuedAction_Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+func (x *QueuedAction) GetUpdateOwner() *MsgUpdateOwner {
+	if x, ok := x.GetAction().(*QueuedAction_UpdateOwner); ok {
+		return x.UpdateOwner
+	}
+	return nil
+}
+
+func (x *QueuedAction) GetUpdateMasterMinter() *MsgUpdateMasterMinter {
+	if x, ok := x.GetAction().(*QueuedAction_UpdateMasterMinter); ok {
+		return x.UpdateMasterMinter
+	}
+	return nil
+}
+
+func (x *QueuedAction) GetUpdatePauser() *MsgUpdatePauser {
+	if x, ok := x.GetAction().(*QueuedAction_UpdatePauser); ok {
+		return x.UpdatePauser
+	}
+	return nil
+}
+
+func (x *QueuedAction) GetUpdateBlacklister() *MsgUpdateBlacklister {
+	if x, ok := x.GetAction().(*QueuedAction_UpdateBlacklister); ok {
+		return x.UpdateBlacklister
+	}
+	return nil
+}
+
+func (x *QueuedAction) GetConfigureMinterController() *MsgConfigureMinterController {
+	if x, ok := x.GetAction().(*QueuedAction_ConfigureMinterController); ok {
+		return x.ConfigureMinterController
+	}
+	return nil
+}
+
+func (x *QueuedAction) GetRemoveMinterController() *MsgRemoveMinterController {
+	if x, ok := x.GetAction().(*QueuedAction_RemoveMinterController); ok {
+		return x.RemoveMinterController
+	}
+	return nil
+}
+
+func (x *QueuedAction) GetUpdateAllowlister() *MsgUpdateAllowlister {
+	if x, ok := x.GetAction().(*QueuedAction_UpdateAllowlister); ok {
+		return x.UpdateAllowlister
+	}
+	return nil
+}
+
+func (x *QueuedAction) GetSetSignerSet() *MsgSetSignerSet {
+	if x, ok := x.GetAction().(*QueuedAction_SetSignerSet); ok {
+		return x.SetSignerSet
+	}
+	return nil
+}
+
+type isQueuedAction_Action interface {
+	isQueuedAction_Action()
+}
+
+type QueuedAction_UpdateOwner struct {
+	UpdateOwner *MsgUpdateOwner `protobuf:"bytes,10,opt,name=updateOwner,proto3,oneof"`
+}
+
+type QueuedAction_UpdateMasterMinter struct {
+	UpdateMasterMinter *MsgUpdateMasterMinter `protobuf:"bytes,11,opt,name=updateMasterMinter,proto3,oneof"`
+}
+
+type QueuedAction_UpdatePauser struct {
+	UpdatePauser *MsgUpdatePauser `protobuf:"bytes,12,opt,name=updatePauser,proto3,oneof"`
+}
+
+type QueuedAction_UpdateBlacklister struct {
+	UpdateBlacklister *MsgUpdateBlacklister `protobuf:"bytes,13,opt,name=updateBlacklister,proto3,oneof"`
+}
+
+type QueuedAction_ConfigureMinterController struct {
+	ConfigureMinterController *MsgConfigureMinterController `protobuf:"bytes,14,opt,name=configureMinterController,proto3,oneof"`
+}
+
+type QueuedAction_RemoveMinterController struct {
+	// removeMinterController is only set on actions queued before minter
+	// controller removals applied immediately.
+	RemoveMinterController *MsgRemoveMinterController `protobuf:"bytes,15,opt,name=removeMinterController,proto3,oneof"`
+}
+
+type QueuedAction_UpdateAllowlister struct {
+	UpdateAllowlister *MsgUpdateAllowlister `protobuf:"bytes,16,opt,name=updateAllowlister,proto3,oneof"`
+}
+
+type QueuedAction_SetSignerSet struct {
+	SetSignerSet *MsgSetSignerSet `protobuf:"bytes,17,opt,name=setSignerSet,proto3,oneof"`
+}
+
+func (*QueuedAction_UpdateOwner) isQueuedAction_Action() {}
+
+func (*QueuedAction_UpdateMasterMinter) isQueuedAction_Action() {}
+
+func (*QueuedAction_UpdatePauser) isQueuedAction_Action() {}
+
+func (*QueuedAction_UpdateBlacklister) isQueuedAction_Action() {}
+
+func (*QueuedAction_ConfigureMinterController) isQueuedAction_Action() {}
+
+func (*QueuedAction_RemoveMinterController) isQueuedAction_Action() {}
+
+func (*QueuedAction_UpdateAllowlister) isQueuedAction_Action() {}
+
+func (*QueuedAction_SetSignerSet) isQueuedAction_Action() {}
+
+var File_circle_fiattokenfactory_v1_timelock_proto protoreflect.FileDescriptor
+
+var file_circle_fiattokenfactory_v1_timelock_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x69, 0x6d,
+	0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1a, 0x63, 0x69, 0x72,
+	0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x1a, 0x23, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f,
+	0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x2f, 0x76, 0x31, 0x2f, 0x74, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f,
+	0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x8c, 0x07, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x42, 0x0a, 0x09, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90,
+	0xdf, 0x1f, 0x01, 0x52, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x74, 0x12, 0x4e,
+	0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61,
+	0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x48,
+	0x00, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x63,
+	0x0a, 0x12, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x63, 0x69, 0x72,
+	0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52,
+	0x12, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x12, 0x51, 0x0a, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x69, 0x72, 0x63,
+	0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x72, 0x12, 0x60, 0x0a, 0x11, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x30, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x11, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x61,
+	0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x78, 0x0a, 0x19, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x65, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x63, 0x69,
+	0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x65, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x48, 0x00, 0x52, 0x19, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x65, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x12, 0x6f, 0x0a, 0x16, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x35, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x48, 0x00, 0x52, 0x16, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x12, 0x60, 0x0a, 0x11, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30,
+	0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x48, 0x00, 0x52, 0x11, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x51, 0x0a, 0x0c, 0x73, 0x65, 0x74, 0x53, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x53, 0x65, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x69,
+	0x72, 0x63, 0x6c, 0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x65, 0x74, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x98, 0x02, 0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c,
+	0x65, 0x2e, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x42, 0x0d, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x5d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x66, 0x69, 0x6e, 0x2f, 0x6e, 0x6f, 0x62,
+	0x6c, 0x65, 0x2d, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x66,
+	0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f,
+	0x76, 0x31, 0x3b, 0x66, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x46, 0x58, 0xaa, 0x02, 0x1a, 0x43, 0x69,
+	0x72, 0x63, 0x6c, 0x65, 0x2e, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1a, 0x43, 0x69, 0x72, 0x63, 0x6c,
+	0x65, 0x5c, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x26, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x5c, 0x46,
+	0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5c,
+	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x1c, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x46, 0x69, 0x61, 0x74, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_circle_fiattokenfactory_v1_timelock_proto_rawDescOnce sync.Once
+	file_circle_fiattokenfactory_v1_timelock_proto_rawDescData = file_circle_fiattokenfactory_v1_timelock_proto_rawDesc
+)
+
+func file_circle_fiattokenfactory_v1_timelock_proto_rawDescGZIP() []byte {
+	file_circle_fiattokenfactory_v1_timelock_proto_rawDescOnce.Do(func() {
+		file_circle_fiattokenfactory_v1_timelock_proto_rawDescData = protoimpl.X.CompressGZIP(file_circle_fiattokenfactory_v1_timelock_proto_rawDescData)
+	})
+	return file_circle_fiattokenfactory_v1_timelock_proto_rawDescData
+}
+
+var file_circle_fiattokenfactory_v1_timelock_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_circle_fiattokenfactory_v1_timelock_proto_goTypes = []interface{}{
+	(*QueuedAction)(nil),                 // 0: circle.fiattokenfactory.v1.QueuedAction
+	(*timestamppb.Timestamp)(nil),        // 1: google.protobuf.Timestamp
+	(*MsgUpdateOwner)(nil),               // 2: circle.fiattokenfactory.v1.MsgUpdateOwner
+	(*MsgUpdateMasterMinter)(nil),        // 3: circle.fiattokenfactory.v1.MsgUpdateMasterMinter
+	(*MsgUpdatePauser)(nil),              // 4: circle.fiattokenfactory.v1.MsgUpdatePauser
+	(*MsgUpdateBlacklister)(nil),         // 5: circle.fiattokenfactory.v1.MsgUpdateBlacklister
+	(*MsgConfigureMinterController)(nil), // 6: circle.fiattokenfactory.v1.MsgConfigureMinterController
+	(*MsgRemoveMinterController)(nil),    // 7: circle.fiattokenfactory.v1.MsgRemoveMinterController
+	(*MsgUpdateAllowlister)(nil),         // 8: circle.fiattokenfactory.v1.MsgUpdateAllowlister
+	(*MsgSetSignerSet)(nil),              // 9: circle.fiattokenfactory.v1.MsgSetSignerSet
+}
+var file_circle_fiattokenfactory_v1_timelock_proto_depIdxs = []int32{
+	1, // 0: circle.fiattokenfactory.v1.QueuedAction.executeAt:type_name -> google.protobuf.Timestamp
+	2, // 1: circle.fiattokenfactory.v1.QueuedAction.updateOwner:type_name -> circle.fiattokenfactory.v1.MsgUpdateOwner
+	3, // 2: circle.fiattokenfactory.v1.QueuedAction.updateMasterMinter:type_name -> circle.fiattokenfactory.v1.MsgUpdateMasterMinter
+	4, // 3: circle.fiattokenfactory.v1.QueuedAction.updatePauser:type_name -> circle.fiattokenfactory.v1.MsgUpdatePauser
+	5, // 4: circle.fiattokenfactory.v1.QueuedAction.updateBlacklister:type_name -> circle.fiattokenfactory.v1.MsgUpdateBlacklister
+	6, // 5: circle.fiattokenfactory.v1.QueuedAction.configureMinterController:type_name -> circle.fiattokenfactory.v1.MsgConfigureMinterController
+	7, // 6: circle.fiattokenfactory.v1.QueuedAction.removeMinterController:type_name -> circle.fiattokenfactory.v1.MsgRemoveMinterController
+	8, // 7: circle.fiattokenfactory.v1.QueuedAction.updateAllowlister:type_name -> circle.fiattokenfactory.v1.MsgUpdateAllowlister
+	9, // 8: circle.fiattokenfactory.v1.QueuedAction.setSignerSet:type_name -> circle.fiattokenfactory.v1.MsgSetSignerSet
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_circle_fiattokenfactory_v1_timelock_proto_init() }
+func file_circle_fiattokenfactory_v1_timelock_proto_init() {
+	if File_circle_fiattokenfactory_v1_timelock_proto != nil {
+		return
+	}
+	file_circle_fiattokenfactory_v1_tx_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_circle_fiattokenfactory_v1_timelock_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueuedAction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_circle_fiattokenfactory_v1_timelock_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*QueuedAction_UpdateOwner)(nil),
+		(*QueuedAction_UpdateMasterMinter)(nil),
+		(*QueuedAction_UpdatePauser)(nil),
+		(*QueuedAction_UpdateBlacklister)(nil),
+		(*QueuedAction_ConfigureMinterController)(nil),
+		(*QueuedAction_RemoveMinterController)(nil),
+		(*QueuedAction_UpdateAllowlister)(nil),
+		(*QueuedAction_SetSignerSet)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_circle_fiattokenfactory_v1_timelock_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_circle_fiattokenfactory_v1_timelock_proto_goTypes,
+		DependencyIndexes: file_circle_fiattokenfactory_v1_timelock_proto_depIdxs,
+		MessageInfos:      file_circle_fiattokenfactory_v1_timelock_proto_msgTypes,
+	}.Build()
+	File_circle_fiattokenfactory_v1_timelock_proto = out.File
+	file_circle_fiattokenfactory_v1_timelock_proto_rawDesc = nil
+	file_circle_fiattokenfactory_v1_timelock_proto_goTypes = nil
+	file_circle_fiattokenfactory_v1_timelock_proto_depIdxs = nil
+}
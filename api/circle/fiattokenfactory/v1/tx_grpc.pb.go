@@ -24,16 +24,39 @@ const (
 	Msg_UpdateBlacklister_FullMethodName         = "/circle.fiattokenfactory.v1.Msg/UpdateBlacklister"
 	Msg_UpdateOwner_FullMethodName               = "/circle.fiattokenfactory.v1.Msg/UpdateOwner"
 	Msg_AcceptOwner_FullMethodName               = "/circle.fiattokenfactory.v1.Msg/AcceptOwner"
+	Msg_CancelOwnershipTransfer_FullMethodName   = "/circle.fiattokenfactory.v1.Msg/CancelOwnershipTransfer"
+	Msg_AcceptRole_FullMethodName                = "/circle.fiattokenfactory.v1.Msg/AcceptRole"
 	Msg_ConfigureMinter_FullMethodName           = "/circle.fiattokenfactory.v1.Msg/ConfigureMinter"
 	Msg_RemoveMinter_FullMethodName              = "/circle.fiattokenfactory.v1.Msg/RemoveMinter"
 	Msg_Mint_FullMethodName                      = "/circle.fiattokenfactory.v1.Msg/Mint"
 	Msg_Burn_FullMethodName                      = "/circle.fiattokenfactory.v1.Msg/Burn"
 	Msg_Blacklist_FullMethodName                 = "/circle.fiattokenfactory.v1.Msg/Blacklist"
 	Msg_Unblacklist_FullMethodName               = "/circle.fiattokenfactory.v1.Msg/Unblacklist"
+	Msg_BlacklistBatch_FullMethodName            = "/circle.fiattokenfactory.v1.Msg/BlacklistBatch"
+	Msg_UnblacklistBatch_FullMethodName          = "/circle.fiattokenfactory.v1.Msg/UnblacklistBatch"
+	Msg_SeizeBlacklistedFunds_FullMethodName     = "/circle.fiattokenfactory.v1.Msg/SeizeBlacklistedFunds"
 	Msg_Pause_FullMethodName                     = "/circle.fiattokenfactory.v1.Msg/Pause"
 	Msg_Unpause_FullMethodName                   = "/circle.fiattokenfactory.v1.Msg/Unpause"
 	Msg_ConfigureMinterController_FullMethodName = "/circle.fiattokenfactory.v1.Msg/ConfigureMinterController"
 	Msg_RemoveMinterController_FullMethodName    = "/circle.fiattokenfactory.v1.Msg/RemoveMinterController"
+	Msg_ConfigureMinterRateLimit_FullMethodName  = "/circle.fiattokenfactory.v1.Msg/ConfigureMinterRateLimit"
+	Msg_SetSignerSet_FullMethodName              = "/circle.fiattokenfactory.v1.Msg/SetSignerSet"
+	Msg_ApproveAction_FullMethodName             = "/circle.fiattokenfactory.v1.Msg/ApproveAction"
+	Msg_CancelQueuedAction_FullMethodName        = "/circle.fiattokenfactory.v1.Msg/CancelQueuedAction"
+	Msg_UpdateParams_FullMethodName              = "/circle.fiattokenfactory.v1.Msg/UpdateParams"
+	Msg_ForceRoleAssignment_FullMethodName       = "/circle.fiattokenfactory.v1.Msg/ForceRoleAssignment"
+	Msg_SetChannelPolicy_FullMethodName          = "/circle.fiattokenfactory.v1.Msg/SetChannelPolicy"
+	Msg_SetChannelRateLimit_FullMethodName       = "/circle.fiattokenfactory.v1.Msg/SetChannelRateLimit"
+	Msg_UpdateAllowlister_FullMethodName         = "/circle.fiattokenfactory.v1.Msg/UpdateAllowlister"
+	Msg_Allowlist_FullMethodName                 = "/circle.fiattokenfactory.v1.Msg/Allowlist"
+	Msg_Unallowlist_FullMethodName               = "/circle.fiattokenfactory.v1.Msg/Unallowlist"
+	Msg_AllowlistBatch_FullMethodName            = "/circle.fiattokenfactory.v1.Msg/AllowlistBatch"
+	Msg_UnallowlistBatch_FullMethodName          = "/circle.fiattokenfactory.v1.Msg/UnallowlistBatch"
+	Msg_SetAllowlistMode_FullMethodName          = "/circle.fiattokenfactory.v1.Msg/SetAllowlistMode"
+	Msg_RegisterMintingDenom_FullMethodName      = "/circle.fiattokenfactory.v1.Msg/RegisterMintingDenom"
+	Msg_RemoveChannelPolicy_FullMethodName       = "/circle.fiattokenfactory.v1.Msg/RemoveChannelPolicy"
+	Msg_ReleaseEscrow_FullMethodName             = "/circle.fiattokenfactory.v1.Msg/ReleaseEscrow"
+	Msg_BurnEscrow_FullMethodName                = "/circle.fiattokenfactory.v1.Msg/BurnEscrow"
 )
 
 // MsgClient is the client API for Msg service.
@@ -45,16 +68,39 @@ type MsgClient interface {
 	UpdateBlacklister(ctx context.Context, in *MsgUpdateBlacklister, opts ...grpc.CallOption) (*MsgUpdateBlacklisterResponse, error)
 	UpdateOwner(ctx context.Context, in *MsgUpdateOwner, opts ...grpc.CallOption) (*MsgUpdateOwnerResponse, error)
 	AcceptOwner(ctx context.Context, in *MsgAcceptOwner, opts ...grpc.CallOption) (*MsgAcceptOwnerResponse, error)
+	CancelOwnershipTransfer(ctx context.Context, in *MsgCancelOwnershipTransfer, opts ...grpc.CallOption) (*MsgCancelOwnershipTransferResponse, error)
+	AcceptRole(ctx context.Context, in *MsgAcceptRole, opts ...grpc.CallOption) (*MsgAcceptRoleResponse, error)
 	ConfigureMinter(ctx context.Context, in *MsgConfigureMinter, opts ...grpc.CallOption) (*MsgConfigureMinterResponse, error)
 	RemoveMinter(ctx context.Context, in *MsgRemoveMinter, opts ...grpc.CallOption) (*MsgRemoveMinterResponse, error)
 	Mint(ctx context.Context, in *MsgMint, opts ...grpc.CallOption) (*MsgMintResponse, error)
 	Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error)
 	Blacklist(ctx context.Context, in *MsgBlacklist, opts ...grpc.CallOption) (*MsgBlacklistResponse, error)
 	Unblacklist(ctx context.Context, in *MsgUnblacklist, opts ...grpc.CallOption) (*MsgUnblacklistResponse, error)
+	BlacklistBatch(ctx context.Context, in *MsgBlacklistBatch, opts ...grpc.CallOption) (*MsgBlacklistBatchResponse, error)
+	UnblacklistBatch(ctx context.Context, in *MsgUnblacklistBatch, opts ...grpc.CallOption) (*MsgUnblacklistBatchResponse, error)
+	SeizeBlacklistedFunds(ctx context.Context, in *MsgSeizeBlacklistedFunds, opts ...grpc.CallOption) (*MsgSeizeBlacklistedFundsResponse, error)
 	Pause(ctx context.Context, in *MsgPause, opts ...grpc.CallOption) (*MsgPauseResponse, error)
 	Unpause(ctx context.Context, in *MsgUnpause, opts ...grpc.CallOption) (*MsgUnpauseResponse, error)
 	ConfigureMinterController(ctx context.Context, in *MsgConfigureMinterController, opts ...grpc.CallOption) (*MsgConfigureMinterControllerResponse, error)
 	RemoveMinterController(ctx context.Context, in *MsgRemoveMinterController, opts ...grpc.CallOption) (*MsgRemoveMinterControllerResponse, error)
+	ConfigureMinterRateLimit(ctx context.Context, in *MsgConfigureMinterRateLimit, opts ...grpc.CallOption) (*MsgConfigureMinterRateLimitResponse, error)
+	SetSignerSet(ctx context.Context, in *MsgSetSignerSet, opts ...grpc.CallOption) (*MsgSetSignerSetResponse, error)
+	ApproveAction(ctx context.Context, in *MsgApproveAction, opts ...grpc.CallOption) (*MsgApproveActionResponse, error)
+	CancelQueuedAction(ctx context.Context, in *MsgCancelQueuedAction, opts ...grpc.CallOption) (*MsgCancelQueuedActionResponse, error)
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	ForceRoleAssignment(ctx context.Context, in *MsgForceRoleAssignment, opts ...grpc.CallOption) (*MsgForceRoleAssignmentResponse, error)
+	SetChannelPolicy(ctx context.Context, in *MsgSetChannelPolicy, opts ...grpc.CallOption) (*MsgSetChannelPolicyResponse, error)
+	SetChannelRateLimit(ctx context.Context, in *MsgSetChannelRateLimit, opts ...grpc.CallOption) (*MsgSetChannelRateLimitResponse, error)
+	UpdateAllowlister(ctx context.Context, in *MsgUpdateAllowlister, opts ...grpc.CallOption) (*MsgUpdateAllowlisterResponse, error)
+	Allowlist(ctx context.Context, in *MsgAllowlist, opts ...grpc.CallOption) (*MsgAllowlistResponse, error)
+	Unallowlist(ctx context.Context, in *MsgUnallowlist, opts ...grpc.CallOption) (*MsgUnallowlistResponse, error)
+	AllowlistBatch(ctx context.Context, in *MsgAllowlistBatch, opts ...grpc.CallOption) (*MsgAllowlistBatchResponse, error)
+	UnallowlistBatch(ctx context.Context, in *MsgUnallowlistBatch, opts ...grpc.CallOption) (*MsgUnallowlistBatchResponse, error)
+	SetAllowlistMode(ctx context.Context, in *MsgSetAllowlistMode, opts ...grpc.CallOption) (*MsgSetAllowlistModeResponse, error)
+	RegisterMintingDenom(ctx context.Context, in *MsgRegisterMintingDenom, opts ...grpc.CallOption) (*MsgRegisterMintingDenomResponse, error)
+	RemoveChannelPolicy(ctx context.Context, in *MsgRemoveChannelPolicy, opts ...grpc.CallOption) (*MsgRemoveChannelPolicyResponse, error)
+	ReleaseEscrow(ctx context.Context, in *MsgReleaseEscrow, opts ...grpc.CallOption) (*MsgReleaseEscrowResponse, error)
+	BurnEscrow(ctx context.Context, in *MsgBurnEscrow, opts ...grpc.CallOption) (*MsgBurnEscrowResponse, error)
 }
 
 type msgClient struct {
@@ -110,6 +156,24 @@ func (c *msgClient) AcceptOwner(ctx context.Context, in *MsgAcceptOwner, opts ..
 	return out, nil
 }
 
+func (c *msgClient) CancelOwnershipTransfer(ctx context.Context, in *MsgCancelOwnershipTransfer, opts ...grpc.CallOption) (*MsgCancelOwnershipTransferResponse, error) {
+	out := new(MsgCancelOwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelOwnershipTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptRole(ctx context.Context, in *MsgAcceptRole, opts ...grpc.CallOption) (*MsgAcceptRoleResponse, error) {
+	out := new(MsgAcceptRoleResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) ConfigureMinter(ctx context.Context, in *MsgConfigureMinter, opts ...grpc.CallOption) (*MsgConfigureMinterResponse, error) {
 	out := new(MsgConfigureMinterResponse)
 	err := c.cc.Invoke(ctx, Msg_ConfigureMinter_FullMethodName, in, out, opts...)
@@ -164,6 +228,33 @@ func (c *msgClient) Unblacklist(ctx context.Context, in *MsgUnblacklist, opts ..
 	return out, nil
 }
 
+func (c *msgClient) BlacklistBatch(ctx context.Context, in *MsgBlacklistBatch, opts ...grpc.CallOption) (*MsgBlacklistBatchResponse, error) {
+	out := new(MsgBlacklistBatchResponse)
+	err := c.cc.Invoke(ctx, Msg_BlacklistBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnblacklistBatch(ctx context.Context, in *MsgUnblacklistBatch, opts ...grpc.CallOption) (*MsgUnblacklistBatchResponse, error) {
+	out := new(MsgUnblacklistBatchResponse)
+	err := c.cc.Invoke(ctx, Msg_UnblacklistBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SeizeBlacklistedFunds(ctx context.Context, in *MsgSeizeBlacklistedFunds, opts ...grpc.CallOption) (*MsgSeizeBlacklistedFundsResponse, error) {
+	out := new(MsgSeizeBlacklistedFundsResponse)
+	err := c.cc.Invoke(ctx, Msg_SeizeBlacklistedFunds_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Pause(ctx context.Context, in *MsgPause, opts ...grpc.CallOption) (*MsgPauseResponse, error) {
 	out := new(MsgPauseResponse)
 	err := c.cc.Invoke(ctx, Msg_Pause_FullMethodName, in, out, opts...)
@@ -200,6 +291,168 @@ func (c *msgClient) RemoveMinterController(ctx context.Context, in *MsgRemoveMin
 	return out, nil
 }
 
+func (c *msgClient) ConfigureMinterRateLimit(ctx context.Context, in *MsgConfigureMinterRateLimit, opts ...grpc.CallOption) (*MsgConfigureMinterRateLimitResponse, error) {
+	out := new(MsgConfigureMinterRateLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_ConfigureMinterRateLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSignerSet(ctx context.Context, in *MsgSetSignerSet, opts ...grpc.CallOption) (*MsgSetSignerSetResponse, error) {
+	out := new(MsgSetSignerSetResponse)
+	err := c.cc.Invoke(ctx, Msg_SetSignerSet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveAction(ctx context.Context, in *MsgApproveAction, opts ...grpc.CallOption) (*MsgApproveActionResponse, error) {
+	out := new(MsgApproveActionResponse)
+	err := c.cc.Invoke(ctx, Msg_ApproveAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelQueuedAction(ctx context.Context, in *MsgCancelQueuedAction, opts ...grpc.CallOption) (*MsgCancelQueuedActionResponse, error) {
+	out := new(MsgCancelQueuedActionResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelQueuedAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ForceRoleAssignment(ctx context.Context, in *MsgForceRoleAssignment, opts ...grpc.CallOption) (*MsgForceRoleAssignmentResponse, error) {
+	out := new(MsgForceRoleAssignmentResponse)
+	err := c.cc.Invoke(ctx, Msg_ForceRoleAssignment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetChannelPolicy(ctx context.Context, in *MsgSetChannelPolicy, opts ...grpc.CallOption) (*MsgSetChannelPolicyResponse, error) {
+	out := new(MsgSetChannelPolicyResponse)
+	err := c.cc.Invoke(ctx, Msg_SetChannelPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetChannelRateLimit(ctx context.Context, in *MsgSetChannelRateLimit, opts ...grpc.CallOption) (*MsgSetChannelRateLimitResponse, error) {
+	out := new(MsgSetChannelRateLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_SetChannelRateLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateAllowlister(ctx context.Context, in *MsgUpdateAllowlister, opts ...grpc.CallOption) (*MsgUpdateAllowlisterResponse, error) {
+	out := new(MsgUpdateAllowlisterResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateAllowlister_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Allowlist(ctx context.Context, in *MsgAllowlist, opts ...grpc.CallOption) (*MsgAllowlistResponse, error) {
+	out := new(MsgAllowlistResponse)
+	err := c.cc.Invoke(ctx, Msg_Allowlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Unallowlist(ctx context.Context, in *MsgUnallowlist, opts ...grpc.CallOption) (*MsgUnallowlistResponse, error) {
+	out := new(MsgUnallowlistResponse)
+	err := c.cc.Invoke(ctx, Msg_Unallowlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AllowlistBatch(ctx context.Context, in *MsgAllowlistBatch, opts ...grpc.CallOption) (*MsgAllowlistBatchResponse, error) {
+	out := new(MsgAllowlistBatchResponse)
+	err := c.cc.Invoke(ctx, Msg_AllowlistBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnallowlistBatch(ctx context.Context, in *MsgUnallowlistBatch, opts ...grpc.CallOption) (*MsgUnallowlistBatchResponse, error) {
+	out := new(MsgUnallowlistBatchResponse)
+	err := c.cc.Invoke(ctx, Msg_UnallowlistBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAllowlistMode(ctx context.Context, in *MsgSetAllowlistMode, opts ...grpc.CallOption) (*MsgSetAllowlistModeResponse, error) {
+	out := new(MsgSetAllowlistModeResponse)
+	err := c.cc.Invoke(ctx, Msg_SetAllowlistMode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterMintingDenom(ctx context.Context, in *MsgRegisterMintingDenom, opts ...grpc.CallOption) (*MsgRegisterMintingDenomResponse, error) {
+	out := new(MsgRegisterMintingDenomResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterMintingDenom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveChannelPolicy(ctx context.Context, in *MsgRemoveChannelPolicy, opts ...grpc.CallOption) (*MsgRemoveChannelPolicyResponse, error) {
+	out := new(MsgRemoveChannelPolicyResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveChannelPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReleaseEscrow(ctx context.Context, in *MsgReleaseEscrow, opts ...grpc.CallOption) (*MsgReleaseEscrowResponse, error) {
+	out := new(MsgReleaseEscrowResponse)
+	err := c.cc.Invoke(ctx, Msg_ReleaseEscrow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BurnEscrow(ctx context.Context, in *MsgBurnEscrow, opts ...grpc.CallOption) (*MsgBurnEscrowResponse, error) {
+	out := new(MsgBurnEscrowResponse)
+	err := c.cc.Invoke(ctx, Msg_BurnEscrow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -209,16 +462,39 @@ type MsgServer interface {
 	UpdateBlacklister(context.Context, *MsgUpdateBlacklister) (*MsgUpdateBlacklisterResponse, error)
 	UpdateOwner(context.Context, *MsgUpdateOwner) (*MsgUpdateOwnerResponse, error)
 	AcceptOwner(context.Context, *MsgAcceptOwner) (*MsgAcceptOwnerResponse, error)
+	CancelOwnershipTransfer(context.Context, *MsgCancelOwnershipTransfer) (*MsgCancelOwnershipTransferResponse, error)
+	AcceptRole(context.Context, *MsgAcceptRole) (*MsgAcceptRoleResponse, error)
 	ConfigureMinter(context.Context, *MsgConfigureMinter) (*MsgConfigureMinterResponse, error)
 	RemoveMinter(context.Context, *MsgRemoveMinter) (*MsgRemoveMinterResponse, error)
 	Mint(context.Context, *MsgMint) (*MsgMintResponse, error)
 	Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error)
 	Blacklist(context.Context, *MsgBlacklist) (*MsgBlacklistResponse, error)
 	Unblacklist(context.Context, *MsgUnblacklist) (*MsgUnblacklistResponse, error)
+	BlacklistBatch(context.Context, *MsgBlacklistBatch) (*MsgBlacklistBatchResponse, error)
+	UnblacklistBatch(context.Context, *MsgUnblacklistBatch) (*MsgUnblacklistBatchResponse, error)
+	SeizeBlacklistedFunds(context.Context, *MsgSeizeBlacklistedFunds) (*MsgSeizeBlacklistedFundsResponse, error)
 	Pause(context.Context, *MsgPause) (*MsgPauseResponse, error)
 	Unpause(context.Context, *MsgUnpause) (*MsgUnpauseResponse, error)
 	ConfigureMinterController(context.Context, *MsgConfigureMinterController) (*MsgConfigureMinterControllerResponse, error)
 	RemoveMinterController(context.Context, *MsgRemoveMinterController) (*MsgRemoveMinterControllerResponse, error)
+	ConfigureMinterRateLimit(context.Context, *MsgConfigureMinterRateLimit) (*MsgConfigureMinterRateLimitResponse, error)
+	SetSignerSet(context.Context, *MsgSetSignerSet) (*MsgSetSignerSetResponse, error)
+	ApproveAction(context.Context, *MsgApproveAction) (*MsgApproveActionResponse, error)
+	CancelQueuedAction(context.Context, *MsgCancelQueuedAction) (*MsgCancelQueuedActionResponse, error)
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	ForceRoleAssignment(context.Context, *MsgForceRoleAssignment) (*MsgForceRoleAssignmentResponse, error)
+	SetChannelPolicy(context.Context, *MsgSetChannelPolicy) (*MsgSetChannelPolicyResponse, error)
+	SetChannelRateLimit(context.Context, *MsgSetChannelRateLimit) (*MsgSetChannelRateLimitResponse, error)
+	UpdateAllowlister(context.Context, *MsgUpdateAllowlister) (*MsgUpdateAllowlisterResponse, error)
+	Allowlist(context.Context, *MsgAllowlist) (*MsgAllowlistResponse, error)
+	Unallowlist(context.Context, *MsgUnallowlist) (*MsgUnallowlistResponse, error)
+	AllowlistBatch(context.Context, *MsgAllowlistBatch) (*MsgAllowlistBatchResponse, error)
+	UnallowlistBatch(context.Context, *MsgUnallowlistBatch) (*MsgUnallowlistBatchResponse, error)
+	SetAllowlistMode(context.Context, *MsgSetAllowlistMode) (*MsgSetAllowlistModeResponse, error)
+	RegisterMintingDenom(context.Context, *MsgRegisterMintingDenom) (*MsgRegisterMintingDenomResponse, error)
+	RemoveChannelPolicy(context.Context, *MsgRemoveChannelPolicy) (*MsgRemoveChannelPolicyResponse, error)
+	ReleaseEscrow(context.Context, *MsgReleaseEscrow) (*MsgReleaseEscrowResponse, error)
+	BurnEscrow(context.Context, *MsgBurnEscrow) (*MsgBurnEscrowResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -241,6 +517,12 @@ func (UnimplementedMsgServer) UpdateOwner(context.Context, *MsgUpdateOwner) (*Ms
 func (UnimplementedMsgServer) AcceptOwner(context.Context, *MsgAcceptOwner) (*MsgAcceptOwnerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AcceptOwner not implemented")
 }
+func (UnimplementedMsgServer) CancelOwnershipTransfer(context.Context, *MsgCancelOwnershipTransfer) (*MsgCancelOwnershipTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOwnershipTransfer not implemented")
+}
+func (UnimplementedMsgServer) AcceptRole(context.Context, *MsgAcceptRole) (*MsgAcceptRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptRole not implemented")
+}
 func (UnimplementedMsgServer) ConfigureMinter(context.Context, *MsgConfigureMinter) (*MsgConfigureMinterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConfigureMinter not implemented")
 }
@@ -259,6 +541,15 @@ func (UnimplementedMsgServer) Blacklist(context.Context, *MsgBlacklist) (*MsgBla
 func (UnimplementedMsgServer) Unblacklist(context.Context, *MsgUnblacklist) (*MsgUnblacklistResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Unblacklist not implemented")
 }
+func (UnimplementedMsgServer) BlacklistBatch(context.Context, *MsgBlacklistBatch) (*MsgBlacklistBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlacklistBatch not implemented")
+}
+func (UnimplementedMsgServer) UnblacklistBatch(context.Context, *MsgUnblacklistBatch) (*MsgUnblacklistBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblacklistBatch not implemented")
+}
+func (UnimplementedMsgServer) SeizeBlacklistedFunds(context.Context, *MsgSeizeBlacklistedFunds) (*MsgSeizeBlacklistedFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SeizeBlacklistedFunds not implemented")
+}
 func (UnimplementedMsgServer) Pause(context.Context, *MsgPause) (*MsgPauseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
 }
@@ -271,6 +562,60 @@ func (UnimplementedMsgServer) ConfigureMinterController(context.Context, *MsgCon
 func (UnimplementedMsgServer) RemoveMinterController(context.Context, *MsgRemoveMinterController) (*MsgRemoveMinterControllerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveMinterController not implemented")
 }
+func (UnimplementedMsgServer) ConfigureMinterRateLimit(context.Context, *MsgConfigureMinterRateLimit) (*MsgConfigureMinterRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigureMinterRateLimit not implemented")
+}
+func (UnimplementedMsgServer) SetSignerSet(context.Context, *MsgSetSignerSet) (*MsgSetSignerSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSignerSet not implemented")
+}
+func (UnimplementedMsgServer) ApproveAction(context.Context, *MsgApproveAction) (*MsgApproveActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveAction not implemented")
+}
+func (UnimplementedMsgServer) CancelQueuedAction(context.Context, *MsgCancelQueuedAction) (*MsgCancelQueuedActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelQueuedAction not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (UnimplementedMsgServer) ForceRoleAssignment(context.Context, *MsgForceRoleAssignment) (*MsgForceRoleAssignmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceRoleAssignment not implemented")
+}
+func (UnimplementedMsgServer) SetChannelPolicy(context.Context, *MsgSetChannelPolicy) (*MsgSetChannelPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChannelPolicy not implemented")
+}
+func (UnimplementedMsgServer) SetChannelRateLimit(context.Context, *MsgSetChannelRateLimit) (*MsgSetChannelRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChannelRateLimit not implemented")
+}
+func (UnimplementedMsgServer) UpdateAllowlister(context.Context, *MsgUpdateAllowlister) (*MsgUpdateAllowlisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAllowlister not implemented")
+}
+func (UnimplementedMsgServer) Allowlist(context.Context, *MsgAllowlist) (*MsgAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Allowlist not implemented")
+}
+func (UnimplementedMsgServer) Unallowlist(context.Context, *MsgUnallowlist) (*MsgUnallowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unallowlist not implemented")
+}
+func (UnimplementedMsgServer) AllowlistBatch(context.Context, *MsgAllowlistBatch) (*MsgAllowlistBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowlistBatch not implemented")
+}
+func (UnimplementedMsgServer) UnallowlistBatch(context.Context, *MsgUnallowlistBatch) (*MsgUnallowlistBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnallowlistBatch not implemented")
+}
+func (UnimplementedMsgServer) SetAllowlistMode(context.Context, *MsgSetAllowlistMode) (*MsgSetAllowlistModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAllowlistMode not implemented")
+}
+func (UnimplementedMsgServer) RegisterMintingDenom(context.Context, *MsgRegisterMintingDenom) (*MsgRegisterMintingDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterMintingDenom not implemented")
+}
+func (UnimplementedMsgServer) RemoveChannelPolicy(context.Context, *MsgRemoveChannelPolicy) (*MsgRemoveChannelPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveChannelPolicy not implemented")
+}
+func (UnimplementedMsgServer) ReleaseEscrow(context.Context, *MsgReleaseEscrow) (*MsgReleaseEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseEscrow not implemented")
+}
+func (UnimplementedMsgServer) BurnEscrow(context.Context, *MsgBurnEscrow) (*MsgBurnEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnEscrow not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -374,6 +719,42 @@ func _Msg_AcceptOwner_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelOwnershipTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelOwnershipTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelOwnershipTransfer(ctx, req.(*MsgCancelOwnershipTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptRole(ctx, req.(*MsgAcceptRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_ConfigureMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgConfigureMinter)
 	if err := dec(in); err != nil {
@@ -482,6 +863,60 @@ func _Msg_Unblacklist_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BlacklistBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBlacklistBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BlacklistBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BlacklistBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BlacklistBatch(ctx, req.(*MsgBlacklistBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnblacklistBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnblacklistBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnblacklistBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnblacklistBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnblacklistBatch(ctx, req.(*MsgUnblacklistBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SeizeBlacklistedFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSeizeBlacklistedFunds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SeizeBlacklistedFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SeizeBlacklistedFunds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SeizeBlacklistedFunds(ctx, req.(*MsgSeizeBlacklistedFunds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgPause)
 	if err := dec(in); err != nil {
@@ -554,6 +989,330 @@ func _Msg_RemoveMinterController_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ConfigureMinterRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConfigureMinterRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConfigureMinterRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ConfigureMinterRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConfigureMinterRateLimit(ctx, req.(*MsgConfigureMinterRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSignerSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSignerSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSignerSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetSignerSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSignerSet(ctx, req.(*MsgSetSignerSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveAction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ApproveAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveAction(ctx, req.(*MsgApproveAction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelQueuedAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelQueuedAction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelQueuedAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelQueuedAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelQueuedAction(ctx, req.(*MsgCancelQueuedAction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ForceRoleAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForceRoleAssignment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForceRoleAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ForceRoleAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForceRoleAssignment(ctx, req.(*MsgForceRoleAssignment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetChannelPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetChannelPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetChannelPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetChannelPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetChannelPolicy(ctx, req.(*MsgSetChannelPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetChannelRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetChannelRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetChannelRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetChannelRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetChannelRateLimit(ctx, req.(*MsgSetChannelRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateAllowlister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAllowlister)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAllowlister(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateAllowlister_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAllowlister(ctx, req.(*MsgUpdateAllowlister))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Allowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Allowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Allowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Allowlist(ctx, req.(*MsgAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Unallowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnallowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unallowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Unallowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unallowlist(ctx, req.(*MsgUnallowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AllowlistBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAllowlistBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AllowlistBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AllowlistBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AllowlistBatch(ctx, req.(*MsgAllowlistBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnallowlistBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnallowlistBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnallowlistBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnallowlistBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnallowlistBatch(ctx, req.(*MsgUnallowlistBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAllowlistMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAllowlistMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAllowlistMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetAllowlistMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAllowlistMode(ctx, req.(*MsgSetAllowlistMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterMintingDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterMintingDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterMintingDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterMintingDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterMintingDenom(ctx, req.(*MsgRegisterMintingDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveChannelPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveChannelPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveChannelPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveChannelPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveChannelPolicy(ctx, req.(*MsgRemoveChannelPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReleaseEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReleaseEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReleaseEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReleaseEscrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReleaseEscrow(ctx, req.(*MsgReleaseEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BurnEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurnEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BurnEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BurnEscrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BurnEscrow(ctx, req.(*MsgBurnEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -581,6 +1340,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AcceptOwner",
 			Handler:    _Msg_AcceptOwner_Handler,
 		},
+		{
+			MethodName: "CancelOwnershipTransfer",
+			Handler:    _Msg_CancelOwnershipTransfer_Handler,
+		},
+		{
+			MethodName: "AcceptRole",
+			Handler:    _Msg_AcceptRole_Handler,
+		},
 		{
 			MethodName: "ConfigureMinter",
 			Handler:    _Msg_ConfigureMinter_Handler,
@@ -605,6 +1372,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Unblacklist",
 			Handler:    _Msg_Unblacklist_Handler,
 		},
+		{
+			MethodName: "BlacklistBatch",
+			Handler:    _Msg_BlacklistBatch_Handler,
+		},
+		{
+			MethodName: "UnblacklistBatch",
+			Handler:    _Msg_UnblacklistBatch_Handler,
+		},
+		{
+			MethodName: "SeizeBlacklistedFunds",
+			Handler:    _Msg_SeizeBlacklistedFunds_Handler,
+		},
 		{
 			MethodName: "Pause",
 			Handler:    _Msg_Pause_Handler,
@@ -621,6 +1400,78 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveMinterController",
 			Handler:    _Msg_RemoveMinterController_Handler,
 		},
+		{
+			MethodName: "ConfigureMinterRateLimit",
+			Handler:    _Msg_ConfigureMinterRateLimit_Handler,
+		},
+		{
+			MethodName: "SetSignerSet",
+			Handler:    _Msg_SetSignerSet_Handler,
+		},
+		{
+			MethodName: "ApproveAction",
+			Handler:    _Msg_ApproveAction_Handler,
+		},
+		{
+			MethodName: "CancelQueuedAction",
+			Handler:    _Msg_CancelQueuedAction_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "ForceRoleAssignment",
+			Handler:    _Msg_ForceRoleAssignment_Handler,
+		},
+		{
+			MethodName: "SetChannelPolicy",
+			Handler:    _Msg_SetChannelPolicy_Handler,
+		},
+		{
+			MethodName: "SetChannelRateLimit",
+			Handler:    _Msg_SetChannelRateLimit_Handler,
+		},
+		{
+			MethodName: "UpdateAllowlister",
+			Handler:    _Msg_UpdateAllowlister_Handler,
+		},
+		{
+			MethodName: "Allowlist",
+			Handler:    _Msg_Allowlist_Handler,
+		},
+		{
+			MethodName: "Unallowlist",
+			Handler:    _Msg_Unallowlist_Handler,
+		},
+		{
+			MethodName: "AllowlistBatch",
+			Handler:    _Msg_AllowlistBatch_Handler,
+		},
+		{
+			MethodName: "UnallowlistBatch",
+			Handler:    _Msg_UnallowlistBatch_Handler,
+		},
+		{
+			MethodName: "SetAllowlistMode",
+			Handler:    _Msg_SetAllowlistMode_Handler,
+		},
+		{
+			MethodName: "RegisterMintingDenom",
+			Handler:    _Msg_RegisterMintingDenom_Handler,
+		},
+		{
+			MethodName: "RemoveChannelPolicy",
+			Handler:    _Msg_RemoveChannelPolicy_Handler,
+		},
+		{
+			MethodName: "ReleaseEscrow",
+			Handler:    _Msg_ReleaseEscrow_Handler,
+		},
+		{
+			MethodName: "BurnEscrow",
+			Handler:    _Msg_BurnEscrow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "circle/fiattokenfactory/v1/tx.proto",
@@ -117,42 +117,42 @@ func nobleTokenfactory_e2e(t *testing.T, ctx context.Context, tokenfactoryModNam
 	nobleValidator := noble.Validators[0]
 
 	_, err := nobleValidator.ExecTx(ctx, roles.Owner2.KeyName(),
-		tokenfactoryModName, "update-master-minter", roles.MasterMinter.FormattedAddress(),
+		tokenfactoryModName, "update-master-minter", mintingDenom, roles.MasterMinter.FormattedAddress(),
 	)
 	require.Error(t, err, "succeeded to execute update master minter tx by invalid owner")
 
 	_, err = nobleValidator.ExecTx(ctx, roles.Owner2.KeyName(),
-		tokenfactoryModName, "update-owner", roles.Owner2.FormattedAddress(),
+		tokenfactoryModName, "update-owner", mintingDenom, roles.Owner2.FormattedAddress(),
 	)
 	require.Error(t, err, "succeeded to execute update owner tx by invalid owner")
 
 	_, err = nobleValidator.ExecTx(ctx, roles.Owner.KeyName(),
-		tokenfactoryModName, "update-owner", roles.Owner2.FormattedAddress(),
+		tokenfactoryModName, "update-owner", mintingDenom, roles.Owner2.FormattedAddress(),
 	)
 	require.NoError(t, err, "failed to execute update owner tx")
 
 	_, err = nobleValidator.ExecTx(ctx, roles.Owner2.KeyName(),
-		tokenfactoryModName, "update-master-minter", roles.MasterMinter.FormattedAddress(),
+		tokenfactoryModName, "update-master-minter", mintingDenom, roles.MasterMinter.FormattedAddress(),
 	)
 	require.Error(t, err, "succeeded to execute update master minter tx by pending owner")
 
 	_, err = nobleValidator.ExecTx(ctx, roles.Owner2.KeyName(),
-		tokenfactoryModName, "accept-owner",
+		tokenfactoryModName, "accept-owner", mintingDenom,
 	)
 	require.NoError(t, err, "failed to execute tx to accept ownership")
 
 	_, err = nobleValidator.ExecTx(ctx, roles.Owner.KeyName(),
-		tokenfactoryModName, "update-master-minter", roles.MasterMinter.FormattedAddress(),
+		tokenfactoryModName, "update-master-minter", mintingDenom, roles.MasterMinter.FormattedAddress(),
 	)
 	require.Error(t, err, "succeeded to execute update master minter tx by prior owner")
 
 	_, err = nobleValidator.ExecTx(ctx, roles.Owner2.KeyName(),
-		tokenfactoryModName, "update-master-minter", roles.MasterMinter.FormattedAddress(),
+		tokenfactoryModName, "update-master-minter", mintingDenom, roles.MasterMinter.FormattedAddress(),
 	)
 	require.NoError(t, err, "failed to execute update master minter tx")
 
 	_, err = nobleValidator.ExecTx(ctx, roles.MasterMinter.KeyName(),
-		tokenfactoryModName, "configure-minter-controller", roles.MinterController.FormattedAddress(), roles.Minter.FormattedAddress(),
+		tokenfactoryModName, "configure-minter-controller", mintingDenom, roles.MinterController.FormattedAddress(), roles.Minter.FormattedAddress(),
 	)
 	require.NoError(t, err, "failed to execute configure minter controller tx")
 
@@ -175,12 +175,12 @@ func nobleTokenfactory_e2e(t *testing.T, ctx context.Context, tokenfactoryModNam
 	testIBCTransferSucceed(t, ctx, mintingDenom, noble, gaia, extraWallets.User, gaiaWallets[1])
 
 	_, err = nobleValidator.ExecTx(ctx, roles.Owner2.KeyName(),
-		tokenfactoryModName, "update-blacklister", roles.Blacklister.FormattedAddress(),
+		tokenfactoryModName, "update-blacklister", mintingDenom, roles.Blacklister.FormattedAddress(),
 	)
 	require.NoError(t, err, "failed to set blacklister")
 
 	_, err = nobleValidator.ExecTx(ctx, roles.Blacklister.KeyName(),
-		tokenfactoryModName, "blacklist", extraWallets.User.FormattedAddress(),
+		tokenfactoryModName, "blacklist", mintingDenom, extraWallets.User.FormattedAddress(),
 	)
 	require.NoError(t, err, "failed to blacklist user address")
 
@@ -188,7 +188,7 @@ func nobleTokenfactory_e2e(t *testing.T, ctx context.Context, tokenfactoryModNam
 	require.NoError(t, err, "failed to convert gaia wallet address")
 
 	_, err = nobleValidator.ExecTx(ctx, roles.Blacklister.KeyName(),
-		tokenfactoryModName, "blacklist", gaiaWalletBech32Addr,
+		tokenfactoryModName, "blacklist", mintingDenom, gaiaWalletBech32Addr,
 	)
 	require.NoError(t, err, "failed to blacklist gaia wallet address")
 
@@ -259,7 +259,7 @@ func nobleTokenfactory_e2e(t *testing.T, ctx context.Context, tokenfactoryModNam
 	require.NoError(t, err, "The tx should have been successfull as that is not the minting denom")
 
 	_, err = nobleValidator.ExecTx(ctx, roles.Blacklister.KeyName(),
-		tokenfactoryModName, "unblacklist", extraWallets.User.FormattedAddress(),
+		tokenfactoryModName, "unblacklist", mintingDenom, extraWallets.User.FormattedAddress(),
 	)
 	require.NoError(t, err, "failed to unblacklist user address")
 
@@ -291,12 +291,12 @@ func nobleTokenfactory_e2e(t *testing.T, ctx context.Context, tokenfactoryModNam
 	require.Equal(t, math.NewInt(90), minterBalance, "minter balance should have decreased because tokens were burned")
 
 	_, err = nobleValidator.ExecTx(ctx, roles.Owner2.KeyName(),
-		tokenfactoryModName, "update-pauser", roles.Pauser.FormattedAddress(),
+		tokenfactoryModName, "update-pauser", mintingDenom, roles.Pauser.FormattedAddress(),
 	)
 	require.NoError(t, err, "failed to update pauser")
 
 	_, err = nobleValidator.ExecTx(ctx, roles.MasterMinter.KeyName(),
-		tokenfactoryModName, "configure-minter-controller", roles.MinterController2.FormattedAddress(), extraWallets.User.FormattedAddress())
+		tokenfactoryModName, "configure-minter-controller", mintingDenom, roles.MinterController2.FormattedAddress(), extraWallets.User.FormattedAddress())
 	require.NoError(t, err, "failed to execute configure minter controller tx")
 
 	_, err = nobleValidator.ExecTx(ctx, roles.MinterController2.KeyName(),
@@ -306,7 +306,7 @@ func nobleTokenfactory_e2e(t *testing.T, ctx context.Context, tokenfactoryModNam
 	// -- chain paused --
 
 	_, err = nobleValidator.ExecTx(ctx, roles.Pauser.KeyName(),
-		tokenfactoryModName, "pause",
+		tokenfactoryModName, "pause", mintingDenom,
 	)
 	require.NoError(t, err, "failed to pause mints")
 
@@ -342,7 +342,7 @@ func nobleTokenfactory_e2e(t *testing.T, ctx context.Context, tokenfactoryModNam
 	require.Equal(t, math.NewInt(90), minterBalance, "this burn should not have been successful because the chain is paused")
 
 	_, err = nobleValidator.ExecTx(ctx, roles.MasterMinter.KeyName(),
-		tokenfactoryModName, "configure-minter-controller", roles.MinterController2.FormattedAddress(), extraWallets.User.FormattedAddress())
+		tokenfactoryModName, "configure-minter-controller", mintingDenom, roles.MinterController2.FormattedAddress(), extraWallets.User.FormattedAddress())
 
 	require.NoError(t, err, "failed to execute configure minter controller tx")
 
@@ -350,7 +350,7 @@ func nobleTokenfactory_e2e(t *testing.T, ctx context.Context, tokenfactoryModNam
 		tokenfactoryModName, "configure-minter", extraWallets.User.FormattedAddress(), "1000"+mintingDenom)
 	require.Error(t, err, "failed to block configure minter tx when chain is paused")
 
-	res, _, err := nobleValidator.ExecQuery(ctx, tokenfactoryModName, "show-minter-controller", roles.MinterController2.FormattedAddress(), "-o", "json")
+	res, _, err := nobleValidator.ExecQuery(ctx, tokenfactoryModName, "show-minter-controller", mintingDenom, roles.MinterController2.FormattedAddress(), "-o", "json")
 	require.NoError(t, err, "failed to query minter controller")
 
 	var minterControllerType types.QueryGetMinterControllerResponse
@@ -365,7 +365,7 @@ func nobleTokenfactory_e2e(t *testing.T, ctx context.Context, tokenfactoryModNam
 	require.Equal(t, userAddress, minterControllerType.MinterController.Minter)
 
 	_, err = nobleValidator.ExecTx(ctx, roles.MinterController2.KeyName(),
-		tokenfactoryModName, "remove-minter", extraWallets.User.FormattedAddress(),
+		tokenfactoryModName, "remove-minter", mintingDenom, extraWallets.User.FormattedAddress(),
 	)
 	require.NoError(t, err, "minters should be able to be removed while in paused state")
 
@@ -387,7 +387,7 @@ func nobleTokenfactory_e2e(t *testing.T, ctx context.Context, tokenfactoryModNam
 	require.ErrorContains(t, err, "paused", "failed to block spending gas in minting denom")
 
 	_, err = nobleValidator.ExecTx(ctx, roles.Pauser.KeyName(),
-		tokenfactoryModName, "unpause",
+		tokenfactoryModName, "unpause", mintingDenom,
 	)
 	require.NoError(t, err, "failed to unpause mints")
 
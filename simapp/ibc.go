@@ -83,7 +83,10 @@ func (app *SimApp) RegisterIBCModules() error {
 	)
 	app.ScopedTransferKeeper = scopedTransferKeeper
 
-	ibcRouter := porttypes.NewRouter().AddRoute(transfertypes.ModuleName, blockibc.NewIBCMiddleware(transfer.NewIBCModule(app.TransferKeeper), app.FiatTokenFactoryKeeper))
+	transferStack := blockibc.NewIBCMiddleware(transfer.NewIBCModule(app.TransferKeeper), app.IBCKeeper.ChannelKeeper, app.FiatTokenFactoryKeeper)
+	app.TransferKeeper.WithICS4Wrapper(transferStack)
+
+	ibcRouter := porttypes.NewRouter().AddRoute(transfertypes.ModuleName, transferStack)
 	app.IBCKeeper.SetRouter(ibcRouter)
 
 	if err := app.RegisterModules(
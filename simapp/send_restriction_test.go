@@ -0,0 +1,177 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simapp
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/require"
+
+	fiattokenfactorykeeper "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	fiattokenfactorytypes "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+func init() {
+	config := sdk.GetConfig()
+	config.SetBech32PrefixForAccount("noble", "noblepub")
+	config.SetBech32PrefixForValidator("noblevaloper", "noblevaloperpub")
+	config.SetBech32PrefixForConsensusNode("noblevalcons", "noblevalconspub")
+}
+
+// setupSendRestrictionTest builds an in memory app whose bank keeper enforces
+// the send restrictions of the fiattokenfactory module, and funds the fee
+// collector and a user with the minting denom.
+func setupSendRestrictionTest(t *testing.T) (*SimApp, sdk.Context, sdk.AccAddress) {
+	app, err := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+	require.NoError(t, err)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{})
+
+	app.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{Base: "uusdc", Display: "usdc"})
+	app.FiatTokenFactoryKeeper.SetMintingDenom(ctx, fiattokenfactorytypes.MintingDenom{Denom: "uusdc"})
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1000))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, fiattokenfactorytypes.ModuleName, coins.Add(coins...)))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, fiattokenfactorytypes.ModuleName, authtypes.FeeCollectorName, coins))
+
+	user := sdk.AccAddress("user________________")
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, fiattokenfactorytypes.ModuleName, user, coins))
+
+	return app, ctx, user
+}
+
+func TestSendRestriction_PauseExemption(t *testing.T) {
+	app, ctx, user := setupSendRestrictionTest(t)
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 10))
+	app.FiatTokenFactoryKeeper.SetPaused(ctx, fiattokenfactorytypes.Paused{Denom: "uusdc", Paused: true})
+
+	// ASSERT: Without exemptions, a pause halts the distribution of fees.
+	err := app.BankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, distributiontypes.ModuleName, coins)
+	require.ErrorIs(t, err, fiattokenfactorytypes.ErrPaused)
+
+	// ACT: Exempt the fee collector and distribution module accounts from the pause.
+	params := fiattokenfactorytypes.DefaultParams()
+	params.ModuleExemptions = append(params.ModuleExemptions,
+		fiattokenfactorytypes.ModuleExemption{Module: authtypes.FeeCollectorName, Pause: true},
+		fiattokenfactorytypes.ModuleExemption{Module: distributiontypes.ModuleName, Pause: true},
+	)
+	app.FiatTokenFactoryKeeper.SetParams(ctx, params)
+
+	// ASSERT: Fees are distributed while paused.
+	err = app.BankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, distributiontypes.ModuleName, coins)
+	require.NoError(t, err)
+	distribution := app.AccountKeeper.GetModuleAddress(distributiontypes.ModuleName)
+	require.Equal(t, int64(10), app.BankKeeper.GetBalance(ctx, distribution, "uusdc").Amount.Int64())
+
+	// ASSERT: Users still can not pay fees while paused.
+	err = app.BankKeeper.SendCoinsFromAccountToModule(ctx, user, authtypes.FeeCollectorName, coins)
+	require.ErrorIs(t, err, fiattokenfactorytypes.ErrPaused)
+}
+
+func TestSendRestriction_BlacklistExemption(t *testing.T) {
+	app, ctx, user := setupSendRestrictionTest(t)
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 10))
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	app.FiatTokenFactoryKeeper.SetBlacklisted(ctx, fiattokenfactorytypes.Blacklisted{Denom: "uusdc", AddressBz: feeCollector})
+
+	// ASSERT: Without exemptions, blacklisting the fee collector halts fee payments.
+	err := app.BankKeeper.SendCoinsFromAccountToModule(ctx, user, authtypes.FeeCollectorName, coins)
+	require.ErrorIs(t, err, fiattokenfactorytypes.ErrUnauthorized)
+
+	// ACT: Exempt the fee collector module account from the blacklist.
+	params := fiattokenfactorytypes.DefaultParams()
+	params.ModuleExemptions = append(params.ModuleExemptions,
+		fiattokenfactorytypes.ModuleExemption{Module: authtypes.FeeCollectorName, Blacklist: true},
+	)
+	app.FiatTokenFactoryKeeper.SetParams(ctx, params)
+
+	// ASSERT: Fees are paid to the blacklisted fee collector.
+	err = app.BankKeeper.SendCoinsFromAccountToModule(ctx, user, authtypes.FeeCollectorName, coins)
+	require.NoError(t, err)
+
+	// ASSERT: Blacklisted users still can not pay fees.
+	app.FiatTokenFactoryKeeper.SetBlacklisted(ctx, fiattokenfactorytypes.Blacklisted{Denom: "uusdc", AddressBz: user})
+	err = app.BankKeeper.SendCoinsFromAccountToModule(ctx, user, authtypes.FeeCollectorName, coins)
+	require.ErrorIs(t, err, fiattokenfactorytypes.ErrUnauthorized)
+}
+
+func TestSendRestriction_AllowlistExemption(t *testing.T) {
+	app, ctx, user := setupSendRestrictionTest(t)
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 10))
+	app.FiatTokenFactoryKeeper.SetAllowlistEnabled(ctx, "uusdc", true)
+	app.FiatTokenFactoryKeeper.SetAllowlisted(ctx, fiattokenfactorytypes.Allowlisted{Denom: "uusdc", AddressBz: user})
+
+	// ASSERT: Without exemptions, the fee collector has to be allowlisted.
+	err := app.BankKeeper.SendCoinsFromAccountToModule(ctx, user, authtypes.FeeCollectorName, coins)
+	require.ErrorIs(t, err, fiattokenfactorytypes.ErrNotAllowlisted)
+
+	// ASSERT: The fiattokenfactory module account is exempted by default.
+	err = app.BankKeeper.SendCoinsFromAccountToModule(ctx, user, fiattokenfactorytypes.ModuleName, coins)
+	require.NoError(t, err)
+
+	// ACT: Exempt the fee collector module account from the allowlist.
+	params := fiattokenfactorytypes.DefaultParams()
+	params.ModuleExemptions = append(params.ModuleExemptions,
+		fiattokenfactorytypes.ModuleExemption{Module: authtypes.FeeCollectorName, Allowlist: true},
+	)
+	app.FiatTokenFactoryKeeper.SetParams(ctx, params)
+
+	// ASSERT: Allowlisted users pay fees to the fee collector.
+	err = app.BankKeeper.SendCoinsFromAccountToModule(ctx, user, authtypes.FeeCollectorName, coins)
+	require.NoError(t, err)
+}
+
+func TestSendRestriction_Escrow(t *testing.T) {
+	app, ctx, user := setupSendRestrictionTest(t)
+	msgServer := fiattokenfactorykeeper.NewMsgServerImpl(app.FiatTokenFactoryKeeper)
+	authority := app.FiatTokenFactoryKeeper.GetAuthority()
+	escrow := authtypes.NewModuleAddress(fiattokenfactorytypes.EscrowName)
+
+	blacklister := sdk.AccAddress("blacklister_________")
+	app.FiatTokenFactoryKeeper.SetBlacklister(ctx, fiattokenfactorytypes.Blacklister{Denom: "uusdc", Address: blacklister.String()})
+	app.FiatTokenFactoryKeeper.SetBlacklisted(ctx, fiattokenfactorytypes.Blacklisted{Denom: "uusdc", AddressBz: user})
+
+	// ACT: Seize the funds of the blacklisted user.
+	_, err := msgServer.SeizeBlacklistedFunds(ctx, &fiattokenfactorytypes.MsgSeizeBlacklistedFunds{From: blacklister.String(), Address: user.String(), Denom: "uusdc"})
+	require.NoError(t, err)
+
+	// ASSERT: The escrow account only holds the seized funds.
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 1000), app.BankKeeper.GetBalance(ctx, escrow, "uusdc"))
+
+	// ASSERT: Seized funds cannot be released to a blacklisted address.
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = msgServer.ReleaseEscrow(cacheCtx, &fiattokenfactorytypes.MsgReleaseEscrow{Authority: authority, Recipient: user.String(), Amount: sdk.NewInt64Coin("uusdc", 400)})
+	require.ErrorIs(t, err, fiattokenfactorytypes.ErrUnauthorized)
+
+	// ACT: Release part of the seized funds and burn the rest.
+	recipient := sdk.AccAddress("recipient___________")
+	_, err = msgServer.ReleaseEscrow(ctx, &fiattokenfactorytypes.MsgReleaseEscrow{Authority: authority, Recipient: recipient.String(), Amount: sdk.NewInt64Coin("uusdc", 400)})
+	require.NoError(t, err)
+	_, err = msgServer.BurnEscrow(ctx, &fiattokenfactorytypes.MsgBurnEscrow{Authority: authority, Amount: sdk.NewInt64Coin("uusdc", 600)})
+	require.NoError(t, err)
+
+	// ASSERT: The escrow is empty and the burned funds left the supply.
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 400), app.BankKeeper.GetBalance(ctx, recipient, "uusdc"))
+	require.True(t, app.BankKeeper.GetBalance(ctx, escrow, "uusdc").IsZero())
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 1400), app.BankKeeper.GetSupply(ctx, "uusdc"))
+}
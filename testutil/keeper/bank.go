@@ -81,12 +81,17 @@ func (k MockBankKeeper) SendCoins(ctx context.Context, fromAddr sdk.AccAddress,
 	return nil
 }
 
+func (k MockBankKeeper) GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, k.Balances[addr.String()].AmountOf(denom))
+}
+
 func (MockBankKeeper) GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool) {
-	if denom == "uusdc" {
+	switch denom {
+	case "uusdc", "ueurc":
 		return banktypes.Metadata{
 			DenomUnits: []*banktypes.DenomUnit{
 				{
-					Denom: "uusdc",
+					Denom: denom,
 				},
 			},
 		}, true
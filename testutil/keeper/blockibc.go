@@ -26,11 +26,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	portkeeper "github.com/cosmos/ibc-go/v8/modules/core/05-port/keeper"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	"github.com/cosmos/ibc-go/v8/testing/mock"
 )
@@ -64,13 +68,35 @@ func BlockIBC() (blockibc.IBCMiddleware, *fiattokenfactorykeeper.Keeper, sdk.Con
 	}
 
 	ftfKeeper := fiattokenfactorykeeper.NewKeeper(
-		cdc, nil, runtime.NewKVStoreService(keys[fiattokenfactorytypes.StoreKey]), MockBankKeeper{},
+		cdc, nil, runtime.NewKVStoreService(keys[fiattokenfactorytypes.StoreKey]), authtypes.NewModuleAddress(govtypes.ModuleName).String(), MockBankKeeper{},
 	)
 	ftfKeeper.SetMintingDenom(ctx, fiattokenfactorytypes.MintingDenom{Denom: "uusdc"})
-	ftfKeeper.SetPaused(ctx, fiattokenfactorytypes.Paused{Paused: false})
+	ftfKeeper.SetPaused(ctx, fiattokenfactorytypes.Paused{Denom: "uusdc", Paused: false})
 
 	return blockibc.NewIBCMiddleware(
 		transferIBCModule,
+		&MockICS4Wrapper{},
 		ftfKeeper,
 	), ftfKeeper, ctx
 }
+
+// MockICS4Wrapper accepts every outgoing packet without touching any channel state, assigning
+// sequences starting from 1.
+type MockICS4Wrapper struct {
+	sequence uint64
+}
+
+var _ porttypes.ICS4Wrapper = &MockICS4Wrapper{}
+
+func (w *MockICS4Wrapper) SendPacket(_ sdk.Context, _ *capabilitytypes.Capability, _ string, _ string, _ clienttypes.Height, _ uint64, _ []byte) (uint64, error) {
+	w.sequence++
+	return w.sequence, nil
+}
+
+func (*MockICS4Wrapper) WriteAcknowledgement(_ sdk.Context, _ *capabilitytypes.Capability, _ exported.PacketI, _ exported.Acknowledgement) error {
+	return nil
+}
+
+func (*MockICS4Wrapper) GetAppVersion(_ sdk.Context, _, _ string) (string, bool) {
+	return transfertypes.Version, true
+}
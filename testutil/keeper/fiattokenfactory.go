@@ -29,9 +29,18 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func FiatTokenfactoryKeeper() (*keeper.Keeper, sdk.Context) {
+	k, ctx, _ := FiatTokenfactoryKeeperWithKey()
+	return k, ctx
+}
+
+// FiatTokenfactoryKeeperWithKey additionally returns the store key of the
+// module, allowing tests to access the raw store.
+func FiatTokenfactoryKeeperWithKey() (*keeper.Keeper, sdk.Context, *storetypes.KVStoreKey) {
 	logger := log.NewNopLogger()
 
 	key := storetypes.NewKVStoreKey(types.StoreKey)
@@ -43,8 +52,9 @@ func FiatTokenfactoryKeeper() (*keeper.Keeper, sdk.Context) {
 		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
 		logger,
 		runtime.NewKVStoreService(key),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		MockBankKeeper{
 			Balances: make(map[string]sdk.Coins),
 		},
-	), sdk.NewContext(state, cmtproto.Header{}, false, logger)
+	), sdk.NewContext(state, cmtproto.Header{}, false, logger), key
 }
@@ -18,29 +18,36 @@ package blockibc
 
 import (
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
-var _ porttypes.IBCModule = &IBCMiddleware{}
+var _ porttypes.Middleware = &IBCMiddleware{}
 
 // IBCMiddleware implements the tokenfactory keeper in order to check against blacklisted addresses.
 type IBCMiddleware struct {
-	app    porttypes.IBCModule
-	keeper *keeper.Keeper
+	app         porttypes.IBCModule
+	ics4Wrapper porttypes.ICS4Wrapper
+	keeper      *keeper.Keeper
 }
 
-// NewIBCMiddleware creates a new IBCMiddleware given the keeper and underlying application.
-func NewIBCMiddleware(app porttypes.IBCModule, k *keeper.Keeper) IBCMiddleware {
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper, the underlying application and the
+// ICS4Wrapper the outgoing packets of the application are sent through.
+func NewIBCMiddleware(app porttypes.IBCModule, ics4Wrapper porttypes.ICS4Wrapper, k *keeper.Keeper) IBCMiddleware {
 	return IBCMiddleware{
-		app:    app,
-		keeper: k,
+		app:         app,
+		ics4Wrapper: ics4Wrapper,
+		keeper:      k,
 	}
 }
 
@@ -96,9 +103,12 @@ func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID st
 	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
 }
 
-// OnRecvPacket intercepts the packet data and checks the sender and receiver address against
-// the blacklisted addresses held in the tokenfactory keeper. If the address is found in the blacklist, an
-// acknowledgment error is returned.
+// OnRecvPacket intercepts the packet data and checks the sender and receiver address, as well as the
+// eventual recipients named in the memo, against the blacklisted addresses held in the tokenfactory
+// keeper. If an address is found in the blacklist, the sender or receiver is not allowlisted while
+// the allowlist mode of the denom is enabled, or the channel is not allowlisted for inbound
+// transfers of the denom, an acknowledgment error is returned. Memos nesting too many forwards or
+// naming recipients that cannot be decoded are rejected as well.
 func (im IBCMiddleware) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -113,51 +123,262 @@ func (im IBCMiddleware) OnRecvPacket(
 
 	denomTrace := transfertypes.ParseDenomTrace(data.Denom)
 
-	mintingDenom := im.keeper.GetMintingDenom(ctx)
-	if denomTrace.BaseDenom != mintingDenom.Denom {
+	denom := denomTrace.BaseDenom
+	if _, found := im.keeper.GetMintingDenom(ctx, denom); !found {
 		return im.app.OnRecvPacket(ctx, packet, relayer)
 	}
 
-	if im.keeper.GetPaused(ctx).Paused {
+	if im.keeper.GetPaused(ctx, denom).IsPaused(types.PauseScope_PAUSE_SCOPE_IBC_IN) {
 		return channeltypes.NewErrorAcknowledgement(types.ErrPaused)
 	}
 
-	_, addressBz, err := keeper.DecodeNoLimitToBase256(data.Receiver)
+	if !im.keeper.IsInboundChannelAllowed(ctx, denom, packet.DestinationChannel) {
+		ackErr = errors.Wrapf(types.ErrChannelNotAllowed, "%s cannot be received through %s", denom, packet.DestinationChannel)
+		return channeltypes.NewErrorAcknowledgement(ackErr)
+	}
+
+	receiverBz, err := im.keeper.DecodeAddress(data.Receiver)
 	if err != nil {
 		return channeltypes.NewErrorAcknowledgement(err)
 	}
 
-	_, found := im.keeper.GetBlacklisted(ctx, addressBz)
+	_, found := im.keeper.GetBlacklisted(ctx, denom, receiverBz)
 	if found {
 		ackErr = errors.Wrapf(types.ErrUnauthorized, "receiver address is blacklisted")
 		return channeltypes.NewErrorAcknowledgement(ackErr)
 	}
 
-	_, addressBz, err = keeper.DecodeNoLimitToBase256(data.Sender)
+	senderBz, err := im.keeper.DecodeAddress(data.Sender)
 	if err != nil {
 		return channeltypes.NewErrorAcknowledgement(err)
 	}
 
-	_, found = im.keeper.GetBlacklisted(ctx, addressBz)
+	_, found = im.keeper.GetBlacklisted(ctx, denom, senderBz)
 	if found {
 		ackErr = errors.Wrapf(types.ErrUnauthorized, "sender address is blacklisted")
 		return channeltypes.NewErrorAcknowledgement(ackErr)
 	}
 
+	if im.keeper.IsAllowlistEnabled(ctx, denom) {
+		if !im.keeper.IsAllowlisted(ctx, denom, receiverBz) {
+			ackErr = errors.Wrapf(types.ErrNotAllowlisted, "receiver address is not allowlisted")
+			return channeltypes.NewErrorAcknowledgement(ackErr)
+		}
+
+		if !im.keeper.IsAllowlisted(ctx, denom, senderBz) {
+			ackErr = errors.Wrapf(types.ErrNotAllowlisted, "sender address is not allowlisted")
+			return channeltypes.NewErrorAcknowledgement(ackErr)
+		}
+	}
+
+	recipients, err := memoRecipients(data.Memo, im.keeper.GetParams(ctx).MemoRecipientPaths)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	// recipients in an address format without a codec are rejected, as they cannot be checked against the blacklist
+	for _, recipient := range recipients {
+		addressBz, err := im.keeper.DecodeAddress(recipient)
+		if err != nil {
+			ackErr = errors.Wrapf(err, "cannot decode memo recipient address %s", recipient)
+			return channeltypes.NewErrorAcknowledgement(ackErr)
+		}
+
+		if _, found := im.keeper.GetBlacklisted(ctx, denom, addressBz); found {
+			ackErr = errors.Wrapf(types.ErrUnauthorized, "memo recipient address %s is blacklisted", recipient)
+			return channeltypes.NewErrorAcknowledgement(ackErr)
+		}
+	}
+
+	amount, ok := math.NewIntFromString(data.Amount)
+	if !ok {
+		ackErr = errors.Wrapf(types.ErrInvalidCoins, "invalid amount %s", data.Amount)
+		return channeltypes.NewErrorAcknowledgement(ackErr)
+	}
+
+	// the inflow is discarded along with the other state changes of the packet when it is acknowledged with an error
+	if err := im.keeper.ConsumeChannelInflow(ctx, denom, packet.DestinationChannel, amount); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
 	return im.app.OnRecvPacket(ctx, packet, relayer)
 }
 
-// OnAcknowledgementPacket implements the IBCModule interface.
+// OnAcknowledgementPacket implements the IBCModule interface. The outflow of a failed transfer is
+// undone in the rate limit of its channel, and its refund is moved into the escrow of the
+// tokenfactory module when its sender has been blacklisted since sending.
 func (im IBCMiddleware) OnAcknowledgementPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	acknowledgement []byte,
 	relayer sdk.AccAddress,
 ) error {
+	// like the transfer application, an unparsable acknowledgement is rejected rather than read as a
+	// success, which would keep the outflow and drop the pending packet of the transfer
+	var ack channeltypes.Acknowledgement
+	if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errors.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+
+	if !ack.Success() {
+		im.undoOutflow(ctx, packet)
+
+		var err error
+		if packet, err = im.escrowRefund(ctx, packet); err != nil {
+			return err
+		}
+	} else {
+		im.keeper.RemovePendingSendPacket(ctx, packet.SourceChannel, packet.Sequence)
+	}
+
 	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
 }
 
-// OnTimeoutPacket implements the IBCModule interface.
+// OnTimeoutPacket implements the IBCModule interface. The outflow of a timed out transfer is undone
+// in the rate limit of its channel, and its refund is moved into the escrow of the tokenfactory
+// module when its sender has been blacklisted since sending.
 func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	im.undoOutflow(ctx, packet)
+
+	packet, err := im.escrowRefund(ctx, packet)
+	if err != nil {
+		return err
+	}
+
 	return im.app.OnTimeoutPacket(ctx, packet, relayer)
 }
+
+// undoOutflow reverts the outflow of a failed transfer counted against the rate limit of its channel.
+func (im IBCMiddleware) undoOutflow(ctx sdk.Context, packet channeltypes.Packet) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return
+	}
+
+	amount, ok := math.NewIntFromString(data.Amount)
+	if !ok {
+		return
+	}
+
+	im.keeper.UndoChannelOutflow(ctx, packet.SourceChannel, packet.Sequence, amount)
+}
+
+// escrowRefund redirects the refund of a minting denom transfer to the escrow account of the
+// tokenfactory module when its sender is blacklisted, as the refund could otherwise never be
+// delivered. The packet was verified by core IBC already, so only the application sees the
+// rewritten sender.
+func (im IBCMiddleware) escrowRefund(ctx sdk.Context, packet channeltypes.Packet) (channeltypes.Packet, error) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return packet, nil
+	}
+
+	denom := transfertypes.ParseDenomTrace(data.Denom).BaseDenom
+	if _, found := im.keeper.GetMintingDenom(ctx, denom); !found {
+		return packet, nil
+	}
+
+	addressBz, err := im.keeper.DecodeAddress(data.Sender)
+	if err != nil {
+		return packet, nil
+	}
+	if _, found := im.keeper.GetBlacklisted(ctx, denom, addressBz); !found {
+		return packet, nil
+	}
+
+	escrow := authtypes.NewModuleAddress(types.EscrowName).String()
+	sender := data.Sender
+	data.Sender = escrow
+	packet.Data = data.GetBytes()
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRefundEscrowed{
+		Denom:         denom,
+		Sender:        sender,
+		Amount:        data.Amount,
+		SourcePort:    packet.SourcePort,
+		SourceChannel: packet.SourceChannel,
+		Sequence:      packet.Sequence,
+		Escrow:        escrow,
+	})
+	return packet, err
+}
+
+// SendPacket implements the ICS4Wrapper interface. Outgoing transfers of a minting denom are
+// rejected while outbound transfers of the denom are paused, on channels not allowlisted for
+// outbound transfers of the denom, when the sender or receiver is blacklisted, or when they
+// exceed the rate limit of their channel.
+func (im IBCMiddleware) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(data, &packetData); err != nil {
+		return im.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	}
+
+	denom := transfertypes.ParseDenomTrace(packetData.Denom).BaseDenom
+	if _, found := im.keeper.GetMintingDenom(ctx, denom); !found {
+		return im.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	}
+
+	if im.keeper.GetPaused(ctx, denom).IsPaused(types.PauseScope_PAUSE_SCOPE_IBC_OUT) {
+		return 0, errors.Wrapf(types.ErrPaused, "outbound ibc transfers of %s are paused", denom)
+	}
+
+	if !im.keeper.IsOutboundChannelAllowed(ctx, denom, sourceChannel) {
+		return 0, errors.Wrapf(types.ErrChannelNotAllowed, "%s cannot be sent through %s", denom, sourceChannel)
+	}
+
+	addressBz, err := im.keeper.DecodeAddress(packetData.Sender)
+	if err != nil {
+		return 0, err
+	}
+	if _, found := im.keeper.GetBlacklisted(ctx, denom, addressBz); found {
+		return 0, errors.Wrapf(types.ErrUnauthorized, "sender address is blacklisted")
+	}
+
+	addressBz, err = im.keeper.DecodeAddress(packetData.Receiver)
+	if err != nil {
+		return 0, err
+	}
+	if _, found := im.keeper.GetBlacklisted(ctx, denom, addressBz); found {
+		return 0, errors.Wrapf(types.ErrUnauthorized, "receiver address is blacklisted")
+	}
+
+	amount, ok := math.NewIntFromString(packetData.Amount)
+	if !ok {
+		return 0, errors.Wrapf(types.ErrInvalidCoins, "invalid amount %s", packetData.Amount)
+	}
+
+	if err := im.keeper.ConsumeChannelOutflow(ctx, denom, sourceChannel, amount); err != nil {
+		return 0, err
+	}
+
+	sequence, err := im.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
+	}
+
+	im.keeper.RecordPendingSendPacket(ctx, denom, sourceChannel, sequence)
+	return sequence, nil
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface.
+func (im IBCMiddleware) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+) error {
+	return im.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion implements the ICS4Wrapper interface.
+func (im IBCMiddleware) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return im.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
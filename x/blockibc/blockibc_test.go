@@ -17,8 +17,13 @@
 package blockibc_test
 
 import (
+	"bytes"
+	"encoding/hex"
+	"strconv"
 	"testing"
 
+	"cosmossdk.io/math"
+	"github.com/btcsuite/btcd/btcutil/base58"
 	"github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
 	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
 	fiattokenfactorytypes "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
@@ -26,6 +31,7 @@ import (
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 	"github.com/stretchr/testify/require"
 )
 
@@ -35,14 +41,35 @@ func TestBlockIBC(t *testing.T) {
 	senderBech32m, receiverBech32m := sample.TestAccountBech32m(), sample.TestAccountBech32m()
 	receiverAddress, _ := codec.NewBech32Codec("osmo").BytesToString(receiver.AddressBz)
 	receiverBech32mAddress, _ := codec.NewBech32Codec("osmo").BytesToString(receiverBech32m.AddressBz)
+	senderHex := sample.Account{AddressBz: sender.AddressBz, Address: "0x" + hex.EncodeToString(sender.AddressBz)}
+	senderBase58 := sample.Account{AddressBz: bytes.Repeat([]byte{0x01}, 32)}
+	senderBase58.Address = base58.Encode(senderBase58.AddressBz)
 
 	// ARRANGE: Organize table driven test cases.
 	testCases := map[string]struct {
 		toBlacklist         *sample.Account
 		setPaused           bool
+		pausedScopes        uint32
+		channelPolicy       *fiattokenfactorytypes.ChannelPolicy
+		toAllowlist         []sample.Account
 		packet              channeltypes.Packet
 		expectSuccessfulAck bool
 	}{
+		"channel allowlisted for inbound transfers": {
+			channelPolicy:       &fiattokenfactorytypes.ChannelPolicy{Denom: "uusdc", ChannelId: "channel-0", AllowInbound: true},
+			packet:              mockPacket(sender.Address, receiverAddress),
+			expectSuccessfulAck: true,
+		},
+		"channel allowlisted for outbound transfers only": {
+			channelPolicy:       &fiattokenfactorytypes.ChannelPolicy{Denom: "uusdc", ChannelId: "channel-0", AllowOutbound: true},
+			packet:              mockPacket(sender.Address, receiverAddress),
+			expectSuccessfulAck: false,
+		},
+		"channel not allowlisted": {
+			channelPolicy:       &fiattokenfactorytypes.ChannelPolicy{Denom: "uusdc", ChannelId: "channel-1", AllowInbound: true},
+			packet:              mockPacket(sender.Address, receiverAddress),
+			expectSuccessfulAck: false,
+		},
 		"happy path": {
 			toBlacklist:         nil,
 			setPaused:           false,
@@ -78,6 +105,18 @@ func TestBlockIBC(t *testing.T) {
 			packet:              mockPacket(sender.Address, receiverAddress),
 			expectSuccessfulAck: false,
 		},
+		"tokenfactory paused except for inbound ibc": {
+			toBlacklist:         nil,
+			pausedScopes:        fiattokenfactorytypes.PauseScopesAll &^ fiattokenfactorytypes.PauseScope_PAUSE_SCOPE_IBC_IN.Bit(),
+			packet:              mockPacket(sender.Address, receiverAddress),
+			expectSuccessfulAck: true,
+		},
+		"tokenfactory inbound ibc paused": {
+			toBlacklist:         nil,
+			pausedScopes:        fiattokenfactorytypes.PauseScope_PAUSE_SCOPE_IBC_IN.Bit(),
+			packet:              mockPacket(sender.Address, receiverAddress),
+			expectSuccessfulAck: false,
+		},
 		"blacklisted bech32 sender": {
 			toBlacklist:         &sender,
 			setPaused:           false,
@@ -90,6 +129,24 @@ func TestBlockIBC(t *testing.T) {
 			packet:              mockPacket(senderBech32m.Address, receiverAddress),
 			expectSuccessfulAck: false,
 		},
+		"blacklisted hex sender": {
+			toBlacklist:         &senderHex,
+			setPaused:           false,
+			packet:              mockPacket(senderHex.Address, receiverAddress),
+			expectSuccessfulAck: false,
+		},
+		"blacklisted base58 sender": {
+			toBlacklist:         &senderBase58,
+			setPaused:           false,
+			packet:              mockPacket(senderBase58.Address, receiverAddress),
+			expectSuccessfulAck: false,
+		},
+		"non-blacklisted base58 sender": {
+			toBlacklist:         &sender,
+			setPaused:           false,
+			packet:              mockPacket(senderBase58.Address, receiverAddress),
+			expectSuccessfulAck: true,
+		},
 		"blacklisted bech32 receiver": {
 			toBlacklist:         &receiver,
 			setPaused:           false,
@@ -102,6 +159,21 @@ func TestBlockIBC(t *testing.T) {
 			packet:              mockPacket(sender.Address, receiverBech32mAddress),
 			expectSuccessfulAck: false,
 		},
+		"allowlisted sender and receiver": {
+			toAllowlist:         []sample.Account{sender, receiver},
+			packet:              mockPacket(sender.Address, receiverAddress),
+			expectSuccessfulAck: true,
+		},
+		"receiver not allowlisted": {
+			toAllowlist:         []sample.Account{sender},
+			packet:              mockPacket(sender.Address, receiverAddress),
+			expectSuccessfulAck: false,
+		},
+		"sender not allowlisted": {
+			toAllowlist:         []sample.Account{receiver},
+			packet:              mockPacket(sender.Address, receiverAddress),
+			expectSuccessfulAck: false,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -113,12 +185,25 @@ func TestBlockIBC(t *testing.T) {
 			middleware, ftf, ctx := keeper.BlockIBC()
 
 			// ACT: Set paused and blacklisted state based on test case.
-			ftf.SetPaused(ctx, fiattokenfactorytypes.Paused{Paused: tc.setPaused})
+			ftf.SetPaused(ctx, fiattokenfactorytypes.Paused{Denom: "uusdc", Paused: tc.setPaused, Scopes: tc.pausedScopes})
 			if tc.toBlacklist != nil {
 				ftf.SetBlacklisted(ctx, fiattokenfactorytypes.Blacklisted{
 					AddressBz: tc.toBlacklist.AddressBz,
+					Denom:     "uusdc",
 				})
 			}
+			if tc.channelPolicy != nil {
+				ftf.SetChannelPolicy(ctx, *tc.channelPolicy)
+			}
+			if tc.toAllowlist != nil {
+				ftf.SetAllowlistEnabled(ctx, "uusdc", true)
+				for _, account := range tc.toAllowlist {
+					ftf.SetAllowlisted(ctx, fiattokenfactorytypes.Allowlisted{
+						AddressBz: account.AddressBz,
+						Denom:     "uusdc",
+					})
+				}
+			}
 
 			// ACT: Receive transfer packet in middleware.
 			ack := middleware.OnRecvPacket(ctx, tc.packet, nil)
@@ -136,9 +221,13 @@ func TestBlockIBC(t *testing.T) {
 }
 
 func mockPacket(sender, receiver string) channeltypes.Packet {
+	return mockPacketWithMemo(sender, receiver, "")
+}
+
+func mockPacketWithMemo(sender, receiver, memo string) channeltypes.Packet {
 	return channeltypes.NewPacket(
 		transfertypes.NewFungibleTokenPacketData(
-			"uusdc", "1000000", sender, receiver, "",
+			"uusdc", "1000000", sender, receiver, memo,
 		).GetBytes(),
 		1,
 		transfertypes.PortID,
@@ -152,3 +241,373 @@ func mockPacket(sender, receiver string) channeltypes.Packet {
 		1234,
 	)
 }
+
+func TestSendPacket(t *testing.T) {
+	// ARRANGE: Mock sender and receiver.
+	sender, receiver := sample.TestAccount(), sample.TestAccount()
+	receiverAddress, _ := codec.NewBech32Codec("osmo").BytesToString(receiver.AddressBz)
+
+	// ARRANGE: Organize table driven test cases.
+	testCases := map[string]struct {
+		toBlacklist   *sample.Account
+		pausedScopes  uint32
+		channelPolicy *fiattokenfactorytypes.ChannelPolicy
+		data          []byte
+		expectedErr   error
+	}{
+		"channel allowlisted for outbound transfers": {
+			channelPolicy: &fiattokenfactorytypes.ChannelPolicy{Denom: "uusdc", ChannelId: "channel-0", AllowOutbound: true},
+			data:          mockPacket(sender.Address, receiverAddress).Data,
+		},
+		"channel allowlisted for inbound transfers only": {
+			channelPolicy: &fiattokenfactorytypes.ChannelPolicy{Denom: "uusdc", ChannelId: "channel-0", AllowInbound: true},
+			data:          mockPacket(sender.Address, receiverAddress).Data,
+			expectedErr:   fiattokenfactorytypes.ErrChannelNotAllowed,
+		},
+		"channel not allowlisted": {
+			channelPolicy: &fiattokenfactorytypes.ChannelPolicy{Denom: "uusdc", ChannelId: "channel-1", AllowOutbound: true},
+			data:          mockPacket(sender.Address, receiverAddress).Data,
+			expectedErr:   fiattokenfactorytypes.ErrChannelNotAllowed,
+		},
+		"happy path": {
+			data: mockPacket(sender.Address, receiverAddress).Data,
+		},
+		"non ICS-20 packet data": {
+			pausedScopes: fiattokenfactorytypes.PauseScopesAll,
+			data:         []byte("non ICS-20 packet data"),
+		},
+		"non minting denom": {
+			pausedScopes: fiattokenfactorytypes.PauseScopesAll,
+			data:         transfertypes.NewFungibleTokenPacketData("uatom", "1000000", sender.Address, receiverAddress, "").GetBytes(),
+		},
+		"outbound transfers paused": {
+			pausedScopes: fiattokenfactorytypes.PauseScope_PAUSE_SCOPE_IBC_OUT.Bit(),
+			data:         mockPacket(sender.Address, receiverAddress).Data,
+			expectedErr:  fiattokenfactorytypes.ErrPaused,
+		},
+		"inbound transfers paused": {
+			pausedScopes: fiattokenfactorytypes.PauseScope_PAUSE_SCOPE_IBC_IN.Bit(),
+			data:         mockPacket(sender.Address, receiverAddress).Data,
+		},
+		"blacklisted sender": {
+			toBlacklist: &sender,
+			data:        mockPacket(sender.Address, receiverAddress).Data,
+			expectedErr: fiattokenfactorytypes.ErrUnauthorized,
+		},
+		"blacklisted receiver": {
+			toBlacklist: &receiver,
+			data:        mockPacket(sender.Address, receiverAddress).Data,
+			expectedErr: fiattokenfactorytypes.ErrUnauthorized,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// ARRANGE: Mock middleware stack.
+			middleware, ftf, ctx := keeper.BlockIBC()
+
+			// ACT: Set paused and blacklisted state based on test case.
+			ftf.SetPaused(ctx, fiattokenfactorytypes.Paused{Denom: "uusdc", Scopes: tc.pausedScopes})
+			if tc.toBlacklist != nil {
+				ftf.SetBlacklisted(ctx, fiattokenfactorytypes.Blacklisted{
+					AddressBz: tc.toBlacklist.AddressBz,
+					Denom:     "uusdc",
+				})
+			}
+
+			if tc.channelPolicy != nil {
+				ftf.SetChannelPolicy(ctx, *tc.channelPolicy)
+			}
+
+			// ACT: Send transfer packet through middleware.
+			_, err := middleware.SendPacket(ctx, nil, transfertypes.PortID, "channel-0", clienttypes.ZeroHeight(), 1234, tc.data)
+
+			// ASSERT: Assert the error based on the test case.
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRefundEscrow(t *testing.T) {
+	// ARRANGE: Mock sender and receiver.
+	sender, receiver := sample.TestAccount(), sample.TestAccount()
+	receiverAddress, _ := codec.NewBech32Codec("osmo").BytesToString(receiver.AddressBz)
+	errorAck := channeltypes.NewErrorAcknowledgement(fiattokenfactorytypes.ErrUnauthorized).Acknowledgement()
+	successAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+
+	// ARRANGE: Organize table driven test cases.
+	testCases := map[string]struct {
+		blacklistSender bool
+		timeout         bool
+		ack             []byte
+		expectEscrow    bool
+	}{
+		"timeout": {
+			timeout: true,
+		},
+		"timeout with blacklisted sender": {
+			blacklistSender: true,
+			timeout:         true,
+			expectEscrow:    true,
+		},
+		"error acknowledgement": {
+			ack: errorAck,
+		},
+		"error acknowledgement with blacklisted sender": {
+			blacklistSender: true,
+			ack:             errorAck,
+			expectEscrow:    true,
+		},
+		"successful acknowledgement with blacklisted sender": {
+			blacklistSender: true,
+			ack:             successAck,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// ARRANGE: Mock middleware stack.
+			middleware, ftf, ctx := keeper.BlockIBC()
+			if tc.blacklistSender {
+				ftf.SetBlacklisted(ctx, fiattokenfactorytypes.Blacklisted{
+					AddressBz: sender.AddressBz,
+					Denom:     "uusdc",
+				})
+			}
+
+			// ACT: Process the refund of a transfer packet in middleware.
+			packet := mockPacket(sender.Address, receiverAddress)
+			var err error
+			if tc.timeout {
+				err = middleware.OnTimeoutPacket(ctx, packet, nil)
+			} else {
+				err = middleware.OnAcknowledgementPacket(ctx, packet, tc.ack, nil)
+			}
+			require.NoError(t, err)
+
+			// ASSERT: Assert whether the refund was moved into escrow.
+			escrowed := false
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == "circle.fiattokenfactory.v1.EventRefundEscrowed" {
+					escrowed = true
+				}
+			}
+			require.Equal(t, tc.expectEscrow, escrowed)
+		})
+	}
+}
+
+func TestRemovedChannelPolicy(t *testing.T) {
+	// ARRANGE: Mock sender and receiver.
+	sender, receiver := sample.TestAccount(), sample.TestAccount()
+	receiverAddress, _ := codec.NewBech32Codec("osmo").BytesToString(receiver.AddressBz)
+
+	// ARRANGE: Mock middleware stack with only channel-1 allowlisted.
+	middleware, ftf, ctx := keeper.BlockIBC()
+	ftf.SetChannelPolicy(ctx, fiattokenfactorytypes.ChannelPolicy{Denom: "uusdc", ChannelId: "channel-1", AllowInbound: true, AllowOutbound: true})
+	packet := mockPacket(sender.Address, receiverAddress)
+
+	_, err := middleware.SendPacket(ctx, nil, transfertypes.PortID, "channel-0", clienttypes.ZeroHeight(), 1234, packet.Data)
+	require.ErrorIs(t, err, fiattokenfactorytypes.ErrChannelNotAllowed)
+	require.False(t, middleware.OnRecvPacket(ctx, packet, nil).Success())
+
+	// ACT: Remove the only channel policy of the denom.
+	ftf.RemoveChannelPolicy(ctx, "uusdc", "channel-1")
+
+	// ASSERT: Transfers through any channel are allowed again.
+	_, err = middleware.SendPacket(ctx, nil, transfertypes.PortID, "channel-0", clienttypes.ZeroHeight(), 1234, packet.Data)
+	require.NoError(t, err)
+	require.True(t, middleware.OnRecvPacket(ctx, packet, nil).Success())
+}
+
+func TestChannelRateLimit(t *testing.T) {
+	// ARRANGE: Mock sender and receiver.
+	sender, receiver := sample.TestAccount(), sample.TestAccount()
+	receiverAddress, _ := codec.NewBech32Codec("osmo").BytesToString(receiver.AddressBz)
+	errorAck := channeltypes.NewErrorAcknowledgement(fiattokenfactorytypes.ErrUnauthorized).Acknowledgement()
+	successAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+
+	// ARRANGE: Mock middleware stack with the outflow of channel-0 capped at a single transfer.
+	middleware, ftf, ctx := keeper.BlockIBC()
+	ftf.SetChannelRateLimit(ctx, fiattokenfactorytypes.NewChannelRateLimit(
+		"uusdc", "channel-0", math.NewInt(1000000), math.NewInt(1000000), 100, 0))
+	packet := mockPacket(sender.Address, receiverAddress)
+	send := func() error {
+		sequence, err := middleware.SendPacket(ctx, nil, transfertypes.PortID, "channel-0", clienttypes.ZeroHeight(), 1234, packet.Data)
+		if err == nil {
+			packet.Sequence = sequence
+		}
+		return err
+	}
+
+	// ACT: Exhaust the outflow of the window.
+	require.NoError(t, send())
+	require.ErrorIs(t, send(), fiattokenfactorytypes.ErrRateLimitExceeded)
+
+	// ACT: The transfer times out, releasing its outflow.
+	require.NoError(t, middleware.OnTimeoutPacket(ctx, packet, nil))
+	require.NoError(t, send())
+
+	// ACT: The transfer fails with an error acknowledgement, releasing its outflow.
+	require.NoError(t, middleware.OnAcknowledgementPacket(ctx, packet, errorAck, nil))
+	require.NoError(t, send())
+
+	// ACT: The transfer succeeds, keeping its outflow.
+	require.NoError(t, middleware.OnAcknowledgementPacket(ctx, packet, successAck, nil))
+	require.ErrorIs(t, send(), fiattokenfactorytypes.ErrRateLimitExceeded)
+	require.Empty(t, ftf.GetAllPendingSendPackets(ctx))
+
+	// ACT: Receive transfers, offsetting the outflow before the inflow is capped.
+	require.True(t, middleware.OnRecvPacket(ctx, packet, nil).Success())
+	require.True(t, middleware.OnRecvPacket(ctx, packet, nil).Success())
+	require.False(t, middleware.OnRecvPacket(ctx, packet, nil).Success())
+
+	// ASSERT: Other channels are not rate limited.
+	packet.DestinationChannel = "channel-1"
+	require.True(t, middleware.OnRecvPacket(ctx, packet, nil).Success())
+}
+
+func TestUnparsableAcknowledgement(t *testing.T) {
+	// ARRANGE: Mock sender and receiver.
+	sender, receiver := sample.TestAccount(), sample.TestAccount()
+	receiverAddress, _ := codec.NewBech32Codec("osmo").BytesToString(receiver.AddressBz)
+
+	// ARRANGE: Mock middleware stack with a rate limited channel-0, and send a transfer through it.
+	middleware, ftf, ctx := keeper.BlockIBC()
+	ftf.SetChannelRateLimit(ctx, fiattokenfactorytypes.NewChannelRateLimit(
+		"uusdc", "channel-0", math.NewInt(1000000), math.NewInt(1000000), 100, 0))
+	packet := mockPacket(sender.Address, receiverAddress)
+	sequence, err := middleware.SendPacket(ctx, nil, transfertypes.PortID, "channel-0", clienttypes.ZeroHeight(), 1234, packet.Data)
+	require.NoError(t, err)
+	packet.Sequence = sequence
+
+	// ACT: Acknowledge the transfer with an unparsable acknowledgement.
+	err = middleware.OnAcknowledgementPacket(ctx, packet, []byte("invalid"), nil)
+
+	// ASSERT: The acknowledgement is rejected and the transfer is still pending.
+	require.ErrorIs(t, err, ibcerrors.ErrUnknownRequest)
+	require.Len(t, ftf.GetAllPendingSendPackets(ctx), 1)
+}
+
+func TestMemoRecipients(t *testing.T) {
+	// ARRANGE: Mock sender, intermediate receiver and a blacklisted eventual recipient.
+	sender, receiver, blacklisted := sample.TestAccount(), sample.TestAccount(), sample.TestAccount()
+	hop, _ := codec.NewBech32Codec("osmo").BytesToString(sample.TestAccount().AddressBz)
+	recipient, _ := codec.NewBech32Codec("osmo").BytesToString(blacklisted.AddressBz)
+
+	// ARRANGE: Organize table driven test cases.
+	testCases := map[string]struct {
+		memo                string
+		paths               []string
+		expectSuccessfulAck bool
+	}{
+		"no memo": {
+			memo:                "",
+			expectSuccessfulAck: true,
+		},
+		"memo is not json": {
+			memo:                "hello " + recipient,
+			expectSuccessfulAck: true,
+		},
+		"forward to clean receiver": {
+			memo:                `{"forward":{"receiver":"` + hop + `","port":"transfer","channel":"channel-1"}}`,
+			expectSuccessfulAck: true,
+		},
+		"forward to blacklisted receiver": {
+			memo:                `{"forward":{"receiver":"` + recipient + `","port":"transfer","channel":"channel-1"}}`,
+			expectSuccessfulAck: false,
+		},
+		"forward to non bech32 receiver": {
+			memo:                `{"forward":{"receiver":"0x0000000000000000000000000000000000000000","port":"transfer","channel":"channel-1"}}`,
+			expectSuccessfulAck: true,
+		},
+		"wasm hook to undecodable recipient": {
+			memo:                `{"wasm":{"contract":"` + hop + `","msg":{"recipient":"not an address"}}}`,
+			paths:               []string{"wasm.msg.recipient"},
+			expectSuccessfulAck: false,
+		},
+		"forwards nested up to the depth limit": {
+			memo:                nestedForwards(hop, 16),
+			expectSuccessfulAck: true,
+		},
+		"forwards nested beyond the depth limit": {
+			memo:                nestedForwards(hop, 17),
+			expectSuccessfulAck: false,
+		},
+		"nested forward to blacklisted receiver": {
+			memo:                `{"forward":{"receiver":"` + hop + `","port":"transfer","channel":"channel-1","next":{"forward":{"receiver":"` + recipient + `","port":"transfer","channel":"channel-2"}}}}`,
+			expectSuccessfulAck: false,
+		},
+		"string encoded nested forwards to blacklisted receiver": {
+			memo: `{"forward":{"receiver":"` + hop + `","port":"transfer","channel":"channel-1","next":` +
+				strconv.Quote(`{"forward":{"receiver":"`+hop+`","port":"transfer","channel":"channel-2","next":`+
+					strconv.Quote(`{"forward":{"receiver":"`+recipient+`","port":"transfer","channel":"channel-3"}}`)+`}}`) + `}}`,
+			expectSuccessfulAck: false,
+		},
+		"nested forwards to clean receivers": {
+			memo:                `{"forward":{"receiver":"` + hop + `","port":"transfer","channel":"channel-1","next":{"forward":{"receiver":"` + hop + `","port":"transfer","channel":"channel-2"}}}}`,
+			expectSuccessfulAck: true,
+		},
+		"wasm hook to blacklisted recipient without configured path": {
+			memo:                `{"wasm":{"contract":"` + hop + `","msg":{"recipient":"` + recipient + `"}}}`,
+			expectSuccessfulAck: true,
+		},
+		"wasm hook to blacklisted recipient": {
+			memo:                `{"wasm":{"contract":"` + hop + `","msg":{"recipient":"` + recipient + `"}}}`,
+			paths:               []string{"wasm.msg.recipient"},
+			expectSuccessfulAck: false,
+		},
+		"wasm hook after forward to blacklisted recipient": {
+			memo:                `{"forward":{"receiver":"` + hop + `","port":"transfer","channel":"channel-1","next":{"wasm":{"contract":"` + hop + `","msg":{"recipient":"` + recipient + `"}}}}}`,
+			paths:               []string{"wasm.msg.recipient"},
+			expectSuccessfulAck: false,
+		},
+		"configured path holding an object": {
+			memo:                `{"wasm":{"msg":{"recipient":{"address":"` + recipient + `"}}}}`,
+			paths:               []string{"wasm.msg.recipient"},
+			expectSuccessfulAck: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// ARRANGE: Mock middleware stack.
+			middleware, ftf, ctx := keeper.BlockIBC()
+			ftf.SetBlacklisted(ctx, fiattokenfactorytypes.Blacklisted{
+				AddressBz: blacklisted.AddressBz,
+				Denom:     "uusdc",
+			})
+			params := fiattokenfactorytypes.DefaultParams()
+			params.MemoRecipientPaths = tc.paths
+			ftf.SetParams(ctx, params)
+
+			// ACT: Receive transfer packet in middleware.
+			ack := middleware.OnRecvPacket(ctx, mockPacketWithMemo(sender.Address, receiver.Address, tc.memo), nil)
+
+			// ASSERT: Assert the acknowledgment's success based on the test case.
+			require.Equal(t, tc.expectSuccessfulAck, ack.Success())
+		})
+	}
+}
+
+// nestedForwards returns a memo of the given number of nested forwards to the receiver.
+func nestedForwards(receiver string, count int) string {
+	memo := `{"forward":{"receiver":"` + receiver + `","port":"transfer","channel":"channel-1"}}`
+	for i := 1; i < count; i++ {
+		memo = `{"forward":{"receiver":"` + receiver + `","port":"transfer","channel":"channel-1","next":` + memo + `}}`
+	}
+	return memo
+}
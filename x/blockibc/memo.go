@@ -0,0 +1,94 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blockibc
+
+import (
+	"encoding/json"
+	"strings"
+
+	"cosmossdk.io/errors"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+// maxMemoDepth bounds the number of nested forwards followed in a memo. Memos nesting more forwards
+// are rejected, as their eventual recipients cannot be checked.
+const maxMemoDepth = 16
+
+// memoRecipients returns the eventual recipients named in an ICS-20 memo. These are the receivers
+// of packet forward middleware forwards, including those of nested multi-hop forwards, and the
+// values found at the given dot separated paths of every forward. Memos that are not JSON objects
+// name no recipients. An error is returned when the memo nests more than maxMemoDepth forwards.
+func memoRecipients(memo string, paths []string) ([]string, error) {
+	var recipients []string
+
+	obj := parseMemo(memo)
+	for depth := 0; obj != nil; depth++ {
+		if depth == maxMemoDepth {
+			return nil, errors.Wrapf(types.ErrInvalidType, "memo nests more than %d forwards", maxMemoDepth)
+		}
+
+		for _, path := range paths {
+			if recipient, ok := lookupPath(obj, path); ok {
+				recipients = append(recipients, recipient)
+			}
+		}
+
+		forward, ok := obj["forward"].(map[string]any)
+		if !ok {
+			break
+		}
+		if receiver, ok := forward["receiver"].(string); ok {
+			recipients = append(recipients, receiver)
+		}
+
+		// the next memo of a forward is either embedded or encoded as a string
+		switch next := forward["next"].(type) {
+		case map[string]any:
+			obj = next
+		case string:
+			obj = parseMemo(next)
+		default:
+			obj = nil
+		}
+	}
+
+	return recipients, nil
+}
+
+// parseMemo decodes a memo holding a JSON object, returning nil otherwise.
+func parseMemo(memo string) map[string]any {
+	var obj map[string]any
+	if err := json.Unmarshal([]byte(memo), &obj); err != nil {
+		return nil
+	}
+	return obj
+}
+
+// lookupPath returns the string found at a dot separated path of a JSON object.
+func lookupPath(obj map[string]any, path string) (string, bool) {
+	keys := strings.Split(path, ".")
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := obj[key].(map[string]any)
+		if !ok {
+			return "", false
+		}
+		obj = next
+	}
+
+	value, ok := obj[keys[len(keys)-1]].(string)
+	return value, ok
+}
@@ -74,12 +74,17 @@ func (ad IsPausedDecorator) CheckMessages(ctx sdk.Context, msgs []sdk.Msg) error
 
 			if grant, ok := authorization.(*banktypes.SendAuthorization); ok {
 				for _, coin := range grant.SpendLimit {
-					paused, err := checkPausedStatebyTokenFactory(ctx, coin, ad.fiatTokenFactory)
+					paused, err := checkPausedStatebyTokenFactory(ctx, coin, types.PauseScope_PAUSE_SCOPE_AUTHZ, ad.fiatTokenFactory)
 					if paused {
 						return sdkerrors.Wrapf(err, "can not perform token authorizations")
 					}
 				}
 			}
+		case *transfertypes.MsgTransfer:
+			paused, err := checkPausedStatebyTokenFactory(ctx, m.Token, types.PauseScope_PAUSE_SCOPE_IBC_OUT, ad.fiatTokenFactory)
+			if paused {
+				return sdkerrors.Wrapf(err, "can not perform ibc transfers")
+			}
 		default:
 			continue
 		}
@@ -88,11 +93,10 @@ func (ad IsPausedDecorator) CheckMessages(ctx sdk.Context, msgs []sdk.Msg) error
 	return nil
 }
 
-func checkPausedStatebyTokenFactory(ctx sdk.Context, c sdk.Coin, ctf *fiattokenfactorykeeper.Keeper) (bool, *sdkerrors.Error) {
-	ctfMintingDenom := ctf.GetMintingDenom(ctx)
-	if c.Denom == ctfMintingDenom.Denom {
-		paused := ctf.GetPaused(ctx)
-		if paused.Paused {
+func checkPausedStatebyTokenFactory(ctx sdk.Context, c sdk.Coin, scope types.PauseScope, ctf *fiattokenfactorykeeper.Keeper) (bool, *sdkerrors.Error) {
+	if _, found := ctf.GetMintingDenom(ctx, c.Denom); found {
+		paused := ctf.GetPaused(ctx, c.Denom)
+		if paused.IsPaused(scope) {
 			return true, fiattokenfactorytypes.ErrPaused
 		}
 	}
@@ -162,15 +166,14 @@ func (ad IsBlacklistedDecorator) CheckMessages(ctx sdk.Context, msgs []sdk.Msg,
 }
 
 // checkForBlacklistedAddressByTokenFactory first checks if the denom being transacted is a mintable asset from a TokenFactory,
-// if it is, it checks if the address involved in the tx is blacklisted by that specific TokenFactory.
+// if it is, it checks if the address involved in the tx is blacklisted for that specific denom.
 func checkForBlacklistedAddressByTokenFactory(ctx sdk.Context, address string, c sdk.Coin, ctf *fiattokenfactorykeeper.Keeper) error {
-	ctfMintingDenom := ctf.GetMintingDenom(ctx)
-	if c.Denom == ctfMintingDenom.Denom {
-		_, addressBz, err := fiattokenfactorykeeper.DecodeNoLimitToBase256(address)
+	if _, found := ctf.GetMintingDenom(ctx, c.Denom); found {
+		addressBz, err := ctf.DecodeAddress(address)
 		if err != nil {
 			return err
 		}
-		_, found := ctf.GetBlacklisted(ctx, addressBz)
+		_, found := ctf.GetBlacklisted(ctx, c.Denom, addressBz)
 		if found {
 			return fiattokenfactorytypes.ErrUnauthorized
 		}
@@ -17,6 +17,7 @@
 package fiattokenfactory_test
 
 import (
+	"encoding/hex"
 	"testing"
 	"time"
 
@@ -37,6 +38,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -51,6 +53,7 @@ func TestAnteHandlerIsPaused(t *testing.T) {
 	// ARRANGE: Arrange table driven test cases
 	testCases := map[string]struct {
 		expectedFailOnPause bool
+		scope               types.PauseScope
 		message             sdk.Msg
 	}{
 		"no message": {
@@ -60,8 +63,14 @@ func TestAnteHandlerIsPaused(t *testing.T) {
 			expectedFailOnPause: false,
 			message:             &testdata.MsgCreateDog{},
 		},
+		"msgTransfer": {
+			expectedFailOnPause: true,
+			scope:               types.PauseScope_PAUSE_SCOPE_IBC_OUT,
+			message:             transfertypes.NewMsgTransfer("transfer", "channel-0", uusdcCoin, testAccount1.Address, testAccount2.Address, clienttypes.ZeroHeight(), 1, ""),
+		},
 		"msgGrant": {
 			expectedFailOnPause: true,
+			scope:               types.PauseScope_PAUSE_SCOPE_AUTHZ,
 			message: func() sdk.Msg {
 				mockTime := time.Date(1, 1, 1, 1, 1, 1, 1, time.UTC)
 				mockExpires := mockTime.Add(time.Hour)
@@ -85,7 +94,7 @@ func TestAnteHandlerIsPaused(t *testing.T) {
 			// ARRANGE: setup tokenfactory and isPaused decorator
 			ftf, ctx := keeper.FiatTokenfactoryKeeper()
 			ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
-			ftf.SetPaused(ctx, types.Paused{Paused: false})
+			ftf.SetPaused(ctx, types.Paused{Denom: "uusdc", Paused: false})
 			cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
 			ad := fiattokenfactory.NewIsPausedDecorator(cdc, ftf)
 
@@ -104,8 +113,15 @@ func TestAnteHandlerIsPaused(t *testing.T) {
 			// ASSERT: No errors while chain is not paused
 			require.NoError(t, err)
 
+			// ACT: Run transaction through ante handler while other scopes are paused.
+			if tc.scope != types.PauseScope_PAUSE_SCOPE_UNSPECIFIED {
+				ftf.SetPaused(ctx, types.Paused{Denom: "uusdc", Scopes: types.PauseScopesAll &^ tc.scope.Bit()})
+				_, err = ad.AnteHandle(ctx, tx, true, mockNext)
+				require.NoError(t, err)
+			}
+
 			// ARRANGE: Pause tokenfactory
-			ftf.SetPaused(ctx, types.Paused{Paused: true})
+			ftf.SetPaused(ctx, types.Paused{Denom: "uusdc", Paused: true})
 
 			// ACT: Run transaction through ante handler while chain IS paused.
 			_, err = ad.AnteHandle(ctx, tx, true, mockNext)
@@ -129,7 +145,7 @@ func TestAnteHandlerIsBlacklisted(t *testing.T) {
 		// 	- and also, blacklisting the specified address bytes
 		blacklistAddressBz []byte
 		// set testInvalidAddress to true if testing for an invalid address or an address that
-		// cannot be bech32 decoded.
+		// cannot be decoded.
 		testInvalidAddress bool
 		expectedError      error
 	}{
@@ -164,6 +180,15 @@ func TestAnteHandlerIsBlacklisted(t *testing.T) {
 			testInvalidAddress: true,
 			expectedError:      bech32.ErrInvalidCharacter(32),
 		},
+		"msgTransfer blocked hex receiver": {
+			message: &transfertypes.MsgTransfer{
+				Sender:   testAccount1.Address,
+				Receiver: "0x" + hex.EncodeToString(testAccount2.AddressBz),
+				Token:    uusdcCoin,
+			},
+			blacklistAddressBz: testAccount2.AddressBz,
+			expectedError:      types.ErrUnauthorized,
+		},
 		"msgExec MsgTransfer": {
 			message: func() sdk.Msg {
 				msgTransfer := &transfertypes.MsgTransfer{
@@ -190,7 +215,7 @@ func TestAnteHandlerIsBlacklisted(t *testing.T) {
 			// ARRANGE: setup tokenfactory and isBlacklisted decorator
 			ftf, ctx := keeper.FiatTokenfactoryKeeper()
 			ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
-			ftf.SetPaused(ctx, types.Paused{Paused: false})
+			ftf.SetPaused(ctx, types.Paused{Denom: "uusdc", Paused: false})
 			cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
 			ad := fiattokenfactory.NewIsBlacklistedDecorator(ftf)
 
@@ -215,7 +240,7 @@ func TestAnteHandlerIsBlacklisted(t *testing.T) {
 
 			if tc.blacklistAddressBz != nil {
 				// ARRANGE: Blacklist account
-				ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: tc.blacklistAddressBz})
+				ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: tc.blacklistAddressBz, Denom: "uusdc"})
 
 				// ACT: Run transaction through ante handler while account is blacklisted
 				_, err = ad.AnteHandle(ctx, tx, true, mockNext)
@@ -224,7 +249,7 @@ func TestAnteHandlerIsBlacklisted(t *testing.T) {
 				require.ErrorIs(t, err, tc.expectedError)
 
 				// ARRANGE: Un-blacklist account
-				ftf.RemoveBlacklisted(ctx, tc.blacklistAddressBz)
+				ftf.RemoveBlacklisted(ctx, "uusdc", tc.blacklistAddressBz)
 			}
 		})
 	}
@@ -277,7 +302,7 @@ func TestAddGranteeToContextIfPresent(t *testing.T) {
 			// ARRANGE: setup tokenfactory and isBlacklisted decorator
 			ftf, ctx := keeper.FiatTokenfactoryKeeper()
 			ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
-			ftf.SetPaused(ctx, types.Paused{Paused: false})
+			ftf.SetPaused(ctx, types.Paused{Denom: "uusdc", Paused: false})
 			ad := fiattokenfactory.NewIsBlacklistedDecorator(ftf)
 
 			// ACT: Run transaction through ante handler without blacklisting
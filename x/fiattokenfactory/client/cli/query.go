@@ -37,6 +37,7 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdListBlacklisted())
 	cmd.AddCommand(CmdShowBlacklisted())
+	cmd.AddCommand(CmdShowBlacklistedByBytes())
 	cmd.AddCommand(CmdShowPaused())
 	cmd.AddCommand(CmdShowMasterMinter())
 	cmd.AddCommand(CmdListMinters())
@@ -44,9 +45,27 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdShowPauser())
 	cmd.AddCommand(CmdShowBlacklister())
 	cmd.AddCommand(CmdShowOwner())
+	cmd.AddCommand(CmdShowPendingOwner())
+	cmd.AddCommand(CmdListPendingRoles())
 	cmd.AddCommand(CmdListMinterController())
 	cmd.AddCommand(CmdShowMinterController())
+	cmd.AddCommand(CmdListMintingDenom())
 	cmd.AddCommand(CmdShowMintingDenom())
+	cmd.AddCommand(CmdShowParams())
+	cmd.AddCommand(CmdAuditLog())
+	cmd.AddCommand(CmdShowSignerSet())
+	cmd.AddCommand(CmdListActionProposal())
+	cmd.AddCommand(CmdShowActionProposal())
+	cmd.AddCommand(CmdListQueuedAction())
+	cmd.AddCommand(CmdShowQueuedAction())
+	cmd.AddCommand(CmdShowEscrowBalance())
+	cmd.AddCommand(CmdListChannelPolicies())
+	cmd.AddCommand(CmdListChannelRateLimits())
+	cmd.AddCommand(CmdShowAllowlister())
+	cmd.AddCommand(CmdListAllowlisted())
+	cmd.AddCommand(CmdShowAllowlisted())
+	cmd.AddCommand(CmdShowAllowlistMode())
+	cmd.AddCommand(CmdListModuleExemptions())
 
 	return cmd
 }
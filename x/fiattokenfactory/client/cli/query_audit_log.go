@@ -0,0 +1,83 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagDenom     = "denom"
+	FlagRole      = "role"
+	FlagAction    = "action"
+	FlagMinHeight = "min-height"
+	FlagMaxHeight = "max-height"
+)
+
+func CmdAuditLog() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit-log",
+		Short: "list the audit log of privileged role actions",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom, _ := cmd.Flags().GetString(FlagDenom)
+			role, _ := cmd.Flags().GetString(FlagRole)
+			action, _ := cmd.Flags().GetString(FlagAction)
+			minHeight, _ := cmd.Flags().GetInt64(FlagMinHeight)
+			maxHeight, _ := cmd.Flags().GetInt64(FlagMaxHeight)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAuditLogRequest{
+				Pagination: pageReq,
+				Denom:      denom,
+				Role:       role,
+				Action:     action,
+				MinHeight:  minHeight,
+				MaxHeight:  maxHeight,
+			}
+
+			res, err := queryClient.AuditLog(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "only list entries of this minting denom")
+	cmd.Flags().String(FlagRole, "", "only list entries executed by this role")
+	cmd.Flags().String(FlagAction, "", "only list entries of this action")
+	cmd.Flags().Int64(FlagMinHeight, 0, "only list entries at or above this height")
+	cmd.Flags().Int64(FlagMaxHeight, 0, "only list entries at or below this height")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
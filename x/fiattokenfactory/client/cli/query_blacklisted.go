@@ -25,11 +25,16 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const FlagHrp = "hrp"
+
 func CmdListBlacklisted() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "list-blacklisted",
+		Use:   "list-blacklisted [denom]",
 		Short: "list all blacklisted",
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			argDenom := args[0]
+
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
 			pageReq, err := client.ReadPageRequest(cmd.Flags())
@@ -37,10 +42,14 @@ func CmdListBlacklisted() *cobra.Command {
 				return err
 			}
 
+			hrps, _ := cmd.Flags().GetStringSlice(FlagHrp)
+
 			queryClient := types.NewQueryClient(clientCtx)
 
 			params := &types.QueryAllBlacklistedRequest{
 				Pagination: pageReq,
+				Denom:      argDenom,
+				Hrps:       hrps,
 			}
 
 			res, err := queryClient.BlacklistedAll(context.Background(), params)
@@ -52,6 +61,7 @@ func CmdListBlacklisted() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().StringSlice(FlagHrp, nil, "human readable parts to render the addresses with, such as cosmos,osmo")
 	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
 	flags.AddQueryFlagsToCmd(cmd)
 
@@ -60,18 +70,23 @@ func CmdListBlacklisted() *cobra.Command {
 
 func CmdShowBlacklisted() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "show-blacklisted [address]",
+		Use:   "show-blacklisted [denom] [address]",
 		Short: "shows a blacklisted",
-		Args:  cobra.ExactArgs(1),
+		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			argAddress := args[0]
+			argDenom := args[0]
+			argAddress := args[1]
+
+			hrps, _ := cmd.Flags().GetStringSlice(FlagHrp)
 
 			params := &types.QueryGetBlacklistedRequest{
 				Address: argAddress,
+				Denom:   argDenom,
+				Hrps:    hrps,
 			}
 
 			res, err := queryClient.Blacklisted(context.Background(), params)
@@ -83,6 +98,43 @@ func CmdShowBlacklisted() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().StringSlice(FlagHrp, nil, "human readable parts to render the address with, such as cosmos,osmo")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowBlacklistedByBytes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-blacklisted-by-bytes [denom] [address-bytes]",
+		Short: "shows a blacklisted by its hex or base64 encoded address bytes",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argDenom := args[0]
+			argAddress := args[1]
+
+			hrps, _ := cmd.Flags().GetStringSlice(FlagHrp)
+
+			params := &types.QueryBlacklistedByBytesRequest{
+				Address: argAddress,
+				Denom:   argDenom,
+				Hrps:    hrps,
+			}
+
+			res, err := queryClient.BlacklistedByBytes(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagHrp, nil, "human readable parts to render the address with, such as cosmos,osmo")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -27,15 +27,19 @@ import (
 
 func CmdShowBlacklister() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "show-blacklister",
+		Use:   "show-blacklister [denom]",
 		Short: "shows blacklister",
-		Args:  cobra.NoArgs,
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			argDenom := args[0]
+
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			params := &types.QueryGetBlacklisterRequest{}
+			params := &types.QueryGetBlacklisterRequest{
+				Denom: argDenom,
+			}
 
 			res, err := queryClient.Blacklister(context.Background(), params)
 			if err != nil {
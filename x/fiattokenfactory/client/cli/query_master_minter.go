@@ -27,15 +27,19 @@ import (
 
 func CmdShowMasterMinter() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "show-master-minter",
+		Use:   "show-master-minter [denom]",
 		Short: "shows master-minter",
-		Args:  cobra.NoArgs,
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			argDenom := args[0]
+
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			params := &types.QueryGetMasterMinterRequest{}
+			params := &types.QueryGetMasterMinterRequest{
+				Denom: argDenom,
+			}
 
 			res, err := queryClient.MasterMinter(context.Background(), params)
 			if err != nil {
@@ -28,9 +28,12 @@ import (
 
 func CmdListMinterController() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "list-minter-controller",
+		Use:   "list-minter-controller [denom]",
 		Short: "list all minter-controller",
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			argDenom := args[0]
+
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
 			pageReq, err := client.ReadPageRequest(cmd.Flags())
@@ -42,6 +45,7 @@ func CmdListMinterController() *cobra.Command {
 
 			params := &types.QueryAllMinterControllerRequest{
 				Pagination: pageReq,
+				Denom:      argDenom,
 			}
 
 			res, err := queryClient.MinterControllerAll(context.Background(), params)
@@ -61,18 +65,20 @@ func CmdListMinterController() *cobra.Command {
 
 func CmdShowMinterController() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "show-minter-controller [minter-address]",
+		Use:   "show-minter-controller [denom] [minter-address]",
 		Short: "shows a minter-controller",
-		Args:  cobra.ExactArgs(1),
+		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			argControllerAddress := args[0]
+			argDenom := args[0]
+			argControllerAddress := args[1]
 
 			params := &types.QueryGetMinterControllerRequest{
 				ControllerAddress: argControllerAddress,
+				Denom:             argDenom,
 			}
 
 			res, err := queryClient.MinterController(context.Background(), params)
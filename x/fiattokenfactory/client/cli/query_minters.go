@@ -27,9 +27,12 @@ import (
 
 func CmdListMinters() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "list-minters",
+		Use:   "list-minters [denom]",
 		Short: "list all minters",
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			argDenom := args[0]
+
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
 			pageReq, err := client.ReadPageRequest(cmd.Flags())
@@ -41,6 +44,7 @@ func CmdListMinters() *cobra.Command {
 
 			params := &types.QueryAllMintersRequest{
 				Pagination: pageReq,
+				Denom:      argDenom,
 			}
 
 			res, err := queryClient.MintersAll(context.Background(), params)
@@ -60,18 +64,20 @@ func CmdListMinters() *cobra.Command {
 
 func CmdShowMinters() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "show-minters [address]",
+		Use:   "show-minters [denom] [address]",
 		Short: "shows a minters",
-		Args:  cobra.ExactArgs(1),
+		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			argAddress := args[0]
+			argDenom := args[0]
+			argAddress := args[1]
 
 			params := &types.QueryGetMintersRequest{
 				Address: argAddress,
+				Denom:   argDenom,
 			}
 
 			res, err := queryClient.Minters(context.Background(), params)
@@ -27,15 +27,19 @@ import (
 
 func CmdShowPauser() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "show-pauser",
+		Use:   "show-pauser [denom]",
 		Short: "shows pauser",
-		Args:  cobra.NoArgs,
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			argDenom := args[0]
+
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			params := &types.QueryGetPauserRequest{}
+			params := &types.QueryGetPauserRequest{
+				Denom: argDenom,
+			}
 
 			res, err := queryClient.Pauser(context.Background(), params)
 			if err != nil {
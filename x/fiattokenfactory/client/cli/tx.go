@@ -39,16 +39,34 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdUpdateBlacklister())
 	cmd.AddCommand(CmdUpdateOwner())
 	cmd.AddCommand(CmdAcceptOwner())
+	cmd.AddCommand(CmdCancelOwnershipTransfer())
+	cmd.AddCommand(CmdAcceptRole())
 	cmd.AddCommand(CmdConfigureMinter())
 	cmd.AddCommand(CmdRemoveMinter())
 	cmd.AddCommand(CmdMint())
 	cmd.AddCommand(CmdBurn())
 	cmd.AddCommand(CmdBlacklist())
 	cmd.AddCommand(CmdUnblacklist())
+	cmd.AddCommand(CmdBlacklistBatch())
+	cmd.AddCommand(CmdUnblacklistBatch())
+	cmd.AddCommand(CmdSeizeBlacklistedFunds())
 	cmd.AddCommand(CmdPause())
 	cmd.AddCommand(CmdUnpause())
 	cmd.AddCommand(CmdConfigureMinterController())
 	cmd.AddCommand(CmdRemoveMinterController())
+	cmd.AddCommand(CmdConfigureMinterRateLimit())
+	cmd.AddCommand(CmdSetSignerSet())
+	cmd.AddCommand(CmdApproveAction())
+	cmd.AddCommand(CmdCancelQueuedAction())
+	cmd.AddCommand(CmdSetChannelPolicy())
+	cmd.AddCommand(CmdRemoveChannelPolicy())
+	cmd.AddCommand(CmdSetChannelRateLimit())
+	cmd.AddCommand(CmdUpdateAllowlister())
+	cmd.AddCommand(CmdAllowlist())
+	cmd.AddCommand(CmdUnallowlist())
+	cmd.AddCommand(CmdAllowlistBatch())
+	cmd.AddCommand(CmdUnallowlistBatch())
+	cmd.AddCommand(CmdSetAllowlistMode())
 
 	return cmd
 }
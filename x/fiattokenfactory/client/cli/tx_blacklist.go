@@ -18,6 +18,7 @@ package cli
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -28,29 +29,83 @@ import (
 
 var _ = strconv.Itoa(0)
 
+const (
+	FlagReason       = "reason"
+	FlagReferenceId  = "reference-id"
+	FlagExpiryHeight = "expiry-height"
+	FlagExpiryTime   = "expiry-time"
+)
+
 func CmdBlacklist() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "blacklist [address]",
+		Use:   "blacklist [denom] [address]",
 		Short: "Broadcast message blacklist",
-		Args:  cobra.ExactArgs(1),
+		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
-			argAddress := args[0]
+			argDenom := args[0]
+			argAddress := args[1]
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
+			reason, _ := cmd.Flags().GetString(FlagReason)
+			referenceId, _ := cmd.Flags().GetString(FlagReferenceId)
+
+			expiryHeight, expiryTime, err := getBlacklistExpiryFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgBlacklist{
-				From:    clientCtx.GetFromAddress().String(),
-				Address: argAddress,
+				From:         clientCtx.GetFromAddress().String(),
+				Address:      argAddress,
+				Denom:        argDenom,
+				Reason:       reason,
+				ReferenceId:  referenceId,
+				ExpiryHeight: expiryHeight,
+				ExpiryTime:   expiryTime,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	addBlacklistMetadataFlags(cmd)
+	addBlacklistExpiryFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// addBlacklistMetadataFlags adds the flags setting the metadata of blacklist
+// entries.
+func addBlacklistMetadataFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagReason, "", "reason code of the blacklisting")
+	cmd.Flags().String(FlagReferenceId, "", "reference of the case the address is blacklisted for")
+}
+
+// addBlacklistExpiryFlags adds the flags setting the expiry of blacklist
+// entries.
+func addBlacklistExpiryFlags(cmd *cobra.Command) {
+	cmd.Flags().Int64(FlagExpiryHeight, 0, "block height at which the blacklisting is lifted")
+	cmd.Flags().String(FlagExpiryTime, "", "block time (RFC 3339) at which the blacklisting is lifted")
+}
+
+// getBlacklistExpiryFlags returns the expiry height and expiry time of
+// blacklist entries set by the flags.
+func getBlacklistExpiryFlags(cmd *cobra.Command) (int64, *time.Time, error) {
+	expiryHeight, _ := cmd.Flags().GetInt64(FlagExpiryHeight)
+
+	var expiryTime *time.Time
+	if value, _ := cmd.Flags().GetString(FlagExpiryTime); value != "" {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return 0, nil, err
+		}
+		expiryTime = &parsed
+	}
+
+	return expiryHeight, expiryTime, nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bufio"
+	"os"
+	"strings"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+func CmdBlacklistBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blacklist-batch [denom] [file]",
+		Short: "Broadcast message blacklist-batch",
+		Long:  "Blacklist the addresses listed in a file, one per line. Empty lines and lines starting with # are ignored.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argDenom := args[0]
+			argAddresses, err := readAddressesFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			reason, _ := cmd.Flags().GetString(FlagReason)
+			referenceId, _ := cmd.Flags().GetString(FlagReferenceId)
+
+			expiryHeight, expiryTime, err := getBlacklistExpiryFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgBlacklistBatch{
+				From:         clientCtx.GetFromAddress().String(),
+				Addresses:    argAddresses,
+				Denom:        argDenom,
+				Reason:       reason,
+				ReferenceId:  referenceId,
+				ExpiryHeight: expiryHeight,
+				ExpiryTime:   expiryTime,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addBlacklistMetadataFlags(cmd)
+	addBlacklistExpiryFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUnblacklistBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unblacklist-batch [denom] [file]",
+		Short: "Broadcast message unblacklist-batch",
+		Long:  "Unblacklist the addresses listed in a file, one per line. Empty lines and lines starting with # are ignored.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argDenom := args[0]
+			argAddresses, err := readAddressesFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUnblacklistBatch{
+				From:      clientCtx.GetFromAddress().String(),
+				Addresses: argAddresses,
+				Denom:     argDenom,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readAddressesFile reads a list of addresses from a file, one per line.
+func readAddressesFile(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var addresses []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		addresses = append(addresses, line)
+	}
+
+	return addresses, scanner.Err()
+}
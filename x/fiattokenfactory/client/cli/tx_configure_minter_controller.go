@@ -30,12 +30,13 @@ var _ = strconv.Itoa(0)
 
 func CmdConfigureMinterController() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "configure-minter-controller [controller] [minter]",
+		Use:   "configure-minter-controller [denom] [controller] [minter]",
 		Short: "Broadcast message configure-minter-controller",
-		Args:  cobra.ExactArgs(2),
+		Args:  cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
-			argController := args[0]
-			argMinter := args[1]
+			argDenom := args[0]
+			argController := args[1]
+			argMinter := args[2]
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -46,6 +47,7 @@ func CmdConfigureMinterController() *cobra.Command {
 				From:       clientCtx.GetFromAddress().String(),
 				Controller: argController,
 				Minter:     argMinter,
+				Denom:      argDenom,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
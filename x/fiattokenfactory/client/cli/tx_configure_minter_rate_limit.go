@@ -0,0 +1,77 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+func CmdConfigureMinterRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "configure-minter-rate-limit [denom] [address] [limit] [window]",
+		Short: "Broadcast message configure-minter-rate-limit",
+		Long: `Caps the amount a minter can mint within a window. The window is either a
+number of blocks (e.g. 100) or a duration of block time (e.g. 24h). A window
+of 0 removes the rate limit of the minter.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argDenom := args[0]
+			argAddress := args[1]
+			argLimit, ok := math.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid limit: %s", args[2])
+			}
+
+			var windowBlocks int64
+			var windowDuration time.Duration
+			if blocks, err := strconv.ParseInt(args[3], 10, 64); err == nil {
+				windowBlocks = blocks
+			} else if windowDuration, err = time.ParseDuration(args[3]); err != nil {
+				return fmt.Errorf("invalid window, expected a number of blocks or a duration: %s", args[3])
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgConfigureMinterRateLimit{
+				From:           clientCtx.GetFromAddress().String(),
+				Address:        argAddress,
+				Denom:          argDenom,
+				Limit:          argLimit,
+				WindowBlocks:   windowBlocks,
+				WindowDuration: windowDuration,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -17,6 +17,7 @@
 package cli
 
 import (
+	"fmt"
 	"strconv"
 
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
@@ -28,26 +29,70 @@ import (
 
 var _ = strconv.Itoa(0)
 
+const (
+	FlagScopes        = "scopes"
+	FlagDuration      = "duration"
+	FlagUnpauseHeight = "unpause-height"
+)
+
 func CmdPause() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "pause",
+		Use:   "pause [denom]",
 		Short: "Broadcast message pause",
-		Args:  cobra.ExactArgs(0),
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argDenom := args[0]
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
+			scopes, err := readPauseScopes(cmd)
+			if err != nil {
+				return err
+			}
+
+			duration, _ := cmd.Flags().GetDuration(FlagDuration)
+			unpauseHeight, _ := cmd.Flags().GetInt64(FlagUnpauseHeight)
+
 			msg := &types.MsgPause{
-				From: clientCtx.GetFromAddress().String(),
+				From:          clientCtx.GetFromAddress().String(),
+				Denom:         argDenom,
+				Scopes:        scopes,
+				Duration:      duration,
+				UnpauseHeight: unpauseHeight,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	addPauseScopesFlag(cmd, "pause")
+	cmd.Flags().Duration(FlagDuration, 0, "block time after which the pause is lifted")
+	cmd.Flags().Int64(FlagUnpauseHeight, 0, "block height at which the pause is lifted")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// addPauseScopesFlag adds the flag selecting the scopes to pause or unpause.
+func addPauseScopesFlag(cmd *cobra.Command, action string) {
+	cmd.Flags().StringSlice(FlagScopes, nil, fmt.Sprintf("scopes to %s (mint, burn, transfer, ibc_in, ibc_out, authz), all scopes when empty", action))
+}
+
+// readPauseScopes reads the scopes selected by the scopes flag.
+func readPauseScopes(cmd *cobra.Command) ([]types.PauseScope, error) {
+	names, err := cmd.Flags().GetStringSlice(FlagScopes)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := make([]types.PauseScope, len(names))
+	for i, name := range names {
+		if scopes[i], err = types.ParsePauseScope(name); err != nil {
+			return nil, err
+		}
+	}
+	return scopes, nil
+}
@@ -31,11 +31,12 @@ var _ = strconv.Itoa(0)
 
 func CmdRemoveMinterController() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "remove-minter-controller [controller]",
+		Use:   "remove-minter-controller [denom] [controller]",
 		Short: "Broadcast message remove-minter-controller",
-		Args:  cobra.ExactArgs(1),
+		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
-			argAddress := args[0]
+			argDenom := args[0]
+			argAddress := args[1]
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -45,6 +46,7 @@ func CmdRemoveMinterController() *cobra.Command {
 			msg := &types.MsgRemoveMinterController{
 				From:       clientCtx.GetFromAddress().String(),
 				Controller: argAddress,
+				Denom:      argDenom,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
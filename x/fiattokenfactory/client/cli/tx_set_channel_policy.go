@@ -0,0 +1,76 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"strconv"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+const (
+	FlagAllowInbound  = "allow-inbound"
+	FlagAllowOutbound = "allow-outbound"
+)
+
+func CmdSetChannelPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-channel-policy [denom] [channel-id]",
+		Short: "Broadcast message set-channel-policy",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argDenom := args[0]
+			argChannelID := args[1]
+
+			allowInbound, err := cmd.Flags().GetBool(FlagAllowInbound)
+			if err != nil {
+				return err
+			}
+			allowOutbound, err := cmd.Flags().GetBool(FlagAllowOutbound)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetChannelPolicy{
+				From:          clientCtx.GetFromAddress().String(),
+				Denom:         argDenom,
+				ChannelId:     argChannelID,
+				AllowInbound:  allowInbound,
+				AllowOutbound: allowOutbound,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagAllowInbound, false, "Allow the minting denom to be received through the channel")
+	cmd.Flags().Bool(FlagAllowOutbound, false, "Allow the minting denom to be sent through the channel")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,83 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+func CmdSetChannelRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-channel-rate-limit [denom] [channel-id] [max-inflow] [max-outflow] [window]",
+		Short: "Broadcast message set-channel-rate-limit",
+		Long: `Caps the net amount of a minting denom received and sent through an IBC
+channel within a window. A cap of 0 leaves that direction uncapped. The window
+is either a number of blocks (e.g. 100) or a duration of block time (e.g. 24h).
+A window of 0 removes the rate limit of the channel.`,
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argDenom := args[0]
+			argChannelID := args[1]
+			argMaxInflow, ok := math.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid max inflow: %s", args[2])
+			}
+			argMaxOutflow, ok := math.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("invalid max outflow: %s", args[3])
+			}
+
+			var windowBlocks int64
+			var windowDuration time.Duration
+			if blocks, err := strconv.ParseInt(args[4], 10, 64); err == nil {
+				windowBlocks = blocks
+			} else if windowDuration, err = time.ParseDuration(args[4]); err != nil {
+				return fmt.Errorf("invalid window, expected a number of blocks or a duration: %s", args[4])
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetChannelRateLimit{
+				From:           clientCtx.GetFromAddress().String(),
+				Denom:          argDenom,
+				ChannelId:      argChannelID,
+				MaxInflow:      argMaxInflow,
+				MaxOutflow:     argMaxOutflow,
+				WindowBlocks:   windowBlocks,
+				WindowDuration: windowDuration,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
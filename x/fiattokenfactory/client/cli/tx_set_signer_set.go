@@ -0,0 +1,68 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+func CmdSetSignerSet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-signer-set [denom] [role] [threshold] [signers]",
+		Short: "Broadcast message set-signer-set",
+		Long:  "Configure a role as a comma separated set of signers, threshold of which must approve its sensitive actions. An empty signers argument removes the signer set.",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argDenom := args[0]
+			argRole := args[1]
+			argThreshold, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+			var argSigners []string
+			if args[3] != "" {
+				argSigners = strings.Split(args[3], ",")
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetSignerSet{
+				From:      clientCtx.GetFromAddress().String(),
+				Denom:     argDenom,
+				Role:      argRole,
+				Signers:   argSigners,
+				Threshold: uint32(argThreshold),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -30,11 +30,12 @@ var _ = strconv.Itoa(0)
 
 func CmdUnblacklist() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "unblacklist [address]",
+		Use:   "unblacklist [denom] [address]",
 		Short: "Broadcast message unblacklist",
-		Args:  cobra.ExactArgs(1),
+		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
-			argAddress := args[0]
+			argDenom := args[0]
+			argAddress := args[1]
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -44,6 +45,7 @@ func CmdUnblacklist() *cobra.Command {
 			msg := &types.MsgUnblacklist{
 				From:    clientCtx.GetFromAddress().String(),
 				Address: argAddress,
+				Denom:   argDenom,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -30,23 +30,33 @@ var _ = strconv.Itoa(0)
 
 func CmdUnpause() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "unpause",
+		Use:   "unpause [denom]",
 		Short: "Broadcast message unpause",
-		Args:  cobra.ExactArgs(0),
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argDenom := args[0]
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
+			scopes, err := readPauseScopes(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgUnpause{
-				From: clientCtx.GetFromAddress().String(),
+				From:   clientCtx.GetFromAddress().String(),
+				Denom:  argDenom,
+				Scopes: scopes,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	addPauseScopesFlag(cmd, "unpause")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -30,11 +30,12 @@ var _ = strconv.Itoa(0)
 
 func CmdUpdateBlacklister() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "update-blacklister [address]",
+		Use:   "update-blacklister [denom] [address]",
 		Short: "Broadcast message update-blacklister",
-		Args:  cobra.ExactArgs(1),
+		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
-			argAddress := args[0]
+			argDenom := args[0]
+			argAddress := args[1]
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -44,6 +45,7 @@ func CmdUpdateBlacklister() *cobra.Command {
 			msg := &types.MsgUpdateBlacklister{
 				From:    clientCtx.GetFromAddress().String(),
 				Address: argAddress,
+				Denom:   argDenom,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
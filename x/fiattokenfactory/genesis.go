@@ -27,80 +27,125 @@ import (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k *keeper.Keeper, bankKeeper types.BankKeeper, genState types.GenesisState) {
+	genState.MigrateSingleDenom()
+
+	k.SetParams(ctx, genState.Params)
+
+	for _, elem := range genState.MintingDenomList {
+		_, found := bankKeeper.GetDenomMetaData(ctx, elem.Denom)
+		if !found {
+			panic(errors.Wrapf(types.ErrDenomNotRegistered, "fiattokenfactory minting denom %s is not registered in bank module denom_metadata", elem.Denom))
+		}
+		k.SetMintingDenom(ctx, elem)
+	}
+
 	for _, elem := range genState.BlacklistedList {
 		k.SetBlacklisted(ctx, elem)
 	}
 
-	if genState.Paused != nil {
-		k.SetPaused(ctx, *genState.Paused)
+	for _, elem := range genState.PausedList {
+		k.SetPaused(ctx, elem)
 	}
 
-	if genState.MasterMinter != nil {
-		k.SetMasterMinter(ctx, *genState.MasterMinter)
+	for _, elem := range genState.MasterMinterList {
+		k.SetMasterMinter(ctx, elem)
 	}
 
 	for _, elem := range genState.MintersList {
 		k.SetMinters(ctx, elem)
 	}
 
-	if genState.Pauser != nil {
-		k.SetPauser(ctx, *genState.Pauser)
+	for _, elem := range genState.PauserList {
+		k.SetPauser(ctx, elem)
+	}
+
+	for _, elem := range genState.BlacklisterList {
+		k.SetBlacklister(ctx, elem)
 	}
 
-	if genState.Blacklister != nil {
-		k.SetBlacklister(ctx, *genState.Blacklister)
+	for _, elem := range genState.OwnerList {
+		k.SetOwner(ctx, elem)
 	}
 
-	if genState.Owner != nil {
-		k.SetOwner(ctx, *genState.Owner)
+	for _, elem := range genState.PendingOwnerList {
+		k.SetPendingOwner(ctx, elem)
+	}
+
+	for _, elem := range genState.PendingRoles {
+		k.SetPendingRole(ctx, elem)
 	}
 
 	for _, elem := range genState.MinterControllerList {
 		k.SetMinterController(ctx, elem)
 	}
 
-	if genState.MintingDenom != nil {
-		_, found := bankKeeper.GetDenomMetaData(ctx, genState.MintingDenom.Denom)
-		if !found {
-			panic(errors.Wrapf(types.ErrDenomNotRegistered, "fiattokenfactory minting denom %s is not registered in bank module denom_metadata", genState.MintingDenom.Denom))
-		}
-		k.SetMintingDenom(ctx, *genState.MintingDenom)
+	for _, elem := range genState.AuditLog {
+		k.SetAuditEntry(ctx, elem)
 	}
-}
 
-// ExportGenesis returns the module's exported GenesisState
-func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
-	genesis := types.DefaultGenesis()
+	for _, elem := range genState.SignerSets {
+		k.SetSignerSet(ctx, elem)
+	}
 
-	genesis.BlacklistedList = k.GetAllBlacklisted(ctx)
+	for _, elem := range genState.ActionProposals {
+		k.SetActionProposal(ctx, elem)
+	}
 
-	paused := k.GetPaused(ctx)
-	genesis.Paused = &paused
+	for _, elem := range genState.QueuedActions {
+		k.SetQueuedAction(ctx, elem)
+	}
 
-	masterMinter, found := k.GetMasterMinter(ctx)
-	if found {
-		genesis.MasterMinter = &masterMinter
+	for _, elem := range genState.ChannelPolicies {
+		k.SetChannelPolicy(ctx, elem)
 	}
-	genesis.MintersList = k.GetAllMinters(ctx)
 
-	pauser, found := k.GetPauser(ctx)
-	if found {
-		genesis.Pauser = &pauser
+	for _, elem := range genState.ChannelRateLimits {
+		k.SetChannelRateLimit(ctx, elem)
 	}
 
-	blacklister, found := k.GetBlacklister(ctx)
-	if found {
-		genesis.Blacklister = &blacklister
+	for _, elem := range genState.PendingSendPackets {
+		k.SetPendingSendPacket(ctx, elem)
 	}
 
-	owner, found := k.GetOwner(ctx)
-	if found {
-		genesis.Owner = &owner
+	for _, elem := range genState.AllowlisterList {
+		k.SetAllowlister(ctx, elem)
 	}
-	genesis.MinterControllerList = k.GetAllMinterControllers(ctx)
 
-	mintingDenom := k.GetMintingDenom(ctx)
-	genesis.MintingDenom = &mintingDenom
+	for _, elem := range genState.AllowlistedList {
+		k.SetAllowlisted(ctx, elem)
+	}
+
+	for _, denom := range genState.AllowlistEnabledDenoms {
+		k.SetAllowlistEnabled(ctx, denom, true)
+	}
+}
+
+// ExportGenesis returns the module's exported GenesisState
+func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+
+	genesis.Params = k.GetParams(ctx)
+	genesis.MintingDenomList = k.GetAllMintingDenoms(ctx)
+	genesis.BlacklistedList = k.GetAllBlacklisted(ctx)
+	genesis.PausedList = k.GetAllPaused(ctx)
+	genesis.MasterMinterList = k.GetAllMasterMinters(ctx)
+	genesis.MintersList = k.GetAllMinters(ctx)
+	genesis.PauserList = k.GetAllPausers(ctx)
+	genesis.BlacklisterList = k.GetAllBlacklisters(ctx)
+	genesis.OwnerList = k.GetAllOwners(ctx)
+	genesis.PendingOwnerList = k.GetAllPendingOwners(ctx)
+	genesis.PendingRoles = k.GetAllPendingRoles(ctx)
+	genesis.MinterControllerList = k.GetAllMinterControllers(ctx)
+	genesis.AuditLog = k.GetAllAuditEntries(ctx)
+	genesis.SignerSets = k.GetAllSignerSets(ctx)
+	genesis.ActionProposals = k.GetAllActionProposals(ctx)
+	genesis.QueuedActions = k.GetAllQueuedActions(ctx)
+	genesis.ChannelPolicies = k.GetAllChannelPolicies(ctx)
+	genesis.ChannelRateLimits = k.GetAllChannelRateLimits(ctx)
+	genesis.PendingSendPackets = k.GetAllPendingSendPackets(ctx)
+	genesis.AllowlisterList = k.GetAllAllowlisters(ctx)
+	genesis.AllowlistedList = k.GetAllAllowlisted(ctx)
+	genesis.AllowlistEnabledDenoms = k.GetAllowlistEnabledDenoms(ctx)
 
 	return genesis
 }
@@ -18,13 +18,16 @@ package fiattokenfactory_test
 
 import (
 	"testing"
+	"time"
 
+	"cosmossdk.io/math"
 	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
 	"github.com/circlefin/noble-fiattokenfactory/testutil/nullify"
 	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
 	fiattokenfactory "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory"
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -75,18 +78,19 @@ func TestInitGenesis_MissingFields(t *testing.T) {
 			k, ctx := keepertest.FiatTokenfactoryKeeper()
 			fiattokenfactory.InitGenesis(ctx, k, keepertest.MockBankKeeper{}, tc.genState)
 
-			_, found := k.GetOwner(ctx)
+			_, found := k.GetOwner(ctx, "uusdc")
 			require.False(t, found)
-			_, found = k.GetMasterMinter(ctx)
+			_, found = k.GetMasterMinter(ctx, "uusdc")
 			require.False(t, found)
-			_, found = k.GetPauser(ctx)
+			_, found = k.GetPauser(ctx, "uusdc")
 			require.False(t, found)
-			_, found = k.GetBlacklister(ctx)
+			_, found = k.GetBlacklister(ctx, "uusdc")
+			require.False(t, found)
+			_, found = k.GetMintingDenom(ctx, "uusdc")
 			require.False(t, found)
 
-			require.Panics(t, func() { k.GetMintingDenom(ctx) })
-			require.Panics(t, func() { k.GetPaused(ctx) })
-
+			require.Empty(t, k.GetAllMintingDenoms(ctx))
+			require.Empty(t, k.GetAllPaused(ctx))
 			require.Empty(t, k.GetAllBlacklisted(ctx))
 			require.Empty(t, k.GetAllMinters(ctx))
 			require.Empty(t, k.GetAllMinterControllers(ctx))
@@ -100,35 +104,60 @@ func TestInitGenesis_allSpecifiedPasses(t *testing.T) {
 	k, ctx := keepertest.FiatTokenfactoryKeeper()
 	fiattokenfactory.InitGenesis(ctx, k, keepertest.MockBankKeeper{}, genesisState)
 
-	owner, found := k.GetOwner(ctx)
+	owner, found := k.GetOwner(ctx, "uusdc")
 	require.True(t, found)
-	require.Equal(t, genesisState.Owner, &owner)
-	masterMinter, found := k.GetMasterMinter(ctx)
+	require.Equal(t, types.Owner{Address: genesisState.Owner.Address, Denom: "uusdc"}, owner)
+	masterMinter, found := k.GetMasterMinter(ctx, "uusdc")
 	require.True(t, found)
-	require.Equal(t, genesisState.MasterMinter, &masterMinter)
-	pauser, found := k.GetPauser(ctx)
+	require.Equal(t, types.MasterMinter{Address: genesisState.MasterMinter.Address, Denom: "uusdc"}, masterMinter)
+	pauser, found := k.GetPauser(ctx, "uusdc")
 	require.True(t, found)
-	require.Equal(t, genesisState.Pauser, &pauser)
-	blacklister, found := k.GetBlacklister(ctx)
+	require.Equal(t, types.Pauser{Address: genesisState.Pauser.Address, Denom: "uusdc"}, pauser)
+	blacklister, found := k.GetBlacklister(ctx, "uusdc")
 	require.True(t, found)
-	require.Equal(t, genesisState.Blacklister, &blacklister)
+	require.Equal(t, types.Blacklister{Address: genesisState.Blacklister.Address, Denom: "uusdc"}, blacklister)
 
-	paused := k.GetPaused(ctx)
-	require.Equal(t, genesisState.Paused, &paused)
-	denom := k.GetMintingDenom(ctx)
+	paused := k.GetPaused(ctx, "uusdc")
+	// a legacy paused flag pauses all scopes
+	require.Equal(t, types.Paused{Paused: genesisState.Paused.Paused, Denom: "uusdc", Scopes: types.PauseScopesAll}, paused)
+	denom, found := k.GetMintingDenom(ctx, "uusdc")
+	require.True(t, found)
 	require.Equal(t, genesisState.MintingDenom, &denom)
 
+	migrated := genesisState
+	migrated.MigrateSingleDenom()
+
 	require.ElementsMatch(t,
-		nullify.Fill(genesisState.BlacklistedList),
+		nullify.Fill(migrated.BlacklistedList),
 		nullify.Fill(k.GetAllBlacklisted(ctx)))
 	require.ElementsMatch(t,
-		nullify.Fill(genesisState.MintersList),
+		nullify.Fill(migrated.MintersList),
 		nullify.Fill(k.GetAllMinters(ctx)))
 	require.ElementsMatch(t,
-		nullify.Fill(genesisState.MinterControllerList),
+		nullify.Fill(migrated.MinterControllerList),
 		nullify.Fill(k.GetAllMinterControllers(ctx)))
 }
 
+func TestInitGenesis_MultiDenom(t *testing.T) {
+	genesisState := createCompleteValidGenesis()
+	genesisState.MigrateSingleDenom()
+	genesisState.MintingDenomList = append(genesisState.MintingDenomList, types.MintingDenom{Denom: "ueurc"})
+	genesisState.PausedList = append(genesisState.PausedList, types.Paused{Denom: "ueurc", Paused: false})
+	genesisState.OwnerList = append(genesisState.OwnerList, types.Owner{Denom: "ueurc", Address: sample.AccAddress()})
+
+	k, ctx := keepertest.FiatTokenfactoryKeeper()
+	fiattokenfactory.InitGenesis(ctx, k, keepertest.MockBankKeeper{}, genesisState)
+
+	require.True(t, k.GetPaused(ctx, "uusdc").Paused)
+	require.False(t, k.GetPaused(ctx, "ueurc").Paused)
+
+	owner, found := k.GetOwner(ctx, "ueurc")
+	require.True(t, found)
+	require.Equal(t, genesisState.OwnerList[1], owner)
+	_, found = k.GetPauser(ctx, "ueurc")
+	require.False(t, found)
+}
+
 func TestExportGenesis(t *testing.T) {
 	genesisState := createCompleteValidGenesis()
 
@@ -137,30 +166,150 @@ func TestExportGenesis(t *testing.T) {
 
 	got := fiattokenfactory.ExportGenesis(ctx, k)
 	require.NotNil(t, got)
+	// slices are compared before nullify empties them
+	require.Equal(t, genesisState.AllowlisterList, got.AllowlisterList)
+	require.Equal(t, genesisState.AllowlistedList, got.AllowlistedList)
+	require.Equal(t, genesisState.AllowlistEnabledDenoms, got.AllowlistEnabledDenoms)
+	require.Equal(t, genesisState.PendingOwnerList, got.PendingOwnerList)
+	require.Equal(t, genesisState.PendingRoles, got.PendingRoles)
 
+	genesisState.MigrateSingleDenom()
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
 	require.ElementsMatch(t, genesisState.BlacklistedList, got.BlacklistedList)
-	require.Equal(t, genesisState.Paused, got.Paused)
-	require.Equal(t, genesisState.MasterMinter, got.MasterMinter)
+	require.ElementsMatch(t, genesisState.PausedList, got.PausedList)
+	require.ElementsMatch(t, genesisState.MasterMinterList, got.MasterMinterList)
 	require.ElementsMatch(t, genesisState.MintersList, got.MintersList)
-	require.Equal(t, genesisState.Pauser, got.Pauser)
-	require.Equal(t, genesisState.Blacklister, got.Blacklister)
-	require.Equal(t, genesisState.Owner, got.Owner)
+	require.ElementsMatch(t, genesisState.PauserList, got.PauserList)
+	require.ElementsMatch(t, genesisState.BlacklisterList, got.BlacklisterList)
+	require.ElementsMatch(t, genesisState.OwnerList, got.OwnerList)
 	require.ElementsMatch(t, genesisState.MinterControllerList, got.MinterControllerList)
-	require.Equal(t, genesisState.MintingDenom, got.MintingDenom)
+	require.ElementsMatch(t, genesisState.MintingDenomList, got.MintingDenomList)
+	require.Equal(t, genesisState.Params, got.Params)
+	require.Equal(t, genesisState.AuditLog, got.AuditLog)
+	require.Equal(t, genesisState.SignerSets, got.SignerSets)
+	require.Equal(t, genesisState.ActionProposals, got.ActionProposals)
+	require.Equal(t, genesisState.QueuedActions, got.QueuedActions)
+	require.Equal(t, genesisState.ChannelPolicies, got.ChannelPolicies)
+	require.Equal(t, genesisState.ChannelRateLimits, got.ChannelRateLimits)
+	require.Equal(t, genesisState.PendingSendPackets, got.PendingSendPackets)
+
+	require.Nil(t, got.Paused)
+	require.Nil(t, got.Owner)
+	require.Nil(t, got.MintingDenom)
 }
 
 func createCompleteValidGenesis() types.GenesisState {
+	blacklistedAt := time.Unix(10, 0).UTC()
+	expiresAt := time.Unix(1000, 0).UTC()
+
 	return types.GenesisState{
-		BlacklistedList: []types.Blacklisted{
+		Params: types.Params{
+			AuditLogRetention:      100,
+			ActionProposalLifetime: time.Hour,
+			RoleChangeDelay:        time.Minute,
+			AuthzGranteeCheck:      true,
+			MaxBlacklistBatchSize:  10,
+		},
+		AuditLog: []types.AuditEntry{
+			{
+				Id:     0,
+				Height: 1,
+				Time:   time.Unix(1, 0).UTC(),
+				Denom:  "uusdc",
+				Actor:  sample.AccAddress(),
+				Role:   types.RolePauser,
+				Action: types.ActionPause,
+				Target: "uusdc",
+				Before: "false",
+				After:  "true",
+			},
+		},
+		SignerSets: []types.SignerSet{
+			{
+				Denom:     "uusdc",
+				Role:      types.RolePauser,
+				Signers:   []string{sample.AccAddress(), sample.AccAddress()},
+				Threshold: 2,
+			},
+		},
+		ActionProposals: []types.ActionProposal{
+			{
+				Id:        3,
+				Denom:     "uusdc",
+				Role:      types.RolePauser,
+				Proposer:  sample.AccAddress(),
+				Approvals: []string{sample.AccAddress()},
+				Expiry:    time.Unix(3600, 0).UTC(),
+				Action:    &types.ActionProposal_Unpause{Unpause: &types.MsgUnpause{From: sample.AccAddress(), Denom: "uusdc"}},
+			},
+		},
+		QueuedActions: []types.QueuedAction{
+			{
+				Id:        7,
+				Denom:     "uusdc",
+				ExecuteAt: time.Unix(60, 0).UTC(),
+				Action:    &types.QueuedAction_UpdateBlacklister{UpdateBlacklister: &types.MsgUpdateBlacklister{From: sample.AccAddress(), Address: sample.AccAddress(), Denom: "uusdc"}},
+			},
+		},
+		ChannelPolicies: []types.ChannelPolicy{
+			{
+				Denom:        "uusdc",
+				ChannelId:    "channel-0",
+				AllowInbound: true,
+			},
+		},
+		ChannelRateLimits: []types.ChannelRateLimit{
+			types.NewChannelRateLimit("uusdc", "channel-0", math.NewInt(100), math.NewInt(50), 0, time.Hour),
+		},
+		PendingSendPackets: []types.PendingSendPacket{
+			{
+				ChannelId: "channel-0",
+				Sequence:  4,
+				Denom:     "uusdc",
+				Bucket:    1,
+			},
+		},
+		AllowlisterList: []types.Allowlister{
 			{
+				Denom:   "uusdc",
+				Address: sample.AccAddress(),
+			},
+		},
+		AllowlistedList: []types.Allowlisted{
+			{
+				Denom:     "uusdc",
 				AddressBz: sample.AddressBz(),
 			},
+		},
+		AllowlistEnabledDenoms: []string{"uusdc"},
+		PendingOwnerList: []types.Owner{
+			{
+				Denom:   "uusdc",
+				Address: sample.AccAddress(),
+			},
+		},
+		PendingRoles: []types.PendingRole{
+			{
+				Denom:   "uusdc",
+				Role:    types.RolePauser,
+				Address: sample.AccAddress(),
+			},
+		},
+		BlacklistedList: []types.Blacklisted{
 			{
 				AddressBz: sample.AddressBz(),
 			},
+			{
+				AddressBz:           sample.AddressBz(),
+				Reason:              "sanctions",
+				ReferenceId:         "case-1234",
+				BlacklistedAtHeight: 10,
+				BlacklistedAtTime:   &blacklistedAt,
+				ExpiresAtHeight:     1000,
+				ExpiresAtTime:       &expiresAt,
+			},
 		},
 		Paused: &types.Paused{
 			Paused: true,
@@ -170,10 +319,12 @@ func createCompleteValidGenesis() types.GenesisState {
 		},
 		MintersList: []types.Minters{
 			{
-				Address: sample.AccAddress(),
+				Address:   sample.AccAddress(),
+				Allowance: sdk.NewInt64Coin("uusdc", 1),
 			},
 			{
-				Address: sample.AccAddress(),
+				Address:   sample.AccAddress(),
+				Allowance: sdk.NewInt64Coin("uusdc", 1),
 			},
 		},
 		Pauser: &types.Pauser{
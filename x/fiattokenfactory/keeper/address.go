@@ -16,7 +16,129 @@
 
 package keeper
 
-import "github.com/btcsuite/btcd/btcutil/bech32"
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/errors"
+	"github.com/btcsuite/btcd/btcutil/base58"
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+// AddressCodec decodes the addresses of a format into the canonical bytes
+// blacklist entries are keyed by, so that counterparty addresses of any
+// supported format can be blacklisted and screened.
+type AddressCodec interface {
+	// Supports reports whether an address is in the format of the codec.
+	Supports(address string) bool
+	// Decode returns the canonical bytes of an address in the format of the
+	// codec.
+	Decode(address string) ([]byte, error)
+}
+
+// DefaultAddressCodecs returns the address codecs of a new keeper, in the
+// order they are tried.
+func DefaultAddressCodecs() []AddressCodec {
+	return []AddressCodec{HexCodec{}, Bech32Codec{}, Base58Codec{}}
+}
+
+// SetAddressCodecs sets the codecs addresses are decoded with. The first
+// codec supporting an address decodes it.
+func (k *Keeper) SetAddressCodecs(codecs ...AddressCodec) {
+	k.addressCodecs = codecs
+}
+
+// DecodeAddress returns the canonical bytes of an address, decoded by the
+// first address codec of the keeper supporting its format. Addresses in an
+// unsupported format are decoded as Bech32 so that malformed input keeps
+// reporting the Bech32 decoding error.
+func (k Keeper) DecodeAddress(address string) ([]byte, error) {
+	for _, codec := range k.addressCodecs {
+		if codec.Supports(address) {
+			return codec.Decode(address)
+		}
+	}
+	if _, _, err := DecodeNoLimitToBase256(address); err != nil {
+		return nil, err
+	}
+	return nil, errors.Wrapf(types.ErrInvalidAddress, "unsupported address format (%s)", address)
+}
+
+// Bech32Codec decodes Bech32 and Bech32m addresses of any human readable part.
+type Bech32Codec struct{}
+
+func (Bech32Codec) Supports(address string) bool {
+	if strings.ToLower(address) != address && strings.ToUpper(address) != address {
+		return false
+	}
+
+	// the data part holds at least the checksum after the last separator
+	separator := strings.LastIndexByte(address, '1')
+	if separator < 1 || len(address)-separator-1 < 6 {
+		return false
+	}
+	for _, c := range strings.ToLower(address[separator+1:]) {
+		if !strings.ContainsRune(bech32Charset, c) {
+			return false
+		}
+	}
+	return true
+}
+
+func (Bech32Codec) Decode(address string) ([]byte, error) {
+	_, bz, err := DecodeNoLimitToBase256(address)
+	return bz, err
+}
+
+// bech32Charset is the alphabet of the data part of a Bech32 string.
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// HexCodec decodes 0x prefixed hex addresses of 20 or 32 bytes, such as those
+// of EVM chains.
+type HexCodec struct{}
+
+func (HexCodec) Supports(address string) bool {
+	return strings.HasPrefix(address, "0x") || strings.HasPrefix(address, "0X")
+}
+
+func (HexCodec) Decode(address string) ([]byte, error) {
+	bz, err := hex.DecodeString(address[2:])
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidAddress, "invalid hex address (%s)", err)
+	}
+	if len(bz) != 20 && len(bz) != 32 {
+		return nil, errors.Wrapf(types.ErrInvalidAddress, "hex address must be 20 or 32 bytes, got %d", len(bz))
+	}
+	return bz, nil
+}
+
+// Base58Codec decodes base58 addresses of 32 bytes, such as those of Solana.
+type Base58Codec struct{}
+
+func (Base58Codec) Supports(address string) bool {
+	if address == "" {
+		return false
+	}
+	for _, c := range address {
+		if !strings.ContainsRune(base58Alphabet, c) {
+			return false
+		}
+	}
+	return true
+}
+
+func (Base58Codec) Decode(address string) ([]byte, error) {
+	bz := base58.Decode(address)
+	if len(bz) != 32 {
+		return nil, errors.Wrap(types.ErrInvalidAddress, fmt.Sprintf("base58 address must be 32 bytes, got %d", len(bz)))
+	}
+	return bz, nil
+}
+
+// base58Alphabet is the alphabet of a base58 string.
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
 
 // DecodeNoLimitToBase256 is a combination of both DecodeNoLimit and
 // DecodeToBase256 utilities included in the btcutil library. It allows the
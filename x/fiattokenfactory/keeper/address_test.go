@@ -17,11 +17,17 @@
 package keeper_test
 
 import (
+	"bytes"
+	"encoding/hex"
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/btcsuite/btcd/btcutil/base58"
 	"github.com/btcsuite/btcd/btcutil/bech32"
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -97,6 +103,84 @@ func TestDecodeNoLimitToBase256(t *testing.T) {
 	}
 }
 
+func TestDecodeAddress(t *testing.T) {
+	ftf, _ := testkeeper.FiatTokenfactoryKeeper()
+	evm := bytes.Repeat([]byte{0xab}, 20)
+	solana := bytes.Repeat([]byte{0x01}, 32)
+
+	testCases := map[string]struct {
+		address  string
+		expected []byte
+		err      error
+	}{
+		"bech32": {
+			address:  "cosmos1hjz2rjqfn7yhaawqgfk6j6hv5dtf9nau70fusm",
+			expected: mustDecodeBech32(t, "cosmos1hjz2rjqfn7yhaawqgfk6j6hv5dtf9nau70fusm"),
+		},
+		"bech32 invalid checksum": {
+			address: "cosmos1hjz2rjqfn7yhaawqgfk6j6hv5dtf9nau70fusq",
+			err:     bech32.ErrInvalidChecksum{Expected: "70fusm", ExpectedM: "70fusmtnes4e", Actual: "70fusq"},
+		},
+		"hex 20 bytes": {
+			address:  "0x" + hex.EncodeToString(evm),
+			expected: evm,
+		},
+		"hex uppercase prefix": {
+			address:  "0X" + strings.ToUpper(hex.EncodeToString(evm)),
+			expected: evm,
+		},
+		"hex invalid length": {
+			address: "0xabcd",
+			err:     types.ErrInvalidAddress,
+		},
+		"hex invalid character": {
+			address: "0x" + strings.Repeat("zz", 20),
+			err:     types.ErrInvalidAddress,
+		},
+		"base58 32 bytes": {
+			address:  base58.Encode(solana),
+			expected: solana,
+		},
+		"base58 invalid length": {
+			address: base58.Encode(evm),
+			err:     types.ErrInvalidAddress,
+		},
+		"unsupported format": {
+			address: "not an address",
+			err:     bech32.ErrInvalidCharacter(' '),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			bz, err := ftf.DecodeAddress(tc.address)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, bz)
+		})
+	}
+}
+
+func TestSetAddressCodecs(t *testing.T) {
+	ftf, _ := testkeeper.FiatTokenfactoryKeeper()
+	ftf.SetAddressCodecs(keeper.Bech32Codec{})
+
+	_, err := ftf.DecodeAddress("0x" + strings.Repeat("ab", 20))
+	require.Error(t, err)
+
+	_, err = ftf.DecodeAddress("cosmos1hjz2rjqfn7yhaawqgfk6j6hv5dtf9nau70fusm")
+	require.NoError(t, err)
+}
+
+func mustDecodeBech32(t *testing.T, address string) []byte {
+	_, bz, err := keeper.DecodeNoLimitToBase256(address)
+	require.NoError(t, err)
+	return bz
+}
+
 // Function that reverts `DecodeNoLimitToBase256` and converts
 func convertAndEncodeBase256(hrp string, data []byte, bech32m bool) (string, error) {
 	converted, _ := bech32.ConvertBits(data, 8, 5, true)
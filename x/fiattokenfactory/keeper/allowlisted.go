@@ -0,0 +1,97 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// SetAllowlisted set a specific allowlisted in the store from its index
+func (k Keeper) SetAllowlisted(ctx context.Context, allowlisted types.Allowlisted) {
+	set(ctx, k.allowlisted, collections.Join(allowlisted.Denom, allowlisted.AddressBz), allowlisted)
+}
+
+// GetAllowlisted returns an allowlisted from its index
+func (k Keeper) GetAllowlisted(ctx context.Context, denom string, addressBz []byte) (val types.Allowlisted, found bool) {
+	return get(ctx, k.allowlisted, collections.Join(denom, addressBz))
+}
+
+// RemoveAllowlisted removes an allowlisted from the store
+func (k Keeper) RemoveAllowlisted(ctx context.Context, denom string, addressBz []byte) {
+	remove(ctx, k.allowlisted, collections.Join(denom, addressBz))
+}
+
+// GetAllAllowlisted returns all allowlisted of all minting denoms
+func (k Keeper) GetAllAllowlisted(ctx context.Context) []types.Allowlisted {
+	return values(ctx, k.allowlisted, nil)
+}
+
+// SetAllowlistEnabled enables or disables the allowlist mode of a minting denom
+func (k Keeper) SetAllowlistEnabled(ctx context.Context, denom string, enabled bool) {
+	var err error
+	if enabled {
+		err = k.allowlistEnabled.Set(ctx, denom)
+	} else {
+		err = k.allowlistEnabled.Remove(ctx, denom)
+	}
+	if err != nil {
+		panic(err)
+	}
+}
+
+// IsAllowlistEnabled reports whether the allowlist mode of a minting denom is enabled
+func (k Keeper) IsAllowlistEnabled(ctx context.Context, denom string) bool {
+	enabled, err := k.allowlistEnabled.Has(ctx, denom)
+	if err != nil {
+		panic(err)
+	}
+	return enabled
+}
+
+// GetAllowlistEnabledDenoms returns the minting denoms whose allowlist mode is enabled
+func (k Keeper) GetAllowlistEnabledDenoms(ctx context.Context) []string {
+	iter, err := k.allowlistEnabled.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	denoms, err := iter.Keys()
+	if err != nil {
+		panic(err)
+	}
+	return denoms
+}
+
+// IsAllowlisted reports whether an address can hold a minting denom while its
+// allowlist mode is enabled, either because it is allowlisted or because it
+// is the account of a module exempted from the allowlist. The escrow account
+// of the module can always hold seized funds.
+func (k Keeper) IsAllowlisted(ctx context.Context, denom string, addressBz []byte) bool {
+	if _, found := k.GetAllowlisted(ctx, denom, addressBz); found {
+		return true
+	}
+
+	if sdk.AccAddress(addressBz).Equals(authtypes.NewModuleAddress(types.EscrowName)) {
+		return true
+	}
+
+	return k.GetModuleExemption(ctx, addressBz).Allowlist
+}
@@ -0,0 +1,95 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+func createNAllowlisted(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.Allowlisted {
+	items := make([]types.Allowlisted, n)
+	for i := range items {
+		items[i].AddressBz = sample.AddressBz()
+		items[i].Denom = "uusdc"
+
+		keeper.SetAllowlisted(ctx, items[i])
+	}
+	return items
+}
+
+func TestAllowlistedGetSetAndRemove(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	items := createNAllowlisted(keeper, ctx, 2)
+
+	for _, item := range items {
+		rst, found := keeper.GetAllowlisted(ctx, item.Denom, item.AddressBz)
+		require.True(t, found)
+		require.Equal(t, item, rst)
+	}
+	require.ElementsMatch(t, items, keeper.GetAllAllowlisted(ctx))
+
+	keeper.RemoveAllowlisted(ctx, items[0].Denom, items[0].AddressBz)
+	_, found := keeper.GetAllowlisted(ctx, items[0].Denom, items[0].AddressBz)
+	require.False(t, found)
+	_, found = keeper.GetAllowlisted(ctx, items[1].Denom, items[1].AddressBz)
+	require.True(t, found)
+}
+
+func TestAllowlistEnabled(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	require.False(t, keeper.IsAllowlistEnabled(ctx, "uusdc"))
+
+	keeper.SetAllowlistEnabled(ctx, "uusdc", true)
+	keeper.SetAllowlistEnabled(ctx, "ueurc", true)
+	require.True(t, keeper.IsAllowlistEnabled(ctx, "uusdc"))
+	require.Equal(t, []string{"ueurc", "uusdc"}, keeper.GetAllowlistEnabledDenoms(ctx))
+
+	keeper.SetAllowlistEnabled(ctx, "uusdc", false)
+	require.False(t, keeper.IsAllowlistEnabled(ctx, "uusdc"))
+	require.Equal(t, []string{"ueurc"}, keeper.GetAllowlistEnabledDenoms(ctx))
+}
+
+func TestIsAllowlisted(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	items := createNAllowlisted(keeper, ctx, 1)
+
+	require.True(t, keeper.IsAllowlisted(ctx, "uusdc", items[0].AddressBz))
+	require.False(t, keeper.IsAllowlisted(ctx, "ueurc", items[0].AddressBz))
+	require.False(t, keeper.IsAllowlisted(ctx, "uusdc", sample.AddressBz()))
+
+	// ASSERT: The accounts of exempt modules are implicitly allowlisted.
+	require.True(t, keeper.IsAllowlisted(ctx, "uusdc", authtypes.NewModuleAddress(types.ModuleName)))
+	require.False(t, keeper.IsAllowlisted(ctx, "uusdc", authtypes.NewModuleAddress("transfer")))
+
+	params := types.DefaultParams()
+	params.ModuleExemptions = []types.ModuleExemption{{Module: "transfer", Allowlist: true}}
+	keeper.SetParams(ctx, params)
+	require.False(t, keeper.IsAllowlisted(ctx, "uusdc", authtypes.NewModuleAddress(types.ModuleName)))
+	require.True(t, keeper.IsAllowlisted(ctx, "uusdc", authtypes.NewModuleAddress("transfer")))
+
+	// ASSERT: The escrow account can always hold seized funds.
+	require.True(t, keeper.IsAllowlisted(ctx, "uusdc", authtypes.NewModuleAddress(types.EscrowName)))
+}
@@ -0,0 +1,38 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+// SetAllowlister set allowlister in the store
+func (k Keeper) SetAllowlister(ctx context.Context, allowlister types.Allowlister) {
+	set(ctx, k.allowlisters, allowlister.Denom, allowlister)
+}
+
+// GetAllowlister returns the allowlister of a minting denom
+func (k Keeper) GetAllowlister(ctx context.Context, denom string) (val types.Allowlister, found bool) {
+	return get(ctx, k.allowlisters, denom)
+}
+
+// GetAllAllowlisters returns the allowlisters of all minting denoms
+func (k Keeper) GetAllAllowlisters(ctx context.Context) []types.Allowlister {
+	return values(ctx, k.allowlisters, nil)
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+func createTestAllowlister(keeper *keeper.Keeper, ctx sdk.Context) types.Allowlister {
+	item := types.Allowlister{Denom: "uusdc", Address: sample.AccAddress()}
+	keeper.SetAllowlister(ctx, item)
+	return item
+}
+
+func TestAllowlisterGetAndSet_Success(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	expected := createTestAllowlister(keeper, ctx)
+
+	rst, found := keeper.GetAllowlister(ctx, "uusdc")
+	require.True(t, found)
+	require.Equal(t, expected, rst)
+	require.Equal(t, []types.Allowlister{expected}, keeper.GetAllAllowlisters(ctx))
+}
+
+func TestAllowlisterGet_Unset(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	_, found := keeper.GetAllowlister(ctx, "uusdc")
+	require.False(t, found)
+}
@@ -0,0 +1,248 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetSignerSet set the signer set of a role in the store
+func (k Keeper) SetSignerSet(ctx context.Context, signerSet types.SignerSet) {
+	set(ctx, k.signerSets, collections.Join(signerSet.Denom, signerSet.Role), signerSet)
+}
+
+// GetSignerSet returns the signer set of a role of a minting denom
+func (k Keeper) GetSignerSet(ctx context.Context, denom string, role string) (val types.SignerSet, found bool) {
+	return get(ctx, k.signerSets, collections.Join(denom, role))
+}
+
+// RemoveSignerSet removes the signer set of a role of a minting denom
+func (k Keeper) RemoveSignerSet(ctx context.Context, denom string, role string) {
+	remove(ctx, k.signerSets, collections.Join(denom, role))
+}
+
+// GetAllSignerSets returns the signer sets of all roles
+func (k Keeper) GetAllSignerSets(ctx context.Context) []types.SignerSet {
+	return values(ctx, k.signerSets, nil)
+}
+
+// SetActionProposal set an action proposal in the store, advancing the action
+// proposal sequence past its id.
+func (k Keeper) SetActionProposal(ctx context.Context, proposal types.ActionProposal) {
+	set(ctx, k.actionProposals, proposal.Id, proposal)
+
+	next, err := k.actionProposalSeq.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if proposal.Id >= next {
+		if err := k.actionProposalSeq.Set(ctx, proposal.Id+1); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// GetActionProposal returns an action proposal from its id
+func (k Keeper) GetActionProposal(ctx context.Context, id uint64) (val types.ActionProposal, found bool) {
+	return get(ctx, k.actionProposals, id)
+}
+
+// RemoveActionProposal removes an action proposal from the store
+func (k Keeper) RemoveActionProposal(ctx context.Context, id uint64) {
+	remove(ctx, k.actionProposals, id)
+}
+
+// GetAllActionProposals returns all pending action proposals
+func (k Keeper) GetAllActionProposals(ctx context.Context) []types.ActionProposal {
+	return values(ctx, k.actionProposals, nil)
+}
+
+// PruneActionProposals removes the action proposals that expired without
+// collecting enough approvals.
+func (k Keeper) PruneActionProposals(ctx context.Context) error {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	var expired []uint64
+	err := k.actionProposals.Walk(ctx, nil, func(id uint64, proposal types.ActionProposal) (bool, error) {
+		if !blockTime.Before(proposal.Expiry) {
+			expired = append(expired, id)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, id := range expired {
+		if err := k.actionProposals.Remove(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// proposeAction submits a sensitive action of a role as an action proposal
+// when the role is configured as a signer set. It reports whether the action
+// was handled as a proposal, in which case the action is executed once
+// threshold signers approved it, which may be immediately.
+func (k Keeper) proposeAction(ctx sdk.Context, denom string, role string, from string, proposal types.ActionProposal) (bool, error) {
+	signerSet, found := k.GetSignerSet(ctx, denom, role)
+	if !found {
+		return false, nil
+	}
+
+	if !signerSet.IsSigner(from) {
+		return true, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not a signer of the %s signer set", role)
+	}
+
+	id, err := k.actionProposalSeq.Next(ctx)
+	if err != nil {
+		return true, err
+	}
+
+	proposal.Id = id
+	proposal.Denom = denom
+	proposal.Role = role
+	proposal.Proposer = from
+	proposal.Approvals = []string{from}
+	proposal.Expiry = ctx.BlockTime().Add(k.GetParams(ctx).ActionProposalLifetime)
+
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  denom,
+		Actor:  from,
+		Role:   role,
+		Action: types.ActionProposeAction,
+		Target: strconv.FormatUint(id, 10),
+		After:  proposal.ActionName(),
+	}); err != nil {
+		return true, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventActionProposed{
+		Id:       id,
+		Denom:    denom,
+		Role:     role,
+		Proposer: from,
+		Action:   proposal.ActionName(),
+		Expiry:   proposal.Expiry,
+	}); err != nil {
+		return true, err
+	}
+
+	_, err = k.tallyAction(ctx, proposal, signerSet)
+	return true, err
+}
+
+// tallyAction executes an action proposal once approved by threshold members
+// of its signer set, or stores it to collect further approvals.
+func (k Keeper) tallyAction(ctx sdk.Context, proposal types.ActionProposal, signerSet types.SignerSet) (bool, error) {
+	if countApprovals(proposal, signerSet) < signerSet.Threshold {
+		k.SetActionProposal(ctx, proposal)
+		return false, nil
+	}
+
+	k.RemoveActionProposal(ctx, proposal.Id)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventActionExecuted{
+		Id:     proposal.Id,
+		Action: proposal.ActionName(),
+	}); err != nil {
+		return true, err
+	}
+	return true, k.executeAction(ctx, proposal)
+}
+
+// executeAction executes the action of an approved action proposal.
+func (k Keeper) executeAction(ctx sdk.Context, proposal types.ActionProposal) error {
+	switch action := proposal.Action.(type) {
+	case *types.ActionProposal_UpdateOwner:
+		return k.scheduleAction(ctx, proposal.Proposer, proposal.Role, types.QueuedAction{
+			Denom:  proposal.Denom,
+			Action: &types.QueuedAction_UpdateOwner{UpdateOwner: action.UpdateOwner},
+		})
+	case *types.ActionProposal_UpdateMasterMinter:
+		return k.scheduleAction(ctx, proposal.Proposer, proposal.Role, types.QueuedAction{
+			Denom:  proposal.Denom,
+			Action: &types.QueuedAction_UpdateMasterMinter{UpdateMasterMinter: action.UpdateMasterMinter},
+		})
+	case *types.ActionProposal_ConfigureMinterController:
+		return k.scheduleAction(ctx, proposal.Proposer, proposal.Role, types.QueuedAction{
+			Denom:  proposal.Denom,
+			Action: &types.QueuedAction_ConfigureMinterController{ConfigureMinterController: action.ConfigureMinterController},
+		})
+	case *types.ActionProposal_Unpause:
+		return k.unpause(ctx, action.Unpause)
+	case *types.ActionProposal_SetSignerSet:
+		return k.scheduleAction(ctx, proposal.Proposer, proposal.Role, types.QueuedAction{
+			Denom:  proposal.Denom,
+			Action: &types.QueuedAction_SetSignerSet{SetSignerSet: action.SetSignerSet},
+		})
+	case *types.ActionProposal_UpdatePauser:
+		return k.scheduleAction(ctx, proposal.Proposer, proposal.Role, types.QueuedAction{
+			Denom:  proposal.Denom,
+			Action: &types.QueuedAction_UpdatePauser{UpdatePauser: action.UpdatePauser},
+		})
+	case *types.ActionProposal_UpdateBlacklister:
+		return k.scheduleAction(ctx, proposal.Proposer, proposal.Role, types.QueuedAction{
+			Denom:  proposal.Denom,
+			Action: &types.QueuedAction_UpdateBlacklister{UpdateBlacklister: action.UpdateBlacklister},
+		})
+	case *types.ActionProposal_UpdateAllowlister:
+		return k.scheduleAction(ctx, proposal.Proposer, proposal.Role, types.QueuedAction{
+			Denom:  proposal.Denom,
+			Action: &types.QueuedAction_UpdateAllowlister{UpdateAllowlister: action.UpdateAllowlister},
+		})
+	case *types.ActionProposal_SetChannelPolicy:
+		return k.setChannelPolicy(ctx, action.SetChannelPolicy)
+	case *types.ActionProposal_SetChannelRateLimit:
+		return k.setChannelRateLimit(ctx, action.SetChannelRateLimit)
+	case *types.ActionProposal_SetAllowlistMode:
+		return k.setAllowlistMode(ctx, action.SetAllowlistMode)
+	case *types.ActionProposal_CancelQueuedAction:
+		return k.cancelQueuedAction(ctx, action.CancelQueuedAction)
+	case *types.ActionProposal_CancelOwnershipTransfer:
+		return k.cancelOwnershipTransfer(ctx, action.CancelOwnershipTransfer)
+	case *types.ActionProposal_RemoveChannelPolicy:
+		return k.removeChannelPolicy(ctx, action.RemoveChannelPolicy)
+	default:
+		return fmt.Errorf("unknown action of action proposal %d", proposal.Id)
+	}
+}
+
+// countApprovals returns the number of approvals of an action proposal given
+// by current members of its signer set.
+func countApprovals(proposal types.ActionProposal, signerSet types.SignerSet) uint32 {
+	var approvals uint32
+	for _, approval := range proposal.Approvals {
+		if signerSet.IsSigner(approval) {
+			approvals++
+		}
+	}
+	return approvals
+}
+
+// hasApproved reports whether a signer already approved an action proposal.
+func hasApproved(proposal types.ActionProposal, signer string) bool {
+	return slices.Contains(proposal.Approvals, signer)
+}
@@ -0,0 +1,80 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignerSetGetRemove(t *testing.T) {
+	ftf, ctx := keepertest.FiatTokenfactoryKeeper()
+	signerSet := types.SignerSet{Denom: "uusdc", Role: types.RolePauser, Signers: []string{sample.AccAddress()}, Threshold: 1}
+	ftf.SetSignerSet(ctx, signerSet)
+
+	got, found := ftf.GetSignerSet(ctx, "uusdc", types.RolePauser)
+	require.True(t, found)
+	require.Equal(t, signerSet, got)
+
+	_, found = ftf.GetSignerSet(ctx, "ueurc", types.RolePauser)
+	require.False(t, found)
+
+	ftf.RemoveSignerSet(ctx, "uusdc", types.RolePauser)
+	_, found = ftf.GetSignerSet(ctx, "uusdc", types.RolePauser)
+	require.False(t, found)
+}
+
+func TestSetActionProposal_AdvancesSequence(t *testing.T) {
+	ftf, ctx := keepertest.FiatTokenfactoryKeeper()
+	ftf.SetActionProposal(ctx, types.ActionProposal{Id: 5, Denom: "uusdc", Role: types.RolePauser})
+
+	got, found := ftf.GetActionProposal(ctx, 5)
+	require.True(t, found)
+	require.Equal(t, uint64(5), got.Id)
+
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: sample.AccAddress()})
+	signer := sample.AccAddress()
+	ftf.SetSignerSet(ctx, types.SignerSet{Denom: "uusdc", Role: types.RolePauser, Signers: []string{signer, sample.AccAddress()}, Threshold: 2})
+	ftf.SetPauser(ctx, types.Pauser{Denom: "uusdc", Address: sample.AccAddress()})
+
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	_, err := msgServer.Unpause(ctx, &types.MsgUnpause{From: signer, Denom: "uusdc"})
+	require.NoError(t, err)
+
+	_, found = ftf.GetActionProposal(ctx, 6)
+	require.True(t, found)
+}
+
+func TestPruneActionProposals(t *testing.T) {
+	ftf, ctx := keepertest.FiatTokenfactoryKeeper()
+	ctx = ctx.WithBlockTime(time.Unix(100, 0))
+	ftf.SetActionProposal(ctx, types.ActionProposal{Id: 1, Expiry: time.Unix(50, 0)})
+	ftf.SetActionProposal(ctx, types.ActionProposal{Id: 2, Expiry: time.Unix(100, 0)})
+	ftf.SetActionProposal(ctx, types.ActionProposal{Id: 3, Expiry: time.Unix(150, 0)})
+
+	require.NoError(t, ftf.PruneActionProposals(ctx))
+
+	proposals := ftf.GetAllActionProposals(ctx)
+	require.Len(t, proposals, 1)
+	require.Equal(t, uint64(3), proposals[0].Id)
+}
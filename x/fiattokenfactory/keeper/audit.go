@@ -0,0 +1,80 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// recordAudit appends an entry to the audit log, stamped with the current
+// block height and time.
+func (k Keeper) recordAudit(ctx context.Context, entry types.AuditEntry) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	id, err := k.auditLogSeq.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	entry.Id = id
+	entry.Height = sdkCtx.BlockHeight()
+	entry.Time = sdkCtx.BlockTime()
+
+	return k.auditLog.Set(ctx, collections.Join(entry.Height, entry.Id), entry)
+}
+
+// SetAuditEntry stores an audit log entry as is, advancing the audit log
+// sequence past its id.
+func (k Keeper) SetAuditEntry(ctx context.Context, entry types.AuditEntry) {
+	set(ctx, k.auditLog, collections.Join(entry.Height, entry.Id), entry)
+
+	next, err := k.auditLogSeq.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if entry.Id >= next {
+		if err := k.auditLogSeq.Set(ctx, entry.Id+1); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// GetAllAuditEntries returns all entries of the audit log, ordered by height
+func (k Keeper) GetAllAuditEntries(ctx context.Context) []types.AuditEntry {
+	return values(ctx, k.auditLog, nil)
+}
+
+// PruneAuditLog removes the audit log entries that are older than the audit
+// log retention param.
+func (k Keeper) PruneAuditLog(ctx context.Context) error {
+	retention := k.GetParams(ctx).AuditLogRetention
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if retention == 0 || retention >= uint64(height) {
+		return nil
+	}
+
+	// entries recorded at or below the cutoff height have exceeded the retention
+	cutoff := height - int64(retention)
+	ranger := new(collections.Range[collections.Pair[int64, uint64]]).
+		EndExclusive(collections.Join(cutoff+1, uint64(0)))
+
+	return k.auditLog.Clear(ctx, ranger)
+}
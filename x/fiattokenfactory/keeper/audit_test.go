@@ -0,0 +1,150 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+func TestAuditLog_RecordsRoleActions(t *testing.T) {
+	ftf, ctx := keepertest.FiatTokenfactoryKeeper()
+	ctx = ctx.WithBlockHeight(10)
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	owner := sample.TestAccount()
+	masterMinter := sample.TestAccount()
+	pauser := sample.TestAccount()
+	controller := sample.TestAccount()
+	minter := sample.TestAccount()
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner.Address})
+	ftf.SetPauser(ctx, types.Pauser{Denom: "uusdc", Address: pauser.Address})
+
+	_, err := msgServer.UpdateMasterMinter(ctx, &types.MsgUpdateMasterMinter{From: owner.Address, Address: masterMinter.Address, Denom: "uusdc"})
+	require.NoError(t, err)
+	_, err = msgServer.Pause(ctx, &types.MsgPause{From: pauser.Address, Denom: "uusdc"})
+	require.NoError(t, err)
+	_, err = msgServer.ConfigureMinterController(ctx, &types.MsgConfigureMinterController{From: masterMinter.Address, Controller: controller.Address, Minter: minter.Address, Denom: "uusdc"})
+	require.NoError(t, err)
+	_, err = msgServer.RemoveMinterController(ctx, &types.MsgRemoveMinterController{From: masterMinter.Address, Controller: controller.Address, Denom: "uusdc"})
+	require.NoError(t, err)
+
+	entries := ftf.GetAllAuditEntries(ctx)
+	require.Len(t, entries, 4)
+	for i, entry := range entries {
+		require.Equal(t, uint64(i), entry.Id)
+		require.Equal(t, int64(10), entry.Height)
+		require.Equal(t, "uusdc", entry.Denom)
+	}
+
+	require.Equal(t, owner.Address, entries[0].Actor)
+	require.Equal(t, types.RoleOwner, entries[0].Role)
+	require.Equal(t, types.ActionUpdateMasterMinter, entries[0].Action)
+	require.Equal(t, types.RoleMasterMinter, entries[0].Target)
+	require.Equal(t, "", entries[0].Before)
+	require.Equal(t, masterMinter.Address, entries[0].After)
+
+	require.Equal(t, types.ActionPause, entries[1].Action)
+	require.Equal(t, "", entries[1].Before)
+	require.Equal(t, "mint,burn,transfer,ibc_in,ibc_out,authz", entries[1].After)
+
+	require.Equal(t, types.ActionConfigureMinterController, entries[2].Action)
+	require.Equal(t, controller.Address, entries[2].Target)
+	require.Equal(t, minter.Address, entries[2].After)
+
+	require.Equal(t, types.ActionRemoveMinterController, entries[3].Action)
+	require.Equal(t, minter.Address, entries[3].Before)
+	require.Equal(t, "", entries[3].After)
+}
+
+func TestAuditLog_FailedActionNotRecorded(t *testing.T) {
+	ftf, ctx := keepertest.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetPauser(ctx, types.Pauser{Denom: "uusdc", Address: sample.AccAddress()})
+
+	_, err := msgServer.Pause(ctx, &types.MsgPause{From: sample.AccAddress(), Denom: "uusdc"})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.Empty(t, ftf.GetAllAuditEntries(ctx))
+}
+
+func TestAuditLog_ConfigureMinter(t *testing.T) {
+	ftf, ctx := keepertest.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	controller := sample.TestAccount()
+	minter := sample.TestAccount()
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	ftf.SetMinterController(ctx, types.MinterController{Denom: "uusdc", Controller: controller.Address, Minter: minter.Address})
+
+	for _, amount := range []int64{10, 20} {
+		_, err := msgServer.ConfigureMinter(ctx, &types.MsgConfigureMinter{From: controller.Address, Address: minter.Address, Allowance: sdk.NewCoin("uusdc", math.NewInt(amount))})
+		require.NoError(t, err)
+	}
+
+	entries := ftf.GetAllAuditEntries(ctx)
+	require.Len(t, entries, 2)
+	require.Equal(t, types.RoleMinterController, entries[1].Role)
+	require.Equal(t, "10uusdc", entries[1].Before)
+	require.Equal(t, "20uusdc", entries[1].After)
+}
+
+func TestPruneAuditLog(t *testing.T) {
+	ftf, ctx := keepertest.FiatTokenfactoryKeeper()
+	for height := int64(1); height <= 5; height++ {
+		ftf.SetAuditEntry(ctx, types.AuditEntry{Id: uint64(height - 1), Height: height, Denom: "uusdc"})
+	}
+
+	// without retention, entries are kept indefinitely
+	require.NoError(t, ftf.PruneAuditLog(ctx.WithBlockHeight(100)))
+	require.Len(t, ftf.GetAllAuditEntries(ctx), 5)
+
+	ftf.SetParams(ctx, types.Params{AuditLogRetention: 3})
+
+	require.NoError(t, ftf.PruneAuditLog(ctx.WithBlockHeight(3)))
+	require.Len(t, ftf.GetAllAuditEntries(ctx), 5)
+
+	require.NoError(t, ftf.PruneAuditLog(ctx.WithBlockHeight(6)))
+	entries := ftf.GetAllAuditEntries(ctx)
+	require.Len(t, entries, 2)
+	require.Equal(t, int64(4), entries[0].Height)
+	require.Equal(t, int64(5), entries[1].Height)
+}
+
+func TestSetAuditEntry_AdvancesSequence(t *testing.T) {
+	ftf, ctx := keepertest.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	pauser := sample.TestAccount()
+	ftf.SetPauser(ctx, types.Pauser{Denom: "uusdc", Address: pauser.Address})
+
+	ftf.SetAuditEntry(ctx, types.AuditEntry{Id: 7, Height: 1, Denom: "uusdc"})
+
+	ctx = ctx.WithBlockHeight(2)
+	_, err := msgServer.Pause(ctx, &types.MsgPause{From: pauser.Address, Denom: "uusdc"})
+	require.NoError(t, err)
+
+	entries := ftf.GetAllAuditEntries(ctx)
+	require.Len(t, entries, 2)
+	require.Equal(t, uint64(8), entries[1].Id)
+}
@@ -18,53 +18,132 @@ package keeper
 
 import (
 	"context"
+	"time"
 
-	"cosmossdk.io/store/prefix"
+	"cosmossdk.io/collections"
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
-	"github.com/cosmos/cosmos-sdk/runtime"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // SetBlacklisted set a specific blacklisted in the store from its index
 func (k Keeper) SetBlacklisted(ctx context.Context, blacklisted types.Blacklisted) {
-	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(adapter, types.KeyPrefix(types.BlacklistedKeyPrefix))
-	b := k.cdc.MustMarshal(&blacklisted)
-	store.Set(types.BlacklistedKey(blacklisted.AddressBz), b)
+	if prev, found := k.GetBlacklisted(ctx, blacklisted.Denom, blacklisted.AddressBz); found {
+		k.removeBlacklistExpiry(ctx, prev)
+	}
+	set(ctx, k.blacklisted, collections.Join(blacklisted.Denom, blacklisted.AddressBz), blacklisted)
+
+	if blacklisted.ExpiresAtHeight > 0 {
+		key := collections.Join3(blacklisted.ExpiresAtHeight, blacklisted.Denom, blacklisted.AddressBz)
+		if err := k.blacklistExpiryHeights.Set(ctx, key); err != nil {
+			panic(err)
+		}
+	}
+	if blacklisted.ExpiresAtTime != nil {
+		key := collections.Join3(*blacklisted.ExpiresAtTime, blacklisted.Denom, blacklisted.AddressBz)
+		if err := k.blacklistExpiryTimes.Set(ctx, key); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // GetBlacklisted returns a blacklisted from its index
-func (k Keeper) GetBlacklisted(ctx context.Context, addressBz []byte) (val types.Blacklisted, found bool) {
-	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(adapter, types.KeyPrefix(types.BlacklistedKeyPrefix))
+func (k Keeper) GetBlacklisted(ctx context.Context, denom string, addressBz []byte) (val types.Blacklisted, found bool) {
+	return get(ctx, k.blacklisted, collections.Join(denom, addressBz))
+}
 
-	b := store.Get(types.BlacklistedKey(addressBz))
-	if b == nil {
-		return val, false
+// RemoveBlacklisted removes a blacklisted from the store
+func (k Keeper) RemoveBlacklisted(ctx context.Context, denom string, addressBz []byte) {
+	if prev, found := k.GetBlacklisted(ctx, denom, addressBz); found {
+		k.removeBlacklistExpiry(ctx, prev)
 	}
+	remove(ctx, k.blacklisted, collections.Join(denom, addressBz))
+}
 
-	k.cdc.MustUnmarshal(b, &val)
-	return val, true
+// GetAllBlacklisted returns all blacklisted of all minting denoms
+func (k Keeper) GetAllBlacklisted(ctx context.Context) []types.Blacklisted {
+	return values(ctx, k.blacklisted, nil)
 }
 
-// RemoveBlacklisted removes a blacklisted from the store
-func (k Keeper) RemoveBlacklisted(ctx context.Context, addressBz []byte) {
-	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(adapter, types.KeyPrefix(types.BlacklistedKeyPrefix))
-	store.Delete(types.BlacklistedKey(addressBz))
+// removeBlacklistExpiry removes a blacklisted from the expiry indexes.
+func (k Keeper) removeBlacklistExpiry(ctx context.Context, blacklisted types.Blacklisted) {
+	if blacklisted.ExpiresAtHeight > 0 {
+		key := collections.Join3(blacklisted.ExpiresAtHeight, blacklisted.Denom, blacklisted.AddressBz)
+		if err := k.blacklistExpiryHeights.Remove(ctx, key); err != nil {
+			panic(err)
+		}
+	}
+	if blacklisted.ExpiresAtTime != nil {
+		key := collections.Join3(*blacklisted.ExpiresAtTime, blacklisted.Denom, blacklisted.AddressBz)
+		if err := k.blacklistExpiryTimes.Remove(ctx, key); err != nil {
+			panic(err)
+		}
+	}
 }
 
-// GetAllBlacklisted returns all blacklisted
-func (k Keeper) GetAllBlacklisted(ctx context.Context) (list []types.Blacklisted) {
-	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(adapter, types.KeyPrefix(types.BlacklistedKeyPrefix))
-	iterator := store.Iterator(nil, nil)
-	defer iterator.Close()
-
-	for ; iterator.Valid(); iterator.Next() {
-		var val types.Blacklisted
-		k.cdc.MustUnmarshal(iterator.Value(), &val)
-		list = append(list, val)
+// ExpireBlacklisted removes the blacklisted entries whose expiry height or
+// time has been reached. Only the expired part of the expiry indexes is
+// iterated.
+func (k Keeper) ExpireBlacklisted(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var expired []collections.Pair[string, []byte]
+
+	heights, err := k.blacklistExpiryHeights.Iterate(ctx, collections.NewPrefixUntilTripleRange[int64, string, []byte](sdkCtx.BlockHeight()))
+	if err != nil {
+		return err
+	}
+	keys, err := heights.Keys()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		expired = append(expired, collections.Join(key.K2(), key.K3()))
+	}
+
+	times, err := k.blacklistExpiryTimes.Iterate(ctx, collections.NewPrefixUntilTripleRange[time.Time, string, []byte](sdkCtx.BlockTime()))
+	if err != nil {
+		return err
+	}
+	timeKeys, err := times.Keys()
+	if err != nil {
+		return err
+	}
+	for _, key := range timeKeys {
+		expired = append(expired, collections.Join(key.K2(), key.K3()))
+	}
+
+	module := authtypes.NewModuleAddress(types.ModuleName).String()
+	for _, key := range expired {
+		// an entry that reached both of its expiries is only removed once
+		if _, found := k.GetBlacklisted(ctx, key.K1(), key.K2()); !found {
+			continue
+		}
+		k.RemoveBlacklisted(ctx, key.K1(), key.K2())
+
+		// the entry may list an address of any chain, so it is rendered as hex
+		address := types.HexAddress(key.K2())
+		if err := k.recordAudit(sdkCtx, types.AuditEntry{
+			Denom:  key.K1(),
+			Actor:  module,
+			Role:   types.RoleModule,
+			Action: types.ActionUnblacklist,
+			Target: address,
+			Before: "true",
+			After:  "false",
+		}); err != nil {
+			return err
+		}
+
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventUnblacklisted{
+			Denom:   key.K1(),
+			Address: address,
+			Expired: true,
+		}); err != nil {
+			return err
+		}
 	}
 
-	return
+	return nil
 }
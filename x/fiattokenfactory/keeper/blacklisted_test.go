@@ -17,8 +17,10 @@
 package keeper_test
 
 import (
+	"encoding/hex"
 	"strconv"
 	"testing"
+	"time"
 
 	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
 	"github.com/circlefin/noble-fiattokenfactory/testutil/nullify"
@@ -26,6 +28,7 @@ import (
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -43,6 +46,7 @@ func createNBlacklisted(keeper *keeper.Keeper, ctx sdk.Context, n int) []blackli
 		acc := sample.TestAccount()
 		items[i].address = acc.Address
 		items[i].bl.AddressBz = acc.AddressBz
+		items[i].bl.Denom = "uusdc"
 
 		keeper.SetBlacklisted(ctx, items[i].bl)
 	}
@@ -55,6 +59,7 @@ func createNBlacklistedBech32m(keeper *keeper.Keeper, ctx sdk.Context, n int) []
 		acc := sample.TestAccountBech32m()
 		items[i].address = acc.Address
 		items[i].bl.AddressBz = acc.AddressBz
+		items[i].bl.Denom = "uusdc"
 
 		keeper.SetBlacklisted(ctx, items[i].bl)
 	}
@@ -65,8 +70,8 @@ func TestBlacklistedGetAndSet_Success(t *testing.T) {
 	accBech32, accBech32M := sample.TestAccount(), sample.TestAccountBech32m()
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	keeper.SetBlacklisted(ctx, types.Blacklisted{AddressBz: accBech32.AddressBz})
-	keeper.SetBlacklisted(ctx, types.Blacklisted{AddressBz: accBech32M.AddressBz})
+	keeper.SetBlacklisted(ctx, types.Blacklisted{Denom: "uusdc", AddressBz: accBech32.AddressBz})
+	keeper.SetBlacklisted(ctx, types.Blacklisted{Denom: "uusdc", AddressBz: accBech32M.AddressBz})
 
 	assertAccountIsBlacklisted(t, *keeper, ctx, accBech32)
 	assertAccountIsBlacklisted(t, *keeper, ctx, accBech32M)
@@ -84,13 +89,13 @@ func TestBlacklistedSet_AlreadyBlacklisted(t *testing.T) {
 	accBech32, accBech32M := sample.TestAccount(), sample.TestAccountBech32m()
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	keeper.SetBlacklisted(ctx, types.Blacklisted{AddressBz: accBech32.AddressBz})
-	keeper.SetBlacklisted(ctx, types.Blacklisted{AddressBz: accBech32M.AddressBz})
+	keeper.SetBlacklisted(ctx, types.Blacklisted{Denom: "uusdc", AddressBz: accBech32.AddressBz})
+	keeper.SetBlacklisted(ctx, types.Blacklisted{Denom: "uusdc", AddressBz: accBech32M.AddressBz})
 	assertAccountIsBlacklisted(t, *keeper, ctx, accBech32)
 	assertAccountIsBlacklisted(t, *keeper, ctx, accBech32M)
 
-	keeper.SetBlacklisted(ctx, types.Blacklisted{AddressBz: accBech32.AddressBz})
-	keeper.SetBlacklisted(ctx, types.Blacklisted{AddressBz: accBech32M.AddressBz})
+	keeper.SetBlacklisted(ctx, types.Blacklisted{Denom: "uusdc", AddressBz: accBech32.AddressBz})
+	keeper.SetBlacklisted(ctx, types.Blacklisted{Denom: "uusdc", AddressBz: accBech32M.AddressBz})
 
 	assertAccountIsBlacklisted(t, *keeper, ctx, accBech32)
 	assertAccountIsBlacklisted(t, *keeper, ctx, accBech32M)
@@ -109,8 +114,8 @@ func TestBlacklistedRemove_AccountNotBlacklisted(t *testing.T) {
 	accBech32, accBech32M := sample.TestAccount(), sample.TestAccountBech32m()
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	keeper.RemoveBlacklisted(ctx, accBech32.AddressBz)
-	keeper.RemoveBlacklisted(ctx, accBech32M.AddressBz)
+	keeper.RemoveBlacklisted(ctx, "uusdc", accBech32.AddressBz)
+	keeper.RemoveBlacklisted(ctx, "uusdc", accBech32M.AddressBz)
 
 	assertAccountIsNotBlacklisted(t, *keeper, ctx, accBech32)
 	assertAccountIsNotBlacklisted(t, *keeper, ctx, accBech32M)
@@ -120,14 +125,14 @@ func TestBlacklistedRemove_Success(t *testing.T) {
 	accBech32, accBech32M := sample.TestAccount(), sample.TestAccountBech32m()
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	keeper.SetBlacklisted(ctx, types.Blacklisted{AddressBz: accBech32.AddressBz})
-	keeper.SetBlacklisted(ctx, types.Blacklisted{AddressBz: accBech32M.AddressBz})
+	keeper.SetBlacklisted(ctx, types.Blacklisted{Denom: "uusdc", AddressBz: accBech32.AddressBz})
+	keeper.SetBlacklisted(ctx, types.Blacklisted{Denom: "uusdc", AddressBz: accBech32M.AddressBz})
 
 	assertAccountIsBlacklisted(t, *keeper, ctx, accBech32)
 	assertAccountIsBlacklisted(t, *keeper, ctx, accBech32M)
 
-	keeper.RemoveBlacklisted(ctx, accBech32.AddressBz)
-	keeper.RemoveBlacklisted(ctx, accBech32M.AddressBz)
+	keeper.RemoveBlacklisted(ctx, "uusdc", accBech32.AddressBz)
+	keeper.RemoveBlacklisted(ctx, "uusdc", accBech32M.AddressBz)
 
 	assertAccountIsNotBlacklisted(t, *keeper, ctx, accBech32)
 	assertAccountIsNotBlacklisted(t, *keeper, ctx, accBech32M)
@@ -140,12 +145,25 @@ func TestBlacklistedRemove_Multiple(t *testing.T) {
 	for _, item := range items {
 		assertAddressIsBlacklisted(t, *keeper, ctx, item.bl)
 
-		keeper.RemoveBlacklisted(ctx, item.bl.AddressBz)
+		keeper.RemoveBlacklisted(ctx, item.bl.Denom, item.bl.AddressBz)
 
 		assertAddressIsNotBlacklisted(t, *keeper, ctx, item.bl)
 	}
 }
 
+func TestBlacklisted_ScopedToDenom(t *testing.T) {
+	acc := sample.TestAccount()
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	keeper.SetBlacklisted(ctx, types.Blacklisted{Denom: "uusdc", AddressBz: acc.AddressBz})
+
+	assertAccountIsBlacklisted(t, *keeper, ctx, acc)
+	assertAddressIsNotBlacklisted(t, *keeper, ctx, types.Blacklisted{Denom: "ueurc", AddressBz: acc.AddressBz})
+
+	keeper.RemoveBlacklisted(ctx, "ueurc", acc.AddressBz)
+	assertAccountIsBlacklisted(t, *keeper, ctx, acc)
+}
+
 func TestBlacklistedGetAll_EmptyBlacklist(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
@@ -168,11 +186,11 @@ func TestBlacklistedGetAll_NonEmptyBlacklist(t *testing.T) {
 }
 
 func assertAccountIsBlacklisted(t *testing.T, keeper keeper.Keeper, ctx sdk.Context, acc sample.Account) {
-	assertAddressIsBlacklisted(t, keeper, ctx, types.Blacklisted{AddressBz: acc.AddressBz})
+	assertAddressIsBlacklisted(t, keeper, ctx, types.Blacklisted{Denom: "uusdc", AddressBz: acc.AddressBz})
 }
 
 func assertAddressIsBlacklisted(t *testing.T, keeper keeper.Keeper, ctx sdk.Context, bl types.Blacklisted) {
-	rst, found := keeper.GetBlacklisted(ctx, bl.AddressBz)
+	rst, found := keeper.GetBlacklisted(ctx, bl.Denom, bl.AddressBz)
 	require.True(t, found)
 	require.Equal(t,
 		nullify.Fill(&bl),
@@ -181,10 +199,89 @@ func assertAddressIsBlacklisted(t *testing.T, keeper keeper.Keeper, ctx sdk.Cont
 }
 
 func assertAccountIsNotBlacklisted(t *testing.T, keeper keeper.Keeper, ctx sdk.Context, acc sample.Account) {
-	assertAddressIsNotBlacklisted(t, keeper, ctx, types.Blacklisted{AddressBz: acc.AddressBz})
+	assertAddressIsNotBlacklisted(t, keeper, ctx, types.Blacklisted{Denom: "uusdc", AddressBz: acc.AddressBz})
 }
 
 func assertAddressIsNotBlacklisted(t *testing.T, keeper keeper.Keeper, ctx sdk.Context, bl types.Blacklisted) {
-	_, found := keeper.GetBlacklisted(ctx, bl.AddressBz)
+	_, found := keeper.GetBlacklisted(ctx, bl.Denom, bl.AddressBz)
+	require.False(t, found)
+}
+
+func TestExpireBlacklisted_Height(t *testing.T) {
+	expiring, permanent := sample.TestAccount(), sample.TestAccount()
+	ftf, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	ftf.SetBlacklisted(ctx, types.Blacklisted{Denom: "uusdc", AddressBz: expiring.AddressBz, ExpiresAtHeight: 10})
+	ftf.SetBlacklisted(ctx, types.Blacklisted{Denom: "uusdc", AddressBz: permanent.AddressBz})
+
+	require.NoError(t, ftf.ExpireBlacklisted(ctx.WithBlockHeight(9)))
+	_, found := ftf.GetBlacklisted(ctx, "uusdc", expiring.AddressBz)
+	require.True(t, found)
+
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, ftf.ExpireBlacklisted(ctx))
+	_, found = ftf.GetBlacklisted(ctx, "uusdc", expiring.AddressBz)
+	require.False(t, found)
+	assertAccountIsBlacklisted(t, *ftf, ctx, permanent)
+
+	module := authtypes.NewModuleAddress(types.ModuleName).String()
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "circle.fiattokenfactory.v1.EventUnblacklisted", events[0].Type)
+	expired, found := events[0].GetAttribute("expired")
+	require.True(t, found)
+	require.Equal(t, "true", expired.Value)
+	address, found := events[0].GetAttribute("address")
+	require.True(t, found)
+	require.Equal(t, strconv.Quote("0x"+hex.EncodeToString(expiring.AddressBz)), address.Value)
+
+	entries := ftf.GetAllAuditEntries(ctx)
+	require.Equal(t, "0x"+hex.EncodeToString(expiring.AddressBz), entries[len(entries)-1].Target)
+	require.Equal(t, module, entries[len(entries)-1].Actor)
+	require.Equal(t, types.RoleModule, entries[len(entries)-1].Role)
+	require.Equal(t, types.ActionUnblacklist, entries[len(entries)-1].Action)
+}
+
+func TestExpireBlacklisted_Time(t *testing.T) {
+	user := sample.TestAccount()
+	ftf, ctx := keepertest.FiatTokenfactoryKeeper()
+	expiry := time.Unix(1700000000, 0).UTC()
+
+	// an entry reaching both of its expiries in the same block is lifted once
+	ftf.SetBlacklisted(ctx, types.Blacklisted{Denom: "uusdc", AddressBz: user.AddressBz, ExpiresAtHeight: 5, ExpiresAtTime: &expiry})
+
+	require.NoError(t, ftf.ExpireBlacklisted(ctx.WithBlockHeight(1).WithBlockTime(expiry.Add(-time.Second))))
+	_, found := ftf.GetBlacklisted(ctx, "uusdc", user.AddressBz)
+	require.True(t, found)
+
+	ctx = ctx.WithBlockHeight(5).WithBlockTime(expiry).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, ftf.ExpireBlacklisted(ctx))
+	_, found = ftf.GetBlacklisted(ctx, "uusdc", user.AddressBz)
+	require.False(t, found)
+	require.Len(t, ctx.EventManager().Events(), 1)
+}
+
+func TestExpireBlacklisted_IndexFollowsEntry(t *testing.T) {
+	user := sample.TestAccount()
+	ftf, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	// lifting an expiring entry early and blacklisting the address again
+	// without expiry must not leave the previous expiry behind
+	ftf.SetBlacklisted(ctx, types.Blacklisted{Denom: "uusdc", AddressBz: user.AddressBz, ExpiresAtHeight: 10})
+	ftf.RemoveBlacklisted(ctx, "uusdc", user.AddressBz)
+	ftf.SetBlacklisted(ctx, types.Blacklisted{Denom: "uusdc", AddressBz: user.AddressBz})
+
+	require.NoError(t, ftf.ExpireBlacklisted(ctx.WithBlockHeight(10)))
+	assertAccountIsBlacklisted(t, *ftf, ctx, user)
+
+	// overwriting an entry replaces its expiry
+	ftf.SetBlacklisted(ctx, types.Blacklisted{Denom: "uusdc", AddressBz: user.AddressBz, ExpiresAtHeight: 20})
+	ftf.SetBlacklisted(ctx, types.Blacklisted{Denom: "uusdc", AddressBz: user.AddressBz, ExpiresAtHeight: 30})
+
+	require.NoError(t, ftf.ExpireBlacklisted(ctx.WithBlockHeight(20)))
+	_, found := ftf.GetBlacklisted(ctx, "uusdc", user.AddressBz)
+	require.True(t, found)
+	require.NoError(t, ftf.ExpireBlacklisted(ctx.WithBlockHeight(30)))
+	_, found = ftf.GetBlacklisted(ctx, "uusdc", user.AddressBz)
 	require.False(t, found)
 }
@@ -20,26 +20,19 @@ import (
 	"context"
 
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
-
-	"github.com/cosmos/cosmos-sdk/runtime"
 )
 
 // SetBlacklister set blacklister in the store
 func (k Keeper) SetBlacklister(ctx context.Context, blacklister types.Blacklister) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	b := k.cdc.MustMarshal(&blacklister)
-	store.Set(types.KeyPrefix(types.BlacklisterKey), b)
+	set(ctx, k.blacklisters, blacklister.Denom, blacklister)
 }
 
-// GetBlacklister returns blacklister
-func (k Keeper) GetBlacklister(ctx context.Context) (val types.Blacklister, found bool) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-
-	b := store.Get(types.KeyPrefix(types.BlacklisterKey))
-	if b == nil {
-		return val, false
-	}
+// GetBlacklister returns the blacklister of a minting denom
+func (k Keeper) GetBlacklister(ctx context.Context, denom string) (val types.Blacklister, found bool) {
+	return get(ctx, k.blacklisters, denom)
+}
 
-	k.cdc.MustUnmarshal(b, &val)
-	return val, true
+// GetAllBlacklisters returns the blacklisters of all minting denoms
+func (k Keeper) GetAllBlacklisters(ctx context.Context) []types.Blacklister {
+	return values(ctx, k.blacklisters, nil)
 }
@@ -30,7 +30,7 @@ import (
 )
 
 func createTestBlacklister(keeper *keeper.Keeper, ctx sdk.Context) types.Blacklister {
-	item := types.Blacklister{}
+	item := types.Blacklister{Denom: "uusdc"}
 	keeper.SetBlacklister(ctx, item)
 	return item
 }
@@ -39,7 +39,7 @@ func TestBlacklisterGetAndSet_Success(t *testing.T) {
 	blacklister := sample.TestAccount()
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	keeper.SetBlacklister(ctx, types.Blacklister{Address: blacklister.Address})
+	keeper.SetBlacklister(ctx, types.Blacklister{Denom: "uusdc", Address: blacklister.Address})
 
 	assertBlacklister(t, *keeper, ctx, blacklister)
 }
@@ -47,26 +47,26 @@ func TestBlacklisterGetAndSet_Success(t *testing.T) {
 func TestBlacklisterSet_Overwrite(t *testing.T) {
 	blacklister := sample.TestAccount()
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
-	keeper.SetBlacklister(ctx, types.Blacklister{Address: blacklister.Address})
+	keeper.SetBlacklister(ctx, types.Blacklister{Denom: "uusdc", Address: blacklister.Address})
 	assertBlacklister(t, *keeper, ctx, blacklister)
 
 	newBlacklister := sample.TestAccount()
-	keeper.SetBlacklister(ctx, types.Blacklister{Address: newBlacklister.Address})
+	keeper.SetBlacklister(ctx, types.Blacklister{Denom: "uusdc", Address: newBlacklister.Address})
 	assertBlacklister(t, *keeper, ctx, newBlacklister)
 }
 
 func TestBlacklisterGet_Unset(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	_, found := keeper.GetBlacklister(ctx)
+	_, found := keeper.GetBlacklister(ctx, "uusdc")
 	require.False(t, found)
 }
 
 func assertBlacklister(t *testing.T, keeper keeper.Keeper, ctx sdk.Context, expectedBlacklister sample.Account) {
-	rst, found := keeper.GetBlacklister(ctx)
+	rst, found := keeper.GetBlacklister(ctx, "uusdc")
 	require.True(t, found)
 	require.Equal(t,
-		nullify.Fill(&types.Blacklister{Address: expectedBlacklister.Address}),
+		nullify.Fill(&types.Blacklister{Denom: "uusdc", Address: expectedBlacklister.Address}),
 		nullify.Fill(&rst),
 	)
 }
@@ -0,0 +1,75 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+// SetChannelPolicy set the policy of an IBC channel for a minting denom in the store
+func (k Keeper) SetChannelPolicy(ctx context.Context, policy types.ChannelPolicy) {
+	set(ctx, k.channelPolicies, collections.Join(policy.Denom, policy.ChannelId), policy)
+}
+
+// GetChannelPolicy returns the policy of an IBC channel for a minting denom
+func (k Keeper) GetChannelPolicy(ctx context.Context, denom string, channelID string) (val types.ChannelPolicy, found bool) {
+	return get(ctx, k.channelPolicies, collections.Join(denom, channelID))
+}
+
+// RemoveChannelPolicy removes the policy of an IBC channel for a minting denom from the store
+func (k Keeper) RemoveChannelPolicy(ctx context.Context, denom string, channelID string) {
+	remove(ctx, k.channelPolicies, collections.Join(denom, channelID))
+}
+
+// GetAllChannelPolicies returns the channel policies of all minting denoms
+func (k Keeper) GetAllChannelPolicies(ctx context.Context) []types.ChannelPolicy {
+	return values(ctx, k.channelPolicies, nil)
+}
+
+// IsInboundChannelAllowed reports whether a minting denom may be received
+// through an IBC channel.
+func (k Keeper) IsInboundChannelAllowed(ctx context.Context, denom string, channelID string) bool {
+	policy, enforced := k.channelPolicy(ctx, denom, channelID)
+	return !enforced || policy.AllowInbound
+}
+
+// IsOutboundChannelAllowed reports whether a minting denom may be sent
+// through an IBC channel.
+func (k Keeper) IsOutboundChannelAllowed(ctx context.Context, denom string, channelID string) bool {
+	policy, enforced := k.channelPolicy(ctx, denom, channelID)
+	return !enforced || policy.AllowOutbound
+}
+
+// channelPolicy returns the policy of an IBC channel for a minting denom, and
+// whether the channel allowlist of the denom is enforced at all. A denom
+// without any channel policy may move through every channel.
+func (k Keeper) channelPolicy(ctx context.Context, denom string, channelID string) (types.ChannelPolicy, bool) {
+	if policy, found := k.GetChannelPolicy(ctx, denom, channelID); found {
+		return policy, true
+	}
+
+	iter, err := k.channelPolicies.Iterate(ctx, collections.NewPrefixedPairRange[string, string](denom))
+	if err != nil {
+		panic(err)
+	}
+	defer iter.Close()
+
+	return types.ChannelPolicy{}, iter.Valid()
+}
@@ -0,0 +1,138 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetChannelRateLimit set the rate limit of an IBC channel for a minting denom in the store
+func (k Keeper) SetChannelRateLimit(ctx context.Context, rateLimit types.ChannelRateLimit) {
+	set(ctx, k.channelRateLimits, collections.Join(rateLimit.Denom, rateLimit.ChannelId), rateLimit)
+}
+
+// GetChannelRateLimit returns the rate limit of an IBC channel for a minting denom
+func (k Keeper) GetChannelRateLimit(ctx context.Context, denom string, channelID string) (val types.ChannelRateLimit, found bool) {
+	return get(ctx, k.channelRateLimits, collections.Join(denom, channelID))
+}
+
+// RemoveChannelRateLimit removes the rate limit of an IBC channel for a minting denom
+func (k Keeper) RemoveChannelRateLimit(ctx context.Context, denom string, channelID string) {
+	remove(ctx, k.channelRateLimits, collections.Join(denom, channelID))
+}
+
+// GetAllChannelRateLimits returns the channel rate limits of all minting denoms
+func (k Keeper) GetAllChannelRateLimits(ctx context.Context) []types.ChannelRateLimit {
+	return values(ctx, k.channelRateLimits, nil)
+}
+
+// SetPendingSendPacket set a pending send packet in the store
+func (k Keeper) SetPendingSendPacket(ctx context.Context, packet types.PendingSendPacket) {
+	set(ctx, k.pendingSendPackets, collections.Join(packet.ChannelId, packet.Sequence), packet)
+}
+
+// GetPendingSendPacket returns a pending send packet from its source channel and sequence
+func (k Keeper) GetPendingSendPacket(ctx context.Context, channelID string, sequence uint64) (val types.PendingSendPacket, found bool) {
+	return get(ctx, k.pendingSendPackets, collections.Join(channelID, sequence))
+}
+
+// RemovePendingSendPacket removes a pending send packet from the store
+func (k Keeper) RemovePendingSendPacket(ctx context.Context, channelID string, sequence uint64) {
+	remove(ctx, k.pendingSendPackets, collections.Join(channelID, sequence))
+}
+
+// GetAllPendingSendPackets returns all pending send packets
+func (k Keeper) GetAllPendingSendPackets(ctx context.Context) []types.PendingSendPacket {
+	return values(ctx, k.pendingSendPackets, nil)
+}
+
+// ConsumeChannelInflow counts an amount of a minting denom received through
+// an IBC channel against the rate limit of the channel, if any.
+func (k Keeper) ConsumeChannelInflow(ctx context.Context, denom string, channelID string, amount math.Int) error {
+	rateLimit, found := k.GetChannelRateLimit(ctx, denom, channelID)
+	if !found {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := rateLimit.ConsumeInflow(amount, sdkCtx.BlockHeight(), sdkCtx.BlockTime()); err != nil {
+		return err
+	}
+
+	k.SetChannelRateLimit(ctx, rateLimit)
+	return nil
+}
+
+// ConsumeChannelOutflow counts an amount of a minting denom sent through an
+// IBC channel against the rate limit of the channel, if any. The transfer is
+// recorded as pending once sent, see RecordPendingSendPacket.
+func (k Keeper) ConsumeChannelOutflow(ctx context.Context, denom string, channelID string, amount math.Int) error {
+	rateLimit, found := k.GetChannelRateLimit(ctx, denom, channelID)
+	if !found {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := rateLimit.ConsumeOutflow(amount, sdkCtx.BlockHeight(), sdkCtx.BlockTime()); err != nil {
+		return err
+	}
+
+	k.SetChannelRateLimit(ctx, rateLimit)
+	return nil
+}
+
+// RecordPendingSendPacket records an outgoing transfer counted against the
+// rate limit of its channel, along with the bucket it was counted in.
+func (k Keeper) RecordPendingSendPacket(ctx context.Context, denom string, channelID string, sequence uint64) {
+	rateLimit, found := k.GetChannelRateLimit(ctx, denom, channelID)
+	if !found {
+		return
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	k.SetPendingSendPacket(ctx, types.PendingSendPacket{
+		ChannelId: channelID,
+		Sequence:  sequence,
+		Denom:     denom,
+		Bucket:    rateLimit.Bucket(sdkCtx.BlockHeight(), sdkCtx.BlockTime()),
+	})
+}
+
+// UndoChannelOutflow reverts the outflow of a failed outgoing transfer. The
+// outflow is only reverted while the bucket the transfer was counted in is
+// within the window, as the window no longer holds it afterwards.
+func (k Keeper) UndoChannelOutflow(ctx context.Context, channelID string, sequence uint64, amount math.Int) {
+	pending, found := k.GetPendingSendPacket(ctx, channelID, sequence)
+	if !found {
+		return
+	}
+	k.RemovePendingSendPacket(ctx, channelID, sequence)
+
+	rateLimit, found := k.GetChannelRateLimit(ctx, pending.Denom, channelID)
+	if !found {
+		return
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	rateLimit.UndoOutflow(amount, pending.Bucket, sdkCtx.BlockHeight(), sdkCtx.BlockTime())
+	k.SetChannelRateLimit(ctx, rateLimit)
+}
@@ -0,0 +1,65 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) AllowlistedAll(ctx context.Context, req *types.QueryAllAllowlistedRequest) (*types.QueryAllAllowlistedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var opts []func(opt *query.CollectionsPaginateOptions[collections.Pair[string, []byte]])
+	if req.Denom != "" {
+		opts = append(opts, query.WithCollectionPaginationPairPrefix[string, []byte](req.Denom))
+	}
+
+	allowlisted, pageRes, err := query.CollectionPaginate(ctx, k.allowlisted, req.Pagination, func(_ collections.Pair[string, []byte], value types.Allowlisted) (types.Allowlisted, error) {
+		return value, nil
+	}, opts...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllAllowlistedResponse{Allowlisted: allowlisted, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Allowlisted(ctx context.Context, req *types.QueryGetAllowlistedRequest) (*types.QueryGetAllowlistedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addressBz, err := k.DecodeAddress(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	val, found := k.GetAllowlisted(ctx, req.Denom, addressBz)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetAllowlistedResponse{Allowlisted: val}, nil
+}
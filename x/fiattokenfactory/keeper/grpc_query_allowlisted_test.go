@@ -0,0 +1,91 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+func TestAllowlistedQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	acc := sample.TestAccount()
+	expected := types.Allowlisted{Denom: "uusdc", AddressBz: acc.AddressBz}
+	keeper.SetAllowlisted(ctx, expected)
+
+	res, err := keeper.Allowlisted(ctx, &types.QueryGetAllowlistedRequest{Address: acc.Address, Denom: "uusdc"})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryGetAllowlistedResponse{Allowlisted: expected}, res)
+
+	_, err = keeper.Allowlisted(ctx, &types.QueryGetAllowlistedRequest{Address: acc.Address, Denom: "ueurc"})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+
+	_, err = keeper.Allowlisted(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
+
+func TestAllowlistedQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	msgs := createNAllowlisted(keeper, ctx, 5)
+	keeper.SetAllowlisted(ctx, types.Allowlisted{Denom: "ueurc", AddressBz: sample.AddressBz()})
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllAllowlistedRequest {
+		return &types.QueryAllAllowlistedRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+			Denom: "uusdc",
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		var allowlisted []types.Allowlisted
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.AllowlistedAll(ctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Allowlisted), step)
+			allowlisted = append(allowlisted, resp.Allowlisted...)
+		}
+		require.ElementsMatch(t, msgs, allowlisted)
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.AllowlistedAll(ctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t, msgs, resp.Allowlisted)
+	})
+	t.Run("AllDenoms", func(t *testing.T) {
+		resp, err := keeper.AllowlistedAll(ctx, &types.QueryAllAllowlistedRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.Allowlisted, len(msgs)+1)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.AllowlistedAll(ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
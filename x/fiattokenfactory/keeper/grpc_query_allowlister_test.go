@@ -0,0 +1,63 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+func TestAllowlisterQuery_NoAllowlisterConfigured(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	_, err := keeper.Allowlister(ctx, &types.QueryGetAllowlisterRequest{Denom: "uusdc"})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+}
+
+func TestAllowlisterQuery(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	expectedAllowlister := createTestAllowlister(keeper, ctx)
+
+	res, err := keeper.Allowlister(ctx, &types.QueryGetAllowlisterRequest{Denom: "uusdc"})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryGetAllowlisterResponse{Allowlister: expectedAllowlister}, res)
+
+	_, err = keeper.Allowlister(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
+
+func TestAllowlistModeQuery(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	res, err := keeper.AllowlistMode(ctx, &types.QueryAllowlistModeRequest{Denom: "uusdc"})
+	require.NoError(t, err)
+	require.False(t, res.Enabled)
+
+	keeper.SetAllowlistEnabled(ctx, "uusdc", true)
+	res, err = keeper.AllowlistMode(ctx, &types.QueryAllowlistModeRequest{Denom: "uusdc"})
+	require.NoError(t, err)
+	require.True(t, res.Enabled)
+
+	_, err = keeper.AllowlistMode(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
@@ -0,0 +1,134 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) AuditLog(ctx context.Context, req *types.QueryAuditLogRequest) (*types.QueryAuditLogResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.MinHeight < 0 || req.MaxHeight < 0 || (req.MaxHeight != 0 && req.MinHeight > req.MaxHeight) {
+		return nil, status.Error(codes.InvalidArgument, "invalid height range")
+	}
+
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) != 0 && pageReq.Offset != 0 {
+		return nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	limit = min(limit, types.MaxAuditLogLimit)
+
+	// entries are keyed by (height, id), so the height bounds of the request
+	// delimit the iterated range and entries outside of it are never read
+	ranger := new(collections.Range[collections.Pair[int64, uint64]])
+	if req.MinHeight != 0 {
+		ranger = ranger.StartInclusive(collections.Join(req.MinHeight, uint64(0)))
+	}
+	if req.MaxHeight != 0 {
+		ranger = ranger.EndExclusive(collections.Join(req.MaxHeight+1, uint64(0)))
+	}
+	if len(pageReq.Key) != 0 {
+		_, key, err := k.auditLog.KeyCodec().Decode(pageReq.Key)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+		}
+		if pageReq.Reverse {
+			ranger = ranger.EndInclusive(key)
+		} else {
+			ranger = ranger.StartInclusive(key)
+		}
+	}
+	if pageReq.Reverse {
+		ranger = ranger.Descending()
+	}
+
+	iterator, err := k.auditLog.Iterate(ctx, ranger)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer iterator.Close()
+
+	var entries []types.AuditEntry
+	var nextKey []byte
+	var total uint64
+	for ; iterator.Valid(); iterator.Next() {
+		kv, err := iterator.KeyValue()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if !matchAuditEntry(req, kv.Value) {
+			continue
+		}
+		total++
+
+		switch {
+		case total <= pageReq.Offset:
+		case uint64(len(entries)) < limit:
+			entries = append(entries, kv.Value)
+		default:
+			if nextKey == nil {
+				nextKey, err = collections.EncodeKeyWithPrefix(nil, k.auditLog.KeyCodec(), kv.Key)
+				if err != nil {
+					return nil, status.Error(codes.Internal, err.Error())
+				}
+			}
+			if !pageReq.CountTotal {
+				return &types.QueryAuditLogResponse{Entries: entries, Pagination: &query.PageResponse{NextKey: nextKey}}, nil
+			}
+		}
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if pageReq.CountTotal {
+		pageRes.Total = total
+	}
+	return &types.QueryAuditLogResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// matchAuditEntry reports whether an audit log entry matches the filters of
+// the request.
+func matchAuditEntry(req *types.QueryAuditLogRequest, entry types.AuditEntry) bool {
+	switch {
+	case req.Denom != "" && entry.Denom != req.Denom:
+		return false
+	case req.Role != "" && entry.Role != req.Role:
+		return false
+	case req.Action != "" && entry.Action != req.Action:
+		return false
+	case req.MinHeight != 0 && entry.Height < req.MinHeight:
+		return false
+	case req.MaxHeight != 0 && entry.Height > req.MaxHeight:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,189 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/nullify"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+func TestAuditLogQuery(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	entries := []types.AuditEntry{
+		{Id: 0, Height: 1, Denom: "uusdc", Role: types.RolePauser, Action: types.ActionPause},
+		{Id: 1, Height: 2, Denom: "uusdc", Role: types.RoleBlacklister, Action: types.ActionBlacklist},
+		{Id: 2, Height: 3, Denom: "ueurc", Role: types.RolePauser, Action: types.ActionUnpause},
+		{Id: 3, Height: 4, Denom: "uusdc", Role: types.RolePauser, Action: types.ActionUnpause},
+	}
+	for _, entry := range entries {
+		keeper.SetAuditEntry(ctx, entry)
+	}
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryAuditLogRequest
+		response []types.AuditEntry
+		err      error
+	}{
+		{
+			desc:     "All",
+			request:  &types.QueryAuditLogRequest{},
+			response: entries,
+		},
+		{
+			desc:     "ByDenom",
+			request:  &types.QueryAuditLogRequest{Denom: "ueurc"},
+			response: entries[2:3],
+		},
+		{
+			desc:     "ByRole",
+			request:  &types.QueryAuditLogRequest{Role: types.RolePauser},
+			response: []types.AuditEntry{entries[0], entries[2], entries[3]},
+		},
+		{
+			desc:     "ByRoleAndAction",
+			request:  &types.QueryAuditLogRequest{Role: types.RolePauser, Action: types.ActionUnpause},
+			response: entries[2:],
+		},
+		{
+			desc:     "ByHeightRange",
+			request:  &types.QueryAuditLogRequest{MinHeight: 2, MaxHeight: 3},
+			response: entries[1:3],
+		},
+		{
+			desc:     "ByMinHeight",
+			request:  &types.QueryAuditLogRequest{MinHeight: 4},
+			response: entries[3:],
+		},
+		{
+			desc:    "InvalidHeightRange",
+			request: &types.QueryAuditLogRequest{MinHeight: 3, MaxHeight: 2},
+			err:     status.Error(codes.InvalidArgument, "invalid height range"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.AuditLog(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, nullify.Fill(tc.response), nullify.Fill(response.Entries))
+			}
+		})
+	}
+}
+
+func TestAuditLogQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	var entries []types.AuditEntry
+	for i := 0; i < 5; i++ {
+		entry := types.AuditEntry{Id: uint64(i), Height: int64(i + 1), Denom: "uusdc", Role: types.RolePauser}
+		keeper.SetAuditEntry(ctx, entry)
+		entries = append(entries, entry)
+	}
+	// entries of other roles are skipped without affecting the page size
+	keeper.SetAuditEntry(ctx, types.AuditEntry{Id: 5, Height: 2, Denom: "uusdc", Role: types.RoleOwner})
+
+	var next []byte
+	var got []types.AuditEntry
+	for {
+		resp, err := keeper.AuditLog(ctx, &types.QueryAuditLogRequest{
+			Role:       types.RolePauser,
+			Pagination: &query.PageRequest{Key: next, Limit: 2, CountTotal: next == nil},
+		})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(resp.Entries), 2)
+		if next == nil {
+			require.Equal(t, uint64(len(entries)), resp.Pagination.Total)
+		}
+		got = append(got, resp.Entries...)
+		next = resp.Pagination.NextKey
+		if next == nil {
+			break
+		}
+	}
+	require.Equal(t, nullify.Fill(entries), nullify.Fill(got))
+}
+
+func TestAuditLogQueryPaginatedHeightRange(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	var entries []types.AuditEntry
+	for i := 0; i < 6; i++ {
+		entry := types.AuditEntry{Id: uint64(i), Height: int64(i + 1), Denom: "uusdc", Role: types.RolePauser}
+		keeper.SetAuditEntry(ctx, entry)
+		entries = append(entries, entry)
+	}
+
+	for _, reverse := range []bool{false, true} {
+		var next []byte
+		var got []types.AuditEntry
+		for {
+			resp, err := keeper.AuditLog(ctx, &types.QueryAuditLogRequest{
+				MinHeight:  2,
+				MaxHeight:  5,
+				Pagination: &query.PageRequest{Key: next, Limit: 1, Reverse: reverse},
+			})
+			require.NoError(t, err)
+			got = append(got, resp.Entries...)
+			next = resp.Pagination.NextKey
+			if next == nil {
+				break
+			}
+		}
+
+		expected := append([]types.AuditEntry{}, entries[1:5]...)
+		if reverse {
+			for i, j := 0, len(expected)-1; i < j; i, j = i+1, j-1 {
+				expected[i], expected[j] = expected[j], expected[i]
+			}
+		}
+		require.Equal(t, nullify.Fill(expected), nullify.Fill(got))
+	}
+}
+
+func TestAuditLogQueryLimit(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	for i := 0; i <= types.MaxAuditLogLimit; i++ {
+		keeper.SetAuditEntry(ctx, types.AuditEntry{Id: uint64(i), Height: 1, Denom: "uusdc"})
+	}
+
+	resp, err := keeper.AuditLog(ctx, &types.QueryAuditLogRequest{
+		Pagination: &query.PageRequest{Limit: 10 * types.MaxAuditLogLimit},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Entries, types.MaxAuditLogLimit)
+	require.NotNil(t, resp.Pagination.NextKey)
+
+	resp, err = keeper.AuditLog(ctx, &types.QueryAuditLogRequest{
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Entries, 1)
+	require.Nil(t, resp.Pagination.NextKey)
+}
@@ -19,9 +19,8 @@ package keeper
 import (
 	"context"
 
-	"cosmossdk.io/store/prefix"
+	"cosmossdk.io/collections"
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
-	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -32,41 +31,100 @@ func (k Keeper) BlacklistedAll(ctx context.Context, req *types.QueryAllBlacklist
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	var blacklisteds []types.Blacklisted
+	var opts []func(opt *query.CollectionsPaginateOptions[collections.Pair[string, []byte]])
+	if req.Denom != "" {
+		opts = append(opts, query.WithCollectionPaginationPairPrefix[string, []byte](req.Denom))
+	}
 
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	blacklistedStore := prefix.NewStore(store, types.KeyPrefix(types.BlacklistedKeyPrefix))
+	hrps, err := k.addressRenderHrps(ctx, req.Hrps)
+	if err != nil {
+		return nil, err
+	}
 
-	pageRes, err := query.Paginate(blacklistedStore, req.Pagination, func(key []byte, value []byte) error {
-		var blacklisted types.Blacklisted
-		if err := k.cdc.Unmarshal(value, &blacklisted); err != nil {
-			return err
+	blacklisteds, pageRes, err := query.CollectionPaginate(ctx, k.blacklisted, req.Pagination, func(_ collections.Pair[string, []byte], value types.Blacklisted) (types.Blacklisted, error) {
+		return value, nil
+	}, opts...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	addresses := make([]types.AddressRendering, len(blacklisteds))
+	for i, blacklisted := range blacklisteds {
+		addresses[i], err = types.NewAddressRendering(blacklisted.AddressBz, hrps)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
 		}
+	}
+
+	return &types.QueryAllBlacklistedResponse{Blacklisted: blacklisteds, Pagination: pageRes, Addresses: addresses}, nil
+}
+
+func (k Keeper) Blacklisted(ctx context.Context, req *types.QueryGetBlacklistedRequest) (*types.QueryGetBlacklistedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	hrps, err := k.addressRenderHrps(ctx, req.Hrps)
+	if err != nil {
+		return nil, err
+	}
+
+	addressBz, err := k.DecodeAddress(req.Address)
+	if err != nil {
+		return nil, err
+	}
 
-		blacklisteds = append(blacklisteds, blacklisted)
-		return nil
-	})
+	val, found := k.GetBlacklisted(ctx, req.Denom, addressBz)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	address, err := types.NewAddressRendering(val.AddressBz, hrps)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &types.QueryAllBlacklistedResponse{Blacklisted: blacklisteds, Pagination: pageRes}, nil
+	return &types.QueryGetBlacklistedResponse{Blacklisted: val, Address: address}, nil
 }
 
-func (k Keeper) Blacklisted(ctx context.Context, req *types.QueryGetBlacklistedRequest) (*types.QueryGetBlacklistedResponse, error) {
+func (k Keeper) BlacklistedByBytes(ctx context.Context, req *types.QueryBlacklistedByBytesRequest) (*types.QueryBlacklistedByBytesResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	_, addressBz, err := DecodeNoLimitToBase256(req.Address)
+	hrps, err := k.addressRenderHrps(ctx, req.Hrps)
 	if err != nil {
 		return nil, err
 	}
 
-	val, found := k.GetBlacklisted(ctx, addressBz)
+	addressBz, err := types.ParseAddressBytes(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	val, found := k.GetBlacklisted(ctx, req.Denom, addressBz)
 	if !found {
 		return nil, status.Error(codes.NotFound, "not found")
 	}
 
-	return &types.QueryGetBlacklistedResponse{Blacklisted: val}, nil
+	address, err := types.NewAddressRendering(val.AddressBz, hrps)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBlacklistedByBytesResponse{Blacklisted: val, Address: address}, nil
+}
+
+// addressRenderHrps returns the human readable parts requested for rendering
+// blacklisted addresses, or those of the params when none are requested.
+func (k Keeper) addressRenderHrps(ctx context.Context, requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		return k.GetParams(ctx).AddressRenderHrps, nil
+	}
+	for _, hrp := range requested {
+		if err := types.ValidateHrp(hrp); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	return requested, nil
 }
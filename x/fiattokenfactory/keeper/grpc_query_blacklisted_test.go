@@ -17,6 +17,8 @@
 package keeper_test
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"strconv"
 	"testing"
 
@@ -49,27 +51,49 @@ func TestBlacklistedQuerySingle(t *testing.T) {
 			desc: "FirstBlacklistedSuccess",
 			request: &types.QueryGetBlacklistedRequest{
 				Address: msgs[0].address,
+				Denom:   "uusdc",
 			},
-			response: &types.QueryGetBlacklistedResponse{Blacklisted: msgs[0].bl},
+			response: &types.QueryGetBlacklistedResponse{Blacklisted: msgs[0].bl, Address: renderAddress(t, msgs[0].bl.AddressBz, "noble")},
 		},
 		{
 			desc: "SecondBlacklistedSuccess",
 			request: &types.QueryGetBlacklistedRequest{
 				Address: msgs[1].address,
+				Denom:   "uusdc",
 			},
-			response: &types.QueryGetBlacklistedResponse{Blacklisted: msgs[1].bl},
+			response: &types.QueryGetBlacklistedResponse{Blacklisted: msgs[1].bl, Address: renderAddress(t, msgs[1].bl.AddressBz, "noble")},
 		},
 		{
 			desc: "Bech32mBlacklistedSuccess",
 			request: &types.QueryGetBlacklistedRequest{
 				Address: msgs[2].address,
+				Denom:   "uusdc",
 			},
-			response: &types.QueryGetBlacklistedResponse{Blacklisted: msgs[2].bl},
+			response: &types.QueryGetBlacklistedResponse{Blacklisted: msgs[2].bl, Address: renderAddress(t, msgs[2].bl.AddressBz, "noble")},
+		},
+		{
+			desc: "RequestedHrps",
+			request: &types.QueryGetBlacklistedRequest{
+				Address: msgs[0].address,
+				Denom:   "uusdc",
+				Hrps:    []string{"cosmos", "osmo"},
+			},
+			response: &types.QueryGetBlacklistedResponse{Blacklisted: msgs[0].bl, Address: renderAddress(t, msgs[0].bl.AddressBz, "cosmos", "osmo")},
+		},
+		{
+			desc: "InvalidHrp",
+			request: &types.QueryGetBlacklistedRequest{
+				Address: msgs[0].address,
+				Denom:   "uusdc",
+				Hrps:    []string{"Cosmos"},
+			},
+			err: status.Error(codes.InvalidArgument, `invalid character 'C' in human readable part "Cosmos"`),
 		},
 		{
 			desc: "Bech32AddressIsNotBlacklisted",
 			request: &types.QueryGetBlacklistedRequest{
 				Address: sample.TestAccount().Address,
+				Denom:   "uusdc",
 			},
 			err: status.Error(codes.NotFound, "not found"),
 		},
@@ -77,6 +101,7 @@ func TestBlacklistedQuerySingle(t *testing.T) {
 			desc: "Bech32mAddressIsNotBlacklisted",
 			request: &types.QueryGetBlacklistedRequest{
 				Address: sample.TestAccountBech32m().Address,
+				Denom:   "uusdc",
 			},
 			err: status.Error(codes.NotFound, "not found"),
 		},
@@ -88,6 +113,7 @@ func TestBlacklistedQuerySingle(t *testing.T) {
 			desc: "MalformedAddress",
 			request: &types.QueryGetBlacklistedRequest{
 				Address: "malformed address",
+				Denom:   "uusdc",
 			},
 			err: bech32.ErrInvalidCharacter(' '),
 		},
@@ -98,6 +124,79 @@ func TestBlacklistedQuerySingle(t *testing.T) {
 				require.ErrorIs(t, err, tc.err)
 			} else {
 				require.NoError(t, err)
+				require.Equal(t, tc.response.Address, response.Address)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestBlacklistedByBytesQuery(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	msgs := createNBlacklisted(keeper, ctx, 1)
+	msgs = append(msgs, createNBlacklistedBech32m(keeper, ctx, 1)...)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryBlacklistedByBytesRequest
+		response *types.QueryBlacklistedByBytesResponse
+		err      error
+	}{
+		{
+			desc: "Hex",
+			request: &types.QueryBlacklistedByBytesRequest{
+				Address: hex.EncodeToString(msgs[0].bl.AddressBz),
+				Denom:   "uusdc",
+			},
+			response: &types.QueryBlacklistedByBytesResponse{Blacklisted: msgs[0].bl, Address: renderAddress(t, msgs[0].bl.AddressBz, "noble")},
+		},
+		{
+			desc: "PrefixedHex",
+			request: &types.QueryBlacklistedByBytesRequest{
+				Address: "0x" + hex.EncodeToString(msgs[1].bl.AddressBz),
+				Denom:   "uusdc",
+			},
+			response: &types.QueryBlacklistedByBytesResponse{Blacklisted: msgs[1].bl, Address: renderAddress(t, msgs[1].bl.AddressBz, "noble")},
+		},
+		{
+			desc: "Base64",
+			request: &types.QueryBlacklistedByBytesRequest{
+				Address: base64.StdEncoding.EncodeToString(msgs[1].bl.AddressBz),
+				Denom:   "uusdc",
+				Hrps:    []string{"cosmos", "osmo"},
+			},
+			response: &types.QueryBlacklistedByBytesResponse{Blacklisted: msgs[1].bl, Address: renderAddress(t, msgs[1].bl.AddressBz, "cosmos", "osmo")},
+		},
+		{
+			desc: "NotBlacklisted",
+			request: &types.QueryBlacklistedByBytesRequest{
+				Address: hex.EncodeToString(sample.TestAccount().AddressBz),
+				Denom:   "uusdc",
+			},
+			err: status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		{
+			desc: "MalformedAddress",
+			request: &types.QueryBlacklistedByBytesRequest{
+				Address: "malformed address",
+				Denom:   "uusdc",
+			},
+			err: types.ErrInvalidAddress,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.BlacklistedByBytes(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response.Address, response.Address)
 				require.Equal(t,
 					nullify.Fill(tc.response),
 					nullify.Fill(response),
@@ -160,6 +259,16 @@ func TestBlacklistedQueryPaginated(t *testing.T) {
 			nullify.Fill(resp.Blacklisted),
 		)
 	})
+	t.Run("RenderAddresses", func(t *testing.T) {
+		req := createQueryAllBlacklistedRequest(nil, 0, 0, true)
+		req.Hrps = []string{"cosmos", "osmo"}
+		resp, err := keeper.BlacklistedAll(ctx, req)
+		require.NoError(t, err)
+		require.Len(t, resp.Addresses, len(resp.Blacklisted))
+		for i, bl := range resp.Blacklisted {
+			require.Equal(t, renderAddress(t, bl.AddressBz, "cosmos", "osmo"), resp.Addresses[i])
+		}
+	})
 	t.Run("InvalidRequest", func(t *testing.T) {
 		_, err := keeper.BlacklistedAll(ctx, nil)
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
@@ -170,6 +279,12 @@ func TestBlacklistedQueryPaginated(t *testing.T) {
 	})
 }
 
+func renderAddress(t *testing.T, addressBz []byte, hrps ...string) types.AddressRendering {
+	rendering, err := types.NewAddressRendering(addressBz, hrps)
+	require.NoError(t, err)
+	return rendering
+}
+
 func createQueryAllBlacklistedRequest(next []byte, offset uint64, limit uint64, total bool) *types.QueryAllBlacklistedRequest {
 	return &types.QueryAllBlacklistedRequest{
 		Pagination: &query.PageRequest{
@@ -29,7 +29,7 @@ func (k Keeper) Blacklister(ctx context.Context, req *types.QueryGetBlacklisterR
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	val, found := k.GetBlacklister(ctx)
+	val, found := k.GetBlacklister(ctx, req.Denom)
 	if !found {
 		return nil, status.Error(codes.NotFound, "not found")
 	}
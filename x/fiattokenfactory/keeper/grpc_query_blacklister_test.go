@@ -31,7 +31,7 @@ import (
 func TestBlacklisterQuery_NoBlacklisterConfigured(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	_, err := keeper.Blacklister(ctx, &types.QueryGetBlacklisterRequest{})
+	_, err := keeper.Blacklister(ctx, &types.QueryGetBlacklisterRequest{Denom: "uusdc"})
 	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
 }
 
@@ -48,7 +48,7 @@ func TestBlacklisterQuery(t *testing.T) {
 	}{
 		{
 			desc:     "Success",
-			request:  &types.QueryGetBlacklisterRequest{},
+			request:  &types.QueryGetBlacklisterRequest{Denom: "uusdc"},
 			response: &types.QueryGetBlacklisterResponse{Blacklister: expectedBlacklister},
 		},
 		{
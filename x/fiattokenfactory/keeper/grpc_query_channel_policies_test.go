@@ -0,0 +1,51 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestChannelPoliciesQuery(t *testing.T) {
+	ftf, ctx := keepertest.FiatTokenfactoryKeeper()
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+
+	policies := []types.ChannelPolicy{
+		{Denom: "uusdc", ChannelId: "channel-0", AllowInbound: true},
+		{Denom: "uusdc", ChannelId: "channel-1", AllowInbound: true, AllowOutbound: true},
+	}
+	for _, policy := range policies {
+		ftf.SetChannelPolicy(ctx, policy)
+	}
+	ftf.SetChannelPolicy(ctx, types.ChannelPolicy{Denom: "ueurc", ChannelId: "channel-2", AllowOutbound: true})
+
+	res, err := ftf.ChannelPolicies(ctx, &types.QueryChannelPoliciesRequest{Denom: "uusdc"})
+	require.NoError(t, err)
+	require.Equal(t, policies, res.ChannelPolicies)
+
+	_, err = ftf.ChannelPolicies(ctx, &types.QueryChannelPoliciesRequest{Denom: "ueurc"})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+
+	_, err = ftf.ChannelPolicies(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
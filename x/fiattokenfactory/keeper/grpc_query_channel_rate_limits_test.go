@@ -0,0 +1,50 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestChannelRateLimitsQuery(t *testing.T) {
+	ftf, ctx := keepertest.FiatTokenfactoryKeeper()
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+
+	rateLimits := []types.ChannelRateLimit{
+		types.NewChannelRateLimit("uusdc", "channel-0", math.NewInt(100), math.ZeroInt(), 10, 0),
+		types.NewChannelRateLimit("uusdc", "channel-1", math.NewInt(100), math.NewInt(100), 0, time.Hour),
+	}
+	for _, rateLimit := range rateLimits {
+		ftf.SetChannelRateLimit(ctx, rateLimit)
+	}
+	ftf.SetChannelRateLimit(ctx, types.NewChannelRateLimit("ueurc", "channel-0", math.NewInt(100), math.ZeroInt(), 10, 0))
+
+	res, err := ftf.ChannelRateLimits(ctx, &types.QueryChannelRateLimitsRequest{Denom: "uusdc"})
+	require.NoError(t, err)
+	require.Equal(t, rateLimits, res.ChannelRateLimits)
+
+	_, err = ftf.ChannelRateLimits(ctx, &types.QueryChannelRateLimitsRequest{Denom: "ueurc"})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+}
@@ -29,7 +29,7 @@ func (k Keeper) MasterMinter(ctx context.Context, req *types.QueryGetMasterMinte
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	val, found := k.GetMasterMinter(ctx)
+	val, found := k.GetMasterMinter(ctx, req.Denom)
 	if !found {
 		return nil, status.Error(codes.NotFound, "not found")
 	}
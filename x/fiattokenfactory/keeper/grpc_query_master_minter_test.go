@@ -31,7 +31,7 @@ import (
 func TestMasterMinterQuery_NoMasterMinterConfigured(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	_, err := keeper.MasterMinter(ctx, &types.QueryGetMasterMinterRequest{})
+	_, err := keeper.MasterMinter(ctx, &types.QueryGetMasterMinterRequest{Denom: "uusdc"})
 	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
 }
 
@@ -48,7 +48,7 @@ func TestMasterMinterQuery(t *testing.T) {
 	}{
 		{
 			desc:     "Success",
-			request:  &types.QueryGetMasterMinterRequest{},
+			request:  &types.QueryGetMasterMinterRequest{Denom: "uusdc"},
 			response: &types.QueryGetMasterMinterResponse{MasterMinter: expectedMasterMinter},
 		},
 		{
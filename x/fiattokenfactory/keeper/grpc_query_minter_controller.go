@@ -19,10 +19,9 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
 
-	"cosmossdk.io/store/prefix"
-	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -33,20 +32,14 @@ func (k Keeper) MinterControllerAll(ctx context.Context, req *types.QueryAllMint
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	var minterControllers []types.MinterController
-
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	minterControllerStore := prefix.NewStore(store, types.KeyPrefix(types.MinterControllerKeyPrefix))
-
-	pageRes, err := query.Paginate(minterControllerStore, req.Pagination, func(key []byte, value []byte) error {
-		var minterController types.MinterController
-		if err := k.cdc.Unmarshal(value, &minterController); err != nil {
-			return err
-		}
+	var opts []func(opt *query.CollectionsPaginateOptions[collections.Pair[string, []byte]])
+	if req.Denom != "" {
+		opts = append(opts, query.WithCollectionPaginationPairPrefix[string, []byte](req.Denom))
+	}
 
-		minterControllers = append(minterControllers, minterController)
-		return nil
-	})
+	minterControllers, pageRes, err := query.CollectionPaginate(ctx, k.minterControllers, req.Pagination, func(_ collections.Pair[string, []byte], value types.MinterController) (types.MinterController, error) {
+		return value, nil
+	}, opts...)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -61,6 +54,7 @@ func (k Keeper) MinterController(ctx context.Context, req *types.QueryGetMinterC
 
 	val, found := k.GetMinterController(
 		ctx,
+		req.Denom,
 		req.ControllerAddress,
 	)
 	if !found {
@@ -47,6 +47,7 @@ func TestMinterControllerQuerySingle(t *testing.T) {
 			desc: "FirstControllerSuccess",
 			request: &types.QueryGetMinterControllerRequest{
 				ControllerAddress: msgs[0].Controller,
+				Denom:             "uusdc",
 			},
 			response: &types.QueryGetMinterControllerResponse{MinterController: msgs[0]},
 		},
@@ -54,6 +55,7 @@ func TestMinterControllerQuerySingle(t *testing.T) {
 			desc: "SecondControllerSuccess",
 			request: &types.QueryGetMinterControllerRequest{
 				ControllerAddress: msgs[1].Controller,
+				Denom:             "uusdc",
 			},
 			response: &types.QueryGetMinterControllerResponse{MinterController: msgs[1]},
 		},
@@ -61,6 +63,7 @@ func TestMinterControllerQuerySingle(t *testing.T) {
 			desc: "AddressIsNotController",
 			request: &types.QueryGetMinterControllerRequest{
 				ControllerAddress: strconv.Itoa(100000),
+				Denom:             "uusdc",
 			},
 			err: status.Error(codes.NotFound, "not found"),
 		},
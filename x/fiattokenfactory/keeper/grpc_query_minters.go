@@ -19,9 +19,9 @@ package keeper
 import (
 	"context"
 
-	"cosmossdk.io/store/prefix"
+	"cosmossdk.io/collections"
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
-	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -32,20 +32,14 @@ func (k Keeper) MintersAll(ctx context.Context, req *types.QueryAllMintersReques
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	var minters []types.Minters
-
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	mintersStore := prefix.NewStore(store, types.KeyPrefix(types.MintersKeyPrefix))
-
-	pageRes, err := query.Paginate(mintersStore, req.Pagination, func(key []byte, value []byte) error {
-		var minter types.Minters
-		if err := k.cdc.Unmarshal(value, &minter); err != nil {
-			return err
-		}
+	var opts []func(opt *query.CollectionsPaginateOptions[collections.Pair[string, []byte]])
+	if req.Denom != "" {
+		opts = append(opts, query.WithCollectionPaginationPairPrefix[string, []byte](req.Denom))
+	}
 
-		minters = append(minters, minter)
-		return nil
-	})
+	minters, pageRes, err := query.CollectionPaginate(ctx, k.minters, req.Pagination, func(_ collections.Pair[string, []byte], value types.Minters) (types.Minters, error) {
+		return value, nil
+	}, opts...)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -60,11 +54,19 @@ func (k Keeper) Minters(ctx context.Context, req *types.QueryGetMintersRequest)
 
 	val, found := k.GetMinters(
 		ctx,
+		req.Denom,
 		req.Address,
 	)
 	if !found {
 		return nil, status.Error(codes.NotFound, "not found")
 	}
 
-	return &types.QueryGetMintersResponse{Minters: val}, nil
+	res := &types.QueryGetMintersResponse{Minters: val}
+	if val.RateLimit != nil {
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		remaining := sdk.NewCoin(val.Allowance.Denom, val.RateLimit.Remaining(sdkCtx.BlockHeight(), sdkCtx.BlockTime()))
+		res.WindowRemaining = &remaining
+	}
+
+	return res, nil
 }
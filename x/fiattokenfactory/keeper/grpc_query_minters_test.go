@@ -47,6 +47,7 @@ func TestMintersQuerySingle(t *testing.T) {
 			desc: "FirstMinterSuccess",
 			request: &types.QueryGetMintersRequest{
 				Address: msgs[0].Address,
+				Denom:   "uusdc",
 			},
 			response: &types.QueryGetMintersResponse{Minters: msgs[0]},
 		},
@@ -54,6 +55,7 @@ func TestMintersQuerySingle(t *testing.T) {
 			desc: "SecondMinterSuccess",
 			request: &types.QueryGetMintersRequest{
 				Address: msgs[1].Address,
+				Denom:   "uusdc",
 			},
 			response: &types.QueryGetMintersResponse{Minters: msgs[1]},
 		},
@@ -61,6 +63,7 @@ func TestMintersQuerySingle(t *testing.T) {
 			desc: "AddressIsNotMinter",
 			request: &types.QueryGetMintersRequest{
 				Address: strconv.Itoa(100000),
+				Denom:   "uusdc",
 			},
 			err: status.Error(codes.NotFound, "not found"),
 		},
@@ -20,17 +20,36 @@ import (
 	"context"
 
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+func (k Keeper) MintingDenomAll(ctx context.Context, req *types.QueryAllMintingDenomRequest) (*types.QueryAllMintingDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	mintingDenoms, pageRes, err := query.CollectionPaginate(ctx, k.mintingDenoms, req.Pagination, func(_ string, value types.MintingDenom) (types.MintingDenom, error) {
+		return value, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllMintingDenomResponse{MintingDenom: mintingDenoms, Pagination: pageRes}, nil
+}
+
 func (k Keeper) MintingDenom(ctx context.Context, req *types.QueryGetMintingDenomRequest) (*types.QueryGetMintingDenomResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	val := k.GetMintingDenom(ctx)
+	val, found := k.GetMintingDenom(ctx, req.Denom)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
 
 	return &types.QueryGetMintingDenomResponse{MintingDenom: val}, nil
 }
@@ -19,6 +19,7 @@ package keeper_test
 import (
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -41,9 +42,14 @@ func TestMintingDenomQuery(t *testing.T) {
 	}{
 		{
 			desc:     "Success",
-			request:  &types.QueryGetMintingDenomRequest{},
+			request:  &types.QueryGetMintingDenomRequest{Denom: "uusdc"},
 			response: &types.QueryGetMintingDenomResponse{MintingDenom: expectedMintingDenom},
 		},
+		{
+			desc:    "NotRegistered",
+			request: &types.QueryGetMintingDenomRequest{Denom: "ueurc"},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
 		{
 			desc: "InvalidRequest",
 			err:  status.Error(codes.InvalidArgument, "invalid request"),
@@ -63,3 +69,34 @@ func TestMintingDenomQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestMintingDenomQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	msgs := []types.MintingDenom{createTestMintingDenom(keeper, ctx), {Denom: "ueurc"}}
+	keeper.SetMintingDenom(ctx, msgs[1])
+
+	t.Run("LookupAll", func(t *testing.T) {
+		resp, err := keeper.MintingDenomAll(ctx, &types.QueryAllMintingDenomRequest{
+			Pagination: &query.PageRequest{CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.MintingDenom),
+		)
+	})
+	t.Run("ByLimit", func(t *testing.T) {
+		resp, err := keeper.MintingDenomAll(ctx, &types.QueryAllMintingDenomRequest{
+			Pagination: &query.PageRequest{Limit: 1},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.MintingDenom, 1)
+		require.NotNil(t, resp.Pagination.NextKey)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.MintingDenomAll(ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
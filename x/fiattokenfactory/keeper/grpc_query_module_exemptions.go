@@ -0,0 +1,40 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ModuleExemptions(ctx context.Context, req *types.QueryModuleExemptionsRequest) (*types.QueryModuleExemptionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	exemptions := k.GetParams(ctx).ModuleExemptions
+	addresses := make([]string, len(exemptions))
+	for i, exemption := range exemptions {
+		addresses[i] = authtypes.NewModuleAddress(exemption.Module).String()
+	}
+
+	return &types.QueryModuleExemptionsResponse{Exemptions: exemptions, Addresses: addresses}, nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+func TestModuleExemptionsQuery(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	exemptions := []types.ModuleExemption{
+		{Module: "fee_collector", Pause: true, Blacklist: true},
+		{Module: "distribution", Pause: true},
+	}
+	params := types.DefaultParams()
+	params.ModuleExemptions = exemptions
+	keeper.SetParams(ctx, params)
+
+	response, err := keeper.ModuleExemptions(ctx, &types.QueryModuleExemptionsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryModuleExemptionsResponse{
+		Exemptions: exemptions,
+		Addresses: []string{
+			authtypes.NewModuleAddress("fee_collector").String(),
+			authtypes.NewModuleAddress("distribution").String(),
+		},
+	}, response)
+
+	_, err = keeper.ModuleExemptions(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
@@ -29,7 +29,7 @@ func (k Keeper) Owner(ctx context.Context, req *types.QueryGetOwnerRequest) (*ty
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	val, found := k.GetOwner(ctx)
+	val, found := k.GetOwner(ctx, req.Denom)
 	if !found {
 		return nil, status.Error(codes.NotFound, "not found")
 	}
@@ -31,14 +31,14 @@ import (
 func TestOwnerQuery_NoOwnerConfigured(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	_, err := keeper.Owner(ctx, &types.QueryGetOwnerRequest{})
+	_, err := keeper.Owner(ctx, &types.QueryGetOwnerRequest{Denom: "uusdc"})
 	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
 }
 
 func TestOwnerQuery(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	owner := types.Owner{Address: "test"}
+	owner := types.Owner{Denom: "uusdc", Address: "test"}
 	keeper.SetOwner(ctx, owner)
 
 	for _, tc := range []struct {
@@ -49,7 +49,7 @@ func TestOwnerQuery(t *testing.T) {
 	}{
 		{
 			desc:     "Success",
-			request:  &types.QueryGetOwnerRequest{},
+			request:  &types.QueryGetOwnerRequest{Denom: "uusdc"},
 			response: &types.QueryGetOwnerResponse{Owner: owner},
 		},
 		{
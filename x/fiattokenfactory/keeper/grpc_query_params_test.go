@@ -0,0 +1,69 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestParamsQuery(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	response, err := keeper.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), response.Params)
+
+	keeper.SetParams(ctx, types.Params{AuditLogRetention: 10})
+	response, err = keeper.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.Params{AuditLogRetention: 10}, response.Params)
+}
+
+func TestGetParams_CorruptedPanics(t *testing.T) {
+	keeper, ctx, key := keepertest.FiatTokenfactoryKeeperWithKey()
+
+	// params that cannot be decoded are not replaced by the defaults
+	ctx.KVStore(key).Set(types.ParamsPrefix, []byte{0xff})
+	require.Panics(t, func() { keeper.GetParams(ctx) })
+}
+
+func TestGetModuleExemption(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	module := authtypes.NewModuleAddress(types.ModuleName)
+	transfer := authtypes.NewModuleAddress("transfer")
+
+	// the default exemptions apply while the params are unset
+	require.Equal(t, types.ModuleExemption{Module: types.ModuleName, Allowlist: true}, keeper.GetModuleExemption(ctx, module))
+	require.Equal(t, types.ModuleExemption{}, keeper.GetModuleExemption(ctx, transfer))
+
+	params := types.DefaultParams()
+	params.ModuleExemptions = []types.ModuleExemption{{Module: "transfer", Pause: true}}
+	keeper.SetParams(ctx, params)
+	require.Equal(t, types.ModuleExemption{}, keeper.GetModuleExemption(ctx, module))
+	require.Equal(t, params.ModuleExemptions[0], keeper.GetModuleExemption(ctx, transfer))
+
+	// setting the params again drops the exemptions they no longer hold
+	keeper.SetParams(ctx, types.DefaultParams())
+	require.Equal(t, types.ModuleExemption{Module: types.ModuleName, Allowlist: true}, keeper.GetModuleExemption(ctx, module))
+	require.Equal(t, types.ModuleExemption{}, keeper.GetModuleExemption(ctx, transfer))
+}
@@ -30,7 +30,11 @@ func (k Keeper) Paused(ctx context.Context, req *types.QueryGetPausedRequest) (*
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	val := k.GetPaused(ctx)
+	if _, found := k.GetMintingDenom(ctx, req.Denom); !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	val := k.GetPaused(ctx, req.Denom)
 
 	return &types.QueryGetPausedResponse{Paused: val}, nil
 }
@@ -30,9 +30,10 @@ import (
 
 func TestPausedQuery_Unpaused(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	createTestMintingDenom(keeper, ctx)
 
 	expectedPauseValue := createTestPaused(keeper, ctx, false)
-	response, err := keeper.Paused(ctx, &types.QueryGetPausedRequest{})
+	response, err := keeper.Paused(ctx, &types.QueryGetPausedRequest{Denom: "uusdc"})
 	require.NoError(t, err)
 	require.Equal(t,
 		nullify.Fill(&types.QueryGetPausedResponse{Paused: expectedPauseValue}),
@@ -42,6 +43,7 @@ func TestPausedQuery_Unpaused(t *testing.T) {
 
 func TestPausedQuery(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	createTestMintingDenom(keeper, ctx)
 
 	expectedPauseValue := createTestPaused(keeper, ctx, true)
 
@@ -53,9 +55,14 @@ func TestPausedQuery(t *testing.T) {
 	}{
 		{
 			desc:     "Success",
-			request:  &types.QueryGetPausedRequest{},
+			request:  &types.QueryGetPausedRequest{Denom: "uusdc"},
 			response: &types.QueryGetPausedResponse{Paused: expectedPauseValue},
 		},
+		{
+			desc:    "DenomNotRegistered",
+			request: &types.QueryGetPausedRequest{Denom: "ueurc"},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
 		{
 			desc: "InvalidRequest",
 			err:  status.Error(codes.InvalidArgument, "invalid request"),
@@ -29,7 +29,7 @@ func (k Keeper) Pauser(ctx context.Context, req *types.QueryGetPauserRequest) (*
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	val, found := k.GetPauser(ctx)
+	val, found := k.GetPauser(ctx, req.Denom)
 	if !found {
 		return nil, status.Error(codes.NotFound, "not found")
 	}
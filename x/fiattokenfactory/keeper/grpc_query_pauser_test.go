@@ -31,7 +31,7 @@ import (
 func TestPauserQuery_NoPauserConfigured(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	_, err := keeper.Pauser(ctx, &types.QueryGetPauserRequest{})
+	_, err := keeper.Pauser(ctx, &types.QueryGetPauserRequest{Denom: "uusdc"})
 	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
 }
 
@@ -48,7 +48,7 @@ func TestPauserQuery(t *testing.T) {
 	}{
 		{
 			desc:     "Success",
-			request:  &types.QueryGetPauserRequest{},
+			request:  &types.QueryGetPauserRequest{Denom: "uusdc"},
 			response: &types.QueryGetPauserResponse{Pauser: expectedPauser},
 		},
 		{
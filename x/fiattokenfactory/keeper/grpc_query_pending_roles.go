@@ -0,0 +1,46 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) PendingRoles(ctx context.Context, req *types.QueryPendingRolesRequest) (*types.QueryPendingRolesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, found := k.GetMintingDenom(ctx, req.Denom); !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	pendingRoles, pageRes, err := query.CollectionPaginate(ctx, k.pendingRoles, req.Pagination, func(_ collections.Pair[string, string], value types.PendingRole) (types.PendingRole, error) {
+		return value, nil
+	}, query.WithCollectionPaginationPairPrefix[string, string](req.Denom))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingRolesResponse{PendingRoles: pendingRoles, Pagination: pageRes}, nil
+}
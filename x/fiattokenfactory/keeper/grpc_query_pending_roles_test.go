@@ -0,0 +1,52 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPendingRolesQuery(t *testing.T) {
+	ftf, ctx := keepertest.FiatTokenfactoryKeeper()
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+
+	pendingRoles := []types.PendingRole{
+		{Denom: "uusdc", Role: types.RoleBlacklister, Address: sample.AccAddress()},
+		{Denom: "uusdc", Role: types.RolePauser, Address: sample.AccAddress()},
+	}
+	for _, pendingRole := range pendingRoles {
+		ftf.SetPendingRole(ctx, pendingRole)
+	}
+	ftf.SetPendingRole(ctx, types.PendingRole{Denom: "ueurc", Role: types.RolePauser, Address: sample.AccAddress()})
+
+	res, err := ftf.PendingRoles(ctx, &types.QueryPendingRolesRequest{Denom: "uusdc"})
+	require.NoError(t, err)
+	require.Equal(t, pendingRoles, res.PendingRoles)
+
+	_, err = ftf.PendingRoles(ctx, &types.QueryPendingRolesRequest{Denom: "ueurc"})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+
+	_, err = ftf.PendingRoles(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
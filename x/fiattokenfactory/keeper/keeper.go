@@ -19,15 +19,18 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
 
 	"cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 type (
@@ -35,8 +38,55 @@ type (
 		cdc          codec.BinaryCodec
 		logger       log.Logger
 		storeService store.KVStoreService
+		authority    string
 
-		bankKeeper types.BankKeeper
+		bankKeeper    types.BankKeeper
+		addressCodecs []AddressCodec
+
+		schema            collections.Schema
+		mintingDenoms     collections.Map[string, types.MintingDenom]
+		paused            collections.Map[string, types.Paused]
+		masterMinters     collections.Map[string, types.MasterMinter]
+		pausers           collections.Map[string, types.Pauser]
+		blacklisters      collections.Map[string, types.Blacklister]
+		owners            collections.Map[string, types.Owner]
+		pendingOwners     collections.Map[string, types.Owner]
+		blacklisted       collections.Map[collections.Pair[string, []byte], types.Blacklisted]
+		minters           collections.Map[collections.Pair[string, []byte], types.Minters]
+		minterControllers collections.Map[collections.Pair[string, []byte], types.MinterController]
+		params            collections.Item[types.Params]
+		auditLog          collections.Map[collections.Pair[int64, uint64], types.AuditEntry]
+		auditLogSeq       collections.Sequence
+		signerSets        collections.Map[collections.Pair[string, string], types.SignerSet]
+		actionProposals   collections.Map[uint64, types.ActionProposal]
+		actionProposalSeq collections.Sequence
+		queuedActions     collections.Map[uint64, types.QueuedAction]
+		queuedActionSeq   collections.Sequence
+		channelPolicies   collections.Map[collections.Pair[string, string], types.ChannelPolicy]
+		channelRateLimits collections.Map[collections.Pair[string, string], types.ChannelRateLimit]
+
+		// pendingSendPackets holds the outgoing transfers counted against a
+		// channel rate limit, keyed by source channel and sequence.
+		pendingSendPackets collections.Map[collections.Pair[string, uint64], types.PendingSendPacket]
+
+		// blacklistExpiryHeights and blacklistExpiryTimes index the
+		// blacklisted entries by their expiry.
+		blacklistExpiryHeights collections.KeySet[collections.Triple[int64, string, []byte]]
+		blacklistExpiryTimes   collections.KeySet[collections.Triple[time.Time, string, []byte]]
+
+		allowlisters     collections.Map[string, types.Allowlister]
+		allowlisted      collections.Map[collections.Pair[string, []byte], types.Allowlisted]
+		allowlistEnabled collections.KeySet[string]
+
+		pendingRoles collections.Map[collections.Pair[string, string], types.PendingRole]
+
+		// queuedActionTimes indexes the queued actions by the time they are
+		// executed at.
+		queuedActionTimes collections.KeySet[collections.Pair[time.Time, uint64]]
+
+		// moduleExemptions indexes the module exemptions of the params by
+		// module account address. It is rebuilt whenever the params are set.
+		moduleExemptions collections.Map[[]byte, types.ModuleExemption]
 	}
 )
 
@@ -44,84 +94,183 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	logger log.Logger,
 	storeService store.KVStoreService,
+	authority string,
 
 	bankKeeper types.BankKeeper,
 ) *Keeper {
-	return &Keeper{
+	sb := collections.NewSchemaBuilder(storeService)
+	denomAddressKey := collections.PairKeyCodec(collections.StringKey, collections.BytesKey)
+
+	k := &Keeper{
 		cdc:          cdc,
 		logger:       logger,
 		storeService: storeService,
+		authority:    authority,
 		bankKeeper:   bankKeeper,
+
+		addressCodecs: DefaultAddressCodecs(),
+
+		mintingDenoms:     collections.NewMap(sb, types.MintingDenomPrefix, "minting_denoms", collections.StringKey, codec.CollValue[types.MintingDenom](cdc)),
+		paused:            collections.NewMap(sb, types.PausedPrefix, "paused", collections.StringKey, codec.CollValue[types.Paused](cdc)),
+		masterMinters:     collections.NewMap(sb, types.MasterMinterPrefix, "master_minters", collections.StringKey, codec.CollValue[types.MasterMinter](cdc)),
+		pausers:           collections.NewMap(sb, types.PauserPrefix, "pausers", collections.StringKey, codec.CollValue[types.Pauser](cdc)),
+		blacklisters:      collections.NewMap(sb, types.BlacklisterPrefix, "blacklisters", collections.StringKey, codec.CollValue[types.Blacklister](cdc)),
+		owners:            collections.NewMap(sb, types.OwnerPrefix, "owners", collections.StringKey, codec.CollValue[types.Owner](cdc)),
+		pendingOwners:     collections.NewMap(sb, types.PendingOwnerPrefix, "pending_owners", collections.StringKey, codec.CollValue[types.Owner](cdc)),
+		blacklisted:       collections.NewMap(sb, types.BlacklistedPrefix, "blacklisted", denomAddressKey, codec.CollValue[types.Blacklisted](cdc)),
+		minters:           collections.NewMap(sb, types.MintersPrefix, "minters", denomAddressKey, codec.CollValue[types.Minters](cdc)),
+		minterControllers: collections.NewMap(sb, types.MinterControllerPrefix, "minter_controllers", denomAddressKey, codec.CollValue[types.MinterController](cdc)),
+		params:            collections.NewItem(sb, types.ParamsPrefix, "params", codec.CollValue[types.Params](cdc)),
+		auditLog:          collections.NewMap(sb, types.AuditLogPrefix, "audit_log", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key), codec.CollValue[types.AuditEntry](cdc)),
+		auditLogSeq:       collections.NewSequence(sb, types.AuditLogSeqPrefix, "audit_log_seq"),
+		signerSets:        collections.NewMap(sb, types.SignerSetsPrefix, "signer_sets", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.SignerSet](cdc)),
+		actionProposals:   collections.NewMap(sb, types.ActionProposalsPrefix, "action_proposals", collections.Uint64Key, codec.CollValue[types.ActionProposal](cdc)),
+		actionProposalSeq: collections.NewSequence(sb, types.ActionProposalSeqPrefix, "action_proposal_seq"),
+		queuedActions:     collections.NewMap(sb, types.QueuedActionsPrefix, "queued_actions", collections.Uint64Key, codec.CollValue[types.QueuedAction](cdc)),
+		queuedActionSeq:   collections.NewSequence(sb, types.QueuedActionSeqPrefix, "queued_action_seq"),
+		channelPolicies:   collections.NewMap(sb, types.ChannelPoliciesPrefix, "channel_policies", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.ChannelPolicy](cdc)),
+		channelRateLimits: collections.NewMap(sb, types.ChannelRateLimitsPrefix, "channel_rate_limits", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.ChannelRateLimit](cdc)),
+
+		pendingSendPackets: collections.NewMap(sb, types.PendingSendPacketsPrefix, "pending_send_packets", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.PendingSendPacket](cdc)),
+
+		blacklistExpiryHeights: collections.NewKeySet(sb, types.BlacklistExpiryHeightsPrefix, "blacklist_expiry_heights", collections.TripleKeyCodec(collections.Int64Key, collections.StringKey, collections.BytesKey)),
+		blacklistExpiryTimes:   collections.NewKeySet(sb, types.BlacklistExpiryTimesPrefix, "blacklist_expiry_times", collections.TripleKeyCodec(sdk.TimeKey, collections.StringKey, collections.BytesKey)),
+
+		allowlisters:     collections.NewMap(sb, types.AllowlisterPrefix, "allowlisters", collections.StringKey, codec.CollValue[types.Allowlister](cdc)),
+		allowlisted:      collections.NewMap(sb, types.AllowlistedPrefix, "allowlisted", denomAddressKey, codec.CollValue[types.Allowlisted](cdc)),
+		allowlistEnabled: collections.NewKeySet(sb, types.AllowlistEnabledPrefix, "allowlist_enabled", collections.StringKey),
+
+		pendingRoles: collections.NewMap(sb, types.PendingRolesPrefix, "pending_roles", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.PendingRole](cdc)),
+
+		queuedActionTimes: collections.NewKeySet(sb, types.QueuedActionTimesPrefix, "queued_action_times", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
+
+		moduleExemptions: collections.NewMap(sb, types.ModuleExemptionsPrefix, "module_exemptions", collections.BytesKey, codec.CollValue[types.ModuleExemption](cdc)),
 	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.schema = schema
+
+	return k
+}
+
+// GetAuthority returns the address allowed to update the module parameters.
+func (k Keeper) GetAuthority() string {
+	return k.authority
 }
 
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return k.logger.With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
-// SendRestrictionFn checks every $USDC transfer executed on the Noble chain against the blocklist and paused state
+// SendRestrictionFn checks every transfer of a minting denom executed on the Noble chain against the blocklist, allowlist and paused state of that denom
 func (k Keeper) SendRestrictionFn(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (newToAddr sdk.AccAddress, err error) {
-	mintingDenom := k.GetMintingDenom(ctx)
-	if amount := amt.AmountOf(mintingDenom.Denom); !amount.IsZero() {
-		paused := k.GetPaused(ctx)
-		if paused.Paused {
-			return toAddr, errors.Wrapf(types.ErrPaused, "cannot perform token transfers")
+	for _, coin := range amt {
+		if coin.IsZero() {
+			continue
 		}
 
-		_, found := k.GetBlacklisted(ctx, fromAddr.Bytes())
-		if found {
-			return toAddr, errors.Wrapf(types.ErrUnauthorized, "an address (%s) is blacklisted and can not send tokens", fromAddr.String())
+		if _, found := k.GetMintingDenom(ctx, coin.Denom); !found {
+			continue
 		}
 
-		_, found = k.GetBlacklisted(ctx, toAddr.Bytes())
-		if found {
-			return toAddr, errors.Wrapf(types.ErrUnauthorized, "an address (%s) is blacklisted and can not receive tokens", toAddr.String())
+		if err := k.checkSendRestrictions(ctx, coin.Denom, fromAddr, toAddr); err != nil {
+			return toAddr, err
 		}
+	}
+
+	return toAddr, nil
+}
+
+// checkSendRestrictions checks a single minting denom transfer against the blocklist, allowlist and paused state of that denom.
+func (k Keeper) checkSendRestrictions(ctx context.Context, denom string, fromAddr, toAddr sdk.AccAddress) error {
+	params := k.GetParams(ctx)
+	fromExemption := k.GetModuleExemption(ctx, fromAddr)
+	toExemption := k.GetModuleExemption(ctx, toAddr)
+
+	// only transfers between two module accounts exempted from the pause go through while paused
+	paused := k.GetPaused(ctx, denom)
+	if paused.IsPaused(types.PauseScope_PAUSE_SCOPE_TRANSFER) && !(fromExemption.Pause && toExemption.Pause) {
+		return errors.Wrapf(types.ErrPaused, "cannot perform token transfers")
+	}
+
+	// a seizure moves the funds of a blacklisted address into the escrow
+	_, found := k.GetBlacklisted(ctx, denom, fromAddr.Bytes())
+	if found && !fromExemption.Blacklist && !k.isSeizure(ctx, toAddr) {
+		return errors.Wrapf(types.ErrUnauthorized, "an address (%s) is blacklisted and can not send tokens", fromAddr.String())
+	}
+
+	_, found = k.GetBlacklisted(ctx, denom, toAddr.Bytes())
+	if found && !toExemption.Blacklist {
+		return errors.Wrapf(types.ErrUnauthorized, "an address (%s) is blacklisted and can not receive tokens", toAddr.String())
+	}
 
-		grantees := ctx.Value(types.GranteeKey)
-		if grantees != nil {
-			for _, grantee := range grantees.([]string) {
-				_, addressBz, err := DecodeNoLimitToBase256(grantee)
-				if err != nil {
-					return toAddr, err
-				}
-				_, found := k.GetBlacklisted(ctx, addressBz)
-				if found {
-					return toAddr, errors.Wrapf(types.ErrUnauthorized, "an address (%s) is blacklisted and can not authorize tokens", toAddr.String())
-				}
+	if k.IsAllowlistEnabled(ctx, denom) {
+		if !k.IsAllowlisted(ctx, denom, fromAddr.Bytes()) && !k.isSeizure(ctx, toAddr) {
+			return errors.Wrapf(types.ErrNotAllowlisted, "an address (%s) is not allowlisted and can not send tokens", fromAddr.String())
+		}
+		if !k.IsAllowlisted(ctx, denom, toAddr.Bytes()) {
+			return errors.Wrapf(types.ErrNotAllowlisted, "an address (%s) is not allowlisted and can not receive tokens", toAddr.String())
+		}
+	}
+
+	grantees := ctx.Value(types.GranteeKey)
+	if grantees != nil && params.AuthzGranteeCheck {
+		for _, grantee := range grantees.([]string) {
+			addressBz, err := k.DecodeAddress(grantee)
+			if err != nil {
+				return err
+			}
+			_, found := k.GetBlacklisted(ctx, denom, addressBz)
+			if found {
+				return errors.Wrapf(types.ErrUnauthorized, "an address (%s) is blacklisted and can not authorize tokens", toAddr.String())
 			}
 		}
 	}
 
-	return toAddr, nil
+	return nil
+}
+
+// isSeizure reports whether a transfer to an address is the seizure of
+// blacklisted funds into the escrow account of the module.
+func (k Keeper) isSeizure(ctx context.Context, toAddr sdk.AccAddress) bool {
+	seizure, _ := ctx.Value(types.SeizureKey).(bool)
+	return seizure && toAddr.Equals(authtypes.NewModuleAddress(types.EscrowName))
 }
 
-// ValidatePrivileges checks if a specified address has already been assigned to a privileged role.
-func (k Keeper) ValidatePrivileges(ctx context.Context, address string) error {
+// ValidatePrivileges checks if a specified address has already been assigned to a privileged role of a minting denom.
+func (k Keeper) ValidatePrivileges(ctx context.Context, denom string, address string) error {
 	acc, err := sdk.AccAddressFromBech32(address)
 	if err != nil {
 		return err
 	}
 
-	owner, found := k.GetOwner(ctx)
+	owner, found := k.GetOwner(ctx, denom)
 	if found && owner.Address == acc.String() {
 		return errors.Wrapf(types.ErrAlreadyPrivileged, "cannot assign (%s) to owner role", acc.String())
 	}
 
-	blacklister, found := k.GetBlacklister(ctx)
+	blacklister, found := k.GetBlacklister(ctx, denom)
 	if found && blacklister.Address == acc.String() {
 		return errors.Wrapf(types.ErrAlreadyPrivileged, "cannot assign (%s) to black lister role", acc.String())
 	}
 
-	masterminter, found := k.GetMasterMinter(ctx)
+	masterminter, found := k.GetMasterMinter(ctx, denom)
 	if found && masterminter.Address == acc.String() {
 		return errors.Wrapf(types.ErrAlreadyPrivileged, "cannot assign (%s) to master minter role", acc.String())
 	}
 
-	pauser, found := k.GetPauser(ctx)
+	pauser, found := k.GetPauser(ctx, denom)
 	if found && pauser.Address == acc.String() {
 		return errors.Wrapf(types.ErrAlreadyPrivileged, "cannot assign (%s) to pauser role", acc.String())
 	}
 
+	allowlister, found := k.GetAllowlister(ctx, denom)
+	if found && allowlister.Address == acc.String() {
+		return errors.Wrapf(types.ErrAlreadyPrivileged, "cannot assign (%s) to allowlister role", acc.String())
+	}
+
 	return nil
 }
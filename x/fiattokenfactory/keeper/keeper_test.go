@@ -23,10 +23,30 @@ import (
 	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 )
 
+// requireTypedEvent asserts that the last event of the expected type emitted
+// on ctx decodes to expected.
+func requireTypedEvent(t *testing.T, ctx sdk.Context, expected proto.Message) {
+	t.Helper()
+	events := ctx.EventManager().Events()
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Type != proto.MessageName(expected) {
+			continue
+		}
+		actual, err := sdk.ParseTypedEvent(abci.Event(events[i]))
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+		return
+	}
+	require.Failf(t, "event not emitted", "no %s event", proto.MessageName(expected))
+}
+
 func TestKeeperLogger(t *testing.T) {
 	t.Parallel()
 	k, ctx := keeper.FiatTokenfactoryKeeper()
@@ -50,7 +70,7 @@ func TestSendRestrictionsFn_NotUsingUSDC(t *testing.T) {
 func TestSendRestrictionsFn_Paused(t *testing.T) {
 	k, ctx := keeper.FiatTokenfactoryKeeper()
 	k.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
-	k.SetPaused(ctx, types.Paused{Paused: true})
+	k.SetPaused(ctx, types.Paused{Denom: "uusdc", Paused: true})
 	fromAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
 	toAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
 	amounts := sdk.Coins{sdk.NewInt64Coin("uusdc", 10)}
@@ -61,14 +81,28 @@ func TestSendRestrictionsFn_Paused(t *testing.T) {
 	require.Equal(t, toAddress, newToAddress)
 }
 
+func TestSendRestrictionsFn_OtherScopesPaused(t *testing.T) {
+	k, ctx := keeper.FiatTokenfactoryKeeper()
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	k.SetPaused(ctx, types.Paused{Denom: "uusdc", Scopes: types.PauseScopesAll &^ types.PauseScope_PAUSE_SCOPE_TRANSFER.Bit()})
+	fromAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
+	toAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
+	amounts := sdk.Coins{sdk.NewInt64Coin("uusdc", 10)}
+
+	newToAddress, err := k.SendRestrictionFn(ctx, fromAddress, toAddress, amounts)
+
+	require.NoError(t, err)
+	require.Equal(t, toAddress, newToAddress)
+}
+
 func TestSendRestrictionsFn_FromBlacklisted(t *testing.T) {
 	k, ctx := keeper.FiatTokenfactoryKeeper()
 	k.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
-	k.SetPaused(ctx, types.Paused{Paused: false})
+	k.SetPaused(ctx, types.Paused{Denom: "uusdc", Paused: false})
 
 	fromAccount := sample.TestAccount()
 	fromAddress := sdk.MustAccAddressFromBech32(fromAccount.Address)
-	k.SetBlacklisted(ctx, types.Blacklisted{AddressBz: fromAccount.AddressBz})
+	k.SetBlacklisted(ctx, types.Blacklisted{AddressBz: fromAccount.AddressBz, Denom: "uusdc"})
 	toAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
 	amounts := sdk.Coins{sdk.NewInt64Coin("uusdc", 10)}
 
@@ -78,15 +112,40 @@ func TestSendRestrictionsFn_FromBlacklisted(t *testing.T) {
 	require.Equal(t, toAddress, newToAddress)
 }
 
+func TestSendRestrictionsFn_Seizure(t *testing.T) {
+	k, ctx := keeper.FiatTokenfactoryKeeper()
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	k.SetPaused(ctx, types.Paused{Denom: "uusdc", Paused: false})
+
+	fromAccount := sample.TestAccount()
+	fromAddress := sdk.MustAccAddressFromBech32(fromAccount.Address)
+	k.SetBlacklisted(ctx, types.Blacklisted{AddressBz: fromAccount.AddressBz, Denom: "uusdc"})
+	escrow := authtypes.NewModuleAddress(types.EscrowName)
+	amounts := sdk.Coins{sdk.NewInt64Coin("uusdc", 10)}
+
+	// a blacklisted address can not send to the escrow on its own
+	_, err := k.SendRestrictionFn(ctx, fromAddress, escrow, amounts)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// a seizure only bypasses the blacklist towards the escrow
+	seizureCtx := ctx.WithValue(types.SeizureKey, true)
+	_, err = k.SendRestrictionFn(seizureCtx, fromAddress, sdk.MustAccAddressFromBech32(sample.AccAddress()), amounts)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	newToAddress, err := k.SendRestrictionFn(seizureCtx, fromAddress, escrow, amounts)
+	require.NoError(t, err)
+	require.Equal(t, escrow, newToAddress)
+}
+
 func TestSendRestrictionsFn_ToBlacklisted(t *testing.T) {
 	k, ctx := keeper.FiatTokenfactoryKeeper()
 	k.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
-	k.SetPaused(ctx, types.Paused{Paused: false})
+	k.SetPaused(ctx, types.Paused{Denom: "uusdc", Paused: false})
 
 	fromAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
 	toAccount := sample.TestAccount()
 	toAddress := sdk.MustAccAddressFromBech32(toAccount.Address)
-	k.SetBlacklisted(ctx, types.Blacklisted{AddressBz: toAccount.AddressBz})
+	k.SetBlacklisted(ctx, types.Blacklisted{AddressBz: toAccount.AddressBz, Denom: "uusdc"})
 	amounts := sdk.Coins{sdk.NewInt64Coin("uusdc", 10)}
 
 	newToAddress, err := k.SendRestrictionFn(ctx, fromAddress, toAddress, amounts)
@@ -98,14 +157,14 @@ func TestSendRestrictionsFn_ToBlacklisted(t *testing.T) {
 func TestSendRestrictionsFn_GranteeBlacklisted(t *testing.T) {
 	k, ctx := keeper.FiatTokenfactoryKeeper()
 	k.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
-	k.SetPaused(ctx, types.Paused{Paused: false})
+	k.SetPaused(ctx, types.Paused{Denom: "uusdc", Paused: false})
 
 	fromAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
 	toAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
 	amounts := sdk.Coins{sdk.NewInt64Coin("uusdc", 10)}
 
 	granteeAccount := sample.TestAccount()
-	k.SetBlacklisted(ctx, types.Blacklisted{AddressBz: granteeAccount.AddressBz})
+	k.SetBlacklisted(ctx, types.Blacklisted{AddressBz: granteeAccount.AddressBz, Denom: "uusdc"})
 
 	var grantees []string
 	grantees = append(grantees, granteeAccount.Address)
@@ -116,17 +175,37 @@ func TestSendRestrictionsFn_GranteeBlacklisted(t *testing.T) {
 	require.Equal(t, toAddress, newToAddress)
 }
 
+func TestSendRestrictionsFn_GranteeCheckDisabled(t *testing.T) {
+	k, ctx := keeper.FiatTokenfactoryKeeper()
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	params := types.DefaultParams()
+	params.AuthzGranteeCheck = false
+	k.SetParams(ctx, params)
+
+	fromAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
+	toAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
+	amounts := sdk.Coins{sdk.NewInt64Coin("uusdc", 10)}
+
+	granteeAccount := sample.TestAccount()
+	k.SetBlacklisted(ctx, types.Blacklisted{AddressBz: granteeAccount.AddressBz, Denom: "uusdc"})
+
+	newToAddress, err := k.SendRestrictionFn(ctx.WithValue(types.GranteeKey, []string{granteeAccount.Address}), fromAddress, toAddress, amounts)
+
+	require.NoError(t, err)
+	require.Equal(t, toAddress, newToAddress)
+}
+
 func TestSendRestrictionsFn_Bech32mGranteeBlacklisted(t *testing.T) {
 	k, ctx := keeper.FiatTokenfactoryKeeper()
 	k.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
-	k.SetPaused(ctx, types.Paused{Paused: false})
+	k.SetPaused(ctx, types.Paused{Denom: "uusdc", Paused: false})
 
 	fromAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
 	toAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
 	amounts := sdk.Coins{sdk.NewInt64Coin("uusdc", 10)}
 
 	granteeAccount := sample.TestAccountBech32m()
-	k.SetBlacklisted(ctx, types.Blacklisted{AddressBz: granteeAccount.AddressBz})
+	k.SetBlacklisted(ctx, types.Blacklisted{AddressBz: granteeAccount.AddressBz, Denom: "uusdc"})
 
 	var grantees []string
 	grantees = append(grantees, granteeAccount.Address)
@@ -140,7 +219,7 @@ func TestSendRestrictionsFn_Bech32mGranteeBlacklisted(t *testing.T) {
 func TestSendRestrictionsFn_USDCNotRestricted(t *testing.T) {
 	k, ctx := keeper.FiatTokenfactoryKeeper()
 	k.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
-	k.SetPaused(ctx, types.Paused{Paused: false})
+	k.SetPaused(ctx, types.Paused{Denom: "uusdc", Paused: false})
 	fromAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
 	toAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
 	amounts := sdk.Coins{sdk.NewInt64Coin("uusdc", 10)}
@@ -151,10 +230,34 @@ func TestSendRestrictionsFn_USDCNotRestricted(t *testing.T) {
 	require.Equal(t, toAddress, newToAddress)
 }
 
+func TestSendRestrictionsFn_OnlyRestrictedDenom(t *testing.T) {
+	k, ctx := keeper.FiatTokenfactoryKeeper()
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: "ueurc"})
+	k.SetPaused(ctx, types.Paused{Denom: "uusdc", Paused: true})
+	fromAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
+	toAddress := sdk.MustAccAddressFromBech32(sample.TestAccount().Address)
+
+	_, err := k.SendRestrictionFn(ctx, fromAddress, toAddress, sdk.Coins{sdk.NewInt64Coin("ueurc", 10)})
+	require.NoError(t, err)
+
+	_, err = k.SendRestrictionFn(ctx, fromAddress, toAddress, sdk.NewCoins(sdk.NewInt64Coin("ueurc", 10), sdk.NewInt64Coin("uusdc", 10)))
+	require.ErrorIs(t, err, types.ErrPaused)
+}
+
+func TestValidatePrivileges_OtherDenom(t *testing.T) {
+	owner := sample.TestAccount()
+	k, ctx := keeper.FiatTokenfactoryKeeper()
+	k.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner.Address})
+
+	require.ErrorIs(t, k.ValidatePrivileges(ctx, "uusdc", owner.Address), types.ErrAlreadyPrivileged)
+	require.NoError(t, k.ValidatePrivileges(ctx, "ueurc", owner.Address))
+}
+
 func TestValidatePrivileges_InvalidAddress(t *testing.T) {
 	k, ctx := keeper.FiatTokenfactoryKeeper()
 
-	err := k.ValidatePrivileges(ctx, "malformed bech32 address")
+	err := k.ValidatePrivileges(ctx, "uusdc", "malformed bech32 address")
 	require.Error(t, err)
 }
 
@@ -164,13 +267,13 @@ func TestValidatePrivileges_NonPrivilegedAddress(t *testing.T) {
 	masterMinter := sample.TestAccount()
 	pauser := sample.TestAccount()
 	k, ctx := keeper.FiatTokenfactoryKeeper()
-	k.SetOwner(ctx, types.Owner{Address: owner.Address})
-	k.SetBlacklister(ctx, types.Blacklister{Address: blacklister.Address})
-	k.SetMasterMinter(ctx, types.MasterMinter{Address: masterMinter.Address})
-	k.SetPauser(ctx, types.Pauser{Address: pauser.Address})
+	k.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner.Address})
+	k.SetBlacklister(ctx, types.Blacklister{Denom: "uusdc", Address: blacklister.Address})
+	k.SetMasterMinter(ctx, types.MasterMinter{Denom: "uusdc", Address: masterMinter.Address})
+	k.SetPauser(ctx, types.Pauser{Denom: "uusdc", Address: pauser.Address})
 
 	newAddress := sample.TestAccount()
-	err := k.ValidatePrivileges(ctx, newAddress.Address)
+	err := k.ValidatePrivileges(ctx, "uusdc", newAddress.Address)
 
 	require.NoError(t, err)
 }
@@ -181,14 +284,94 @@ func TestValidatePrivileges_PrivilegedAddress(t *testing.T) {
 	masterMinter := sample.TestAccount()
 	pauser := sample.TestAccount()
 	k, ctx := keeper.FiatTokenfactoryKeeper()
-	k.SetOwner(ctx, types.Owner{Address: owner.Address})
-	k.SetBlacklister(ctx, types.Blacklister{Address: blacklister.Address})
-	k.SetMasterMinter(ctx, types.MasterMinter{Address: masterMinter.Address})
-	k.SetPauser(ctx, types.Pauser{Address: pauser.Address})
+	k.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner.Address})
+	k.SetBlacklister(ctx, types.Blacklister{Denom: "uusdc", Address: blacklister.Address})
+	k.SetMasterMinter(ctx, types.MasterMinter{Denom: "uusdc", Address: masterMinter.Address})
+	k.SetPauser(ctx, types.Pauser{Denom: "uusdc", Address: pauser.Address})
 	address := []sample.Account{owner, blacklister, masterMinter, pauser}
 
 	for _, ad := range address {
-		err := k.ValidatePrivileges(ctx, ad.Address)
+		err := k.ValidatePrivileges(ctx, "uusdc", ad.Address)
 		require.ErrorIs(t, err, types.ErrAlreadyPrivileged)
 	}
 }
+
+func TestSendRestrictionsFn_Allowlist(t *testing.T) {
+	k, ctx := keeper.FiatTokenfactoryKeeper()
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	k.SetAllowlistEnabled(ctx, "uusdc", true)
+
+	allowlisted := sample.TestAccount()
+	k.SetAllowlisted(ctx, types.Allowlisted{AddressBz: allowlisted.AddressBz, Denom: "uusdc"})
+	fromAddress := sdk.MustAccAddressFromBech32(allowlisted.Address)
+	outsider := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	module := authtypes.NewModuleAddress(types.ModuleName)
+	escrow := authtypes.NewModuleAddress(types.EscrowName)
+	amounts := sdk.Coins{sdk.NewInt64Coin("uusdc", 10)}
+
+	// a transfer to an address that is not allowlisted is rejected
+	_, err := k.SendRestrictionFn(ctx, fromAddress, outsider, amounts)
+	require.ErrorIs(t, err, types.ErrNotAllowlisted)
+
+	// a transfer from an address that is not allowlisted is rejected
+	_, err = k.SendRestrictionFn(ctx, outsider, fromAddress, amounts)
+	require.ErrorIs(t, err, types.ErrNotAllowlisted)
+
+	// exempt module accounts do not need to be allowlisted
+	newToAddress, err := k.SendRestrictionFn(ctx, fromAddress, module, amounts)
+	require.NoError(t, err)
+	require.Equal(t, module, newToAddress)
+
+	// a seizure bypasses the allowlist of the sender
+	_, err = k.SendRestrictionFn(ctx.WithValue(types.SeizureKey, true), outsider, escrow, amounts)
+	require.NoError(t, err)
+
+	// other denoms are not affected
+	_, err = k.SendRestrictionFn(ctx, outsider, outsider, sdk.Coins{sdk.NewInt64Coin("ueurc", 10)})
+	require.NoError(t, err)
+
+	k.SetAllowlistEnabled(ctx, "uusdc", false)
+	_, err = k.SendRestrictionFn(ctx, fromAddress, outsider, amounts)
+	require.NoError(t, err)
+}
+
+func TestSendRestrictionsFn_ModuleExemptions(t *testing.T) {
+	k, ctx := keeper.FiatTokenfactoryKeeper()
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	params := types.DefaultParams()
+	params.ModuleExemptions = []types.ModuleExemption{
+		{Module: "fee_collector", Pause: true, Blacklist: true},
+		{Module: "distribution", Pause: true},
+	}
+	k.SetParams(ctx, params)
+
+	feeCollector := authtypes.NewModuleAddress("fee_collector")
+	distribution := authtypes.NewModuleAddress("distribution")
+	user := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	amounts := sdk.Coins{sdk.NewInt64Coin("uusdc", 10)}
+
+	// while paused, only transfers between module accounts exempted from the pause go through
+	k.SetPaused(ctx, types.Paused{Denom: "uusdc", Paused: true})
+	_, err := k.SendRestrictionFn(ctx, feeCollector, distribution, amounts)
+	require.NoError(t, err)
+	_, err = k.SendRestrictionFn(ctx, user, feeCollector, amounts)
+	require.ErrorIs(t, err, types.ErrPaused)
+	_, err = k.SendRestrictionFn(ctx, distribution, user, amounts)
+	require.ErrorIs(t, err, types.ErrPaused)
+	k.SetPaused(ctx, types.Paused{Denom: "uusdc", Paused: false})
+
+	// a module account exempted from the blacklist is never treated as blacklisted
+	k.SetBlacklisted(ctx, types.Blacklisted{AddressBz: feeCollector, Denom: "uusdc"})
+	k.SetBlacklisted(ctx, types.Blacklisted{AddressBz: distribution, Denom: "uusdc"})
+	_, err = k.SendRestrictionFn(ctx, feeCollector, user, amounts)
+	require.NoError(t, err)
+	_, err = k.SendRestrictionFn(ctx, user, feeCollector, amounts)
+	require.NoError(t, err)
+	_, err = k.SendRestrictionFn(ctx, feeCollector, distribution, amounts)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// the other party is still checked against the blacklist
+	k.SetBlacklisted(ctx, types.Blacklisted{AddressBz: user, Denom: "uusdc"})
+	_, err = k.SendRestrictionFn(ctx, user, feeCollector, amounts)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
@@ -20,25 +20,19 @@ import (
 	"context"
 
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
-	"github.com/cosmos/cosmos-sdk/runtime"
 )
 
 // SetMasterMinter set masterMinter in the store
 func (k Keeper) SetMasterMinter(ctx context.Context, masterMinter types.MasterMinter) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	b := k.cdc.MustMarshal(&masterMinter)
-	store.Set(types.KeyPrefix(types.MasterMinterKey), b)
+	set(ctx, k.masterMinters, masterMinter.Denom, masterMinter)
 }
 
-// GetMasterMinter returns masterMinter
-func (k Keeper) GetMasterMinter(ctx context.Context) (val types.MasterMinter, found bool) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-
-	b := store.Get(types.KeyPrefix(types.MasterMinterKey))
-	if b == nil {
-		return val, false
-	}
+// GetMasterMinter returns the masterMinter of a minting denom
+func (k Keeper) GetMasterMinter(ctx context.Context, denom string) (val types.MasterMinter, found bool) {
+	return get(ctx, k.masterMinters, denom)
+}
 
-	k.cdc.MustUnmarshal(b, &val)
-	return val, true
+// GetAllMasterMinters returns the masterMinters of all minting denoms
+func (k Keeper) GetAllMasterMinters(ctx context.Context) []types.MasterMinter {
+	return values(ctx, k.masterMinters, nil)
 }
@@ -30,7 +30,7 @@ import (
 )
 
 func createTestMasterMinter(keeper *keeper.Keeper, ctx sdk.Context) types.MasterMinter {
-	item := types.MasterMinter{}
+	item := types.MasterMinter{Denom: "uusdc"}
 	keeper.SetMasterMinter(ctx, item)
 	return item
 }
@@ -39,7 +39,7 @@ func TestMasterMinterGetAndSet_Success(t *testing.T) {
 	masterMinter := sample.TestAccount()
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	keeper.SetMasterMinter(ctx, types.MasterMinter{Address: masterMinter.Address})
+	keeper.SetMasterMinter(ctx, types.MasterMinter{Denom: "uusdc", Address: masterMinter.Address})
 
 	assertMasterMinter(t, *keeper, ctx, masterMinter)
 }
@@ -47,26 +47,26 @@ func TestMasterMinterGetAndSet_Success(t *testing.T) {
 func TestMasterMinterSet_Overwrite(t *testing.T) {
 	masterMinter := sample.TestAccount()
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
-	keeper.SetMasterMinter(ctx, types.MasterMinter{Address: masterMinter.Address})
+	keeper.SetMasterMinter(ctx, types.MasterMinter{Denom: "uusdc", Address: masterMinter.Address})
 	assertMasterMinter(t, *keeper, ctx, masterMinter)
 
 	newMasterMinter := sample.TestAccount()
-	keeper.SetMasterMinter(ctx, types.MasterMinter{Address: newMasterMinter.Address})
+	keeper.SetMasterMinter(ctx, types.MasterMinter{Denom: "uusdc", Address: newMasterMinter.Address})
 	assertMasterMinter(t, *keeper, ctx, newMasterMinter)
 }
 
 func TestMasterMinterGet_Unset(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	_, found := keeper.GetMasterMinter(ctx)
+	_, found := keeper.GetMasterMinter(ctx, "uusdc")
 	require.False(t, found)
 }
 
 func assertMasterMinter(t *testing.T, keeper keeper.Keeper, ctx sdk.Context, expectedMasterMinter sample.Account) {
-	rst, found := keeper.GetMasterMinter(ctx)
+	rst, found := keeper.GetMasterMinter(ctx, "uusdc")
 	require.True(t, found)
 	require.Equal(t,
-		nullify.Fill(&types.MasterMinter{Address: expectedMasterMinter.Address}),
+		nullify.Fill(&types.MasterMinter{Denom: "uusdc", Address: expectedMasterMinter.Address}),
 		nullify.Fill(&rst),
 	)
 }
@@ -0,0 +1,259 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	v1 "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/migrations/v1"
+	v2 "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/migrations/v2"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the module state from the v1 layout, which supported a
+// single minting denom, to the v2 layout, where every entry is stored under
+// the length-prefixed key of its minting denom. Entries of the v1 layout are
+// assigned to the v1 minting denom and removed afterwards.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	k := m.keeper
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	bz := store.Get(v1.KeyPrefix(v1.MintingDenomKey))
+	if bz == nil {
+		// Nothing to migrate, the module state was never initialized.
+		return nil
+	}
+	var mintingDenom types.MintingDenom
+	if err := k.cdc.Unmarshal(bz, &mintingDenom); err != nil {
+		return err
+	}
+	store.Delete(v1.KeyPrefix(v1.MintingDenomKey))
+	denom := mintingDenom.Denom
+	store.Set(v2.DenomKeyPrefix(v2.MintingDenomKeyPrefix, denom), bz)
+
+	if err := moveValue(store, k.cdc, v1.PausedKey, v2.PausedKeyPrefix, denom, func(v *types.Paused) { v.Denom = denom }); err != nil {
+		return err
+	}
+	if err := moveValue(store, k.cdc, v1.MasterMinterKey, v2.MasterMinterKeyPrefix, denom, func(v *types.MasterMinter) { v.Denom = denom }); err != nil {
+		return err
+	}
+	if err := moveValue(store, k.cdc, v1.PauserKey, v2.PauserKeyPrefix, denom, func(v *types.Pauser) { v.Denom = denom }); err != nil {
+		return err
+	}
+	if err := moveValue(store, k.cdc, v1.BlacklisterKey, v2.BlacklisterKeyPrefix, denom, func(v *types.Blacklister) { v.Denom = denom }); err != nil {
+		return err
+	}
+	if err := moveValue(store, k.cdc, v1.OwnerKey, v2.OwnerKeyPrefix, denom, func(v *types.Owner) { v.Denom = denom }); err != nil {
+		return err
+	}
+	if err := moveValue(store, k.cdc, v1.PendingOwnerKey, v2.PendingOwnerKeyPrefix, denom, func(v *types.Owner) { v.Denom = denom }); err != nil {
+		return err
+	}
+
+	if err := movePrefix(store, k.cdc, v1.BlacklistedKeyPrefix, v2.BlacklistedKeyPrefix, denom, func(v *types.Blacklisted) { v.Denom = denom }); err != nil {
+		return err
+	}
+	if err := movePrefix(store, k.cdc, v1.MintersKeyPrefix, v2.MintersKeyPrefix, denom, func(v *types.Minters) { v.Allowance.Denom = denom }); err != nil {
+		return err
+	}
+	return movePrefix(store, k.cdc, v1.MinterControllerKeyPrefix, v2.MinterControllerKeyPrefix, denom, func(v *types.MinterController) { v.Denom = denom })
+}
+
+// Migrate2to3 migrates the module state from the v2 layout to the v3 layout,
+// where every entry is stored in a collection indexed by its minting denom
+// and, for address indexed entries, the address bytes. The v2 entries are
+// removed afterwards.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	k := m.keeper
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	if err := migrateValues(ctx, store, k.cdc, v2.MintingDenomKeyPrefix, k.mintingDenoms, func(v *types.MintingDenom) string { return v.Denom }); err != nil {
+		return err
+	}
+	if err := migrateValues(ctx, store, k.cdc, v2.PausedKeyPrefix, k.paused, func(v *types.Paused) string { return v.Denom }); err != nil {
+		return err
+	}
+	if err := migrateValues(ctx, store, k.cdc, v2.MasterMinterKeyPrefix, k.masterMinters, func(v *types.MasterMinter) string { return v.Denom }); err != nil {
+		return err
+	}
+	if err := migrateValues(ctx, store, k.cdc, v2.PauserKeyPrefix, k.pausers, func(v *types.Pauser) string { return v.Denom }); err != nil {
+		return err
+	}
+	if err := migrateValues(ctx, store, k.cdc, v2.BlacklisterKeyPrefix, k.blacklisters, func(v *types.Blacklister) string { return v.Denom }); err != nil {
+		return err
+	}
+	if err := migrateValues(ctx, store, k.cdc, v2.OwnerKeyPrefix, k.owners, func(v *types.Owner) string { return v.Denom }); err != nil {
+		return err
+	}
+	if err := migrateValues(ctx, store, k.cdc, v2.PendingOwnerKeyPrefix, k.pendingOwners, func(v *types.Owner) string { return v.Denom }); err != nil {
+		return err
+	}
+
+	if err := migratePrefix(ctx, store, k.cdc, v2.BlacklistedKeyPrefix, k.blacklisted, func(v *types.Blacklisted) (collections.Pair[string, []byte], error) {
+		return collections.Join(v.Denom, v.AddressBz), nil
+	}); err != nil {
+		return err
+	}
+	if err := migratePrefix(ctx, store, k.cdc, v2.MintersKeyPrefix, k.minters, func(v *types.Minters) (collections.Pair[string, []byte], error) {
+		if v.Allowance.Amount.IsNil() {
+			v.Allowance.Amount = math.ZeroInt()
+		}
+		return denomAddressKey(v.Allowance.Denom, v.Address)
+	}); err != nil {
+		return err
+	}
+	return migratePrefix(ctx, store, k.cdc, v2.MinterControllerKeyPrefix, k.minterControllers, func(v *types.MinterController) (collections.Pair[string, []byte], error) {
+		return denomAddressKey(v.Denom, v.Controller)
+	})
+}
+
+// Migrate3to4 migrates the paused state of every minting denom from a single
+// flag to a set of paused scopes. A paused denom has all of its scopes paused.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	k := m.keeper
+	for _, paused := range k.GetAllPaused(ctx) {
+		k.SetPaused(ctx, paused)
+	}
+	return nil
+}
+
+// moveValue moves a v1 singleton entry under the v2 key of the given minting
+// denom.
+func moveValue[V any, PV interface {
+	*V
+	proto.Message
+}](store storetypes.KVStore, cdc codec.BinaryCodec, key string, keyPrefix string, denom string, update func(PV)) error {
+	bz := store.Get(v1.KeyPrefix(key))
+	if bz == nil {
+		return nil
+	}
+
+	var val V
+	if err := cdc.Unmarshal(bz, PV(&val)); err != nil {
+		return err
+	}
+	update(&val)
+
+	bz, err := cdc.Marshal(PV(&val))
+	if err != nil {
+		return err
+	}
+	store.Delete(v1.KeyPrefix(key))
+	store.Set(v2.DenomKeyPrefix(keyPrefix, denom), bz)
+	return nil
+}
+
+// movePrefix moves all v1 entries under a prefix below the v2 prefix of the
+// given minting denom. The index suffix of the keys is left unchanged.
+func movePrefix[V any, PV interface {
+	*V
+	proto.Message
+}](store storetypes.KVStore, cdc codec.BinaryCodec, oldPrefix string, newPrefix string, denom string, update func(PV)) error {
+	// The v1 and v2 prefixes share their leading bytes, so all v1 entries are
+	// read before any v2 entry is written.
+	oldStore := prefix.NewStore(store, v1.KeyPrefix(oldPrefix))
+	newStore := prefix.NewStore(store, v2.DenomKeyPrefix(newPrefix, denom))
+
+	var keys, vals [][]byte
+	iterator := storetypes.KVStorePrefixIterator(oldStore, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		var val V
+		if err := cdc.Unmarshal(iterator.Value(), PV(&val)); err != nil {
+			iterator.Close()
+			return err
+		}
+		update(&val)
+
+		bz, err := cdc.Marshal(PV(&val))
+		if err != nil {
+			iterator.Close()
+			return err
+		}
+		keys = append(keys, iterator.Key())
+		vals = append(vals, bz)
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		oldStore.Delete(key)
+	}
+	for i, key := range keys {
+		newStore.Set(key, vals[i])
+	}
+	return nil
+}
+
+// migrateValues moves all v2 entries under a prefix into their v3 collection,
+// keyed by the minting denom returned by denom.
+func migrateValues[V any, PV interface {
+	*V
+	proto.Message
+}](ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec, keyPrefix string, coll collections.Map[string, V], denom func(PV) string) error {
+	return migratePrefix(ctx, store, cdc, keyPrefix, coll, func(v PV) (string, error) { return denom(v), nil })
+}
+
+// migratePrefix moves all v2 entries under a prefix into their v3 collection,
+// using the key returned by update.
+func migratePrefix[K, V any, PV interface {
+	*V
+	proto.Message
+}](ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec, keyPrefix string, coll collections.Map[K, V], update func(PV) (K, error)) error {
+	prefixStore := prefix.NewStore(store, v2.KeyPrefix(keyPrefix))
+
+	var keys [][]byte
+	var vals []V
+	iterator := storetypes.KVStorePrefixIterator(prefixStore, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		var val V
+		if err := cdc.Unmarshal(iterator.Value(), PV(&val)); err != nil {
+			iterator.Close()
+			return err
+		}
+		keys = append(keys, iterator.Key())
+		vals = append(vals, val)
+	}
+	iterator.Close()
+
+	for i, val := range vals {
+		prefixStore.Delete(keys[i])
+
+		key, err := update(&val)
+		if err != nil {
+			return err
+		}
+		if err := coll.Set(ctx, key, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	v1 "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/migrations/v1"
+	v2 "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/migrations/v2"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	k, ctx, key := keepertest.FiatTokenfactoryKeeperWithKey()
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	store := ctx.KVStore(key)
+
+	owner := sample.TestAccount()
+	pendingOwner := sample.TestAccount()
+	masterMinter := sample.TestAccount()
+	pauser := sample.TestAccount()
+	blacklister := sample.TestAccount()
+	minter := sample.TestAccount()
+	controller := sample.TestAccount()
+	blacklisted := sample.TestAccount()
+
+	// Write the module state in its v1 layout, where entries carry no denom.
+	store.Set(v1.KeyPrefix(v1.MintingDenomKey), cdc.MustMarshal(&types.MintingDenom{Denom: "uusdc"}))
+	store.Set(v1.KeyPrefix(v1.PausedKey), cdc.MustMarshal(&types.Paused{Paused: true}))
+	store.Set(v1.KeyPrefix(v1.OwnerKey), cdc.MustMarshal(&types.Owner{Address: owner.Address}))
+	store.Set(v1.KeyPrefix(v1.PendingOwnerKey), cdc.MustMarshal(&types.Owner{Address: pendingOwner.Address}))
+	store.Set(v1.KeyPrefix(v1.MasterMinterKey), cdc.MustMarshal(&types.MasterMinter{Address: masterMinter.Address}))
+	store.Set(v1.KeyPrefix(v1.PauserKey), cdc.MustMarshal(&types.Pauser{Address: pauser.Address}))
+	store.Set(v1.KeyPrefix(v1.BlacklisterKey), cdc.MustMarshal(&types.Blacklister{Address: blacklister.Address}))
+
+	blacklistedStore := prefix.NewStore(store, v1.KeyPrefix(v1.BlacklistedKeyPrefix))
+	blacklistedStore.Set(v1.BlacklistedKey(blacklisted.AddressBz), cdc.MustMarshal(&types.Blacklisted{AddressBz: blacklisted.AddressBz}))
+
+	mintersStore := prefix.NewStore(store, v1.KeyPrefix(v1.MintersKeyPrefix))
+	mintersStore.Set(v1.MintersKey(minter.Address), cdc.MustMarshal(&types.Minters{
+		Address:   minter.Address,
+		Allowance: sdk.NewInt64Coin("uusdc", 100),
+	}))
+
+	minterControllerStore := prefix.NewStore(store, v1.KeyPrefix(v1.MinterControllerKeyPrefix))
+	minterControllerStore.Set(v1.MinterControllerKey(controller.Address), cdc.MustMarshal(&types.MinterController{
+		Minter:     minter.Address,
+		Controller: controller.Address,
+	}))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+
+	var mintingDenom types.MintingDenom
+	cdc.MustUnmarshal(store.Get(v2.DenomKeyPrefix(v2.MintingDenomKeyPrefix, "uusdc")), &mintingDenom)
+	require.Equal(t, types.MintingDenom{Denom: "uusdc"}, mintingDenom)
+
+	var paused types.Paused
+	cdc.MustUnmarshal(store.Get(v2.DenomKeyPrefix(v2.PausedKeyPrefix, "uusdc")), &paused)
+	require.Equal(t, types.Paused{Denom: "uusdc", Paused: true}, paused)
+
+	var gotOwner, gotPendingOwner types.Owner
+	cdc.MustUnmarshal(store.Get(v2.DenomKeyPrefix(v2.OwnerKeyPrefix, "uusdc")), &gotOwner)
+	require.Equal(t, types.Owner{Denom: "uusdc", Address: owner.Address}, gotOwner)
+	cdc.MustUnmarshal(store.Get(v2.DenomKeyPrefix(v2.PendingOwnerKeyPrefix, "uusdc")), &gotPendingOwner)
+	require.Equal(t, types.Owner{Denom: "uusdc", Address: pendingOwner.Address}, gotPendingOwner)
+
+	var gotMasterMinter types.MasterMinter
+	cdc.MustUnmarshal(store.Get(v2.DenomKeyPrefix(v2.MasterMinterKeyPrefix, "uusdc")), &gotMasterMinter)
+	require.Equal(t, types.MasterMinter{Denom: "uusdc", Address: masterMinter.Address}, gotMasterMinter)
+
+	var gotPauser types.Pauser
+	cdc.MustUnmarshal(store.Get(v2.DenomKeyPrefix(v2.PauserKeyPrefix, "uusdc")), &gotPauser)
+	require.Equal(t, types.Pauser{Denom: "uusdc", Address: pauser.Address}, gotPauser)
+
+	var gotBlacklister types.Blacklister
+	cdc.MustUnmarshal(store.Get(v2.DenomKeyPrefix(v2.BlacklisterKeyPrefix, "uusdc")), &gotBlacklister)
+	require.Equal(t, types.Blacklister{Denom: "uusdc", Address: blacklister.Address}, gotBlacklister)
+
+	var gotBlacklisted types.Blacklisted
+	cdc.MustUnmarshal(prefix.NewStore(store, v2.DenomKeyPrefix(v2.BlacklistedKeyPrefix, "uusdc")).Get(v2.BlacklistedKey(blacklisted.AddressBz)), &gotBlacklisted)
+	require.Equal(t, types.Blacklisted{Denom: "uusdc", AddressBz: blacklisted.AddressBz}, gotBlacklisted)
+
+	var gotMinter types.Minters
+	cdc.MustUnmarshal(prefix.NewStore(store, v2.DenomKeyPrefix(v2.MintersKeyPrefix, "uusdc")).Get(v2.MintersKey(minter.Address)), &gotMinter)
+	require.Equal(t, minter.Address, gotMinter.Address)
+	require.Equal(t, sdk.NewCoin("uusdc", math.NewInt(100)), gotMinter.Allowance)
+
+	var gotMinterController types.MinterController
+	cdc.MustUnmarshal(prefix.NewStore(store, v2.DenomKeyPrefix(v2.MinterControllerKeyPrefix, "uusdc")).Get(v2.MinterControllerKey(controller.Address)), &gotMinterController)
+	require.Equal(t, types.MinterController{Denom: "uusdc", Minter: minter.Address, Controller: controller.Address}, gotMinterController)
+
+	// All v1 entries are removed.
+	for _, key := range []string{
+		v1.MintingDenomKey, v1.PausedKey, v1.OwnerKey, v1.PendingOwnerKey,
+		v1.MasterMinterKey, v1.PauserKey, v1.BlacklisterKey,
+	} {
+		require.False(t, store.Has(v1.KeyPrefix(key)), key)
+	}
+	require.False(t, blacklistedStore.Has(v1.BlacklistedKey(blacklisted.AddressBz)))
+	require.False(t, mintersStore.Has(v1.MintersKey(minter.Address)))
+	require.False(t, minterControllerStore.Has(v1.MinterControllerKey(controller.Address)))
+}
+
+func TestMigrate1to2_Uninitialized(t *testing.T) {
+	k, ctx, key := keepertest.FiatTokenfactoryKeeperWithKey()
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+
+	iterator := ctx.KVStore(key).Iterator(nil, nil)
+	defer iterator.Close()
+	require.False(t, iterator.Valid())
+}
+
+func TestMigrate2to3(t *testing.T) {
+	k, ctx, key := keepertest.FiatTokenfactoryKeeperWithKey()
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	store := ctx.KVStore(key)
+
+	owner := sample.TestAccount()
+	minter := sample.TestAccount()
+	controller := sample.TestAccount()
+	blacklisted := sample.TestAccount()
+
+	// Write the module state of two minting denoms in its v2 layout.
+	for _, denom := range []string{"uusdc", "ueurc"} {
+		store.Set(v2.DenomKeyPrefix(v2.MintingDenomKeyPrefix, denom), cdc.MustMarshal(&types.MintingDenom{Denom: denom}))
+		store.Set(v2.DenomKeyPrefix(v2.PausedKeyPrefix, denom), cdc.MustMarshal(&types.Paused{Denom: denom, Paused: denom == "ueurc"}))
+		store.Set(v2.DenomKeyPrefix(v2.OwnerKeyPrefix, denom), cdc.MustMarshal(&types.Owner{Denom: denom, Address: owner.Address}))
+
+		prefix.NewStore(store, v2.DenomKeyPrefix(v2.BlacklistedKeyPrefix, denom)).
+			Set(v2.BlacklistedKey(blacklisted.AddressBz), cdc.MustMarshal(&types.Blacklisted{Denom: denom, AddressBz: blacklisted.AddressBz}))
+		prefix.NewStore(store, v2.DenomKeyPrefix(v2.MintersKeyPrefix, denom)).
+			Set(v2.MintersKey(minter.Address), cdc.MustMarshal(&types.Minters{Address: minter.Address, Allowance: sdk.NewInt64Coin(denom, 100)}))
+		prefix.NewStore(store, v2.DenomKeyPrefix(v2.MinterControllerKeyPrefix, denom)).
+			Set(v2.MinterControllerKey(controller.Address), cdc.MustMarshal(&types.MinterController{Denom: denom, Minter: minter.Address, Controller: controller.Address}))
+	}
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate2to3(ctx))
+
+	require.Len(t, k.GetAllMintingDenoms(ctx), 2)
+	for _, denom := range []string{"uusdc", "ueurc"} {
+		_, found := k.GetMintingDenom(ctx, denom)
+		require.True(t, found)
+
+		require.Equal(t, types.Paused{Denom: denom, Paused: denom == "ueurc"}, k.GetPaused(ctx, denom))
+
+		gotOwner, found := k.GetOwner(ctx, denom)
+		require.True(t, found)
+		require.Equal(t, types.Owner{Denom: denom, Address: owner.Address}, gotOwner)
+
+		gotBlacklisted, found := k.GetBlacklisted(ctx, denom, blacklisted.AddressBz)
+		require.True(t, found)
+		require.Equal(t, types.Blacklisted{Denom: denom, AddressBz: blacklisted.AddressBz}, gotBlacklisted)
+
+		gotMinter, found := k.GetMinters(ctx, denom, minter.Address)
+		require.True(t, found)
+		require.Equal(t, sdk.NewCoin(denom, math.NewInt(100)), gotMinter.Allowance)
+
+		gotMinterController, found := k.GetMinterController(ctx, denom, controller.Address)
+		require.True(t, found)
+		require.Equal(t, types.MinterController{Denom: denom, Minter: minter.Address, Controller: controller.Address}, gotMinterController)
+	}
+
+	// All v2 entries are removed.
+	for _, keyPrefix := range []string{
+		v2.MintingDenomKeyPrefix, v2.PausedKeyPrefix, v2.OwnerKeyPrefix,
+		v2.BlacklistedKeyPrefix, v2.MintersKeyPrefix, v2.MinterControllerKeyPrefix,
+	} {
+		iterator := prefix.NewStore(store, v2.KeyPrefix(keyPrefix)).Iterator(nil, nil)
+		require.False(t, iterator.Valid(), keyPrefix)
+		iterator.Close()
+	}
+}
+
+func TestMigrate3to4(t *testing.T) {
+	k, ctx, key := keepertest.FiatTokenfactoryKeeperWithKey()
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	store := ctx.KVStore(key)
+
+	// Write the paused states in their v3 layout, which only carries a flag.
+	store.Set(append(types.PausedPrefix.Bytes(), "uusdc"...), cdc.MustMarshal(&types.Paused{Denom: "uusdc", Paused: true}))
+	store.Set(append(types.PausedPrefix.Bytes(), "ueurc"...), cdc.MustMarshal(&types.Paused{Denom: "ueurc", Paused: false}))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate3to4(ctx))
+
+	require.Equal(t, types.Paused{Denom: "uusdc", Paused: true, Scopes: types.PauseScopesAll}, k.GetPaused(ctx, "uusdc"))
+	require.Equal(t, types.Paused{Denom: "ueurc"}, k.GetPaused(ctx, "ueurc"))
+}
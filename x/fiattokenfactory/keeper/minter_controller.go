@@ -20,65 +20,44 @@ import (
 	"context"
 
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
-
-	"cosmossdk.io/store/prefix"
-	"github.com/cosmos/cosmos-sdk/runtime"
 )
 
 // SetMinterController set a specific minterController in the store from its index
 func (k Keeper) SetMinterController(ctx context.Context, minterController types.MinterController) {
-	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(adapter, types.KeyPrefix(types.MinterControllerKeyPrefix))
-	b := k.cdc.MustMarshal(&minterController)
-	store.Set(types.MinterControllerKey(
-		minterController.Controller,
-	), b)
+	key, err := denomAddressKey(minterController.Denom, minterController.Controller)
+	if err != nil {
+		panic(err)
+	}
+	set(ctx, k.minterControllers, key, minterController)
 }
 
 // GetMinterController returns a minterController from its index
 func (k Keeper) GetMinterController(
 	ctx context.Context,
+	denom string,
 	controller string,
 ) (val types.MinterController, found bool) {
-	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(adapter, types.KeyPrefix(types.MinterControllerKeyPrefix))
-
-	b := store.Get(types.MinterControllerKey(
-		controller,
-	))
-	if b == nil {
+	key, err := denomAddressKey(denom, controller)
+	if err != nil {
 		return val, false
 	}
-
-	k.cdc.MustUnmarshal(b, &val)
-	return val, true
+	return get(ctx, k.minterControllers, key)
 }
 
-// RemoveMinterController removes a minterController from the store
+// DeleteMinterController removes a minterController from the store
 func (k Keeper) DeleteMinterController(
 	ctx context.Context,
+	denom string,
 	controller string,
 ) {
-	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(adapter, types.KeyPrefix(types.MinterControllerKeyPrefix))
-	store.Delete(types.MinterControllerKey(
-		controller,
-	))
-}
-
-// GetAllMinterController returns all minterController
-func (k Keeper) GetAllMinterControllers(ctx context.Context) (list []types.MinterController) {
-	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(adapter, types.KeyPrefix(types.MinterControllerKeyPrefix))
-	iterator := store.Iterator(nil, nil)
-
-	defer iterator.Close()
-
-	for ; iterator.Valid(); iterator.Next() {
-		var val types.MinterController
-		k.cdc.MustUnmarshal(iterator.Value(), &val)
-		list = append(list, val)
+	key, err := denomAddressKey(denom, controller)
+	if err != nil {
+		return
 	}
+	remove(ctx, k.minterControllers, key)
+}
 
-	return
+// GetAllMinterControllers returns all minterController of all minting denoms
+func (k Keeper) GetAllMinterControllers(ctx context.Context) []types.MinterController {
+	return values(ctx, k.minterControllers, nil)
 }
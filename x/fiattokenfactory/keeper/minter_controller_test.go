@@ -36,7 +36,8 @@ var _ = strconv.IntSize
 func createNMinterController(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.MinterController {
 	items := make([]types.MinterController, n)
 	for i := range items {
-		items[i].Controller = strconv.Itoa(i)
+		items[i].Controller = sample.AccAddress()
+		items[i].Denom = "uusdc"
 
 		keeper.SetMinterController(ctx, items[i])
 	}
@@ -48,7 +49,7 @@ func TestMinterControllerGetAndSet_Success(t *testing.T) {
 	minter := sample.TestAccount()
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	keeper.SetMinterController(ctx, types.MinterController{Controller: controller.Address, Minter: minter.Address})
+	keeper.SetMinterController(ctx, types.MinterController{Controller: controller.Address, Minter: minter.Address, Denom: "uusdc"})
 
 	assertAccountIsAController(t, *keeper, ctx, controller, minter)
 }
@@ -64,11 +65,11 @@ func TestMinterControllerSet_UpdateControlledMinter(t *testing.T) {
 	controller := sample.TestAccount()
 	minter := sample.TestAccount()
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
-	keeper.SetMinterController(ctx, types.MinterController{Controller: controller.Address, Minter: minter.Address})
+	keeper.SetMinterController(ctx, types.MinterController{Controller: controller.Address, Minter: minter.Address, Denom: "uusdc"})
 	assertAccountIsAController(t, *keeper, ctx, controller, minter)
 
 	newMinter := sample.TestAccount()
-	keeper.SetMinterController(ctx, types.MinterController{Controller: controller.Address, Minter: newMinter.Address})
+	keeper.SetMinterController(ctx, types.MinterController{Controller: controller.Address, Minter: newMinter.Address, Denom: "uusdc"})
 
 	assertAccountIsAController(t, *keeper, ctx, controller, newMinter)
 }
@@ -77,7 +78,7 @@ func TestMinterControllerDelete_AddressIsNotController(t *testing.T) {
 	acc := sample.TestAccount()
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	keeper.DeleteMinterController(ctx, acc.Address)
+	keeper.DeleteMinterController(ctx, "uusdc", acc.Address)
 
 	assertAccountIsNotAController(t, *keeper, ctx, acc)
 }
@@ -86,14 +87,27 @@ func TestMinterControllerDelete_AddressIsController(t *testing.T) {
 	controller := sample.TestAccount()
 	minter := sample.TestAccount()
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
-	keeper.SetMinterController(ctx, types.MinterController{Controller: controller.Address, Minter: minter.Address})
+	keeper.SetMinterController(ctx, types.MinterController{Controller: controller.Address, Minter: minter.Address, Denom: "uusdc"})
 	assertAccountIsAController(t, *keeper, ctx, controller, minter)
 
-	keeper.DeleteMinterController(ctx, controller.Address)
+	keeper.DeleteMinterController(ctx, "uusdc", controller.Address)
 
 	assertAccountIsNotAController(t, *keeper, ctx, controller)
 }
 
+func TestMinterController_ScopedToDenom(t *testing.T) {
+	controller := sample.TestAccount()
+	minter := sample.TestAccount()
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	keeper.SetMinterController(ctx, types.MinterController{Controller: controller.Address, Minter: minter.Address, Denom: "uusdc"})
+
+	_, found := keeper.GetMinterController(ctx, "ueurc", controller.Address)
+	require.False(t, found)
+
+	keeper.DeleteMinterController(ctx, "ueurc", controller.Address)
+	assertAccountIsAController(t, *keeper, ctx, controller, minter)
+}
+
 func TestMinterControllerGetAll_EmptyControllerList(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
@@ -111,15 +125,15 @@ func TestMinterControllerGetAll_NonEmptyControllerList(t *testing.T) {
 }
 
 func assertAccountIsAController(t *testing.T, keeper keeper.Keeper, ctx sdk.Context, controller sample.Account, minter sample.Account) {
-	rst, found := keeper.GetMinterController(ctx, controller.Address)
+	rst, found := keeper.GetMinterController(ctx, "uusdc", controller.Address)
 	require.True(t, found)
 	require.Equal(t,
-		nullify.Fill(&types.MinterController{Controller: controller.Address, Minter: minter.Address}),
+		nullify.Fill(&types.MinterController{Controller: controller.Address, Minter: minter.Address, Denom: "uusdc"}),
 		nullify.Fill(&rst),
 	)
 }
 
 func assertAccountIsNotAController(t *testing.T, keeper keeper.Keeper, ctx sdk.Context, controller sample.Account) {
-	_, found := keeper.GetMinterController(ctx, controller.Address)
+	_, found := keeper.GetMinterController(ctx, "uusdc", controller.Address)
 	require.False(t, found)
 }
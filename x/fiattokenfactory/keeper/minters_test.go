@@ -36,7 +36,8 @@ var _ = strconv.IntSize
 func createNMinters(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.Minters {
 	items := make([]types.Minters, n)
 	for i := range items {
-		items[i].Address = strconv.Itoa(i)
+		items[i].Address = sample.AccAddress()
+		items[i].Allowance = sdk.Coin{Denom: "uusdc", Amount: math.NewInt(int64(i))}
 
 		keeper.SetMinters(ctx, items[i])
 	}
@@ -77,7 +78,7 @@ func TestMintersRemove_AddressIsNotMinter(t *testing.T) {
 	acc := sample.TestAccount()
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	keeper.RemoveMinters(ctx, acc.Address)
+	keeper.RemoveMinters(ctx, "uusdc", acc.Address)
 
 	assertAccountIsNotAMinter(t, *keeper, ctx, acc)
 }
@@ -89,7 +90,7 @@ func TestMintersRemove_AddressIsMinter(t *testing.T) {
 	keeper.SetMinters(ctx, types.Minters{Address: minter.Address, Allowance: allowance})
 	assertAccountIsAMinter(t, *keeper, ctx, minter, allowance)
 
-	keeper.RemoveMinters(ctx, minter.Address)
+	keeper.RemoveMinters(ctx, "uusdc", minter.Address)
 
 	assertAccountIsNotAMinter(t, *keeper, ctx, minter)
 }
@@ -111,7 +112,7 @@ func TestMintersGetAll_NonEmptyMinterList(t *testing.T) {
 }
 
 func assertAccountIsAMinter(t *testing.T, keeper keeper.Keeper, ctx sdk.Context, minter sample.Account, allowance sdk.Coin) {
-	rst, found := keeper.GetMinters(ctx, minter.Address)
+	rst, found := keeper.GetMinters(ctx, "uusdc", minter.Address)
 	require.True(t, found)
 	require.Equal(t,
 		nullify.Fill(&types.Minters{Address: minter.Address, Allowance: allowance}),
@@ -120,6 +121,6 @@ func assertAccountIsAMinter(t *testing.T, keeper keeper.Keeper, ctx sdk.Context,
 }
 
 func assertAccountIsNotAMinter(t *testing.T, keeper keeper.Keeper, ctx sdk.Context, minter sample.Account) {
-	_, found := keeper.GetMinters(ctx, minter.Address)
+	_, found := keeper.GetMinters(ctx, "uusdc", minter.Address)
 	require.False(t, found)
 }
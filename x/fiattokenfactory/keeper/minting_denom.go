@@ -21,14 +21,11 @@ import (
 	"fmt"
 
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
-	"github.com/cosmos/cosmos-sdk/runtime"
-
-	"cosmossdk.io/store/prefix"
 )
 
-// SetMintingDenom set mintingDenom in the store
+// SetMintingDenom registers a new mintingDenom in the store
 func (k *Keeper) SetMintingDenom(ctx context.Context, mintingDenom types.MintingDenom) {
-	if k.MintingDenomSet(ctx) {
+	if _, found := k.GetMintingDenom(ctx, mintingDenom.Denom); found {
 		panic(types.ErrMintingDenomSet)
 	}
 
@@ -37,32 +34,15 @@ func (k *Keeper) SetMintingDenom(ctx context.Context, mintingDenom types.Minting
 		panic(fmt.Sprintf("Denom metadata for '%s' should be set", mintingDenom.Denom))
 	}
 
-	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(adapter, types.KeyPrefix(types.MintingDenomKey))
-	b := k.cdc.MustMarshal(&mintingDenom)
-	store.Set(types.KeyPrefix(types.MintingDenomKey), b)
+	set(ctx, k.mintingDenoms, mintingDenom.Denom, mintingDenom)
 }
 
-// GetMintingDenom returns mintingDenom
-func (k *Keeper) GetMintingDenom(ctx context.Context) (val types.MintingDenom) {
-	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(adapter, types.KeyPrefix(types.MintingDenomKey))
-
-	b := store.Get(types.KeyPrefix(types.MintingDenomKey))
-	if b == nil {
-		panic("Minting denom is not set")
-	}
-
-	k.cdc.MustUnmarshal(b, &val)
-	return val
+// GetMintingDenom returns a mintingDenom from its index
+func (k *Keeper) GetMintingDenom(ctx context.Context, denom string) (val types.MintingDenom, found bool) {
+	return get(ctx, k.mintingDenoms, denom)
 }
 
-// MintingDenomSet returns true if the MintingDenom is already set in the store, it returns false otherwise.
-func (k Keeper) MintingDenomSet(ctx context.Context) bool {
-	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(adapter, types.KeyPrefix(types.MintingDenomKey))
-
-	b := store.Get(types.KeyPrefix(types.MintingDenomKey))
-
-	return b != nil
+// GetAllMintingDenoms returns all registered mintingDenoms
+func (k *Keeper) GetAllMintingDenoms(ctx context.Context) []types.MintingDenom {
+	return values(ctx, k.mintingDenoms, nil)
 }
@@ -40,7 +40,8 @@ func TestMintingDenomGetAndSet_Success(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 	expectedDenom := createTestMintingDenom(keeper, ctx)
 
-	denom := keeper.GetMintingDenom(ctx)
+	denom, found := keeper.GetMintingDenom(ctx, "uusdc")
+	require.True(t, found)
 	require.Equal(t,
 		nullify.Fill(&expectedDenom),
 		nullify.Fill(&denom),
@@ -57,27 +58,25 @@ func TestSetMintingDenom_AlreadySetPanics(t *testing.T) {
 func TestSetMintingDenom_NoMetadataPanics(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	// in the mock bank keeper, it provides metadata only for uusdc
+	// in the mock bank keeper, it provides metadata only for uusdc and ueurc
 	require.Panics(t, func() { keeper.SetMintingDenom(ctx, types.MintingDenom{Denom: "notadenom"}) })
 }
 
-func TestMintingDenomGet_UnsetPanics(t *testing.T) {
+func TestSetMintingDenom_Multiple(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+	usdc := createTestMintingDenom(keeper, ctx)
+	eurc := types.MintingDenom{Denom: "ueurc"}
+	keeper.SetMintingDenom(ctx, eurc)
 
-	require.Panics(t, func() { keeper.GetMintingDenom(ctx) })
-}
-
-func TestIsMintingDenomSet_Unset(t *testing.T) {
-	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
-
-	isSet := keeper.MintingDenomSet(ctx)
-	require.False(t, isSet)
+	require.ElementsMatch(t,
+		nullify.Fill([]types.MintingDenom{usdc, eurc}),
+		nullify.Fill(keeper.GetAllMintingDenoms(ctx)),
+	)
 }
 
-func TestIsMintingDenomSet_AlreadySet(t *testing.T) {
+func TestMintingDenomGet_Unset(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
-	createTestMintingDenom(keeper, ctx)
 
-	isSet := keeper.MintingDenomSet(ctx)
-	require.True(t, isSet)
+	_, found := keeper.GetMintingDenom(ctx, "uusdc")
+	require.False(t, found)
 }
@@ -28,7 +28,7 @@ import (
 func (k msgServer) AcceptOwner(goCtx context.Context, msg *types.MsgAcceptOwner) (*types.MsgAcceptOwnerResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	owner, found := k.GetPendingOwner(ctx)
+	owner, found := k.GetPendingOwner(ctx, msg.Denom)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "pending owner is not set")
 	}
@@ -37,11 +37,29 @@ func (k msgServer) AcceptOwner(goCtx context.Context, msg *types.MsgAcceptOwner)
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the pending owner")
 	}
 
+	previous, _ := k.GetOwner(ctx, msg.Denom)
+
 	k.SetOwner(ctx, owner)
 
-	k.DeletePendingOwner(ctx)
+	k.DeletePendingOwner(ctx, msg.Denom)
+
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  msg.Denom,
+		Actor:  msg.From,
+		Role:   types.RolePendingOwner,
+		Action: types.ActionAcceptOwner,
+		Target: types.RoleOwner,
+		Before: previous.Address,
+		After:  owner.Address,
+	}); err != nil {
+		return nil, err
+	}
 
-	err := ctx.EventManager().EmitTypedEvent(msg)
+	err := ctx.EventManager().EmitTypedEvent(&types.EventOwnerChanged{
+		Denom:         msg.Denom,
+		PreviousOwner: previous.Address,
+		NewOwner:      owner.Address,
+	})
 
 	return &types.MsgAcceptOwnerResponse{}, err
 }
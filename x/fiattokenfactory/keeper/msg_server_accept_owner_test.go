@@ -31,7 +31,7 @@ func TestAcceptOwner_NoPendingOwner(t *testing.T) {
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
 
-	_, err := msgServer.AcceptOwner(sdk.WrapSDKContext(ctx), &types.MsgAcceptOwner{From: sample.AccAddress()})
+	_, err := msgServer.AcceptOwner(sdk.WrapSDKContext(ctx), &types.MsgAcceptOwner{From: sample.AccAddress(), Denom: "uusdc"})
 	require.ErrorIs(t, types.ErrUserNotFound, err)
 }
 
@@ -39,8 +39,8 @@ func TestAcceptOwner_FromAddressIsNotPendingOwner(t *testing.T) {
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
 
-	ftf.SetPendingOwner(ctx, types.Owner{Address: sample.AccAddress()})
-	_, err := msgServer.AcceptOwner(sdk.WrapSDKContext(ctx), &types.MsgAcceptOwner{From: sample.AccAddress()})
+	ftf.SetPendingOwner(ctx, types.Owner{Denom: "uusdc", Address: sample.AccAddress()})
+	_, err := msgServer.AcceptOwner(sdk.WrapSDKContext(ctx), &types.MsgAcceptOwner{From: sample.AccAddress(), Denom: "uusdc"})
 	require.ErrorIs(t, err, types.ErrUnauthorized)
 }
 
@@ -49,8 +49,9 @@ func TestAcceptOwner_Success(t *testing.T) {
 	msgServer := keeper.NewMsgServerImpl(ftf)
 	pendingOwner := sample.AccAddress()
 
-	ftf.SetPendingOwner(ctx, types.Owner{Address: pendingOwner})
-	res, err := msgServer.AcceptOwner(sdk.WrapSDKContext(ctx), &types.MsgAcceptOwner{From: pendingOwner})
+	ftf.SetPendingOwner(ctx, types.Owner{Denom: "uusdc", Address: pendingOwner})
+	res, err := msgServer.AcceptOwner(sdk.WrapSDKContext(ctx), &types.MsgAcceptOwner{From: pendingOwner, Denom: "uusdc"})
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgAcceptOwnerResponse{}, res)
+	requireTypedEvent(t, ctx, &types.EventOwnerChanged{Denom: "uusdc", NewOwner: pendingOwner})
 }
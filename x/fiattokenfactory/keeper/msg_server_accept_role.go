@@ -0,0 +1,71 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) AcceptRole(goCtx context.Context, msg *types.MsgAcceptRole) (*types.MsgAcceptRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Role == types.RoleOwner {
+		if _, err := k.AcceptOwner(goCtx, &types.MsgAcceptOwner{From: msg.From, Denom: msg.Denom}); err != nil {
+			return nil, err
+		}
+		return &types.MsgAcceptRoleResponse{}, nil
+	}
+
+	pendingRole, found := k.GetPendingRole(ctx, msg.Denom, msg.Role)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "pending %s is not set", msg.Role)
+	}
+
+	if pendingRole.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the pending %s", msg.Role)
+	}
+
+	// the address may have been assigned another role since it was proposed
+	err := k.ValidatePrivileges(ctx, msg.Denom, msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	k.DeletePendingRole(ctx, msg.Denom, msg.Role)
+
+	previous, err := k.changeRole(ctx, msg.Denom, msg.Role, msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.recordAudit(ctx, types.AuditEntry{
+		Denom:  msg.Denom,
+		Actor:  msg.From,
+		Role:   types.PendingRoleName(msg.Role),
+		Action: types.ActionAcceptRole,
+		Target: msg.Role,
+		Before: previous,
+		After:  msg.From,
+	})
+
+	return &types.MsgAcceptRoleResponse{}, err
+}
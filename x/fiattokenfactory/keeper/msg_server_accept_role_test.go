@@ -0,0 +1,193 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// roleTransfer updates and reads a role that is not the owner.
+type roleTransfer struct {
+	role   string
+	update func(msgServer types.MsgServer, ctx sdk.Context, from, address string) error
+	get    func(ftf *keeper.Keeper, ctx sdk.Context) string
+}
+
+var roleTransfers = []roleTransfer{
+	{
+		role: types.RoleMasterMinter,
+		update: func(msgServer types.MsgServer, ctx sdk.Context, from, address string) error {
+			_, err := msgServer.UpdateMasterMinter(ctx, &types.MsgUpdateMasterMinter{From: from, Address: address, Denom: "uusdc"})
+			return err
+		},
+		get: func(ftf *keeper.Keeper, ctx sdk.Context) string {
+			masterMinter, _ := ftf.GetMasterMinter(ctx, "uusdc")
+			return masterMinter.Address
+		},
+	},
+	{
+		role: types.RolePauser,
+		update: func(msgServer types.MsgServer, ctx sdk.Context, from, address string) error {
+			_, err := msgServer.UpdatePauser(ctx, &types.MsgUpdatePauser{From: from, Address: address, Denom: "uusdc"})
+			return err
+		},
+		get: func(ftf *keeper.Keeper, ctx sdk.Context) string {
+			pauser, _ := ftf.GetPauser(ctx, "uusdc")
+			return pauser.Address
+		},
+	},
+	{
+		role: types.RoleBlacklister,
+		update: func(msgServer types.MsgServer, ctx sdk.Context, from, address string) error {
+			_, err := msgServer.UpdateBlacklister(ctx, &types.MsgUpdateBlacklister{From: from, Address: address, Denom: "uusdc"})
+			return err
+		},
+		get: func(ftf *keeper.Keeper, ctx sdk.Context) string {
+			blacklister, _ := ftf.GetBlacklister(ctx, "uusdc")
+			return blacklister.Address
+		},
+	},
+	{
+		role: types.RoleAllowlister,
+		update: func(msgServer types.MsgServer, ctx sdk.Context, from, address string) error {
+			_, err := msgServer.UpdateAllowlister(ctx, &types.MsgUpdateAllowlister{From: from, Address: address, Denom: "uusdc"})
+			return err
+		},
+		get: func(ftf *keeper.Keeper, ctx sdk.Context) string {
+			allowlister, _ := ftf.GetAllowlister(ctx, "uusdc")
+			return allowlister.Address
+		},
+	},
+}
+
+func setupForTwoStepRoleTransferTest(owner string) (*keeper.Keeper, sdk.Context, types.MsgServer) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	params := types.DefaultParams()
+	params.TwoStepRoleTransfers = true
+	ftf.SetParams(ctx, params)
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner})
+	return ftf, ctx, keeper.NewMsgServerImpl(ftf)
+}
+
+func TestAcceptRole_TwoStepTransfer(t *testing.T) {
+	for _, tc := range roleTransfers {
+		t.Run(tc.role, func(t *testing.T) {
+			owner := sample.AccAddress()
+			address := sample.AccAddress()
+			ftf, ctx, msgServer := setupForTwoStepRoleTransferTest(owner)
+
+			require.NoError(t, tc.update(msgServer, ctx, owner, address))
+			require.Empty(t, tc.get(ftf, ctx))
+			pendingRole, found := ftf.GetPendingRole(ctx, "uusdc", tc.role)
+			require.True(t, found)
+			require.Equal(t, types.PendingRole{Denom: "uusdc", Role: tc.role, Address: address}, pendingRole)
+			requireTypedEvent(t, ctx, &types.EventPendingRoleSet{Denom: "uusdc", Role: tc.role, PendingAddress: address})
+
+			_, err := msgServer.AcceptRole(ctx, &types.MsgAcceptRole{From: sample.AccAddress(), Denom: "uusdc", Role: tc.role})
+			require.ErrorIs(t, err, types.ErrUnauthorized)
+			require.ErrorContains(t, err, "you are not the pending "+tc.role)
+
+			res, err := msgServer.AcceptRole(ctx, &types.MsgAcceptRole{From: address, Denom: "uusdc", Role: tc.role})
+			require.NoError(t, err)
+			require.Equal(t, &types.MsgAcceptRoleResponse{}, res)
+			require.Equal(t, address, tc.get(ftf, ctx))
+			_, found = ftf.GetPendingRole(ctx, "uusdc", tc.role)
+			require.False(t, found)
+
+			entries := ftf.GetAllAuditEntries(ctx)
+			require.Equal(t, types.ActionAcceptRole, entries[len(entries)-1].Action)
+			require.Equal(t, types.PendingRoleName(tc.role), entries[len(entries)-1].Role)
+			require.Equal(t, tc.role, entries[len(entries)-1].Target)
+		})
+	}
+}
+
+func TestAcceptRole_OneStepTransferClearsPendingRole(t *testing.T) {
+	for _, tc := range roleTransfers {
+		t.Run(tc.role, func(t *testing.T) {
+			owner := sample.AccAddress()
+			pending := sample.AccAddress()
+			address := sample.AccAddress()
+			ftf, ctx, msgServer := setupForTwoStepRoleTransferTest(owner)
+			require.NoError(t, tc.update(msgServer, ctx, owner, pending))
+
+			// disabling two-step transfers lets the owner assign the role directly
+			ftf.SetParams(ctx, types.DefaultParams())
+			require.NoError(t, tc.update(msgServer, ctx, owner, address))
+			require.Equal(t, address, tc.get(ftf, ctx))
+
+			_, found := ftf.GetPendingRole(ctx, "uusdc", tc.role)
+			require.False(t, found)
+			_, err := msgServer.AcceptRole(ctx, &types.MsgAcceptRole{From: pending, Denom: "uusdc", Role: tc.role})
+			require.ErrorIs(t, err, types.ErrUserNotFound)
+		})
+	}
+}
+
+func TestAcceptRole_NoPendingRole(t *testing.T) {
+	_, ctx, msgServer := setupForTwoStepRoleTransferTest(sample.AccAddress())
+
+	_, err := msgServer.AcceptRole(ctx, &types.MsgAcceptRole{From: sample.AccAddress(), Denom: "uusdc", Role: types.RolePauser})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+	require.ErrorContains(t, err, "pending pauser is not set")
+}
+
+func TestAcceptRole_AddressAlreadyPrivileged(t *testing.T) {
+	owner := sample.AccAddress()
+	address := sample.AccAddress()
+	ftf, ctx, msgServer := setupForTwoStepRoleTransferTest(owner)
+
+	_, err := msgServer.UpdatePauser(ctx, &types.MsgUpdatePauser{From: owner, Address: address, Denom: "uusdc"})
+	require.NoError(t, err)
+	_, err = msgServer.UpdateBlacklister(ctx, &types.MsgUpdateBlacklister{From: owner, Address: address, Denom: "uusdc"})
+	require.NoError(t, err)
+
+	_, err = msgServer.AcceptRole(ctx, &types.MsgAcceptRole{From: address, Denom: "uusdc", Role: types.RolePauser})
+	require.NoError(t, err)
+
+	// the address became the pauser since it was proposed as blacklister
+	_, err = msgServer.AcceptRole(ctx, &types.MsgAcceptRole{From: address, Denom: "uusdc", Role: types.RoleBlacklister})
+	require.ErrorIs(t, err, types.ErrAlreadyPrivileged)
+	_, found := ftf.GetBlacklister(ctx, "uusdc")
+	require.False(t, found)
+}
+
+func TestAcceptRole_Owner(t *testing.T) {
+	owner := sample.AccAddress()
+	newOwner := sample.AccAddress()
+	ftf, ctx, msgServer := setupForTwoStepRoleTransferTest(owner)
+
+	_, err := msgServer.UpdateOwner(ctx, &types.MsgUpdateOwner{From: owner, Address: newOwner, Denom: "uusdc"})
+	require.NoError(t, err)
+
+	_, err = msgServer.AcceptRole(ctx, &types.MsgAcceptRole{From: sample.AccAddress(), Denom: "uusdc", Role: types.RoleOwner})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = msgServer.AcceptRole(ctx, &types.MsgAcceptRole{From: newOwner, Denom: "uusdc", Role: types.RoleOwner})
+	require.NoError(t, err)
+	got, found := ftf.GetOwner(ctx, "uusdc")
+	require.True(t, found)
+	require.Equal(t, newOwner, got.Address)
+	requireTypedEvent(t, ctx, &types.EventOwnerChanged{Denom: "uusdc", PreviousOwner: owner, NewOwner: newOwner})
+}
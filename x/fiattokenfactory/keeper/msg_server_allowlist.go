@@ -0,0 +1,85 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) Allowlist(goCtx context.Context, msg *types.MsgAllowlist) (*types.MsgAllowlistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkAllowlister(ctx, msg.Denom, msg.From); err != nil {
+		return nil, err
+	}
+
+	addressBz, err := k.DecodeAddress(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	_, found := k.GetAllowlisted(ctx, msg.Denom, addressBz)
+	if found {
+		return nil, types.ErrUserAllowlisted
+	}
+
+	return &types.MsgAllowlistResponse{}, k.allowlist(ctx, msg, addressBz)
+}
+
+// checkAllowlister ensures that an address is the allowlister of a minting denom.
+func (k Keeper) checkAllowlister(ctx sdk.Context, denom string, address string) error {
+	allowlister, found := k.GetAllowlister(ctx, denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUserNotFound, "allowlister is not set")
+	}
+
+	if allowlister.Address != address {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the allowlister")
+	}
+
+	return nil
+}
+
+// allowlist adds an address to the allowlist of a minting denom.
+func (k Keeper) allowlist(ctx sdk.Context, msg *types.MsgAllowlist, addressBz []byte) error {
+	k.SetAllowlisted(ctx, types.Allowlisted{
+		AddressBz: addressBz,
+		Denom:     msg.Denom,
+	})
+
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  msg.Denom,
+		Actor:  msg.From,
+		Role:   types.RoleAllowlister,
+		Action: types.ActionAllowlist,
+		Target: msg.Address,
+		Before: "false",
+		After:  "true",
+	}); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventAllowlisted{
+		Denom:   msg.Denom,
+		Address: msg.Address,
+	})
+}
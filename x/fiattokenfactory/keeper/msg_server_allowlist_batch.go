@@ -0,0 +1,61 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) AllowlistBatch(goCtx context.Context, msg *types.MsgAllowlistBatch) (*types.MsgAllowlistBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkAllowlister(ctx, msg.Denom, msg.From); err != nil {
+		return nil, err
+	}
+
+	if err := k.checkBatchSize(ctx, len(msg.Addresses)); err != nil {
+		return nil, err
+	}
+
+	results := make([]types.BatchResult, 0, len(msg.Addresses))
+	for _, address := range msg.Addresses {
+		ctx.GasMeter().ConsumeGas(types.BatchGasPerAddress, "allowlist batch address")
+
+		addressBz, err := k.DecodeAddress(address)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidAddress, "invalid address %s (%s)", address, err)
+		}
+
+		if _, found := k.GetAllowlisted(ctx, msg.Denom, addressBz); found {
+			results = append(results, types.BatchResult{Address: address, Applied: false})
+			continue
+		}
+
+		err = k.allowlist(ctx, &types.MsgAllowlist{From: msg.From, Address: address, Denom: msg.Denom}, addressBz)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, types.BatchResult{Address: address, Applied: true})
+	}
+
+	return &types.MsgAllowlistBatchResponse{Results: results}, nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllowlistBatch_FromAddressIsNotAllowlister(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetAllowlister(ctx, types.Allowlister{Denom: "uusdc", Address: sample.AccAddress()})
+
+	_, err := msgServer.AllowlistBatch(ctx, &types.MsgAllowlistBatch{From: sample.AccAddress(), Addresses: []string{sample.AccAddress()}, Denom: "uusdc"})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
+
+func TestAllowlistBatch_TooLarge(t *testing.T) {
+	allowlister := sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetAllowlister(ctx, types.Allowlister{Denom: "uusdc", Address: allowlister})
+	params := types.DefaultParams()
+	params.MaxBlacklistBatchSize = 2
+	ftf.SetParams(ctx, params)
+
+	addresses := []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+	_, err := msgServer.AllowlistBatch(ctx, &types.MsgAllowlistBatch{From: allowlister, Addresses: addresses, Denom: "uusdc"})
+	require.ErrorIs(t, err, types.ErrBatchTooLarge)
+
+	_, err = msgServer.UnallowlistBatch(ctx, &types.MsgUnallowlistBatch{From: allowlister, Addresses: addresses, Denom: "uusdc"})
+	require.ErrorIs(t, err, types.ErrBatchTooLarge)
+}
+
+func TestAllowlistBatch_SkipsListedAddresses(t *testing.T) {
+	allowlister := sample.AccAddress()
+	listed := sample.TestAccount()
+	unlisted := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetAllowlister(ctx, types.Allowlister{Denom: "uusdc", Address: allowlister})
+	ftf.SetAllowlisted(ctx, types.Allowlisted{Denom: "uusdc", AddressBz: listed.AddressBz})
+
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	res, err := msgServer.AllowlistBatch(ctx, &types.MsgAllowlistBatch{
+		From:      allowlister,
+		Addresses: []string{listed.Address, unlisted.Address},
+		Denom:     "uusdc",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.BatchResult{
+		{Address: listed.Address, Applied: false},
+		{Address: unlisted.Address, Applied: true},
+	}, res.Results)
+	require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed(), uint64(2*types.BatchGasPerAddress))
+
+	_, found := ftf.GetAllowlisted(ctx, "uusdc", unlisted.AddressBz)
+	require.True(t, found)
+}
+
+func TestUnallowlistBatch_SkipsUnlistedAddresses(t *testing.T) {
+	allowlister := sample.AccAddress()
+	listed := sample.TestAccount()
+	unlisted := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetAllowlister(ctx, types.Allowlister{Denom: "uusdc", Address: allowlister})
+	ftf.SetAllowlisted(ctx, types.Allowlisted{Denom: "uusdc", AddressBz: listed.AddressBz})
+
+	res, err := msgServer.UnallowlistBatch(ctx, &types.MsgUnallowlistBatch{
+		From:      allowlister,
+		Addresses: []string{listed.Address, unlisted.Address},
+		Denom:     "uusdc",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.BatchResult{
+		{Address: listed.Address, Applied: true},
+		{Address: unlisted.Address, Applied: false},
+	}, res.Results)
+
+	_, found := ftf.GetAllowlisted(ctx, "uusdc", listed.AddressBz)
+	require.False(t, found)
+}
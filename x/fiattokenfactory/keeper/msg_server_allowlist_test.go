@@ -0,0 +1,103 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllowlist_AllowlisterNotSet(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	_, err := msgServer.Allowlist(ctx, &types.MsgAllowlist{From: sample.AccAddress(), Denom: "uusdc"})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+	require.ErrorContains(t, err, "allowlister is not set")
+}
+
+func TestAllowlist_FromAddressIsNotAllowlister(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetAllowlister(ctx, types.Allowlister{Denom: "uusdc", Address: sample.AccAddress()})
+
+	_, err := msgServer.Allowlist(ctx, &types.MsgAllowlist{From: sample.AccAddress(), Denom: "uusdc"})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "you are not the allowlister")
+}
+
+func TestAllowlist_AddressAlreadyAllowlisted(t *testing.T) {
+	allowlister := sample.AccAddress()
+	user := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetAllowlister(ctx, types.Allowlister{Denom: "uusdc", Address: allowlister})
+	ftf.SetAllowlisted(ctx, types.Allowlisted{Denom: "uusdc", AddressBz: user.AddressBz})
+
+	_, err := msgServer.Allowlist(ctx, &types.MsgAllowlist{From: allowlister, Address: user.Address, Denom: "uusdc"})
+	require.ErrorIs(t, err, types.ErrUserAllowlisted)
+}
+
+func TestAllowlist_Success(t *testing.T) {
+	allowlister := sample.AccAddress()
+	user := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetAllowlister(ctx, types.Allowlister{Denom: "uusdc", Address: allowlister})
+
+	res, err := msgServer.Allowlist(ctx, &types.MsgAllowlist{From: allowlister, Address: user.Address, Denom: "uusdc"})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgAllowlistResponse{}, res)
+
+	_, found := ftf.GetAllowlisted(ctx, "uusdc", user.AddressBz)
+	require.True(t, found)
+	// ASSERT: The allowlist is scoped to the denom.
+	_, found = ftf.GetAllowlisted(ctx, "ueurc", user.AddressBz)
+	require.False(t, found)
+}
+
+func TestUnallowlist_AddressNotAllowlisted(t *testing.T) {
+	allowlister := sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetAllowlister(ctx, types.Allowlister{Denom: "uusdc", Address: allowlister})
+
+	_, err := msgServer.Unallowlist(ctx, &types.MsgUnallowlist{From: allowlister, Address: sample.AccAddress(), Denom: "uusdc"})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+	require.ErrorContains(t, err, "the specified address is not allowlisted")
+}
+
+func TestUnallowlist_Success(t *testing.T) {
+	allowlister := sample.AccAddress()
+	user := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetAllowlister(ctx, types.Allowlister{Denom: "uusdc", Address: allowlister})
+	ftf.SetAllowlisted(ctx, types.Allowlisted{Denom: "uusdc", AddressBz: user.AddressBz})
+
+	res, err := msgServer.Unallowlist(ctx, &types.MsgUnallowlist{From: allowlister, Address: user.Address, Denom: "uusdc"})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgUnallowlistResponse{}, res)
+
+	_, found := ftf.GetAllowlisted(ctx, "uusdc", user.AddressBz)
+	require.False(t, found)
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) ApproveAction(goCtx context.Context, msg *types.MsgApproveAction) (*types.MsgApproveActionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	proposal, found := k.GetActionProposal(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrProposalNotFound, "action proposal %d does not exist", msg.Id)
+	}
+
+	if !ctx.BlockTime().Before(proposal.Expiry) {
+		return nil, sdkerrors.Wrapf(types.ErrProposalExpired, "action proposal %d expired at %s", msg.Id, proposal.Expiry)
+	}
+
+	signerSet, found := k.GetSignerSet(ctx, proposal.Denom, proposal.Role)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "%s signer set is not set", proposal.Role)
+	}
+
+	if !signerSet.IsSigner(msg.From) {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not a signer of the %s signer set", proposal.Role)
+	}
+
+	if hasApproved(proposal, msg.From) {
+		return nil, sdkerrors.Wrapf(types.ErrAlreadyApproved, "%s", msg.From)
+	}
+
+	proposal.Approvals = append(proposal.Approvals, msg.From)
+
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  proposal.Denom,
+		Actor:  msg.From,
+		Role:   proposal.Role,
+		Action: types.ActionApproveAction,
+		Target: strconv.FormatUint(proposal.Id, 10),
+		After:  proposal.ActionName(),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventActionApproved{
+		Id:        proposal.Id,
+		Approver:  msg.From,
+		Approvals: countApprovals(proposal, signerSet),
+	}); err != nil {
+		return nil, err
+	}
+
+	executed, err := k.tallyAction(ctx, proposal, signerSet)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveActionResponse{Executed: executed}, nil
+}
@@ -0,0 +1,308 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// setupSignerSet configures the owner role of uusdc as a 2 of 3 signer set.
+func setupSignerSet(t *testing.T) (*keeper.Keeper, sdk.Context, types.MsgServer, []string) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0).UTC())
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	owner := sample.AccAddress()
+	signers := []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner})
+
+	_, err := msgServer.SetSignerSet(ctx, &types.MsgSetSignerSet{
+		From:      owner,
+		Denom:     "uusdc",
+		Role:      types.RoleOwner,
+		Signers:   signers,
+		Threshold: 2,
+	})
+	require.NoError(t, err)
+
+	return ftf, ctx, msgServer, signers
+}
+
+func TestSetSignerSet_FromAddressIsNotOwner(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: sample.AccAddress()})
+
+	_, err := msgServer.SetSignerSet(ctx, &types.MsgSetSignerSet{
+		From:      sample.AccAddress(),
+		Denom:     "uusdc",
+		Role:      types.RolePauser,
+		Signers:   []string{sample.AccAddress()},
+		Threshold: 1,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
+
+func TestUpdateOwner_ProposedBySignerSet(t *testing.T) {
+	ftf, ctx, msgServer, signers := setupSignerSet(t)
+	newOwner := sample.AccAddress()
+
+	_, err := msgServer.UpdateOwner(ctx, &types.MsgUpdateOwner{From: signers[0], Address: newOwner, Denom: "uusdc"})
+	require.NoError(t, err)
+
+	_, found := ftf.GetPendingOwner(ctx, "uusdc")
+	require.False(t, found)
+
+	proposals := ftf.GetAllActionProposals(ctx)
+	require.Len(t, proposals, 1)
+	require.Equal(t, signers[0], proposals[0].Proposer)
+	require.Equal(t, []string{signers[0]}, proposals[0].Approvals)
+	require.Equal(t, ctx.BlockTime().Add(types.DefaultActionProposalLifetime), proposals[0].Expiry)
+
+	res, err := msgServer.ApproveAction(ctx, &types.MsgApproveAction{From: signers[1], Id: proposals[0].Id})
+	require.NoError(t, err)
+	require.True(t, res.Executed)
+
+	pendingOwner, found := ftf.GetPendingOwner(ctx, "uusdc")
+	require.True(t, found)
+	require.Equal(t, newOwner, pendingOwner.Address)
+	require.Empty(t, ftf.GetAllActionProposals(ctx))
+}
+
+func TestUpdateOwner_NotSigner(t *testing.T) {
+	_, ctx, msgServer, _ := setupSignerSet(t)
+
+	_, err := msgServer.UpdateOwner(ctx, &types.MsgUpdateOwner{From: sample.AccAddress(), Address: sample.AccAddress(), Denom: "uusdc"})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "signer set")
+}
+
+func TestApproveAction_NotFound(t *testing.T) {
+	_, ctx, msgServer, signers := setupSignerSet(t)
+
+	_, err := msgServer.ApproveAction(ctx, &types.MsgApproveAction{From: signers[0], Id: 42})
+	require.ErrorIs(t, err, types.ErrProposalNotFound)
+}
+
+func TestApproveAction_AlreadyApproved(t *testing.T) {
+	ftf, ctx, msgServer, signers := setupSignerSet(t)
+
+	_, err := msgServer.Unpause(ctx, &types.MsgUnpause{From: signers[0], Denom: "uusdc"})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+
+	_, err = msgServer.UpdateOwner(ctx, &types.MsgUpdateOwner{From: signers[0], Address: sample.AccAddress(), Denom: "uusdc"})
+	require.NoError(t, err)
+	id := ftf.GetAllActionProposals(ctx)[0].Id
+
+	_, err = msgServer.ApproveAction(ctx, &types.MsgApproveAction{From: signers[0], Id: id})
+	require.ErrorIs(t, err, types.ErrAlreadyApproved)
+}
+
+func TestApproveAction_NotSigner(t *testing.T) {
+	ftf, ctx, msgServer, signers := setupSignerSet(t)
+
+	_, err := msgServer.UpdateOwner(ctx, &types.MsgUpdateOwner{From: signers[0], Address: sample.AccAddress(), Denom: "uusdc"})
+	require.NoError(t, err)
+	id := ftf.GetAllActionProposals(ctx)[0].Id
+
+	_, err = msgServer.ApproveAction(ctx, &types.MsgApproveAction{From: sample.AccAddress(), Id: id})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
+
+func TestApproveAction_Expired(t *testing.T) {
+	ftf, ctx, msgServer, signers := setupSignerSet(t)
+
+	_, err := msgServer.UpdateOwner(ctx, &types.MsgUpdateOwner{From: signers[0], Address: sample.AccAddress(), Denom: "uusdc"})
+	require.NoError(t, err)
+	id := ftf.GetAllActionProposals(ctx)[0].Id
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultActionProposalLifetime))
+
+	_, err = msgServer.ApproveAction(ctx, &types.MsgApproveAction{From: signers[1], Id: id})
+	require.ErrorIs(t, err, types.ErrProposalExpired)
+
+	require.NoError(t, ftf.PruneActionProposals(ctx))
+	_, found := ftf.GetActionProposal(ctx, id)
+	require.False(t, found)
+}
+
+func TestApproveAction_SignerRemovedFromSet(t *testing.T) {
+	ftf, ctx, msgServer, signers := setupSignerSet(t)
+
+	_, err := msgServer.UpdateOwner(ctx, &types.MsgUpdateOwner{From: signers[0], Address: sample.AccAddress(), Denom: "uusdc"})
+	require.NoError(t, err)
+	id := ftf.GetAllActionProposals(ctx)[0].Id
+
+	// the approval of a signer that left the set no longer counts
+	ftf.SetSignerSet(ctx, types.SignerSet{Denom: "uusdc", Role: types.RoleOwner, Signers: signers[1:], Threshold: 2})
+
+	res, err := msgServer.ApproveAction(ctx, &types.MsgApproveAction{From: signers[1], Id: id})
+	require.NoError(t, err)
+	require.False(t, res.Executed)
+
+	res, err = msgServer.ApproveAction(ctx, &types.MsgApproveAction{From: signers[2], Id: id})
+	require.NoError(t, err)
+	require.True(t, res.Executed)
+}
+
+func TestSetSignerSet_RemovedByApproval(t *testing.T) {
+	ftf, ctx, msgServer, signers := setupSignerSet(t)
+
+	_, err := msgServer.SetSignerSet(ctx, &types.MsgSetSignerSet{From: signers[0], Denom: "uusdc", Role: types.RoleOwner})
+	require.NoError(t, err)
+	_, found := ftf.GetSignerSet(ctx, "uusdc", types.RoleOwner)
+	require.True(t, found)
+
+	id := ftf.GetAllActionProposals(ctx)[0].Id
+	res, err := msgServer.ApproveAction(ctx, &types.MsgApproveAction{From: signers[2], Id: id})
+	require.NoError(t, err)
+	require.True(t, res.Executed)
+
+	_, found = ftf.GetSignerSet(ctx, "uusdc", types.RoleOwner)
+	require.False(t, found)
+}
+
+func TestOwnerMsgs_RequireSignerSet(t *testing.T) {
+	ftf, ctx, msgServer, _ := setupSignerSet(t)
+	owner, _ := ftf.GetOwner(ctx, "uusdc")
+	ftf.SetQueuedAction(ctx, types.QueuedAction{
+		Id:     1,
+		Denom:  "uusdc",
+		Action: &types.QueuedAction_UpdatePauser{UpdatePauser: &types.MsgUpdatePauser{From: owner.Address, Address: sample.AccAddress(), Denom: "uusdc"}},
+	})
+	ftf.SetPendingOwner(ctx, types.Owner{Denom: "uusdc", Address: sample.AccAddress()})
+
+	msgs := map[string]func(from string) error{
+		"UpdateOwner": func(from string) error {
+			_, err := msgServer.UpdateOwner(ctx, &types.MsgUpdateOwner{From: from, Address: sample.AccAddress(), Denom: "uusdc"})
+			return err
+		},
+		"UpdateMasterMinter": func(from string) error {
+			_, err := msgServer.UpdateMasterMinter(ctx, &types.MsgUpdateMasterMinter{From: from, Address: sample.AccAddress(), Denom: "uusdc"})
+			return err
+		},
+		"UpdatePauser": func(from string) error {
+			_, err := msgServer.UpdatePauser(ctx, &types.MsgUpdatePauser{From: from, Address: sample.AccAddress(), Denom: "uusdc"})
+			return err
+		},
+		"UpdateBlacklister": func(from string) error {
+			_, err := msgServer.UpdateBlacklister(ctx, &types.MsgUpdateBlacklister{From: from, Address: sample.AccAddress(), Denom: "uusdc"})
+			return err
+		},
+		"UpdateAllowlister": func(from string) error {
+			_, err := msgServer.UpdateAllowlister(ctx, &types.MsgUpdateAllowlister{From: from, Address: sample.AccAddress(), Denom: "uusdc"})
+			return err
+		},
+		"SetChannelPolicy": func(from string) error {
+			_, err := msgServer.SetChannelPolicy(ctx, &types.MsgSetChannelPolicy{From: from, Denom: "uusdc", ChannelId: "channel-0"})
+			return err
+		},
+		"RemoveChannelPolicy": func(from string) error {
+			_, err := msgServer.RemoveChannelPolicy(ctx, &types.MsgRemoveChannelPolicy{From: from, Denom: "uusdc", ChannelId: "channel-0"})
+			return err
+		},
+		"SetChannelRateLimit": func(from string) error {
+			_, err := msgServer.SetChannelRateLimit(ctx, &types.MsgSetChannelRateLimit{From: from, Denom: "uusdc", ChannelId: "channel-0"})
+			return err
+		},
+		"SetAllowlistMode": func(from string) error {
+			_, err := msgServer.SetAllowlistMode(ctx, &types.MsgSetAllowlistMode{From: from, Denom: "uusdc", Enabled: true})
+			return err
+		},
+		"CancelOwnershipTransfer": func(from string) error {
+			_, err := msgServer.CancelOwnershipTransfer(ctx, &types.MsgCancelOwnershipTransfer{From: from, Denom: "uusdc"})
+			return err
+		},
+		"SetSignerSet": func(from string) error {
+			_, err := msgServer.SetSignerSet(ctx, &types.MsgSetSignerSet{From: from, Denom: "uusdc", Role: types.RoleOwner})
+			return err
+		},
+	}
+
+	// the owner address alone can no longer act once the owner is a signer set
+	for name, send := range msgs {
+		t.Run(name, func(t *testing.T) {
+			err := send(owner.Address)
+			require.ErrorIs(t, err, types.ErrUnauthorized)
+			require.ErrorContains(t, err, "signer set")
+		})
+	}
+	require.Empty(t, ftf.GetAllActionProposals(ctx))
+	require.False(t, ftf.IsAllowlistEnabled(ctx, "uusdc"))
+	_, found := ftf.GetQueuedAction(ctx, 1)
+	require.True(t, found)
+	_, found = ftf.GetPendingOwner(ctx, "uusdc")
+	require.True(t, found)
+}
+
+func TestSetAllowlistMode_ProposedBySignerSet(t *testing.T) {
+	ftf, ctx, msgServer, signers := setupSignerSet(t)
+
+	_, err := msgServer.SetAllowlistMode(ctx, &types.MsgSetAllowlistMode{From: signers[0], Denom: "uusdc", Enabled: true})
+	require.NoError(t, err)
+	require.False(t, ftf.IsAllowlistEnabled(ctx, "uusdc"))
+
+	id := ftf.GetAllActionProposals(ctx)[0].Id
+	res, err := msgServer.ApproveAction(ctx, &types.MsgApproveAction{From: signers[1], Id: id})
+	require.NoError(t, err)
+	require.True(t, res.Executed)
+	require.True(t, ftf.IsAllowlistEnabled(ctx, "uusdc"))
+}
+
+func TestCancelQueuedAction_BySingleSigner(t *testing.T) {
+	ftf, ctx, msgServer, signers := setupSignerSet(t)
+	ftf.SetQueuedAction(ctx, types.QueuedAction{
+		Id:     1,
+		Denom:  "uusdc",
+		Action: &types.QueuedAction_UpdatePauser{UpdatePauser: &types.MsgUpdatePauser{From: signers[0], Address: sample.AccAddress(), Denom: "uusdc"}},
+	})
+
+	_, err := msgServer.CancelQueuedAction(ctx, &types.MsgCancelQueuedAction{From: signers[2], Id: 1})
+	require.NoError(t, err)
+	_, found := ftf.GetQueuedAction(ctx, 1)
+	require.False(t, found)
+	require.Empty(t, ftf.GetAllActionProposals(ctx))
+}
+
+func TestApproveAction_EventCountsCurrentSigners(t *testing.T) {
+	ftf, ctx, msgServer, signers := setupSignerSet(t)
+
+	_, err := msgServer.UpdateOwner(ctx, &types.MsgUpdateOwner{From: signers[0], Address: sample.AccAddress(), Denom: "uusdc"})
+	require.NoError(t, err)
+	id := ftf.GetAllActionProposals(ctx)[0].Id
+
+	// signers[0] left the set, so its approval is not reported
+	ftf.SetSignerSet(ctx, types.SignerSet{Denom: "uusdc", Role: types.RoleOwner, Signers: signers[1:], Threshold: 2})
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.ApproveAction(ctx, &types.MsgApproveAction{From: signers[1], Id: id})
+	require.NoError(t, err)
+	requireTypedEvent(t, ctx, &types.EventActionApproved{
+		Id:        id,
+		Approver:  signers[1],
+		Approvals: 1,
+	})
+}
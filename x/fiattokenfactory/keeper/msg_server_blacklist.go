@@ -18,6 +18,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
 
@@ -28,7 +29,7 @@ import (
 func (k msgServer) Blacklist(goCtx context.Context, msg *types.MsgBlacklist) (*types.MsgBlacklistResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	blacklister, found := k.GetBlacklister(ctx)
+	blacklister, found := k.GetBlacklister(ctx, msg.Denom)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "blacklister is not set")
 	}
@@ -37,23 +38,69 @@ func (k msgServer) Blacklist(goCtx context.Context, msg *types.MsgBlacklist) (*t
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the blacklister")
 	}
 
-	_, addressBz, err := DecodeNoLimitToBase256(msg.Address)
+	addressBz, err := k.DecodeAddress(msg.Address)
 	if err != nil {
 		return nil, err
 	}
 
-	_, found = k.GetBlacklisted(ctx, addressBz)
+	_, found = k.GetBlacklisted(ctx, msg.Denom, addressBz)
 	if found {
 		return nil, types.ErrUserBlacklisted
 	}
 
+	if err := checkBlacklistExpiry(ctx, msg.ExpiryHeight, msg.ExpiryTime); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBlacklistResponse{}, k.blacklist(ctx, msg, addressBz)
+}
+
+// checkBlacklistExpiry ensures that the optional expiries of a blacklisting
+// are still ahead of the current block.
+func checkBlacklistExpiry(ctx sdk.Context, expiryHeight int64, expiryTime *time.Time) error {
+	if expiryHeight != 0 && expiryHeight <= ctx.BlockHeight() {
+		return sdkerrors.Wrapf(types.ErrInvalidExpiry, "expiry height %d is not after the current height %d", expiryHeight, ctx.BlockHeight())
+	}
+	if expiryTime != nil && !expiryTime.After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrInvalidExpiry, "expiry time %s is not after the current block time %s", expiryTime, ctx.BlockTime())
+	}
+	return nil
+}
+
+// blacklist adds an address to the blacklist of a minting denom.
+func (k Keeper) blacklist(ctx sdk.Context, msg *types.MsgBlacklist, addressBz []byte) error {
+	blockTime := ctx.BlockTime()
 	blacklisted := types.Blacklisted{
-		AddressBz: addressBz,
+		AddressBz:           addressBz,
+		Denom:               msg.Denom,
+		Reason:              msg.Reason,
+		ReferenceId:         msg.ReferenceId,
+		BlacklistedAtHeight: ctx.BlockHeight(),
+		BlacklistedAtTime:   &blockTime,
+		ExpiresAtHeight:     msg.ExpiryHeight,
+		ExpiresAtTime:       msg.ExpiryTime,
 	}
 
 	k.SetBlacklisted(ctx, blacklisted)
 
-	err = ctx.EventManager().EmitTypedEvent(msg)
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  msg.Denom,
+		Actor:  msg.From,
+		Role:   types.RoleBlacklister,
+		Action: types.ActionBlacklist,
+		Target: msg.Address,
+		Before: "false",
+		After:  "true",
+	}); err != nil {
+		return err
+	}
 
-	return &types.MsgBlacklistResponse{}, err
+	return ctx.EventManager().EmitTypedEvent(&types.EventBlacklisted{
+		Denom:           msg.Denom,
+		Address:         msg.Address,
+		Reason:          msg.Reason,
+		ReferenceId:     msg.ReferenceId,
+		ExpiresAtHeight: msg.ExpiryHeight,
+		ExpiresAtTime:   msg.ExpiryTime,
+	})
 }
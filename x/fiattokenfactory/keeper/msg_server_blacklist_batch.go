@@ -0,0 +1,88 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) BlacklistBatch(goCtx context.Context, msg *types.MsgBlacklistBatch) (*types.MsgBlacklistBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	blacklister, found := k.GetBlacklister(ctx, msg.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "blacklister is not set")
+	}
+
+	if blacklister.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the blacklister")
+	}
+
+	if err := k.checkBatchSize(ctx, len(msg.Addresses)); err != nil {
+		return nil, err
+	}
+
+	if err := checkBlacklistExpiry(ctx, msg.ExpiryHeight, msg.ExpiryTime); err != nil {
+		return nil, err
+	}
+
+	results := make([]types.BatchResult, 0, len(msg.Addresses))
+	for _, address := range msg.Addresses {
+		ctx.GasMeter().ConsumeGas(types.BatchGasPerAddress, "blacklist batch address")
+
+		addressBz, err := k.DecodeAddress(address)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidAddress, "invalid address %s (%s)", address, err)
+		}
+
+		if _, found := k.GetBlacklisted(ctx, msg.Denom, addressBz); found {
+			results = append(results, types.BatchResult{Address: address, Applied: false})
+			continue
+		}
+
+		err = k.blacklist(ctx, &types.MsgBlacklist{
+			From:         msg.From,
+			Address:      address,
+			Denom:        msg.Denom,
+			Reason:       msg.Reason,
+			ReferenceId:  msg.ReferenceId,
+			ExpiryHeight: msg.ExpiryHeight,
+			ExpiryTime:   msg.ExpiryTime,
+		}, addressBz)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, types.BatchResult{Address: address, Applied: true})
+	}
+
+	return &types.MsgBlacklistBatchResponse{Results: results}, nil
+}
+
+// checkBatchSize ensures that a batch message does not exceed the maximum
+// blacklist batch size.
+func (k Keeper) checkBatchSize(ctx sdk.Context, size int) error {
+	maxSize := k.GetParams(ctx).MaxBlacklistBatchSize
+	if size > int(maxSize) {
+		return sdkerrors.Wrapf(types.ErrBatchTooLarge, "batch of %d addresses exceeds the maximum of %d", size, maxSize)
+	}
+	return nil
+}
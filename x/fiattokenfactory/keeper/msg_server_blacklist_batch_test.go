@@ -0,0 +1,157 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlacklistBatch_FromAddressIsNotBlacklister(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetBlacklister(ctx, types.Blacklister{Denom: "uusdc", Address: sample.AccAddress()})
+
+	_, err := msgServer.BlacklistBatch(ctx, &types.MsgBlacklistBatch{From: sample.AccAddress(), Addresses: []string{sample.AccAddress()}, Denom: "uusdc"})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
+
+func TestBlacklistBatch_TooLarge(t *testing.T) {
+	blacklister := sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetBlacklister(ctx, types.Blacklister{Denom: "uusdc", Address: blacklister})
+	params := types.DefaultParams()
+	params.MaxBlacklistBatchSize = 2
+	ftf.SetParams(ctx, params)
+
+	addresses := []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+	_, err := msgServer.BlacklistBatch(ctx, &types.MsgBlacklistBatch{From: blacklister, Addresses: addresses, Denom: "uusdc"})
+	require.ErrorIs(t, err, types.ErrBatchTooLarge)
+
+	_, err = msgServer.UnblacklistBatch(ctx, &types.MsgUnblacklistBatch{From: blacklister, Addresses: addresses, Denom: "uusdc"})
+	require.ErrorIs(t, err, types.ErrBatchTooLarge)
+}
+
+func TestBlacklistBatch_InvalidAddress(t *testing.T) {
+	blacklister := sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetBlacklister(ctx, types.Blacklister{Denom: "uusdc", Address: blacklister})
+
+	_, err := msgServer.BlacklistBatch(ctx, &types.MsgBlacklistBatch{From: blacklister, Addresses: []string{sample.AccAddress(), "invalid address"}, Denom: "uusdc"})
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+}
+
+func TestBlacklistBatch_Expiry(t *testing.T) {
+	blacklister := sample.AccAddress()
+	first, second := sample.TestAccount(), sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	blockTime := time.Unix(1000, 0).UTC()
+	ctx = ctx.WithBlockHeight(42).WithBlockTime(blockTime)
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetBlacklister(ctx, types.Blacklister{Denom: "uusdc", Address: blacklister})
+	addresses := []string{first.Address, second.Address}
+
+	_, err := msgServer.BlacklistBatch(ctx, &types.MsgBlacklistBatch{From: blacklister, Addresses: addresses, Denom: "uusdc", ExpiryHeight: 42})
+	require.ErrorIs(t, err, types.ErrInvalidExpiry)
+	_, err = msgServer.BlacklistBatch(ctx, &types.MsgBlacklistBatch{From: blacklister, Addresses: addresses, Denom: "uusdc", ExpiryTime: &blockTime})
+	require.ErrorIs(t, err, types.ErrInvalidExpiry)
+	require.Empty(t, ftf.GetAllBlacklisted(ctx))
+
+	expiry := blockTime.Add(time.Hour)
+	_, err = msgServer.BlacklistBatch(ctx, &types.MsgBlacklistBatch{From: blacklister, Addresses: addresses, Denom: "uusdc", ExpiryHeight: 100, ExpiryTime: &expiry})
+	require.NoError(t, err)
+	for _, account := range []sample.Account{first, second} {
+		blacklisted, found := ftf.GetBlacklisted(ctx, "uusdc", account.AddressBz)
+		require.True(t, found)
+		require.Equal(t, int64(100), blacklisted.ExpiresAtHeight)
+		require.Equal(t, &expiry, blacklisted.ExpiresAtTime)
+	}
+
+	require.NoError(t, ftf.ExpireBlacklisted(ctx.WithBlockHeight(100)))
+	require.Empty(t, ftf.GetAllBlacklisted(ctx))
+}
+
+func TestBlacklistBatch_SkipsListedAddresses(t *testing.T) {
+	blacklister := sample.AccAddress()
+	listed := sample.TestAccount()
+	unlisted := sample.TestAccount()
+	bech32m := sample.TestAccountBech32m()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetBlacklister(ctx, types.Blacklister{Denom: "uusdc", Address: blacklister})
+	ftf.SetBlacklisted(ctx, types.Blacklisted{Denom: "uusdc", AddressBz: listed.AddressBz})
+
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	res, err := msgServer.BlacklistBatch(ctx, &types.MsgBlacklistBatch{
+		From:      blacklister,
+		Addresses: []string{listed.Address, unlisted.Address, bech32m.Address},
+		Denom:     "uusdc",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.BatchResult{
+		{Address: listed.Address, Applied: false},
+		{Address: unlisted.Address, Applied: true},
+		{Address: bech32m.Address, Applied: true},
+	}, res.Results)
+	require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed(), uint64(3*types.BatchGasPerAddress))
+
+	_, found := ftf.GetBlacklisted(ctx, "uusdc", unlisted.AddressBz)
+	require.True(t, found)
+	_, found = ftf.GetBlacklisted(ctx, "uusdc", bech32m.AddressBz)
+	require.True(t, found)
+
+	var events int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "circle.fiattokenfactory.v1.EventBlacklisted" {
+			events++
+		}
+	}
+	require.Equal(t, 2, events)
+}
+
+func TestUnblacklistBatch_SkipsUnlistedAddresses(t *testing.T) {
+	blacklister := sample.AccAddress()
+	listed := sample.TestAccount()
+	unlisted := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetBlacklister(ctx, types.Blacklister{Denom: "uusdc", Address: blacklister})
+	ftf.SetBlacklisted(ctx, types.Blacklisted{Denom: "uusdc", AddressBz: listed.AddressBz})
+
+	res, err := msgServer.UnblacklistBatch(ctx, &types.MsgUnblacklistBatch{
+		From:      blacklister,
+		Addresses: []string{listed.Address, unlisted.Address},
+		Denom:     "uusdc",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.BatchResult{
+		{Address: listed.Address, Applied: true},
+		{Address: unlisted.Address, Applied: false},
+	}, res.Results)
+
+	_, found := ftf.GetBlacklisted(ctx, "uusdc", listed.AddressBz)
+	require.False(t, found)
+}
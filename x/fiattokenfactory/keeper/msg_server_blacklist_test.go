@@ -18,6 +18,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/btcutil/bech32"
 	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
@@ -32,7 +33,7 @@ func TestBlacklist_BlacklisterNotSet(t *testing.T) {
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
 
-	_, err := msgServer.Blacklist(sdk.WrapSDKContext(ctx), &types.MsgBlacklist{From: sample.TestAccount().Address})
+	_, err := msgServer.Blacklist(sdk.WrapSDKContext(ctx), &types.MsgBlacklist{From: sample.TestAccount().Address, Denom: "uusdc"})
 	require.ErrorIs(t, err, types.ErrUserNotFound)
 	require.ErrorContains(t, err, "blacklister is not set")
 }
@@ -41,9 +42,9 @@ func TestBlacklist_FromAddressIsNotBlacklister(t *testing.T) {
 	blacklister := sample.TestAccount()
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetBlacklister(ctx, types.Blacklister{Address: blacklister.Address})
+	ftf.SetBlacklister(ctx, types.Blacklister{Denom: "uusdc", Address: blacklister.Address})
 
-	_, err := msgServer.Blacklist(sdk.WrapSDKContext(ctx), &types.MsgBlacklist{From: sample.AccAddress()})
+	_, err := msgServer.Blacklist(sdk.WrapSDKContext(ctx), &types.MsgBlacklist{From: sample.AccAddress(), Denom: "uusdc"})
 	require.ErrorIs(t, err, types.ErrUnauthorized)
 	require.ErrorContains(t, err, "you are not the blacklister")
 }
@@ -52,9 +53,9 @@ func TestBlacklist_AddressIsInvalid(t *testing.T) {
 	blacklister := sample.TestAccount()
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetBlacklister(ctx, types.Blacklister{Address: blacklister.Address})
+	ftf.SetBlacklister(ctx, types.Blacklister{Denom: "uusdc", Address: blacklister.Address})
 
-	_, err := msgServer.Blacklist(sdk.WrapSDKContext(ctx), &types.MsgBlacklist{From: blacklister.Address, Address: "invalid address"})
+	_, err := msgServer.Blacklist(sdk.WrapSDKContext(ctx), &types.MsgBlacklist{From: blacklister.Address, Address: "invalid address", Denom: "uusdc"})
 	require.ErrorIs(t, err, bech32.ErrInvalidCharacter(32))
 }
 
@@ -65,13 +66,13 @@ func TestBlacklist_AddressAlreadyBlacklisted(t *testing.T) {
 
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetBlacklister(ctx, types.Blacklister{Address: blacklister.Address})
-	ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: blacklistedUserBech32.AddressBz})
-	ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: blacklistedUserBech32m.AddressBz})
+	ftf.SetBlacklister(ctx, types.Blacklister{Denom: "uusdc", Address: blacklister.Address})
+	ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: blacklistedUserBech32.AddressBz, Denom: "uusdc"})
+	ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: blacklistedUserBech32m.AddressBz, Denom: "uusdc"})
 
-	_, err := msgServer.Blacklist(sdk.WrapSDKContext(ctx), &types.MsgBlacklist{From: blacklister.Address, Address: blacklistedUserBech32.Address})
+	_, err := msgServer.Blacklist(sdk.WrapSDKContext(ctx), &types.MsgBlacklist{From: blacklister.Address, Address: blacklistedUserBech32.Address, Denom: "uusdc"})
 	require.ErrorIs(t, err, types.ErrUserBlacklisted)
-	_, err = msgServer.Blacklist(sdk.WrapSDKContext(ctx), &types.MsgBlacklist{From: blacklister.Address, Address: blacklistedUserBech32m.Address})
+	_, err = msgServer.Blacklist(sdk.WrapSDKContext(ctx), &types.MsgBlacklist{From: blacklister.Address, Address: blacklistedUserBech32m.Address, Denom: "uusdc"})
 	require.ErrorIs(t, err, types.ErrUserBlacklisted)
 }
 
@@ -82,13 +83,79 @@ func TestBlacklist_Success(t *testing.T) {
 
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetBlacklister(ctx, types.Blacklister{Address: blacklister.Address})
+	ftf.SetBlacklister(ctx, types.Blacklister{Denom: "uusdc", Address: blacklister.Address})
 
-	res, err := msgServer.Blacklist(sdk.WrapSDKContext(ctx), &types.MsgBlacklist{From: blacklister.Address, Address: newUserBech32.Address})
+	res, err := msgServer.Blacklist(sdk.WrapSDKContext(ctx), &types.MsgBlacklist{From: blacklister.Address, Address: newUserBech32.Address, Denom: "uusdc"})
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgBlacklistResponse{}, res)
 
-	res, err = msgServer.Blacklist(sdk.WrapSDKContext(ctx), &types.MsgBlacklist{From: blacklister.Address, Address: newUserBech32m.Address})
+	res, err = msgServer.Blacklist(sdk.WrapSDKContext(ctx), &types.MsgBlacklist{From: blacklister.Address, Address: newUserBech32m.Address, Denom: "uusdc"})
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgBlacklistResponse{}, res)
+	requireTypedEvent(t, ctx, &types.EventBlacklisted{Denom: "uusdc", Address: newUserBech32m.Address})
+}
+
+func TestBlacklist_Metadata(t *testing.T) {
+	blacklister := sample.TestAccount()
+	user := sample.TestAccount()
+
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	blockTime := time.Unix(1700000000, 0).UTC()
+	ctx = ctx.WithBlockHeight(42).WithBlockTime(blockTime)
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetBlacklister(ctx, types.Blacklister{Denom: "uusdc", Address: blacklister.Address})
+
+	_, err := msgServer.Blacklist(ctx, &types.MsgBlacklist{
+		From:        blacklister.Address,
+		Address:     user.Address,
+		Denom:       "uusdc",
+		Reason:      "sanctions",
+		ReferenceId: "case-1234",
+	})
+	require.NoError(t, err)
+
+	res, err := ftf.Blacklisted(ctx, &types.QueryGetBlacklistedRequest{Denom: "uusdc", Address: user.Address})
+	require.NoError(t, err)
+	require.Equal(t, types.Blacklisted{
+		AddressBz:           user.AddressBz,
+		Denom:               "uusdc",
+		Reason:              "sanctions",
+		ReferenceId:         "case-1234",
+		BlacklistedAtHeight: 42,
+		BlacklistedAtTime:   &blockTime,
+	}, res.Blacklisted)
+}
+
+func TestBlacklist_Expiry(t *testing.T) {
+	blacklister := sample.TestAccount()
+	user := sample.TestAccount()
+
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	blockTime := time.Unix(1700000000, 0).UTC()
+	ctx = ctx.WithBlockHeight(42).WithBlockTime(blockTime)
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetBlacklister(ctx, types.Blacklister{Denom: "uusdc", Address: blacklister.Address})
+
+	// ACT: Attempt to blacklist with an expiry height that was reached
+	_, err := msgServer.Blacklist(ctx, &types.MsgBlacklist{From: blacklister.Address, Address: user.Address, Denom: "uusdc", ExpiryHeight: 42})
+	require.ErrorIs(t, err, types.ErrInvalidExpiry)
+
+	// ACT: Attempt to blacklist with an expiry time that was reached
+	_, err = msgServer.Blacklist(ctx, &types.MsgBlacklist{From: blacklister.Address, Address: user.Address, Denom: "uusdc", ExpiryTime: &blockTime})
+	require.ErrorIs(t, err, types.ErrInvalidExpiry)
+
+	// ACT: Blacklist until a future height and time
+	expiry := blockTime.Add(time.Hour)
+	_, err = msgServer.Blacklist(ctx, &types.MsgBlacklist{From: blacklister.Address, Address: user.Address, Denom: "uusdc", ExpiryHeight: 100, ExpiryTime: &expiry})
+	require.NoError(t, err)
+
+	blacklisted, found := ftf.GetBlacklisted(ctx, "uusdc", user.AddressBz)
+	require.True(t, found)
+	require.Equal(t, int64(100), blacklisted.ExpiresAtHeight)
+	require.Equal(t, &expiry, blacklisted.ExpiresAtTime)
+
+	// ASSERT: The blacklisting is lifted once the expiry time is reached
+	require.NoError(t, ftf.ExpireBlacklisted(ctx.WithBlockHeight(43).WithBlockTime(expiry)))
+	_, found = ftf.GetBlacklisted(ctx, "uusdc", user.AddressBz)
+	require.False(t, found)
 }
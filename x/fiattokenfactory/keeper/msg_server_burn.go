@@ -31,34 +31,33 @@ func (k msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBu
 }
 
 func (k Keeper) Burn(ctx sdk.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
-	_, found := k.GetMinters(ctx, msg.From)
+	_, found := k.GetMintingDenom(ctx, msg.Amount.Denom)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrBurn, "burning denom is incorrect")
+	}
+
+	_, found = k.GetMinters(ctx, msg.Amount.Denom, msg.From)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrBurn, "%v: you are not a minter", types.ErrUnauthorized)
 	}
 
-	_, addressBz, err := DecodeNoLimitToBase256(msg.From)
+	addressBz, err := k.DecodeAddress(msg.From)
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrBurn, err.Error())
 	}
 
-	_, found = k.GetBlacklisted(ctx, addressBz)
+	_, found = k.GetBlacklisted(ctx, msg.Amount.Denom, addressBz)
 	if found {
 		return nil, sdkerrors.Wrap(types.ErrBurn, "minter address is blacklisted")
 	}
 
-	mintingDenom := k.GetMintingDenom(ctx)
-
-	if msg.Amount.Denom != mintingDenom.Denom {
-		return nil, sdkerrors.Wrap(types.ErrBurn, "burning denom is incorrect")
-	}
-
 	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
 		return nil, sdkerrors.Wrap(types.ErrBurn, "burning amount is invalid")
 	}
 
-	paused := k.GetPaused(ctx)
+	paused := k.GetPaused(ctx, msg.Amount.Denom)
 
-	if paused.Paused {
+	if paused.IsPaused(types.PauseScope_PAUSE_SCOPE_BURN) {
 		return nil, sdkerrors.Wrap(types.ErrBurn, "burning is paused")
 	}
 
@@ -78,7 +77,10 @@ func (k Keeper) Burn(ctx sdk.Context, msg *types.MsgBurn) (*types.MsgBurnRespons
 		return nil, sdkerrors.Wrap(types.ErrBurn, err.Error())
 	}
 
-	err = ctx.EventManager().EmitTypedEvent(msg)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventBurned{
+		Minter: msg.From,
+		Amount: msg.Amount,
+	})
 
 	return &types.MsgBurnResponse{}, err
 }
@@ -0,0 +1,69 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (k msgServer) BurnEscrow(goCtx context.Context, msg *types.MsgBurnEscrow) (*types.MsgBurnEscrowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if _, found := k.GetMintingDenom(ctx, msg.Amount.Denom); !found {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidDenom, "%s is not a minting denom", msg.Amount.Denom)
+	}
+
+	if k.GetPaused(ctx, msg.Amount.Denom).IsPaused(types.PauseScope_PAUSE_SCOPE_BURN) {
+		return nil, sdkerrors.Wrap(types.ErrBurn, "burning is paused")
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.EscrowName), msg.Amount.Denom)
+	if balance.IsLT(msg.Amount) {
+		return nil, sdkerrors.Wrapf(types.ErrInsufficientEscrow, "escrow holds %s", balance)
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.EscrowName, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrBurn, err.Error())
+	}
+
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  msg.Amount.Denom,
+		Actor:  msg.Authority,
+		Role:   types.RoleAuthority,
+		Action: types.ActionBurnEscrow,
+		Before: balance.String(),
+		After:  balance.Sub(msg.Amount).String(),
+	}); err != nil {
+		return nil, err
+	}
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventEscrowBurned{
+		Amount: msg.Amount,
+	})
+
+	return &types.MsgBurnEscrowResponse{}, err
+}
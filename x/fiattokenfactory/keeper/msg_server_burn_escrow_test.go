@@ -0,0 +1,77 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBurnEscrow(t *testing.T) {
+	ftf, ctx, msgServer := setupForEscrowTest(t)
+
+	// ACT: Burn part of the seized funds
+	res, err := msgServer.BurnEscrow(ctx, &types.MsgBurnEscrow{Authority: ftf.GetAuthority(), Amount: sdk.NewInt64Coin("uusdc", 30)})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgBurnEscrowResponse{}, res)
+
+	// ASSERT: The escrow holds the rest of the seized funds
+	balance, err := ftf.EscrowBalance(ctx, &types.QueryEscrowBalanceRequest{Denom: "uusdc"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 70), balance.Balance)
+
+	requireTypedEvent(t, ctx, &types.EventEscrowBurned{Amount: sdk.NewInt64Coin("uusdc", 30)})
+
+	entries := ftf.GetAllAuditEntries(ctx)
+	require.Equal(t, types.RoleAuthority, entries[len(entries)-1].Role)
+	require.Equal(t, types.ActionBurnEscrow, entries[len(entries)-1].Action)
+	require.Equal(t, "100uusdc", entries[len(entries)-1].Before)
+	require.Equal(t, "70uusdc", entries[len(entries)-1].After)
+}
+
+func TestBurnEscrow_InvalidAuthority(t *testing.T) {
+	_, ctx, msgServer := setupForEscrowTest(t)
+
+	_, err := msgServer.BurnEscrow(ctx, &types.MsgBurnEscrow{Authority: sample.AccAddress(), Amount: sdk.NewInt64Coin("uusdc", 30)})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
+
+func TestBurnEscrow_NotMintingDenom(t *testing.T) {
+	ftf, ctx, msgServer := setupForEscrowTest(t)
+
+	_, err := msgServer.BurnEscrow(ctx, &types.MsgBurnEscrow{Authority: ftf.GetAuthority(), Amount: sdk.NewInt64Coin("ustake", 30)})
+	require.ErrorIs(t, err, types.ErrInvalidDenom)
+}
+
+func TestBurnEscrow_Paused(t *testing.T) {
+	ftf, ctx, msgServer := setupForEscrowTest(t)
+	ftf.SetPaused(ctx, types.Paused{Denom: "uusdc", Paused: true})
+
+	_, err := msgServer.BurnEscrow(ctx, &types.MsgBurnEscrow{Authority: ftf.GetAuthority(), Amount: sdk.NewInt64Coin("uusdc", 30)})
+	require.ErrorIs(t, err, types.ErrBurn)
+}
+
+func TestBurnEscrow_InsufficientEscrow(t *testing.T) {
+	ftf, ctx, msgServer := setupForEscrowTest(t)
+
+	_, err := msgServer.BurnEscrow(ctx, &types.MsgBurnEscrow{Authority: ftf.GetAuthority(), Amount: sdk.NewInt64Coin("uusdc", 101)})
+	require.ErrorIs(t, err, types.ErrInsufficientEscrow)
+}
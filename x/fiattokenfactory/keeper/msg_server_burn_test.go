@@ -32,7 +32,7 @@ func TestBurn_FromAddressIsMissing(t *testing.T) {
 	mintingDenom := "uusdc"
 	_, ctx, msgServer := setupForBurnTest(mintingDenom)
 
-	_, err := msgServer.Burn(sdk.WrapSDKContext(ctx), &types.MsgBurn{})
+	_, err := msgServer.Burn(sdk.WrapSDKContext(ctx), &types.MsgBurn{Amount: sdk.NewInt64Coin(mintingDenom, 1)})
 	require.ErrorIs(t, err, types.ErrBurn)
 	require.ErrorContains(t, err, "you are not a minter")
 }
@@ -41,7 +41,7 @@ func TestBurn_FromAddressIsNotMinter(t *testing.T) {
 	mintingDenom := "uusdc"
 	_, ctx, msgServer := setupForBurnTest(mintingDenom)
 
-	_, err := msgServer.Burn(sdk.WrapSDKContext(ctx), &types.MsgBurn{From: "notMinter"})
+	_, err := msgServer.Burn(sdk.WrapSDKContext(ctx), &types.MsgBurn{From: "notMinter", Amount: sdk.NewInt64Coin(mintingDenom, 1)})
 	require.ErrorIs(t, err, types.ErrBurn)
 	require.ErrorContains(t, err, "you are not a minter")
 }
@@ -53,7 +53,7 @@ func TestBurn_InvalidMinterAddress(t *testing.T) {
 
 	invalidMinter := make([]byte, 256)
 	invalidMinterAddress := sdk.AccAddress(invalidMinter).String()
-	ftf.SetMinters(ctx, types.Minters{Address: invalidMinterAddress})
+	ftf.SetMinters(ctx, types.Minters{Address: invalidMinterAddress, Allowance: sdk.NewInt64Coin(mintingDenom, 0)})
 	_, err := msgServer.Burn(sdk.WrapSDKContext(ctx), &types.MsgBurn{From: invalidMinterAddress, Amount: amount})
 	require.ErrorIs(t, err, types.ErrBurn)
 }
@@ -64,8 +64,8 @@ func TestBurn_BlacklistedMinterAddress(t *testing.T) {
 	amount := sdk.Coin{Denom: mintingDenom, Amount: math.NewInt(1)}
 	ftf, ctx, msgServer := setupForBurnTest(mintingDenom)
 
-	ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: minter.AddressBz})
-	ftf.SetMinters(ctx, types.Minters{Address: minter.Address})
+	ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: minter.AddressBz, Denom: "uusdc"})
+	ftf.SetMinters(ctx, types.Minters{Address: minter.Address, Allowance: sdk.NewInt64Coin(mintingDenom, 0)})
 	_, err := msgServer.Burn(sdk.WrapSDKContext(ctx), &types.MsgBurn{From: minter.Address, Amount: amount})
 	require.ErrorIs(t, err, types.ErrBurn)
 	require.ErrorContains(t, err, "minter address is blacklisted")
@@ -77,8 +77,8 @@ func TestBurn_BlacklistedBech32mMinterAddress(t *testing.T) {
 	amount := sdk.Coin{Denom: mintingDenom, Amount: math.NewInt(1)}
 	ftf, ctx, msgServer := setupForBurnTest(mintingDenom)
 
-	ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: minter.AddressBz})
-	ftf.SetMinters(ctx, types.Minters{Address: minter.Address})
+	ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: minter.AddressBz, Denom: "uusdc"})
+	ftf.SetMinters(ctx, types.Minters{Address: minter.Address, Allowance: sdk.NewInt64Coin(mintingDenom, 0)})
 	_, err := msgServer.Burn(sdk.WrapSDKContext(ctx), &types.MsgBurn{From: minter.Address, Amount: amount})
 	require.ErrorIs(t, err, types.ErrBurn)
 	require.ErrorContains(t, err, "minter address is blacklisted")
@@ -89,7 +89,7 @@ func TestBurn_DenomIsMissing(t *testing.T) {
 	minter := sample.TestAccount()
 	ftf, ctx, msgServer := setupForBurnTest(mintingDenom)
 
-	ftf.SetMinters(ctx, types.Minters{Address: minter.Address})
+	ftf.SetMinters(ctx, types.Minters{Address: minter.Address, Allowance: sdk.NewInt64Coin(mintingDenom, 0)})
 	_, err := msgServer.Burn(sdk.WrapSDKContext(ctx), &types.MsgBurn{From: minter.Address, Amount: sdk.Coin{}})
 	require.ErrorIs(t, err, types.ErrBurn)
 	require.ErrorContains(t, err, "burning denom is incorrect")
@@ -100,7 +100,7 @@ func TestBurn_DenomIsEmpty(t *testing.T) {
 	minter := sample.TestAccount()
 	ftf, ctx, msgServer := setupForBurnTest(mintingDenom)
 
-	ftf.SetMinters(ctx, types.Minters{Address: minter.Address})
+	ftf.SetMinters(ctx, types.Minters{Address: minter.Address, Allowance: sdk.NewInt64Coin(mintingDenom, 0)})
 	_, err := msgServer.Burn(sdk.WrapSDKContext(ctx), &types.MsgBurn{From: minter.Address, Amount: sdk.Coin{Denom: ""}})
 	require.ErrorIs(t, err, types.ErrBurn)
 	require.ErrorContains(t, err, "burning denom is incorrect")
@@ -111,7 +111,7 @@ func TestBurn_DenomIsIncorrect(t *testing.T) {
 	minter := sample.TestAccount()
 	ftf, ctx, msgServer := setupForBurnTest(mintingDenom)
 
-	ftf.SetMinters(ctx, types.Minters{Address: minter.Address})
+	ftf.SetMinters(ctx, types.Minters{Address: minter.Address, Allowance: sdk.NewInt64Coin(mintingDenom, 0)})
 	_, err := msgServer.Burn(sdk.WrapSDKContext(ctx), &types.MsgBurn{From: minter.Address, Amount: sdk.Coin{Denom: "notDenom"}})
 	require.ErrorIs(t, err, types.ErrBurn)
 	require.ErrorContains(t, err, "burning denom is incorrect")
@@ -123,8 +123,8 @@ func TestBurn_Paused(t *testing.T) {
 	amount := sdk.Coin{Denom: mintingDenom, Amount: math.NewInt(1)}
 	ftf, ctx, msgServer := setupForBurnTest(mintingDenom)
 
-	ftf.SetMinters(ctx, types.Minters{Address: minter.Address})
-	ftf.SetPaused(ctx, types.Paused{Paused: true})
+	ftf.SetMinters(ctx, types.Minters{Address: minter.Address, Allowance: sdk.NewInt64Coin(mintingDenom, 0)})
+	ftf.SetPaused(ctx, types.Paused{Denom: "uusdc", Paused: true})
 	_, err := msgServer.Burn(sdk.WrapSDKContext(ctx), &types.MsgBurn{From: minter.Address, Amount: amount})
 	require.ErrorIs(t, err, types.ErrBurn)
 	require.ErrorContains(t, err, "burning is paused")
@@ -135,7 +135,7 @@ func TestBurn_NilAmount(t *testing.T) {
 	minter := sample.TestAccount()
 	ftf, ctx, msgServer := setupForBurnTest(mintingDenom)
 
-	ftf.SetMinters(ctx, types.Minters{Address: minter.Address})
+	ftf.SetMinters(ctx, types.Minters{Address: minter.Address, Allowance: sdk.NewInt64Coin(mintingDenom, 0)})
 	_, err := msgServer.Burn(sdk.WrapSDKContext(ctx), &types.MsgBurn{From: minter.Address, Amount: sdk.Coin{Denom: mintingDenom}})
 	require.ErrorIs(t, err, types.ErrBurn)
 	require.ErrorContains(t, err, "burning amount is invalid")
@@ -147,7 +147,7 @@ func TestBurn_NegativeAmount(t *testing.T) {
 	amount := sdk.Coin{Denom: mintingDenom, Amount: math.NewInt(-1)}
 	ftf, ctx, msgServer := setupForBurnTest(mintingDenom)
 
-	ftf.SetMinters(ctx, types.Minters{Address: minter.Address})
+	ftf.SetMinters(ctx, types.Minters{Address: minter.Address, Allowance: sdk.NewInt64Coin(mintingDenom, 0)})
 	_, err := msgServer.Burn(sdk.WrapSDKContext(ctx), &types.MsgBurn{From: minter.Address, Amount: amount})
 	require.ErrorIs(t, err, types.ErrBurn)
 	require.ErrorContains(t, err, "burning amount is invalid")
@@ -159,7 +159,7 @@ func TestBurn_ZeroAmount(t *testing.T) {
 	amount := sdk.Coin{Denom: mintingDenom, Amount: math.NewInt(0)}
 	ftf, ctx, msgServer := setupForBurnTest(mintingDenom)
 
-	ftf.SetMinters(ctx, types.Minters{Address: minter.Address})
+	ftf.SetMinters(ctx, types.Minters{Address: minter.Address, Allowance: sdk.NewInt64Coin(mintingDenom, 0)})
 	_, err := msgServer.Burn(sdk.WrapSDKContext(ctx), &types.MsgBurn{From: minter.Address, Amount: amount})
 	require.ErrorIs(t, err, types.ErrBurn)
 	require.ErrorContains(t, err, "burning amount is invalid")
@@ -171,7 +171,7 @@ func TestBurn_AmountExceedsBurnerBalance(t *testing.T) {
 	amount := sdk.Coin{Denom: mintingDenom, Amount: math.NewInt(10)}
 	ftf, ctx, msgServer := setupForBurnTest(mintingDenom)
 
-	ftf.SetMinters(ctx, types.Minters{Address: minter.Address})
+	ftf.SetMinters(ctx, types.Minters{Address: minter.Address, Allowance: sdk.NewInt64Coin(mintingDenom, 0)})
 	_, err := msgServer.Burn(sdk.WrapSDKContext(ctx), &types.MsgBurn{From: minter.Address, Amount: amount})
 	require.ErrorIs(t, err, types.ErrBurn)
 	require.ErrorContains(t, err, "insufficient funds")
@@ -194,12 +194,13 @@ func TestBurn_Success(t *testing.T) {
 	res, err := msgServer.Burn(sdk.WrapSDKContext(ctx), &types.MsgBurn{From: minter.Address, Amount: amount})
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgBurnResponse{}, res)
+	requireTypedEvent(t, ctx, &types.EventBurned{Minter: minter.Address, Amount: amount})
 }
 
 func setupForBurnTest(mintingDenom string) (*keeper.Keeper, sdk.Context, types.MsgServer) {
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: mintingDenom})
-	ftf.SetPaused(ctx, types.Paused{Paused: false})
+	ftf.SetPaused(ctx, types.Paused{Denom: mintingDenom, Paused: false})
 	msgServer := keeper.NewMsgServerImpl(ftf)
 	return ftf, ctx, msgServer
 }
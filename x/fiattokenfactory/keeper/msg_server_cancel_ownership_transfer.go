@@ -0,0 +1,79 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) CancelOwnershipTransfer(goCtx context.Context, msg *types.MsgCancelOwnershipTransfer) (*types.MsgCancelOwnershipTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, found := k.GetOwner(ctx, msg.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "owner is not set")
+	}
+
+	proposed, err := k.proposeAction(ctx, msg.Denom, types.RoleOwner, msg.From, types.ActionProposal{
+		Action: &types.ActionProposal_CancelOwnershipTransfer{CancelOwnershipTransfer: msg},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if proposed {
+		return &types.MsgCancelOwnershipTransferResponse{}, nil
+	}
+
+	if owner.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
+	}
+
+	return &types.MsgCancelOwnershipTransferResponse{}, k.cancelOwnershipTransfer(ctx, msg)
+}
+
+// cancelOwnershipTransfer drops the pending owner of a minting denom.
+func (k Keeper) cancelOwnershipTransfer(ctx sdk.Context, msg *types.MsgCancelOwnershipTransfer) error {
+	pendingOwner, found := k.GetPendingOwner(ctx, msg.Denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUserNotFound, "pending owner is not set")
+	}
+
+	owner, _ := k.GetOwner(ctx, msg.Denom)
+	k.DeletePendingOwner(ctx, msg.Denom)
+
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  msg.Denom,
+		Actor:  msg.From,
+		Role:   types.RoleOwner,
+		Action: types.ActionCancelOwnershipTransfer,
+		Target: types.RolePendingOwner,
+		Before: pendingOwner.Address,
+	}); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventOwnershipTransferCancelled{
+		Denom:        msg.Denom,
+		Owner:        owner.Address,
+		PendingOwner: pendingOwner.Address,
+	})
+}
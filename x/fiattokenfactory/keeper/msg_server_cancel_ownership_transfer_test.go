@@ -0,0 +1,91 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelOwnershipTransfer_OwnerNotSet(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	_, err := msgServer.CancelOwnershipTransfer(sdk.WrapSDKContext(ctx), &types.MsgCancelOwnershipTransfer{From: sample.AccAddress(), Denom: "uusdc"})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+	require.ErrorContains(t, err, "owner is not set")
+}
+
+func TestCancelOwnershipTransfer_FromAddressIsNotOwner(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	pendingOwner := sample.AccAddress()
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: sample.AccAddress()})
+	ftf.SetPendingOwner(ctx, types.Owner{Denom: "uusdc", Address: pendingOwner})
+
+	// the pending owner cannot cancel the transfer on the owner's behalf
+	_, err := msgServer.CancelOwnershipTransfer(sdk.WrapSDKContext(ctx), &types.MsgCancelOwnershipTransfer{From: pendingOwner, Denom: "uusdc"})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "you are not the owner")
+
+	_, found := ftf.GetPendingOwner(ctx, "uusdc")
+	require.True(t, found)
+}
+
+func TestCancelOwnershipTransfer_NoPendingOwner(t *testing.T) {
+	owner := sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner})
+
+	_, err := msgServer.CancelOwnershipTransfer(sdk.WrapSDKContext(ctx), &types.MsgCancelOwnershipTransfer{From: owner, Denom: "uusdc"})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+	require.ErrorContains(t, err, "pending owner is not set")
+}
+
+func TestCancelOwnershipTransfer_Success(t *testing.T) {
+	owner := sample.AccAddress()
+	pendingOwner := sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner})
+
+	_, err := msgServer.UpdateOwner(sdk.WrapSDKContext(ctx), &types.MsgUpdateOwner{From: owner, Address: pendingOwner, Denom: "uusdc"})
+	require.NoError(t, err)
+
+	res, err := msgServer.CancelOwnershipTransfer(sdk.WrapSDKContext(ctx), &types.MsgCancelOwnershipTransfer{From: owner, Denom: "uusdc"})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgCancelOwnershipTransferResponse{}, res)
+	requireTypedEvent(t, ctx, &types.EventOwnershipTransferCancelled{Denom: "uusdc", Owner: owner, PendingOwner: pendingOwner})
+
+	_, found := ftf.GetPendingOwner(ctx, "uusdc")
+	require.False(t, found)
+
+	entries := ftf.GetAllAuditEntries(ctx)
+	require.Equal(t, types.ActionCancelOwnershipTransfer, entries[len(entries)-1].Action)
+	require.Equal(t, pendingOwner, entries[len(entries)-1].Before)
+
+	// the former pending owner can no longer accept the ownership
+	_, err = msgServer.AcceptOwner(sdk.WrapSDKContext(ctx), &types.MsgAcceptOwner{From: pendingOwner, Denom: "uusdc"})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) CancelQueuedAction(goCtx context.Context, msg *types.MsgCancelQueuedAction) (*types.MsgCancelQueuedActionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	action, found := k.GetQueuedAction(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrQueuedActionNotFound, "queued action %d does not exist", msg.Id)
+	}
+
+	if _, found := k.GetOwner(ctx, action.Denom); !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "owner is not set")
+	}
+
+	// a cancellation only stops a change, so the owner or any single signer of
+	// the owner signer set cancels without the approvals of the signer set,
+	// which may itself be replaced by the queued action
+	if !k.actsForRole(ctx, action.Denom, types.RoleOwner, msg.From) {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
+	}
+
+	return &types.MsgCancelQueuedActionResponse{}, k.cancelQueuedAction(ctx, msg)
+}
+
+// cancelQueuedAction drops a queued action before its delay elapsed.
+func (k Keeper) cancelQueuedAction(ctx sdk.Context, msg *types.MsgCancelQueuedAction) error {
+	action, found := k.GetQueuedAction(ctx, msg.Id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrQueuedActionNotFound, "queued action %d does not exist", msg.Id)
+	}
+
+	k.RemoveQueuedAction(ctx, msg.Id)
+
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  action.Denom,
+		Actor:  msg.From,
+		Role:   types.RoleOwner,
+		Action: types.ActionCancelQueuedAction,
+		Target: strconv.FormatUint(msg.Id, 10),
+		Before: action.ActionName(),
+	}); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventQueuedActionCancelled{
+		Id:     msg.Id,
+		Denom:  action.Denom,
+		Action: action.ActionName(),
+	})
+}
@@ -0,0 +1,95 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelQueuedAction_NotFound(t *testing.T) {
+	_, ctx, msgServer, owner := setupTimelock()
+
+	_, err := msgServer.CancelQueuedAction(ctx, &types.MsgCancelQueuedAction{From: owner, Id: 1})
+	require.ErrorIs(t, err, types.ErrQueuedActionNotFound)
+}
+
+func TestCancelQueuedAction_FromAddressIsNotOwner(t *testing.T) {
+	ftf, ctx, msgServer, owner := setupTimelock()
+
+	_, err := msgServer.UpdateBlacklister(ctx, &types.MsgUpdateBlacklister{From: owner, Address: sample.AccAddress(), Denom: "uusdc"})
+	require.NoError(t, err)
+	id := ftf.GetAllQueuedActions(ctx)[0].Id
+
+	_, err = msgServer.CancelQueuedAction(ctx, &types.MsgCancelQueuedAction{From: sample.AccAddress(), Id: id})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "you are not the owner")
+}
+
+func TestCancelQueuedAction_Success(t *testing.T) {
+	ftf, ctx, msgServer, owner := setupTimelock()
+
+	_, err := msgServer.UpdateOwner(ctx, &types.MsgUpdateOwner{From: owner, Address: sample.AccAddress(), Denom: "uusdc"})
+	require.NoError(t, err)
+	id := ftf.GetAllQueuedActions(ctx)[0].Id
+
+	res, err := msgServer.CancelQueuedAction(ctx, &types.MsgCancelQueuedAction{From: owner, Id: id})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgCancelQueuedActionResponse{}, res)
+	require.Empty(t, ftf.GetAllQueuedActions(ctx))
+
+	require.NoError(t, ftf.ExecuteQueuedActions(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))))
+	_, found := ftf.GetPendingOwner(ctx, "uusdc")
+	require.False(t, found)
+
+	entries := ftf.GetAllAuditEntries(ctx)
+	require.Equal(t, types.ActionCancelQueuedAction, entries[len(entries)-1].Action)
+}
+
+func TestCancelQueuedAction_OwnerCancelsAfterSignerSetChange(t *testing.T) {
+	ftf, ctx, msgServer, owner := setupTimelock()
+	attacker := sample.AccAddress()
+
+	// someone holding the owner key queues an owner change to themselves and
+	// makes themselves the only signer of the owner
+	_, err := msgServer.UpdateOwner(ctx, &types.MsgUpdateOwner{From: owner, Address: attacker, Denom: "uusdc"})
+	require.NoError(t, err)
+	_, err = msgServer.SetSignerSet(ctx, &types.MsgSetSignerSet{From: owner, Denom: "uusdc", Role: types.RoleOwner, Signers: []string{attacker}, Threshold: 1})
+	require.NoError(t, err)
+
+	queued := ftf.GetAllQueuedActions(ctx)
+	require.Len(t, queued, 2)
+
+	// the signer set change is queued, so the owner cancels it
+	_, err = msgServer.CancelQueuedAction(ctx, &types.MsgCancelQueuedAction{From: owner, Id: queued[1].Id})
+	require.NoError(t, err)
+
+	// even with the signer set in force, the owner still cancels
+	ftf.SetSignerSet(ctx, types.SignerSet{Denom: "uusdc", Role: types.RoleOwner, Signers: []string{attacker}, Threshold: 1})
+	_, err = msgServer.CancelQueuedAction(ctx, &types.MsgCancelQueuedAction{From: owner, Id: queued[0].Id})
+	require.NoError(t, err)
+	require.Empty(t, ftf.GetAllQueuedActions(ctx))
+	require.Empty(t, ftf.GetAllActionProposals(ctx))
+
+	require.NoError(t, ftf.ExecuteQueuedActions(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))))
+	_, found := ftf.GetPendingOwner(ctx, "uusdc")
+	require.False(t, found)
+}
@@ -19,6 +19,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/math"
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
 
 	sdkerrors "cosmossdk.io/errors"
@@ -28,9 +29,8 @@ import (
 func (k msgServer) ConfigureMinter(goCtx context.Context, msg *types.MsgConfigureMinter) (*types.MsgConfigureMinterResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	mintingDenom := k.GetMintingDenom(ctx)
-
-	if msg.Allowance.Denom != mintingDenom.Denom {
+	_, found := k.GetMintingDenom(ctx, msg.Allowance.Denom)
+	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrMint, "minting denom is incorrect")
 	}
 
@@ -38,7 +38,7 @@ func (k msgServer) ConfigureMinter(goCtx context.Context, msg *types.MsgConfigur
 		return nil, sdkerrors.Wrapf(types.ErrMint, "allowance amount is invalid")
 	}
 
-	minterController, found := k.GetMinterController(ctx, msg.From)
+	minterController, found := k.GetMinterController(ctx, msg.Allowance.Denom, msg.From)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "minter controller not found")
 	}
@@ -47,9 +47,9 @@ func (k msgServer) ConfigureMinter(goCtx context.Context, msg *types.MsgConfigur
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not a controller of this minter")
 	}
 
-	paused := k.GetPaused(ctx)
+	paused := k.GetPaused(ctx, msg.Allowance.Denom)
 
-	if paused.Paused {
+	if paused.IsPaused(types.PauseScope_PAUSE_SCOPE_MINT) {
 		return nil, sdkerrors.Wrapf(types.ErrMint, "minting is paused")
 	}
 
@@ -61,12 +61,41 @@ func (k msgServer) ConfigureMinter(goCtx context.Context, msg *types.MsgConfigur
 		)
 	}
 
+	// the rate limit of an existing minter is kept when its allowance changes
+	var before string
+	previousAllowance := sdk.NewCoin(msg.Allowance.Denom, math.ZeroInt())
+	var rateLimit *types.RateLimit
+	if previous, found := k.GetMinters(ctx, msg.Allowance.Denom, msg.Address); found {
+		before = previous.Allowance.String()
+		previousAllowance = previous.Allowance
+		rateLimit = previous.RateLimit
+	}
+
 	k.SetMinters(ctx, types.Minters{
 		Address:   msg.Address,
 		Allowance: msg.Allowance,
+		RateLimit: rateLimit,
 	})
 
-	err := ctx.EventManager().EmitTypedEvent(msg)
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  msg.Allowance.Denom,
+		Actor:  msg.From,
+		Role:   types.RoleMinterController,
+		Action: types.ActionConfigureMinter,
+		Target: msg.Address,
+		Before: before,
+		After:  msg.Allowance.String(),
+	}); err != nil {
+		return nil, err
+	}
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventMinterConfigured{
+		Denom:             msg.Allowance.Denom,
+		Controller:        msg.From,
+		Minter:            msg.Address,
+		PreviousAllowance: previousAllowance,
+		Allowance:         msg.Allowance,
+	})
 
 	return &types.MsgConfigureMinterResponse{}, err
 }
@@ -28,21 +28,59 @@ import (
 func (k msgServer) ConfigureMinterController(goCtx context.Context, msg *types.MsgConfigureMinterController) (*types.MsgConfigureMinterControllerResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	masterMinter, found := k.GetMasterMinter(ctx)
+	masterMinter, found := k.GetMasterMinter(ctx, msg.Denom)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "master minter is not set")
 	}
 
+	proposed, err := k.proposeAction(ctx, msg.Denom, types.RoleMasterMinter, msg.From, types.ActionProposal{
+		Action: &types.ActionProposal_ConfigureMinterController{ConfigureMinterController: msg},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if proposed {
+		return &types.MsgConfigureMinterControllerResponse{}, nil
+	}
+
 	if masterMinter.Address != msg.From {
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the master minter")
 	}
 
+	return &types.MsgConfigureMinterControllerResponse{}, k.scheduleAction(ctx, msg.From, types.RoleMasterMinter, types.QueuedAction{
+		Denom:  msg.Denom,
+		Action: &types.QueuedAction_ConfigureMinterController{ConfigureMinterController: msg},
+	})
+}
+
+// configureMinterController assigns a minter to a minter controller of a minting denom.
+func (k Keeper) configureMinterController(ctx sdk.Context, msg *types.MsgConfigureMinterController) error {
 	controller := types.MinterController{
 		Minter:     msg.Minter,
 		Controller: msg.Controller,
+		Denom:      msg.Denom,
 	}
 
+	previous, _ := k.GetMinterController(ctx, msg.Denom, msg.Controller)
+
 	k.SetMinterController(ctx, controller)
 
-	return &types.MsgConfigureMinterControllerResponse{}, nil
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  msg.Denom,
+		Actor:  msg.From,
+		Role:   types.RoleMasterMinter,
+		Action: types.ActionConfigureMinterController,
+		Target: msg.Controller,
+		Before: previous.Minter,
+		After:  msg.Minter,
+	}); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventMinterControllerConfigured{
+		Denom:          msg.Denom,
+		Controller:     msg.Controller,
+		PreviousMinter: previous.Minter,
+		Minter:         msg.Minter,
+	})
 }
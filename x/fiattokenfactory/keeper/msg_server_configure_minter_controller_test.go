@@ -31,7 +31,7 @@ func TestConfigureMinterController_MasterMinterNotSet(t *testing.T) {
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
 
-	_, err := msgServer.ConfigureMinterController(sdk.WrapSDKContext(ctx), &types.MsgConfigureMinterController{})
+	_, err := msgServer.ConfigureMinterController(sdk.WrapSDKContext(ctx), &types.MsgConfigureMinterController{Denom: "uusdc"})
 	require.ErrorIs(t, err, types.ErrUserNotFound)
 	require.ErrorContains(t, err, "master minter is not set")
 }
@@ -40,9 +40,9 @@ func TestConfigureMinterController_FromAddressIsNotMasterMinter(t *testing.T) {
 	masterMinter := sample.TestAccount()
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetMasterMinter(ctx, types.MasterMinter{Address: masterMinter.Address})
+	ftf.SetMasterMinter(ctx, types.MasterMinter{Denom: "uusdc", Address: masterMinter.Address})
 
-	_, err := msgServer.ConfigureMinterController(sdk.WrapSDKContext(ctx), &types.MsgConfigureMinterController{From: sample.AccAddress()})
+	_, err := msgServer.ConfigureMinterController(sdk.WrapSDKContext(ctx), &types.MsgConfigureMinterController{From: sample.AccAddress(), Denom: "uusdc"})
 	require.ErrorIs(t, err, types.ErrUnauthorized)
 	require.ErrorContains(t, err, "you are not the master minter")
 }
@@ -51,8 +51,21 @@ func TestConfigureMinterController_Success(t *testing.T) {
 	masterMinter := sample.TestAccount()
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetMasterMinter(ctx, types.MasterMinter{Address: masterMinter.Address})
+	ftf.SetMasterMinter(ctx, types.MasterMinter{Denom: "uusdc", Address: masterMinter.Address})
 
-	_, err := msgServer.ConfigureMinterController(sdk.WrapSDKContext(ctx), &types.MsgConfigureMinterController{From: masterMinter.Address})
+	controller := sample.AccAddress()
+	minter := sample.AccAddress()
+
+	_, err := msgServer.ConfigureMinterController(sdk.WrapSDKContext(ctx), &types.MsgConfigureMinterController{
+		From:       masterMinter.Address,
+		Controller: controller,
+		Minter:     minter,
+		Denom:      "uusdc",
+	})
 	require.NoError(t, err)
+
+	minterController, found := ftf.GetMinterController(ctx, "uusdc", controller)
+	require.True(t, found)
+	require.Equal(t, minter, minterController.Minter)
+	requireTypedEvent(t, ctx, &types.EventMinterControllerConfigured{Denom: "uusdc", Controller: controller, Minter: minter})
 }
@@ -0,0 +1,82 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) ConfigureMinterRateLimit(goCtx context.Context, msg *types.MsgConfigureMinterRateLimit) (*types.MsgConfigureMinterRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	minterController, found := k.GetMinterController(ctx, msg.Denom, msg.From)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "minter controller not found")
+	}
+
+	if msg.Address != minterController.Minter {
+		return nil, sdkerrors.Wrapf(
+			types.ErrUnauthorized,
+			"minter address ≠ minter controller's minter address, (%s≠%s)",
+			msg.Address, minterController.Minter,
+		)
+	}
+
+	minter, found := k.GetMinters(ctx, msg.Denom, msg.Address)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "a minter with a given address doesn't exist")
+	}
+
+	previous := minter.RateLimit.Config()
+	switch {
+	case msg.IsRemoval():
+		minter.RateLimit = nil
+	case minter.RateLimit == nil:
+		minter.RateLimit = types.NewRateLimit(msg.Limit, msg.WindowBlocks, msg.WindowDuration)
+	default:
+		// the amount minted within the window still counts against the new limit
+		minter.RateLimit.Reconfigure(msg.Limit, msg.WindowBlocks, msg.WindowDuration, ctx.BlockHeight(), ctx.BlockTime())
+	}
+
+	k.SetMinters(ctx, minter)
+
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  msg.Denom,
+		Actor:  msg.From,
+		Role:   types.RoleMinterController,
+		Action: types.ActionConfigureMinterRateLimit,
+		Target: msg.Address,
+		Before: previous,
+		After:  minter.RateLimit.Config(),
+	}); err != nil {
+		return nil, err
+	}
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventMinterRateLimitConfigured{
+		Denom:      msg.Denom,
+		Controller: msg.From,
+		Minter:     msg.Address,
+		RateLimit:  minter.RateLimit,
+	})
+
+	return &types.MsgConfigureMinterRateLimitResponse{}, err
+}
@@ -0,0 +1,204 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+func TestConfigureMinterRateLimit_MinterControllerNotFound(t *testing.T) {
+	minter := sample.TestAccount()
+	_, ctx, msgServer := setupForMintTest("uusdc", minter, sdk.NewInt64Coin("uusdc", 10))
+
+	_, err := msgServer.ConfigureMinterRateLimit(ctx, &types.MsgConfigureMinterRateLimit{
+		From:         sample.AccAddress(),
+		Address:      minter.Address,
+		Denom:        "uusdc",
+		Limit:        math.NewInt(5),
+		WindowBlocks: 10,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "minter controller not found")
+}
+
+func TestConfigureMinterRateLimit_WrongMinter(t *testing.T) {
+	minter := sample.TestAccount()
+	controller := sample.TestAccount()
+	ftf, ctx, msgServer := setupForMintTest("uusdc", minter, sdk.NewInt64Coin("uusdc", 10))
+	ftf.SetMinterController(ctx, types.MinterController{Denom: "uusdc", Controller: controller.Address, Minter: minter.Address})
+
+	_, err := msgServer.ConfigureMinterRateLimit(ctx, &types.MsgConfigureMinterRateLimit{
+		From:         controller.Address,
+		Address:      sample.AccAddress(),
+		Denom:        "uusdc",
+		Limit:        math.NewInt(5),
+		WindowBlocks: 10,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
+
+func TestConfigureMinterRateLimit_MinterNotFound(t *testing.T) {
+	minter := sample.TestAccount()
+	controller := sample.TestAccount()
+	ftf, ctx, msgServer := setupForMintTest("uusdc", sample.TestAccount(), sdk.NewInt64Coin("uusdc", 10))
+	ftf.SetMinterController(ctx, types.MinterController{Denom: "uusdc", Controller: controller.Address, Minter: minter.Address})
+
+	_, err := msgServer.ConfigureMinterRateLimit(ctx, &types.MsgConfigureMinterRateLimit{
+		From:         controller.Address,
+		Address:      minter.Address,
+		Denom:        "uusdc",
+		Limit:        math.NewInt(5),
+		WindowBlocks: 10,
+	})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+}
+
+func TestConfigureMinterRateLimit_SetAndRemove(t *testing.T) {
+	minter := sample.TestAccount()
+	controller := sample.TestAccount()
+	ftf, ctx, msgServer := setupForMintTest("uusdc", minter, sdk.NewInt64Coin("uusdc", 10))
+	ftf.SetMinterController(ctx, types.MinterController{Denom: "uusdc", Controller: controller.Address, Minter: minter.Address})
+	ctx = ctx.WithBlockHeight(7).WithBlockTime(time.Unix(1000, 0).UTC())
+
+	_, err := msgServer.ConfigureMinterRateLimit(ctx, &types.MsgConfigureMinterRateLimit{
+		From:           controller.Address,
+		Address:        minter.Address,
+		Denom:          "uusdc",
+		Limit:          math.NewInt(5),
+		WindowDuration: time.Hour,
+	})
+	require.NoError(t, err)
+
+	got, found := ftf.GetMinters(ctx, "uusdc", minter.Address)
+	require.True(t, found)
+	require.Equal(t, types.NewRateLimit(math.NewInt(5), 0, time.Hour), got.RateLimit)
+
+	// reconfiguring the allowance keeps the rate limit
+	_, err = msgServer.ConfigureMinter(ctx, &types.MsgConfigureMinter{From: controller.Address, Address: minter.Address, Allowance: sdk.NewInt64Coin("uusdc", 20)})
+	require.NoError(t, err)
+	got, _ = ftf.GetMinters(ctx, "uusdc", minter.Address)
+	require.NotNil(t, got.RateLimit)
+
+	_, err = msgServer.ConfigureMinterRateLimit(ctx, &types.MsgConfigureMinterRateLimit{
+		From:    controller.Address,
+		Address: minter.Address,
+		Denom:   "uusdc",
+	})
+	require.NoError(t, err)
+
+	got, _ = ftf.GetMinters(ctx, "uusdc", minter.Address)
+	require.Nil(t, got.RateLimit)
+
+	entries := ftf.GetAllAuditEntries(ctx)
+	require.Equal(t, types.ActionConfigureMinterRateLimit, entries[0].Action)
+	require.Equal(t, "", entries[0].Before)
+	require.Equal(t, "5 per 1h0m0s", entries[0].After)
+	require.Equal(t, "5 per 1h0m0s", entries[2].Before)
+	require.Equal(t, "", entries[2].After)
+}
+
+func TestMint_RateLimitExceeded(t *testing.T) {
+	var (
+		receiver     = sample.TestAccount()
+		minter       = sample.TestAccount()
+		mintingDenom = "uusdc"
+		allowance    = sdk.NewInt64Coin(mintingDenom, 100)
+	)
+	ftf, ctx, msgServer := setupForMintTest(mintingDenom, minter, allowance)
+	ctx = ctx.WithBlockHeight(1)
+	ftf.SetMinters(ctx, types.Minters{
+		Address:   minter.Address,
+		Allowance: allowance,
+		RateLimit: types.NewRateLimit(math.NewInt(10), 5, 0),
+	})
+
+	_, err := msgServer.Mint(ctx, &types.MsgMint{From: minter.Address, Address: receiver.Address, Amount: sdk.NewInt64Coin(mintingDenom, 8)})
+	require.NoError(t, err)
+
+	// allowance remains, but the window capacity does not
+	_, err = msgServer.Mint(ctx.WithBlockHeight(5), &types.MsgMint{From: minter.Address, Address: receiver.Address, Amount: sdk.NewInt64Coin(mintingDenom, 3)})
+	require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+
+	res, err := ftf.Minters(ctx.WithBlockHeight(5), &types.QueryGetMintersRequest{Denom: mintingDenom, Address: minter.Address})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(mintingDenom, 2), *res.WindowRemaining)
+
+	// the first mint leaves the window 5 blocks after it happened
+	_, err = msgServer.Mint(ctx.WithBlockHeight(6), &types.MsgMint{From: minter.Address, Address: receiver.Address, Amount: sdk.NewInt64Coin(mintingDenom, 10)})
+	require.NoError(t, err)
+
+	got, _ := ftf.GetMinters(ctx, mintingDenom, minter.Address)
+	require.Equal(t, sdk.NewInt64Coin(mintingDenom, 82), got.Allowance)
+	require.Equal(t, math.NewInt(10), got.RateLimit.Minted(6, ctx.BlockTime()))
+}
+
+func TestConfigureMinterRateLimit_KeepsMinted(t *testing.T) {
+	receiver := sample.TestAccount()
+	minter := sample.TestAccount()
+	controller := sample.TestAccount()
+	ftf, ctx, msgServer := setupForMintTest("uusdc", minter, sdk.NewInt64Coin("uusdc", 100))
+	ftf.SetMinterController(ctx, types.MinterController{Denom: "uusdc", Controller: controller.Address, Minter: minter.Address})
+	ctx = ctx.WithBlockHeight(1)
+
+	msg := &types.MsgConfigureMinterRateLimit{
+		From:         controller.Address,
+		Address:      minter.Address,
+		Denom:        "uusdc",
+		Limit:        math.NewInt(10),
+		WindowBlocks: 5,
+	}
+	_, err := msgServer.ConfigureMinterRateLimit(ctx, msg)
+	require.NoError(t, err)
+	_, err = msgServer.Mint(ctx, &types.MsgMint{From: minter.Address, Address: receiver.Address, Amount: sdk.NewInt64Coin("uusdc", 8)})
+	require.NoError(t, err)
+
+	// raising the limit does not reset the amount minted in the window
+	ctx = ctx.WithBlockHeight(2)
+	msg.Limit = math.NewInt(12)
+	_, err = msgServer.ConfigureMinterRateLimit(ctx, msg)
+	require.NoError(t, err)
+
+	_, err = msgServer.Mint(ctx, &types.MsgMint{From: minter.Address, Address: receiver.Address, Amount: sdk.NewInt64Coin("uusdc", 5)})
+	require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+	_, err = msgServer.Mint(ctx, &types.MsgMint{From: minter.Address, Address: receiver.Address, Amount: sdk.NewInt64Coin("uusdc", 4)})
+	require.NoError(t, err)
+
+	// changing the window carries the minted amount over
+	msg.WindowBlocks = 10
+	_, err = msgServer.ConfigureMinterRateLimit(ctx, msg)
+	require.NoError(t, err)
+
+	got, _ := ftf.GetMinters(ctx, "uusdc", minter.Address)
+	require.Equal(t, math.NewInt(12), got.RateLimit.Minted(2, ctx.BlockTime()))
+}
+
+func TestMintersQuery_NoRateLimit(t *testing.T) {
+	minter := sample.TestAccount()
+	ftf, ctx, _ := setupForMintTest("uusdc", minter, sdk.NewInt64Coin("uusdc", 10))
+
+	res, err := ftf.Minters(ctx, &types.QueryGetMintersRequest{Denom: "uusdc", Address: minter.Address})
+	require.NoError(t, err)
+	require.Nil(t, res.WindowRemaining)
+}
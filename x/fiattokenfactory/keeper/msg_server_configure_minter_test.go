@@ -94,7 +94,7 @@ func TestConfigureMinter_ControllerMinterMismatch(t *testing.T) {
 	controller := sample.TestAccount()
 	minter := sample.TestAccount()
 	ftf, ctx, msgServer := setupForConfigureMinterTest(mintingDenom)
-	ftf.SetMinterController(ctx, types.MinterController{Controller: controller.Address, Minter: minter.Address})
+	ftf.SetMinterController(ctx, types.MinterController{Controller: controller.Address, Minter: minter.Address, Denom: "uusdc"})
 
 	_, err := msgServer.ConfigureMinter(sdk.WrapSDKContext(ctx), &types.MsgConfigureMinter{From: controller.Address, Address: sample.AccAddress(), Allowance: allowance})
 	require.ErrorIs(t, err, types.ErrUnauthorized)
@@ -107,8 +107,8 @@ func TestConfigureMinter_Paused(t *testing.T) {
 	controller := sample.TestAccount()
 	minter := sample.TestAccount()
 	ftf, ctx, msgServer := setupForConfigureMinterTest(mintingDenom)
-	ftf.SetMinterController(ctx, types.MinterController{Controller: controller.Address, Minter: minter.Address})
-	ftf.SetPaused(ctx, types.Paused{Paused: true})
+	ftf.SetMinterController(ctx, types.MinterController{Controller: controller.Address, Minter: minter.Address, Denom: "uusdc"})
+	ftf.SetPaused(ctx, types.Paused{Denom: "uusdc", Paused: true})
 
 	_, err := msgServer.ConfigureMinter(sdk.WrapSDKContext(ctx), &types.MsgConfigureMinter{From: controller.Address, Address: minter.Address, Allowance: allowance})
 	require.ErrorIs(t, err, types.ErrMint)
@@ -121,7 +121,7 @@ func TestConfigureMinter_ZeroAllowanceSuccess(t *testing.T) {
 	controller := sample.TestAccount()
 	minter := sample.TestAccount()
 	ftf, ctx, msgServer := setupForConfigureMinterTest(mintingDenom)
-	ftf.SetMinterController(ctx, types.MinterController{Controller: controller.Address, Minter: minter.Address})
+	ftf.SetMinterController(ctx, types.MinterController{Controller: controller.Address, Minter: minter.Address, Denom: "uusdc"})
 
 	res, err := msgServer.ConfigureMinter(sdk.WrapSDKContext(ctx), &types.MsgConfigureMinter{From: controller.Address, Address: minter.Address, Allowance: allowance})
 	require.NoError(t, err)
@@ -134,17 +134,35 @@ func TestConfigureMinter_Success(t *testing.T) {
 	controller := sample.TestAccount()
 	minter := sample.TestAccount()
 	ftf, ctx, msgServer := setupForConfigureMinterTest(mintingDenom)
-	ftf.SetMinterController(ctx, types.MinterController{Controller: controller.Address, Minter: minter.Address})
+	ftf.SetMinterController(ctx, types.MinterController{Controller: controller.Address, Minter: minter.Address, Denom: "uusdc"})
 
 	res, err := msgServer.ConfigureMinter(sdk.WrapSDKContext(ctx), &types.MsgConfigureMinter{From: controller.Address, Address: minter.Address, Allowance: allowance})
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgConfigureMinterResponse{}, res)
+	requireTypedEvent(t, ctx, &types.EventMinterConfigured{
+		Denom:             mintingDenom,
+		Controller:        controller.Address,
+		Minter:            minter.Address,
+		PreviousAllowance: sdk.NewCoin(mintingDenom, math.ZeroInt()),
+		Allowance:         allowance,
+	})
+
+	increased := sdk.Coin{Denom: mintingDenom, Amount: math.NewInt(5)}
+	_, err = msgServer.ConfigureMinter(sdk.WrapSDKContext(ctx), &types.MsgConfigureMinter{From: controller.Address, Address: minter.Address, Allowance: increased})
+	require.NoError(t, err)
+	requireTypedEvent(t, ctx, &types.EventMinterConfigured{
+		Denom:             mintingDenom,
+		Controller:        controller.Address,
+		Minter:            minter.Address,
+		PreviousAllowance: allowance,
+		Allowance:         increased,
+	})
 }
 
 func setupForConfigureMinterTest(mintingDenom string) (*keeper.Keeper, sdk.Context, types.MsgServer) {
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: mintingDenom})
-	ftf.SetPaused(ctx, types.Paused{Paused: false})
+	ftf.SetPaused(ctx, types.Paused{Denom: mintingDenom, Paused: false})
 	msgServer := keeper.NewMsgServerImpl(ftf)
 	return ftf, ctx, msgServer
 }
@@ -0,0 +1,129 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+	"slices"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) ForceRoleAssignment(goCtx context.Context, msg *types.MsgForceRoleAssignment) (*types.MsgForceRoleAssignmentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if _, found := k.GetMintingDenom(ctx, msg.Denom); !found {
+		return nil, sdkerrors.Wrapf(types.ErrDenomNotRegistered, "%s is not a minting denom", msg.Denom)
+	}
+
+	// ensure that the specified address is not already assigned to a privileged role
+	err := k.ValidatePrivileges(ctx, msg.Denom, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	var previous string
+	switch msg.Role {
+	case types.RoleOwner:
+		owner, _ := k.GetOwner(ctx, msg.Denom)
+		previous = owner.Address
+		k.SetOwner(ctx, types.Owner{Denom: msg.Denom, Address: msg.Address})
+		k.DeletePendingOwner(ctx, msg.Denom)
+	case types.RoleMasterMinter, types.RolePauser, types.RoleBlacklister, types.RoleAllowlister:
+		previous, err = k.changeRole(ctx, msg.Denom, msg.Role, msg.Address)
+		if err != nil {
+			return nil, err
+		}
+		k.DeletePendingRole(ctx, msg.Denom, msg.Role)
+	default:
+		return nil, sdkerrors.Wrapf(types.ErrInvalidType, "role %s cannot be force assigned", msg.Role)
+	}
+
+	// the replaced holder and the signers of the role may hold the lost or
+	// compromised keys, so nothing they started is left to execute
+	replaced := []string{previous}
+	if signerSet, found := k.GetSignerSet(ctx, msg.Denom, msg.Role); found {
+		replaced = append(replaced, signerSet.Signers...)
+		k.RemoveSignerSet(ctx, msg.Denom, msg.Role)
+	}
+	if err := k.cancelRoleActions(ctx, msg.Denom, msg.Role, replaced); err != nil {
+		return nil, err
+	}
+
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  msg.Denom,
+		Actor:  msg.Authority,
+		Role:   types.RoleAuthority,
+		Action: types.ActionForceRoleAssignment,
+		Target: msg.Role,
+		Before: previous,
+		After:  msg.Address,
+	}); err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRoleForceAssigned{
+		Denom:    msg.Denom,
+		Role:     msg.Role,
+		Previous: previous,
+		Address:  msg.Address,
+	})
+
+	return &types.MsgForceRoleAssignmentResponse{}, err
+}
+
+// cancelRoleActions drops the queued actions and action proposals that the
+// given addresses started for a role of a minting denom.
+func (k Keeper) cancelRoleActions(ctx sdk.Context, denom string, role string, addresses []string) error {
+	for _, action := range k.GetAllQueuedActions(ctx) {
+		if action.Denom != denom || action.Role() != role || !slices.Contains(addresses, action.From()) {
+			continue
+		}
+
+		k.RemoveQueuedAction(ctx, action.Id)
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventQueuedActionCancelled{
+			Id:     action.Id,
+			Denom:  action.Denom,
+			Action: action.ActionName(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, proposal := range k.GetAllActionProposals(ctx) {
+		if proposal.Denom != denom || proposal.Role != role || !slices.Contains(addresses, proposal.Proposer) {
+			continue
+		}
+
+		k.RemoveActionProposal(ctx, proposal.Id)
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventActionCancelled{
+			Id:     proposal.Id,
+			Action: proposal.ActionName(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,261 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForceRoleAssignment_InvalidAuthority(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+
+	_, err := msgServer.ForceRoleAssignment(ctx, &types.MsgForceRoleAssignment{
+		Authority: sample.AccAddress(),
+		Denom:     "uusdc",
+		Role:      types.RoleOwner,
+		Address:   sample.AccAddress(),
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
+
+func TestForceRoleAssignment_DenomNotRegistered(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	_, err := msgServer.ForceRoleAssignment(ctx, &types.MsgForceRoleAssignment{
+		Authority: ftf.GetAuthority(),
+		Denom:     "uusdc",
+		Role:      types.RoleOwner,
+		Address:   sample.AccAddress(),
+	})
+	require.ErrorIs(t, err, types.ErrDenomNotRegistered)
+}
+
+func TestForceRoleAssignment_AddressAlreadyPrivileged(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	pauser := sample.AccAddress()
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	ftf.SetPauser(ctx, types.Pauser{Denom: "uusdc", Address: pauser})
+
+	_, err := msgServer.ForceRoleAssignment(ctx, &types.MsgForceRoleAssignment{
+		Authority: ftf.GetAuthority(),
+		Denom:     "uusdc",
+		Role:      types.RoleBlacklister,
+		Address:   pauser,
+	})
+	require.ErrorIs(t, err, types.ErrAlreadyPrivileged)
+}
+
+func TestForceRoleAssignment_Owner(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	owner := sample.AccAddress()
+	newOwner := sample.AccAddress()
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner})
+	ftf.SetPendingOwner(ctx, types.Owner{Denom: "uusdc", Address: sample.AccAddress()})
+	ftf.SetSignerSet(ctx, types.SignerSet{Denom: "uusdc", Role: types.RoleOwner, Signers: []string{sample.AccAddress()}, Threshold: 1})
+
+	_, err := msgServer.ForceRoleAssignment(ctx, &types.MsgForceRoleAssignment{
+		Authority: ftf.GetAuthority(),
+		Denom:     "uusdc",
+		Role:      types.RoleOwner,
+		Address:   newOwner,
+	})
+	require.NoError(t, err)
+
+	got, found := ftf.GetOwner(ctx, "uusdc")
+	require.True(t, found)
+	require.Equal(t, newOwner, got.Address)
+	_, found = ftf.GetPendingOwner(ctx, "uusdc")
+	require.False(t, found)
+	_, found = ftf.GetSignerSet(ctx, "uusdc", types.RoleOwner)
+	require.False(t, found)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, "circle.fiattokenfactory.v1.EventRoleForceAssigned", events[len(events)-1].Type)
+
+	entries := ftf.GetAllAuditEntries(ctx)
+	require.Len(t, entries, 1)
+	require.Equal(t, types.ActionForceRoleAssignment, entries[0].Action)
+	require.Equal(t, owner, entries[0].Before)
+	require.Equal(t, newOwner, entries[0].After)
+}
+
+func TestForceRoleAssignment_MasterMinter(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	masterMinter := sample.AccAddress()
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	ftf.SetPendingRole(ctx, types.PendingRole{Denom: "uusdc", Role: types.RoleMasterMinter, Address: sample.AccAddress()})
+
+	_, err := msgServer.ForceRoleAssignment(ctx, &types.MsgForceRoleAssignment{
+		Authority: ftf.GetAuthority(),
+		Denom:     "uusdc",
+		Role:      types.RoleMasterMinter,
+		Address:   masterMinter,
+	})
+	require.NoError(t, err)
+
+	got, found := ftf.GetMasterMinter(ctx, "uusdc")
+	require.True(t, found)
+	require.Equal(t, masterMinter, got.Address)
+	_, found = ftf.GetPendingRole(ctx, "uusdc", types.RoleMasterMinter)
+	require.False(t, found)
+}
+
+func TestForceRoleAssignment_Pauser(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	pauser := sample.AccAddress()
+	newPauser := sample.AccAddress()
+	signer := sample.AccAddress()
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	ftf.SetPauser(ctx, types.Pauser{Denom: "uusdc", Address: pauser})
+	ftf.SetPendingRole(ctx, types.PendingRole{Denom: "uusdc", Role: types.RolePauser, Address: sample.AccAddress()})
+	ftf.SetSignerSet(ctx, types.SignerSet{Denom: "uusdc", Role: types.RolePauser, Signers: []string{signer, sample.AccAddress()}, Threshold: 2})
+	ftf.SetActionProposal(ctx, types.ActionProposal{
+		Id:        1,
+		Denom:     "uusdc",
+		Role:      types.RolePauser,
+		Proposer:  signer,
+		Approvals: []string{signer},
+		Action:    &types.ActionProposal_Unpause{Unpause: &types.MsgUnpause{From: signer, Denom: "uusdc"}},
+	})
+
+	_, err := msgServer.ForceRoleAssignment(ctx, &types.MsgForceRoleAssignment{
+		Authority: ftf.GetAuthority(),
+		Denom:     "uusdc",
+		Role:      types.RolePauser,
+		Address:   newPauser,
+	})
+	require.NoError(t, err)
+
+	got, found := ftf.GetPauser(ctx, "uusdc")
+	require.True(t, found)
+	require.Equal(t, newPauser, got.Address)
+	_, found = ftf.GetPendingRole(ctx, "uusdc", types.RolePauser)
+	require.False(t, found)
+	_, found = ftf.GetSignerSet(ctx, "uusdc", types.RolePauser)
+	require.False(t, found)
+
+	// the proposal of a signer of the dissolved signer set is cancelled
+	require.Empty(t, ftf.GetAllActionProposals(ctx))
+	requireTypedEvent(t, ctx, &types.EventActionCancelled{Id: 1, Action: types.ActionUnpause})
+	requireTypedEvent(t, ctx, &types.EventPauserChanged{Denom: "uusdc", PreviousPauser: pauser, NewPauser: newPauser})
+}
+
+func TestForceRoleAssignment_Blacklister(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	blacklister := sample.AccAddress()
+	newBlacklister := sample.AccAddress()
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	ftf.SetBlacklister(ctx, types.Blacklister{Denom: "uusdc", Address: blacklister})
+	ftf.SetPendingRole(ctx, types.PendingRole{Denom: "uusdc", Role: types.RoleBlacklister, Address: sample.AccAddress()})
+
+	_, err := msgServer.ForceRoleAssignment(ctx, &types.MsgForceRoleAssignment{
+		Authority: ftf.GetAuthority(),
+		Denom:     "uusdc",
+		Role:      types.RoleBlacklister,
+		Address:   newBlacklister,
+	})
+	require.NoError(t, err)
+
+	got, found := ftf.GetBlacklister(ctx, "uusdc")
+	require.True(t, found)
+	require.Equal(t, newBlacklister, got.Address)
+	_, found = ftf.GetPendingRole(ctx, "uusdc", types.RoleBlacklister)
+	require.False(t, found)
+	requireTypedEvent(t, ctx, &types.EventBlacklisterChanged{Denom: "uusdc", PreviousBlacklister: blacklister, NewBlacklister: newBlacklister})
+
+	entries := ftf.GetAllAuditEntries(ctx)
+	require.Len(t, entries, 1)
+	require.Equal(t, blacklister, entries[0].Before)
+	require.Equal(t, newBlacklister, entries[0].After)
+}
+
+func TestForceRoleAssignment_Allowlister(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	allowlister := sample.AccAddress()
+	newAllowlister := sample.AccAddress()
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	ftf.SetAllowlister(ctx, types.Allowlister{Denom: "uusdc", Address: allowlister})
+	ftf.SetPendingRole(ctx, types.PendingRole{Denom: "uusdc", Role: types.RoleAllowlister, Address: sample.AccAddress()})
+
+	_, err := msgServer.ForceRoleAssignment(ctx, &types.MsgForceRoleAssignment{
+		Authority: ftf.GetAuthority(),
+		Denom:     "uusdc",
+		Role:      types.RoleAllowlister,
+		Address:   newAllowlister,
+	})
+	require.NoError(t, err)
+
+	got, found := ftf.GetAllowlister(ctx, "uusdc")
+	require.True(t, found)
+	require.Equal(t, newAllowlister, got.Address)
+	_, found = ftf.GetPendingRole(ctx, "uusdc", types.RoleAllowlister)
+	require.False(t, found)
+	requireTypedEvent(t, ctx, &types.EventAllowlisterChanged{Denom: "uusdc", PreviousAllowlister: allowlister, NewAllowlister: newAllowlister})
+}
+
+func TestForceRoleAssignment_CancelsActionsOfReplacedOwner(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	owner := sample.AccAddress()
+	masterMinter := sample.AccAddress()
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "ueurc"})
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner})
+	ftf.SetMasterMinter(ctx, types.MasterMinter{Denom: "uusdc", Address: masterMinter})
+
+	queue := func(id uint64, denom string, action types.QueuedAction) {
+		action.Id = id
+		action.Denom = denom
+		ftf.SetQueuedAction(ctx, action)
+	}
+	queue(1, "uusdc", types.QueuedAction{Action: &types.QueuedAction_UpdatePauser{UpdatePauser: &types.MsgUpdatePauser{From: owner, Address: sample.AccAddress(), Denom: "uusdc"}}})
+	queue(2, "uusdc", types.QueuedAction{Action: &types.QueuedAction_ConfigureMinterController{ConfigureMinterController: &types.MsgConfigureMinterController{From: masterMinter, Controller: sample.AccAddress(), Minter: sample.AccAddress(), Denom: "uusdc"}}})
+	queue(3, "ueurc", types.QueuedAction{Action: &types.QueuedAction_UpdatePauser{UpdatePauser: &types.MsgUpdatePauser{From: owner, Address: sample.AccAddress(), Denom: "ueurc"}}})
+
+	_, err := msgServer.ForceRoleAssignment(ctx, &types.MsgForceRoleAssignment{
+		Authority: ftf.GetAuthority(),
+		Denom:     "uusdc",
+		Role:      types.RoleOwner,
+		Address:   sample.AccAddress(),
+	})
+	require.NoError(t, err)
+
+	// only the action the replaced owner queued for uusdc is cancelled
+	_, found := ftf.GetQueuedAction(ctx, 1)
+	require.False(t, found)
+	_, found = ftf.GetQueuedAction(ctx, 2)
+	require.True(t, found)
+	_, found = ftf.GetQueuedAction(ctx, 3)
+	require.True(t, found)
+	requireTypedEvent(t, ctx, &types.EventQueuedActionCancelled{Id: 1, Denom: "uusdc", Action: types.ActionUpdatePauser})
+}
@@ -32,35 +32,43 @@ func (k msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.MsgMi
 }
 
 func (k Keeper) Mint(ctx sdk.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
-	minter, found := k.GetMinters(ctx, msg.From)
+	_, found := k.GetMintingDenom(ctx, msg.Amount.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrMint, "minting denom is incorrect")
+	}
+
+	minter, found := k.GetMinters(ctx, msg.Amount.Denom, msg.From)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not a minter")
 	}
 
-	_, addressBz, err := DecodeNoLimitToBase256(msg.From)
+	addressBz, err := k.DecodeAddress(msg.From)
 	if err != nil {
 		return nil, err
 	}
 
-	_, found = k.GetBlacklisted(ctx, addressBz)
+	_, found = k.GetBlacklisted(ctx, msg.Amount.Denom, addressBz)
 	if found {
 		return nil, sdkerrors.Wrapf(types.ErrMint, "minter address is blacklisted")
 	}
 
-	_, addressBz, err = DecodeNoLimitToBase256(msg.Address)
+	allowlistEnabled := k.IsAllowlistEnabled(ctx, msg.Amount.Denom)
+	if allowlistEnabled && !k.IsAllowlisted(ctx, msg.Amount.Denom, addressBz) {
+		return nil, sdkerrors.Wrapf(types.ErrNotAllowlisted, "minter address is not allowlisted")
+	}
+
+	addressBz, err = k.DecodeAddress(msg.Address)
 	if err != nil {
 		return nil, err
 	}
 
-	_, found = k.GetBlacklisted(ctx, addressBz)
+	_, found = k.GetBlacklisted(ctx, msg.Amount.Denom, addressBz)
 	if found {
 		return nil, sdkerrors.Wrapf(types.ErrMint, "receiver address is blacklisted")
 	}
 
-	mintingDenom := k.GetMintingDenom(ctx)
-
-	if msg.Amount.Denom != mintingDenom.Denom {
-		return nil, sdkerrors.Wrapf(types.ErrMint, "minting denom is incorrect")
+	if allowlistEnabled && !k.IsAllowlisted(ctx, msg.Amount.Denom, addressBz) {
+		return nil, sdkerrors.Wrapf(types.ErrNotAllowlisted, "receiver address is not allowlisted")
 	}
 
 	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
@@ -71,12 +79,20 @@ func (k Keeper) Mint(ctx sdk.Context, msg *types.MsgMint) (*types.MsgMintRespons
 		return nil, sdkerrors.Wrapf(types.ErrMint, "minting amount is greater than the allowance")
 	}
 
-	paused := k.GetPaused(ctx)
+	paused := k.GetPaused(ctx, msg.Amount.Denom)
 
-	if paused.Paused {
+	if paused.IsPaused(types.PauseScope_PAUSE_SCOPE_MINT) {
 		return nil, sdkerrors.Wrapf(types.ErrMint, "minting is paused")
 	}
 
+	if minter.RateLimit != nil {
+		remaining := minter.RateLimit.Remaining(ctx.BlockHeight(), ctx.BlockTime())
+		if remaining.LT(msg.Amount.Amount) {
+			return nil, sdkerrors.Wrapf(types.ErrRateLimitExceeded, "minting amount is greater than the remaining rate limit window capacity (%s)", remaining)
+		}
+		minter.RateLimit.Consume(msg.Amount.Amount, ctx.BlockHeight(), ctx.BlockTime())
+	}
+
 	minter.Allowance = minter.Allowance.Sub(msg.Amount)
 
 	k.SetMinters(ctx, minter)
@@ -93,7 +109,12 @@ func (k Keeper) Mint(ctx sdk.Context, msg *types.MsgMint) (*types.MsgMintRespons
 		return nil, sdkerrors.Wrap(types.ErrSendCoinsToAccount, err.Error())
 	}
 
-	err = ctx.EventManager().EmitTypedEvent(msg)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMinted{
+		Minter:             msg.From,
+		To:                 msg.Address,
+		Amount:             msg.Amount,
+		RemainingAllowance: minter.Allowance,
+	})
 
 	return &types.MsgMintResponse{}, err
 }
@@ -36,7 +36,7 @@ func TestMint_FromAddressIsNotMinter(t *testing.T) {
 	)
 	_, ctx, msgServer := setupForMintTest(mintingDenom, minter, allowance)
 
-	_, err := msgServer.Mint(sdk.WrapSDKContext(ctx), &types.MsgMint{From: sample.AccAddress()})
+	_, err := msgServer.Mint(sdk.WrapSDKContext(ctx), &types.MsgMint{From: sample.AccAddress(), Amount: allowance})
 	require.ErrorIs(t, err, types.ErrUnauthorized)
 	require.ErrorContains(t, err, "you are not a minter")
 }
@@ -47,11 +47,11 @@ func TestMint_InvalidMinterAddress(t *testing.T) {
 		mintingDenom = "uusdc"
 		allowance    = sdk.Coin{Denom: mintingDenom, Amount: math.NewInt(10)}
 	)
-	ftf, ctx, msgServer := setupForMintTest(mintingDenom, minter, allowance)
+	_, ctx, msgServer := setupForMintTest(mintingDenom, minter, allowance)
 
-	ftf.SetMinters(ctx, types.Minters{Address: "invalid address"})
-	_, err := msgServer.Mint(sdk.WrapSDKContext(ctx), &types.MsgMint{From: "invalid address"})
-	require.Error(t, err)
+	// Minters are indexed by address bytes, so an invalid address can never be a minter.
+	_, err := msgServer.Mint(sdk.WrapSDKContext(ctx), &types.MsgMint{From: "invalid address", Amount: allowance})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
 }
 
 func TestMint_BlacklistedMinterAddress(t *testing.T) {
@@ -62,8 +62,8 @@ func TestMint_BlacklistedMinterAddress(t *testing.T) {
 	)
 	ftf, ctx, msgServer := setupForMintTest(mintingDenom, minter, allowance)
 
-	ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: minter.AddressBz})
-	_, err := msgServer.Mint(sdk.WrapSDKContext(ctx), &types.MsgMint{From: minter.Address})
+	ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: minter.AddressBz, Denom: "uusdc"})
+	_, err := msgServer.Mint(sdk.WrapSDKContext(ctx), &types.MsgMint{From: minter.Address, Amount: allowance})
 	require.ErrorIs(t, err, types.ErrMint)
 	require.ErrorContains(t, err, "minter address is blacklisted")
 }
@@ -76,8 +76,8 @@ func TestMint_BlacklistedBech32mMinterAddress(t *testing.T) {
 	)
 	ftf, ctx, msgServer := setupForMintTest(mintingDenom, minter, allowance)
 
-	ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: minter.AddressBz})
-	_, err := msgServer.Mint(sdk.WrapSDKContext(ctx), &types.MsgMint{From: minter.Address})
+	ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: minter.AddressBz, Denom: "uusdc"})
+	_, err := msgServer.Mint(sdk.WrapSDKContext(ctx), &types.MsgMint{From: minter.Address, Amount: allowance})
 	require.ErrorIs(t, err, types.ErrMint)
 	require.ErrorContains(t, err, "minter address is blacklisted")
 }
@@ -90,7 +90,7 @@ func TestMint_InvalidReceiverAddress(t *testing.T) {
 	)
 	_, ctx, msgServer := setupForMintTest(mintingDenom, minter, allowance)
 
-	_, err := msgServer.Mint(sdk.WrapSDKContext(ctx), &types.MsgMint{From: minter.Address, Address: "invalid address"})
+	_, err := msgServer.Mint(sdk.WrapSDKContext(ctx), &types.MsgMint{From: minter.Address, Address: "invalid address", Amount: allowance})
 	require.Error(t, err)
 }
 
@@ -103,8 +103,8 @@ func TestMint_BlacklistedReceiverAddress(t *testing.T) {
 	)
 	ftf, ctx, msgServer := setupForMintTest(mintingDenom, minter, allowance)
 
-	ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: receiver.AddressBz})
-	_, err := msgServer.Mint(sdk.WrapSDKContext(ctx), &types.MsgMint{From: minter.Address, Address: receiver.Address})
+	ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: receiver.AddressBz, Denom: "uusdc"})
+	_, err := msgServer.Mint(sdk.WrapSDKContext(ctx), &types.MsgMint{From: minter.Address, Address: receiver.Address, Amount: allowance})
 	require.ErrorIs(t, err, types.ErrMint)
 	require.ErrorContains(t, err, "receiver address is blacklisted")
 }
@@ -118,12 +118,40 @@ func TestMint_BlacklistedBech32mReceiverAddress(t *testing.T) {
 	)
 	ftf, ctx, msgServer := setupForMintTest(mintingDenom, minter, allowance)
 
-	ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: receiver.AddressBz})
-	_, err := msgServer.Mint(sdk.WrapSDKContext(ctx), &types.MsgMint{From: minter.Address, Address: receiver.Address})
+	ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: receiver.AddressBz, Denom: "uusdc"})
+	_, err := msgServer.Mint(sdk.WrapSDKContext(ctx), &types.MsgMint{From: minter.Address, Address: receiver.Address, Amount: allowance})
+	require.ErrorIs(t, err, types.ErrMint)
+	require.ErrorContains(t, err, "receiver address is blacklisted")
+}
+
+func TestMint_ReceiverDecodedWithAddressCodecs(t *testing.T) {
+	var (
+		receiver     = sample.TestAccount()
+		minter       = sample.TestAccount()
+		mintingDenom = "uusdc"
+		allowance    = sdk.Coin{Denom: mintingDenom, Amount: math.NewInt(10)}
+		canonical    = sample.TestAccount().AddressBz
+	)
+	ftf, ctx, msgServer := setupForMintTest(mintingDenom, minter, allowance)
+
+	// the receiver is blacklisted under the bytes the keeper codecs decode it to
+	ftf.SetAddressCodecs(aliasCodec{alias: receiver.Address, addressBz: canonical}, keeper.Bech32Codec{})
+	ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: canonical, Denom: "uusdc"})
+	_, err := msgServer.Mint(sdk.WrapSDKContext(ctx), &types.MsgMint{From: minter.Address, Address: receiver.Address, Amount: allowance})
 	require.ErrorIs(t, err, types.ErrMint)
 	require.ErrorContains(t, err, "receiver address is blacklisted")
 }
 
+// aliasCodec decodes a single address to the given bytes.
+type aliasCodec struct {
+	alias     string
+	addressBz []byte
+}
+
+func (c aliasCodec) Supports(address string) bool { return address == c.alias }
+
+func (c aliasCodec) Decode(string) ([]byte, error) { return c.addressBz, nil }
+
 func TestMint_DenomIsMissing(t *testing.T) {
 	var (
 		receiver     = sample.TestAccount()
@@ -248,7 +276,7 @@ func TestMint_Paused(t *testing.T) {
 	)
 	ftf, ctx, msgServer := setupForMintTest(mintingDenom, minter, allowance)
 
-	ftf.SetPaused(ctx, types.Paused{Paused: true})
+	ftf.SetPaused(ctx, types.Paused{Denom: "uusdc", Paused: true})
 	_, err := msgServer.Mint(sdk.WrapSDKContext(ctx), &types.MsgMint{From: minter.Address, Address: receiver.Address, Amount: amount})
 	require.ErrorIs(t, err, types.ErrMint)
 	require.ErrorContains(t, err, "minting is paused")
@@ -269,6 +297,31 @@ func TestMint_AmountEqualsAllowance(t *testing.T) {
 	require.Equal(t, &types.MsgMintResponse{}, res)
 }
 
+func TestMint_AllowlistEnabled(t *testing.T) {
+	var (
+		receiver     = sample.TestAccount()
+		minter       = sample.TestAccount()
+		mintingDenom = "uusdc"
+		allowance    = sdk.Coin{Denom: mintingDenom, Amount: math.NewInt(10)}
+		amount       = sdk.Coin{Denom: mintingDenom, Amount: math.NewInt(1)}
+	)
+	ftf, ctx, msgServer := setupForMintTest(mintingDenom, minter, allowance)
+	ftf.SetAllowlistEnabled(ctx, mintingDenom, true)
+
+	_, err := msgServer.Mint(ctx, &types.MsgMint{From: minter.Address, Address: receiver.Address, Amount: amount})
+	require.ErrorIs(t, err, types.ErrNotAllowlisted)
+	require.ErrorContains(t, err, "minter address is not allowlisted")
+
+	ftf.SetAllowlisted(ctx, types.Allowlisted{AddressBz: minter.AddressBz, Denom: mintingDenom})
+	_, err = msgServer.Mint(ctx, &types.MsgMint{From: minter.Address, Address: receiver.Address, Amount: amount})
+	require.ErrorIs(t, err, types.ErrNotAllowlisted)
+	require.ErrorContains(t, err, "receiver address is not allowlisted")
+
+	ftf.SetAllowlisted(ctx, types.Allowlisted{AddressBz: receiver.AddressBz, Denom: mintingDenom})
+	_, err = msgServer.Mint(ctx, &types.MsgMint{From: minter.Address, Address: receiver.Address, Amount: amount})
+	require.NoError(t, err)
+}
+
 func TestMint_Success(t *testing.T) {
 	var (
 		receiver     = sample.TestAccount()
@@ -282,12 +335,18 @@ func TestMint_Success(t *testing.T) {
 	res, err := msgServer.Mint(sdk.WrapSDKContext(ctx), &types.MsgMint{From: minter.Address, Address: receiver.Address, Amount: amount})
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgMintResponse{}, res)
+	requireTypedEvent(t, ctx, &types.EventMinted{
+		Minter:             minter.Address,
+		To:                 receiver.Address,
+		Amount:             amount,
+		RemainingAllowance: sdk.Coin{Denom: mintingDenom, Amount: math.NewInt(9)},
+	})
 }
 
 func setupForMintTest(mintingDenom string, minter sample.Account, allowance sdk.Coin) (*keeper.Keeper, sdk.Context, types.MsgServer) {
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: mintingDenom})
-	ftf.SetPaused(ctx, types.Paused{Paused: false})
+	ftf.SetPaused(ctx, types.Paused{Denom: mintingDenom, Paused: false})
 	ftf.SetMinters(ctx, types.Minters{Address: minter.Address, Allowance: allowance})
 	msgServer := keeper.NewMsgServerImpl(ftf)
 	return ftf, ctx, msgServer
@@ -28,7 +28,7 @@ import (
 func (k msgServer) Pause(goCtx context.Context, msg *types.MsgPause) (*types.MsgPauseResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	pauser, found := k.GetPauser(ctx)
+	pauser, found := k.GetPauser(ctx, msg.Denom)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "pauser is not set")
 	}
@@ -37,13 +37,60 @@ func (k msgServer) Pause(goCtx context.Context, msg *types.MsgPause) (*types.Msg
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the pauser")
 	}
 
+	scopes, err := types.PauseScopesBitset(msg.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.UnpauseHeight != 0 && msg.UnpauseHeight <= ctx.BlockHeight() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidExpiry, "unpause height %d is not after the current height %d", msg.UnpauseHeight, ctx.BlockHeight())
+	}
+
+	previous := k.GetPaused(ctx, msg.Denom)
+	scheduled := msg.Duration > 0 || msg.UnpauseHeight > 0
+
+	// all paused scopes share the schedule of the pause, so scheduled and
+	// indefinite pauses of different scopes cannot be mixed
+	if previous.Paused && !previous.HasScheduledUnpause() && scheduled {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidExpiry, "scopes %s are paused until an unpause", types.FormatPauseScopes(previous.Scopes))
+	}
+	if previous.HasScheduledUnpause() && previous.Scopes&^scopes != 0 {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidExpiry, "scopes %s have a scheduled unpause and must be paused again along with new scopes", types.FormatPauseScopes(previous.Scopes))
+	}
+
 	paused := types.Paused{
-		Paused: true,
+		Denom:  msg.Denom,
+		Scopes: previous.Scopes | scopes,
+	}
+	if scheduled {
+		paused.UnpauseAtHeight = msg.UnpauseHeight
+		if msg.Duration > 0 {
+			unpauseAt := ctx.BlockTime().Add(msg.Duration)
+			paused.UnpauseAtTime = &unpauseAt
+		}
 	}
 
 	k.SetPaused(ctx, paused)
 
-	err := ctx.EventManager().EmitTypedEvent(msg)
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  msg.Denom,
+		Actor:  msg.From,
+		Role:   types.RolePauser,
+		Action: types.ActionPause,
+		Target: msg.Denom,
+		Before: types.FormatPauseScopes(previous.Scopes),
+		After:  types.FormatPauseScopes(paused.Scopes),
+	}); err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventPaused{
+		Denom:           msg.Denom,
+		Pauser:          msg.From,
+		Scopes:          types.PauseScopesList(paused.Scopes),
+		UnpauseAtHeight: paused.UnpauseAtHeight,
+		UnpauseAtTime:   paused.UnpauseAtTime,
+	})
 
 	return &types.MsgPauseResponse{}, err
 }
@@ -18,12 +18,14 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
 	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -31,7 +33,7 @@ func TestPause_PauserNotSet(t *testing.T) {
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
 
-	_, err := msgServer.Pause(sdk.WrapSDKContext(ctx), &types.MsgPause{})
+	_, err := msgServer.Pause(sdk.WrapSDKContext(ctx), &types.MsgPause{Denom: "uusdc"})
 	require.ErrorIs(t, err, types.ErrUserNotFound)
 	require.ErrorContains(t, err, "pauser is not set")
 }
@@ -40,9 +42,9 @@ func TestPause_FromAddressIsNotPauser(t *testing.T) {
 	pauser := sample.TestAccount()
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetPauser(ctx, types.Pauser{Address: pauser.Address})
+	ftf.SetPauser(ctx, types.Pauser{Denom: "uusdc", Address: pauser.Address})
 
-	_, err := msgServer.Pause(sdk.WrapSDKContext(ctx), &types.MsgPause{From: sample.AccAddress()})
+	_, err := msgServer.Pause(sdk.WrapSDKContext(ctx), &types.MsgPause{From: sample.AccAddress(), Denom: "uusdc"})
 	require.ErrorIs(t, err, types.ErrUnauthorized)
 	require.ErrorContains(t, err, "you are not the pauser")
 }
@@ -51,10 +53,10 @@ func TestPause_AlreadyPausedSuccess(t *testing.T) {
 	pauser := sample.TestAccount()
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetPauser(ctx, types.Pauser{Address: pauser.Address})
-	ftf.SetPaused(ctx, types.Paused{Paused: true})
+	ftf.SetPauser(ctx, types.Pauser{Denom: "uusdc", Address: pauser.Address})
+	ftf.SetPaused(ctx, types.Paused{Denom: "uusdc", Paused: true})
 
-	res, err := msgServer.Pause(sdk.WrapSDKContext(ctx), &types.MsgPause{From: pauser.Address})
+	res, err := msgServer.Pause(sdk.WrapSDKContext(ctx), &types.MsgPause{From: pauser.Address, Denom: "uusdc"})
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgPauseResponse{}, res)
 }
@@ -63,9 +65,166 @@ func TestPause_Success(t *testing.T) {
 	pauser := sample.TestAccount()
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetPauser(ctx, types.Pauser{Address: pauser.Address})
+	ftf.SetPauser(ctx, types.Pauser{Denom: "uusdc", Address: pauser.Address})
 
-	res, err := msgServer.Pause(sdk.WrapSDKContext(ctx), &types.MsgPause{From: pauser.Address})
+	res, err := msgServer.Pause(sdk.WrapSDKContext(ctx), &types.MsgPause{From: pauser.Address, Denom: "uusdc"})
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgPauseResponse{}, res)
+	requireTypedEvent(t, ctx, &types.EventPaused{
+		Denom:  "uusdc",
+		Pauser: pauser.Address,
+		Scopes: types.PauseScopesList(types.PauseScopesAll),
+	})
+}
+
+func TestPause_OtherDenomUnaffected(t *testing.T) {
+	pauser := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetPauser(ctx, types.Pauser{Denom: "uusdc", Address: pauser.Address})
+	ftf.SetPauser(ctx, types.Pauser{Denom: "ueurc", Address: pauser.Address})
+
+	_, err := msgServer.Pause(sdk.WrapSDKContext(ctx), &types.MsgPause{From: pauser.Address, Denom: "uusdc"})
+	require.NoError(t, err)
+
+	require.True(t, ftf.GetPaused(ctx, "uusdc").Paused)
+	require.False(t, ftf.GetPaused(ctx, "ueurc").Paused)
+}
+
+func TestPause_Scopes(t *testing.T) {
+	pauser := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetPauser(ctx, types.Pauser{Denom: "uusdc", Address: pauser.Address})
+
+	// ACT: Pause minting only
+	_, err := msgServer.Pause(ctx, &types.MsgPause{From: pauser.Address, Denom: "uusdc", Scopes: []types.PauseScope{types.PauseScope_PAUSE_SCOPE_MINT}})
+	require.NoError(t, err)
+
+	paused := ftf.GetPaused(ctx, "uusdc")
+	require.True(t, paused.Paused)
+	require.True(t, paused.IsPaused(types.PauseScope_PAUSE_SCOPE_MINT))
+	require.False(t, paused.IsPaused(types.PauseScope_PAUSE_SCOPE_TRANSFER))
+
+	// ACT: Additionally pause inbound IBC
+	_, err = msgServer.Pause(ctx, &types.MsgPause{From: pauser.Address, Denom: "uusdc", Scopes: []types.PauseScope{types.PauseScope_PAUSE_SCOPE_IBC_IN}})
+	require.NoError(t, err)
+	require.Equal(t, types.PauseScope_PAUSE_SCOPE_MINT.Bit()|types.PauseScope_PAUSE_SCOPE_IBC_IN.Bit(), ftf.GetPaused(ctx, "uusdc").Scopes)
+
+	entries := ftf.GetAllAuditEntries(ctx)
+	require.Equal(t, "mint", entries[len(entries)-1].Before)
+	require.Equal(t, "mint,ibc_in", entries[len(entries)-1].After)
+
+	// ACT: Attempt to pause an unknown scope
+	_, err = msgServer.Pause(ctx, &types.MsgPause{From: pauser.Address, Denom: "uusdc", Scopes: []types.PauseScope{7}})
+	require.ErrorIs(t, err, types.ErrInvalidType)
+}
+
+func TestPause_ScheduledUnpause(t *testing.T) {
+	pauser := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	blockTime := time.Unix(1700000000, 0).UTC()
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(blockTime)
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	ftf.SetPauser(ctx, types.Pauser{Denom: "uusdc", Address: pauser.Address})
+
+	// ACT: Attempt to schedule the unpause at a height that was reached
+	_, err := msgServer.Pause(ctx, &types.MsgPause{From: pauser.Address, Denom: "uusdc", UnpauseHeight: 10})
+	require.ErrorIs(t, err, types.ErrInvalidExpiry)
+
+	// ACT: Pause for an hour or until height 100
+	_, err = msgServer.Pause(ctx, &types.MsgPause{From: pauser.Address, Denom: "uusdc", Duration: time.Hour, UnpauseHeight: 100})
+	require.NoError(t, err)
+
+	unpauseAt := blockTime.Add(time.Hour)
+	res, err := ftf.Paused(ctx, &types.QueryGetPausedRequest{Denom: "uusdc"})
+	require.NoError(t, err)
+	require.Equal(t, int64(100), res.Paused.UnpauseAtHeight)
+	require.Equal(t, &unpauseAt, res.Paused.UnpauseAtTime)
+
+	// ACT: End a block before the scheduled unpause
+	require.NoError(t, ftf.UnpauseExpired(ctx.WithBlockHeight(11).WithBlockTime(unpauseAt.Add(-time.Second))))
+	require.True(t, ftf.GetPaused(ctx, "uusdc").Paused)
+
+	// ACT: End the block reaching the scheduled unpause
+	ctx = ctx.WithBlockHeight(12).WithBlockTime(unpauseAt).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, ftf.UnpauseExpired(ctx))
+
+	// ASSERT: The pause and its schedule are lifted by the module
+	require.Equal(t, types.Paused{Denom: "uusdc"}, ftf.GetPaused(ctx, "uusdc"))
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "circle.fiattokenfactory.v1.EventUnpaused", events[0].Type)
+
+	entries := ftf.GetAllAuditEntries(ctx)
+	require.Equal(t, authtypes.NewModuleAddress(types.ModuleName).String(), entries[len(entries)-1].Actor)
+	require.Equal(t, types.RoleModule, entries[len(entries)-1].Role)
+}
+
+func TestPause_ScheduledUnpauseAtHeight(t *testing.T) {
+	pauser := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetPauser(ctx, types.Pauser{Denom: "uusdc", Address: pauser.Address})
+
+	_, err := msgServer.Pause(ctx, &types.MsgPause{From: pauser.Address, Denom: "uusdc", UnpauseHeight: 5})
+	require.NoError(t, err)
+
+	require.NoError(t, ftf.UnpauseExpired(ctx.WithBlockHeight(4)))
+	require.True(t, ftf.GetPaused(ctx, "uusdc").Paused)
+
+	require.NoError(t, ftf.UnpauseExpired(ctx.WithBlockHeight(5)))
+	require.False(t, ftf.GetPaused(ctx, "uusdc").Paused)
+}
+
+func TestPause_MixedSchedules(t *testing.T) {
+	pauser := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetPauser(ctx, types.Pauser{Denom: "uusdc", Address: pauser.Address})
+	mint := []types.PauseScope{types.PauseScope_PAUSE_SCOPE_MINT}
+	burn := []types.PauseScope{types.PauseScope_PAUSE_SCOPE_BURN}
+	mintAndBurn := []types.PauseScope{types.PauseScope_PAUSE_SCOPE_MINT, types.PauseScope_PAUSE_SCOPE_BURN}
+
+	// ACT: Schedule the unpause of a scope while another one is paused until an unpause
+	_, err := msgServer.Pause(ctx, &types.MsgPause{From: pauser.Address, Denom: "uusdc", Scopes: mint})
+	require.NoError(t, err)
+	_, err = msgServer.Pause(ctx, &types.MsgPause{From: pauser.Address, Denom: "uusdc", Scopes: burn, UnpauseHeight: 5})
+	require.ErrorIs(t, err, types.ErrInvalidExpiry)
+
+	// ASSERT: The indefinite pause is not shortened
+	paused := ftf.GetPaused(ctx, "uusdc")
+	require.False(t, paused.IsPaused(types.PauseScope_PAUSE_SCOPE_BURN))
+	require.False(t, paused.HasScheduledUnpause())
+
+	// ACT: Pause another scope until an unpause
+	_, err = msgServer.Pause(ctx, &types.MsgPause{From: pauser.Address, Denom: "uusdc", Scopes: burn})
+	require.NoError(t, err)
+	require.True(t, ftf.GetPaused(ctx, "uusdc").IsPaused(types.PauseScope_PAUSE_SCOPE_BURN))
+
+	// ACT: Pause another scope while a scope has a scheduled unpause
+	ftf.SetPaused(ctx, types.Paused{Denom: "uusdc"})
+	_, err = msgServer.Pause(ctx, &types.MsgPause{From: pauser.Address, Denom: "uusdc", Scopes: mint, UnpauseHeight: 5})
+	require.NoError(t, err)
+	_, err = msgServer.Pause(ctx, &types.MsgPause{From: pauser.Address, Denom: "uusdc", Scopes: burn})
+	require.ErrorIs(t, err, types.ErrInvalidExpiry)
+	_, err = msgServer.Pause(ctx, &types.MsgPause{From: pauser.Address, Denom: "uusdc", Scopes: burn, UnpauseHeight: 10})
+	require.ErrorIs(t, err, types.ErrInvalidExpiry)
+
+	// ASSERT: The scheduled scope keeps its schedule
+	require.Equal(t, types.Paused{Denom: "uusdc", Paused: true, Scopes: types.PauseScope_PAUSE_SCOPE_MINT.Bit(), UnpauseAtHeight: 5}, ftf.GetPaused(ctx, "uusdc"))
+
+	// ACT: Pause the scheduled scope again along with the new scope
+	_, err = msgServer.Pause(ctx, &types.MsgPause{From: pauser.Address, Denom: "uusdc", Scopes: mintAndBurn, UnpauseHeight: 10})
+	require.NoError(t, err)
+	paused = ftf.GetPaused(ctx, "uusdc")
+	require.True(t, paused.IsPaused(types.PauseScope_PAUSE_SCOPE_BURN))
+	require.Equal(t, int64(10), paused.UnpauseAtHeight)
+
+	// ACT: Turn the scheduled pause into one lasting until an unpause
+	_, err = msgServer.Pause(ctx, &types.MsgPause{From: pauser.Address, Denom: "uusdc", Scopes: mintAndBurn})
+	require.NoError(t, err)
+	require.False(t, ftf.GetPaused(ctx, "uusdc").HasScheduledUnpause())
 }
@@ -0,0 +1,62 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) RegisterMintingDenom(goCtx context.Context, msg *types.MsgRegisterMintingDenom) (*types.MsgRegisterMintingDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if _, found := k.GetMintingDenom(ctx, msg.Denom); found {
+		return nil, sdkerrors.Wrapf(types.ErrMintingDenomSet, "%s is already a minting denom", msg.Denom)
+	}
+
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, msg.Denom); !found {
+		return nil, sdkerrors.Wrapf(types.ErrDenomNotRegistered, "denom metadata for %s is not set", msg.Denom)
+	}
+
+	k.SetMintingDenom(ctx, types.MintingDenom{Denom: msg.Denom})
+	k.SetOwner(ctx, types.Owner{Denom: msg.Denom, Address: msg.Owner})
+
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  msg.Denom,
+		Actor:  msg.Authority,
+		Role:   types.RoleAuthority,
+		Action: types.ActionRegisterMintingDenom,
+		After:  msg.Owner,
+	}); err != nil {
+		return nil, err
+	}
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventMintingDenomRegistered{
+		Denom: msg.Denom,
+		Owner: msg.Owner,
+	})
+
+	return &types.MsgRegisterMintingDenomResponse{}, err
+}
@@ -0,0 +1,101 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterMintingDenom(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	owner := sample.AccAddress()
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+
+	_, err := msgServer.RegisterMintingDenom(ctx, &types.MsgRegisterMintingDenom{
+		Authority: ftf.GetAuthority(),
+		Denom:     "ueurc",
+		Owner:     owner,
+	})
+	require.NoError(t, err)
+
+	_, found := ftf.GetMintingDenom(ctx, "ueurc")
+	require.True(t, found)
+	require.Len(t, ftf.GetAllMintingDenoms(ctx), 2)
+
+	got, found := ftf.GetOwner(ctx, "ueurc")
+	require.True(t, found)
+	require.Equal(t, types.Owner{Denom: "ueurc", Address: owner}, got)
+
+	requireTypedEvent(t, ctx, &types.EventMintingDenomRegistered{Denom: "ueurc", Owner: owner})
+
+	entries := ftf.GetAllAuditEntries(ctx)
+	require.Len(t, entries, 1)
+	require.Equal(t, types.ActionRegisterMintingDenom, entries[0].Action)
+	require.Equal(t, owner, entries[0].After)
+}
+
+func TestRegisterMintingDenom_InvalidAuthority(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	_, err := msgServer.RegisterMintingDenom(ctx, &types.MsgRegisterMintingDenom{
+		Authority: sample.AccAddress(),
+		Denom:     "ueurc",
+		Owner:     sample.AccAddress(),
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
+
+func TestRegisterMintingDenom_AlreadyRegistered(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	owner := sample.AccAddress()
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner})
+
+	_, err := msgServer.RegisterMintingDenom(ctx, &types.MsgRegisterMintingDenom{
+		Authority: ftf.GetAuthority(),
+		Denom:     "uusdc",
+		Owner:     sample.AccAddress(),
+	})
+	require.ErrorIs(t, err, types.ErrMintingDenomSet)
+
+	got, _ := ftf.GetOwner(ctx, "uusdc")
+	require.Equal(t, owner, got.Address)
+}
+
+func TestRegisterMintingDenom_NoMetadata(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	_, err := msgServer.RegisterMintingDenom(ctx, &types.MsgRegisterMintingDenom{
+		Authority: ftf.GetAuthority(),
+		Denom:     "ujpyc",
+		Owner:     sample.AccAddress(),
+	})
+	require.ErrorIs(t, err, types.ErrDenomNotRegistered)
+
+	_, found := ftf.GetMintingDenom(ctx, "ujpyc")
+	require.False(t, found)
+}
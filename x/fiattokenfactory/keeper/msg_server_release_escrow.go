@@ -0,0 +1,73 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (k msgServer) ReleaseEscrow(goCtx context.Context, msg *types.MsgReleaseEscrow) (*types.MsgReleaseEscrowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if _, found := k.GetMintingDenom(ctx, msg.Amount.Denom); !found {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidDenom, "%s is not a minting denom", msg.Amount.Denom)
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.EscrowName), msg.Amount.Denom)
+	if balance.IsLT(msg.Amount) {
+		return nil, sdkerrors.Wrapf(types.ErrInsufficientEscrow, "escrow holds %s", balance)
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	// the release is subject to the send restrictions, so it fails for blacklisted recipients
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.EscrowName, recipient, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, err
+	}
+
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  msg.Amount.Denom,
+		Actor:  msg.Authority,
+		Role:   types.RoleAuthority,
+		Action: types.ActionReleaseEscrow,
+		Target: msg.Recipient,
+		Before: balance.String(),
+		After:  balance.Sub(msg.Amount).String(),
+	}); err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventEscrowReleased{
+		Recipient: msg.Recipient,
+		Amount:    msg.Amount,
+	})
+
+	return &types.MsgReleaseEscrowResponse{}, err
+}
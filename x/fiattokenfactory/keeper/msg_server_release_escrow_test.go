@@ -0,0 +1,83 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// setupForEscrowTest seizes the 100uusdc of a blacklisted user into the escrow.
+func setupForEscrowTest(t *testing.T) (*keeper.Keeper, sdk.Context, types.MsgServer) {
+	ftf, ctx, msgServer, blacklister, user := setupForSeizeTest(t)
+	ftf.SetBlacklisted(ctx, types.Blacklisted{Denom: "uusdc", AddressBz: user.AddressBz})
+
+	_, err := msgServer.SeizeBlacklistedFunds(ctx, &types.MsgSeizeBlacklistedFunds{From: blacklister.Address, Address: user.Address, Denom: "uusdc"})
+	require.NoError(t, err)
+
+	return ftf, ctx, msgServer
+}
+
+func TestReleaseEscrow(t *testing.T) {
+	ftf, ctx, msgServer := setupForEscrowTest(t)
+	recipient := sample.AccAddress()
+
+	// ACT: Release part of the seized funds
+	res, err := msgServer.ReleaseEscrow(ctx, &types.MsgReleaseEscrow{Authority: ftf.GetAuthority(), Recipient: recipient, Amount: sdk.NewInt64Coin("uusdc", 40)})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgReleaseEscrowResponse{}, res)
+
+	// ASSERT: The escrow holds the rest of the seized funds
+	balance, err := ftf.EscrowBalance(ctx, &types.QueryEscrowBalanceRequest{Denom: "uusdc"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 60), balance.Balance)
+
+	requireTypedEvent(t, ctx, &types.EventEscrowReleased{Recipient: recipient, Amount: sdk.NewInt64Coin("uusdc", 40)})
+
+	entries := ftf.GetAllAuditEntries(ctx)
+	require.Equal(t, types.RoleAuthority, entries[len(entries)-1].Role)
+	require.Equal(t, types.ActionReleaseEscrow, entries[len(entries)-1].Action)
+	require.Equal(t, recipient, entries[len(entries)-1].Target)
+	require.Equal(t, "100uusdc", entries[len(entries)-1].Before)
+	require.Equal(t, "60uusdc", entries[len(entries)-1].After)
+}
+
+func TestReleaseEscrow_InvalidAuthority(t *testing.T) {
+	_, ctx, msgServer := setupForEscrowTest(t)
+
+	_, err := msgServer.ReleaseEscrow(ctx, &types.MsgReleaseEscrow{Authority: sample.AccAddress(), Recipient: sample.AccAddress(), Amount: sdk.NewInt64Coin("uusdc", 40)})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
+
+func TestReleaseEscrow_NotMintingDenom(t *testing.T) {
+	ftf, ctx, msgServer := setupForEscrowTest(t)
+
+	_, err := msgServer.ReleaseEscrow(ctx, &types.MsgReleaseEscrow{Authority: ftf.GetAuthority(), Recipient: sample.AccAddress(), Amount: sdk.NewInt64Coin("ustake", 40)})
+	require.ErrorIs(t, err, types.ErrInvalidDenom)
+}
+
+func TestReleaseEscrow_InsufficientEscrow(t *testing.T) {
+	ftf, ctx, msgServer := setupForEscrowTest(t)
+
+	_, err := msgServer.ReleaseEscrow(ctx, &types.MsgReleaseEscrow{Authority: ftf.GetAuthority(), Recipient: sample.AccAddress(), Amount: sdk.NewInt64Coin("uusdc", 101)})
+	require.ErrorIs(t, err, types.ErrInsufficientEscrow)
+}
@@ -0,0 +1,86 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveChannelPolicy_FromAddressIsNotOwner(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: sample.AccAddress()})
+	ftf.SetChannelPolicy(ctx, types.ChannelPolicy{Denom: "uusdc", ChannelId: "channel-0", AllowInbound: true})
+
+	_, err := msgServer.RemoveChannelPolicy(ctx, &types.MsgRemoveChannelPolicy{From: sample.AccAddress(), Denom: "uusdc", ChannelId: "channel-0"})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "you are not the owner")
+}
+
+func TestRemoveChannelPolicy_NotFound(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	owner := sample.AccAddress()
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner})
+
+	_, err := msgServer.RemoveChannelPolicy(ctx, &types.MsgRemoveChannelPolicy{From: owner, Denom: "uusdc", ChannelId: "channel-0"})
+	require.ErrorIs(t, err, types.ErrInvalidChannel)
+}
+
+func TestRemoveChannelPolicy(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	owner := sample.AccAddress()
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner})
+
+	// ARRANGE: Allowlist channel-0 and channel-1 for inbound transfers only.
+	for _, channelID := range []string{"channel-0", "channel-1"} {
+		_, err := msgServer.SetChannelPolicy(ctx, &types.MsgSetChannelPolicy{From: owner, Denom: "uusdc", ChannelId: channelID, AllowInbound: true})
+		require.NoError(t, err)
+	}
+
+	// ACT: Remove the policy of channel-0.
+	_, err := msgServer.RemoveChannelPolicy(ctx, &types.MsgRemoveChannelPolicy{From: owner, Denom: "uusdc", ChannelId: "channel-0"})
+	require.NoError(t, err)
+
+	// ASSERT: The allowlist remains in force through channel-1.
+	require.False(t, ftf.IsInboundChannelAllowed(ctx, "uusdc", "channel-0"))
+	require.True(t, ftf.IsInboundChannelAllowed(ctx, "uusdc", "channel-1"))
+	requireTypedEvent(t, ctx, &types.EventChannelPolicyRemoved{Denom: "uusdc", ChannelId: "channel-0"})
+
+	// ACT: Remove the last policy of the denom.
+	_, err = msgServer.RemoveChannelPolicy(ctx, &types.MsgRemoveChannelPolicy{From: owner, Denom: "uusdc", ChannelId: "channel-1"})
+	require.NoError(t, err)
+
+	// ASSERT: Transfers are unrestricted again in both directions.
+	require.Empty(t, ftf.GetAllChannelPolicies(ctx))
+	for _, channelID := range []string{"channel-0", "channel-1", "channel-2"} {
+		require.True(t, ftf.IsInboundChannelAllowed(ctx, "uusdc", channelID))
+		require.True(t, ftf.IsOutboundChannelAllowed(ctx, "uusdc", channelID))
+	}
+
+	entries := ftf.GetAllAuditEntries(ctx)
+	require.Equal(t, types.ActionRemoveChannelPolicy, entries[len(entries)-1].Action)
+	require.Equal(t, "channel-1", entries[len(entries)-1].Target)
+	require.Equal(t, "inbound", entries[len(entries)-1].Before)
+}
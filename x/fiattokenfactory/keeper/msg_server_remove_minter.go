@@ -28,7 +28,7 @@ import (
 func (k msgServer) RemoveMinter(goCtx context.Context, msg *types.MsgRemoveMinter) (*types.MsgRemoveMinterResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	minterController, found := k.GetMinterController(ctx, msg.From)
+	minterController, found := k.GetMinterController(ctx, msg.Denom, msg.From)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "minter controller not found")
 	}
@@ -45,14 +45,31 @@ func (k msgServer) RemoveMinter(goCtx context.Context, msg *types.MsgRemoveMinte
 		)
 	}
 
-	minter, found := k.GetMinters(ctx, msg.Address)
+	minter, found := k.GetMinters(ctx, msg.Denom, msg.Address)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "a minter with a given address doesn't exist")
 	}
 
-	k.RemoveMinters(ctx, minter.Address)
+	k.RemoveMinters(ctx, msg.Denom, minter.Address)
 
-	err := ctx.EventManager().EmitTypedEvent(msg)
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  msg.Denom,
+		Actor:  msg.From,
+		Role:   types.RoleMinterController,
+		Action: types.ActionRemoveMinter,
+		Target: msg.Address,
+		Before: minter.Allowance.String(),
+		After:  "",
+	}); err != nil {
+		return nil, err
+	}
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventMinterRemoved{
+		Denom:             msg.Denom,
+		Controller:        msg.From,
+		Minter:            msg.Address,
+		PreviousAllowance: minter.Allowance,
+	})
 
 	return &types.MsgRemoveMinterResponse{}, err
 }
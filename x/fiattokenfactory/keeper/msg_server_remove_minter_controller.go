@@ -28,7 +28,7 @@ import (
 func (k msgServer) RemoveMinterController(goCtx context.Context, msg *types.MsgRemoveMinterController) (*types.MsgRemoveMinterControllerResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	masterMinter, found := k.GetMasterMinter(ctx)
+	masterMinter, found := k.GetMasterMinter(ctx, msg.Denom)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "master minter is not set")
 	}
@@ -37,12 +37,35 @@ func (k msgServer) RemoveMinterController(goCtx context.Context, msg *types.MsgR
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the master minter")
 	}
 
-	_, found = k.GetMinterController(ctx, msg.Controller)
+	// removing a minter controller only revokes privileges, so it is not
+	// delayed like role grants
+	return &types.MsgRemoveMinterControllerResponse{}, k.removeMinterController(ctx, msg)
+}
+
+// removeMinterController removes a minter controller of a minting denom.
+func (k Keeper) removeMinterController(ctx sdk.Context, msg *types.MsgRemoveMinterController) error {
+	minterController, found := k.GetMinterController(ctx, msg.Denom, msg.Controller)
 	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "minter controller with a given address (%s) doesn't exist", msg.Controller)
+		return sdkerrors.Wrapf(types.ErrUserNotFound, "minter controller with a given address (%s) doesn't exist", msg.Controller)
 	}
 
-	k.DeleteMinterController(ctx, msg.Controller)
+	k.DeleteMinterController(ctx, msg.Denom, msg.Controller)
+
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  msg.Denom,
+		Actor:  msg.From,
+		Role:   types.RoleMasterMinter,
+		Action: types.ActionRemoveMinterController,
+		Target: msg.Controller,
+		Before: minterController.Minter,
+		After:  "",
+	}); err != nil {
+		return err
+	}
 
-	return &types.MsgRemoveMinterControllerResponse{}, nil
+	return ctx.EventManager().EmitTypedEvent(&types.EventMinterControllerRemoved{
+		Denom:      msg.Denom,
+		Controller: msg.Controller,
+		Minter:     minterController.Minter,
+	})
 }
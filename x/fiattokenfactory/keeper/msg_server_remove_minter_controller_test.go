@@ -32,7 +32,7 @@ func TestRemoveMinterController_MasterMinterNotSet(t *testing.T) {
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
 
-	_, err := msgServer.RemoveMinterController(sdk.WrapSDKContext(ctx), &types.MsgRemoveMinterController{})
+	_, err := msgServer.RemoveMinterController(sdk.WrapSDKContext(ctx), &types.MsgRemoveMinterController{Denom: "uusdc"})
 	require.ErrorIs(t, types.ErrUserNotFound, err)
 	require.ErrorContains(t, err, "master minter is not set")
 }
@@ -41,9 +41,9 @@ func TestRemoveMinterController_FromAddressIsNotMasterMinter(t *testing.T) {
 	masterMinter := sample.TestAccount()
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetMasterMinter(ctx, types.MasterMinter{Address: masterMinter.Address})
+	ftf.SetMasterMinter(ctx, types.MasterMinter{Denom: "uusdc", Address: masterMinter.Address})
 
-	_, err := msgServer.RemoveMinterController(sdk.WrapSDKContext(ctx), &types.MsgRemoveMinterController{From: sample.AccAddress()})
+	_, err := msgServer.RemoveMinterController(sdk.WrapSDKContext(ctx), &types.MsgRemoveMinterController{From: sample.AccAddress(), Denom: "uusdc"})
 	require.ErrorIs(t, types.ErrUnauthorized, err)
 	require.ErrorContains(t, err, "you are not the master minter")
 }
@@ -53,9 +53,9 @@ func TestRemoveMinterController_ControllerDoesNotExist(t *testing.T) {
 	notController := sample.AccAddress()
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetMasterMinter(ctx, types.MasterMinter{Address: masterMinter.Address})
+	ftf.SetMasterMinter(ctx, types.MasterMinter{Denom: "uusdc", Address: masterMinter.Address})
 
-	_, err := msgServer.RemoveMinterController(sdk.WrapSDKContext(ctx), &types.MsgRemoveMinterController{From: masterMinter.Address, Controller: notController})
+	_, err := msgServer.RemoveMinterController(sdk.WrapSDKContext(ctx), &types.MsgRemoveMinterController{From: masterMinter.Address, Controller: notController, Denom: "uusdc"})
 	require.ErrorIs(t, types.ErrUserNotFound, err)
 	require.ErrorContains(t, err, fmt.Sprintf("minter controller with a given address (%s) doesn't exist", notController))
 }
@@ -66,10 +66,11 @@ func TestRemoveMinterController_Success(t *testing.T) {
 	minter := sample.TestAccount()
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetMasterMinter(ctx, types.MasterMinter{Address: masterMinter.Address})
-	ftf.SetMinterController(ctx, types.MinterController{Minter: minter.Address, Controller: controller.Address})
+	ftf.SetMasterMinter(ctx, types.MasterMinter{Denom: "uusdc", Address: masterMinter.Address})
+	ftf.SetMinterController(ctx, types.MinterController{Minter: minter.Address, Controller: controller.Address, Denom: "uusdc"})
 
-	res, err := msgServer.RemoveMinterController(sdk.WrapSDKContext(ctx), &types.MsgRemoveMinterController{From: masterMinter.Address, Controller: controller.Address})
+	res, err := msgServer.RemoveMinterController(sdk.WrapSDKContext(ctx), &types.MsgRemoveMinterController{From: masterMinter.Address, Controller: controller.Address, Denom: "uusdc"})
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgRemoveMinterControllerResponse{}, res)
+	requireTypedEvent(t, ctx, &types.EventMinterControllerRemoved{Denom: "uusdc", Controller: controller.Address, Minter: minter.Address})
 }
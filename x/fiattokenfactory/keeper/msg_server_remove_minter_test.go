@@ -34,7 +34,7 @@ func TestRemoveMinter_FromAddressIsNotController(t *testing.T) {
 	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: mintingDenom})
 	msgServer := keeper.NewMsgServerImpl(ftf)
 
-	_, err := msgServer.RemoveMinter(sdk.WrapSDKContext(ctx), &types.MsgRemoveMinter{From: sample.AccAddress()})
+	_, err := msgServer.RemoveMinter(sdk.WrapSDKContext(ctx), &types.MsgRemoveMinter{From: sample.AccAddress(), Denom: "uusdc"})
 	require.ErrorIs(t, err, types.ErrUnauthorized)
 	require.ErrorContains(t, err, "minter controller not found")
 }
@@ -47,9 +47,9 @@ func TestRemoveMinter_ControllerMinterMismatch(t *testing.T) {
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: mintingDenom})
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetMinterController(ctx, types.MinterController{Minter: minter.Address, Controller: controller.Address})
+	ftf.SetMinterController(ctx, types.MinterController{Minter: minter.Address, Controller: controller.Address, Denom: "uusdc"})
 
-	_, err := msgServer.RemoveMinter(sdk.WrapSDKContext(ctx), &types.MsgRemoveMinter{From: controller.Address, Address: notMinter})
+	_, err := msgServer.RemoveMinter(sdk.WrapSDKContext(ctx), &types.MsgRemoveMinter{From: controller.Address, Address: notMinter, Denom: "uusdc"})
 	require.ErrorIs(t, err, types.ErrUnauthorized)
 	require.ErrorContains(t, err, fmt.Sprintf("minter address ≠ minter controller's minter address, (%s≠%s)", notMinter, minter.Address))
 }
@@ -61,9 +61,9 @@ func TestRemoveMinter_MinterNotConfigured(t *testing.T) {
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: mintingDenom})
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetMinterController(ctx, types.MinterController{Minter: minter.Address, Controller: controller.Address})
+	ftf.SetMinterController(ctx, types.MinterController{Minter: minter.Address, Controller: controller.Address, Denom: "uusdc"})
 
-	_, err := msgServer.RemoveMinter(sdk.WrapSDKContext(ctx), &types.MsgRemoveMinter{From: controller.Address, Address: minter.Address})
+	_, err := msgServer.RemoveMinter(sdk.WrapSDKContext(ctx), &types.MsgRemoveMinter{From: controller.Address, Address: minter.Address, Denom: "uusdc"})
 	require.ErrorIs(t, err, types.ErrUserNotFound)
 	require.ErrorContains(t, err, "a minter with a given address doesn't exist")
 }
@@ -75,10 +75,10 @@ func TestRemoveMinter_Success(t *testing.T) {
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: mintingDenom})
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetMinterController(ctx, types.MinterController{Minter: minter.Address, Controller: controller.Address})
-	ftf.SetMinters(ctx, types.Minters{Address: minter.Address})
+	ftf.SetMinterController(ctx, types.MinterController{Minter: minter.Address, Controller: controller.Address, Denom: "uusdc"})
+	ftf.SetMinters(ctx, types.Minters{Address: minter.Address, Allowance: sdk.NewInt64Coin(mintingDenom, 0)})
 
-	res, err := msgServer.RemoveMinter(sdk.WrapSDKContext(ctx), &types.MsgRemoveMinter{From: controller.Address, Address: minter.Address})
+	res, err := msgServer.RemoveMinter(sdk.WrapSDKContext(ctx), &types.MsgRemoveMinter{From: controller.Address, Address: minter.Address, Denom: "uusdc"})
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgRemoveMinterResponse{}, res)
 }
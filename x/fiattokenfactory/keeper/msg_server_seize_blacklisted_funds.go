@@ -0,0 +1,89 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (k msgServer) SeizeBlacklistedFunds(goCtx context.Context, msg *types.MsgSeizeBlacklistedFunds) (*types.MsgSeizeBlacklistedFundsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	blacklister, found := k.GetBlacklister(ctx, msg.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "blacklister is not set")
+	}
+
+	if blacklister.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the blacklister")
+	}
+
+	addressBz, err := k.DecodeAddress(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	_, found = k.GetBlacklisted(ctx, msg.Denom, addressBz)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "the specified address is not blacklisted")
+	}
+
+	if k.GetPaused(ctx, msg.Denom).IsPaused(types.PauseScope_PAUSE_SCOPE_TRANSFER) {
+		return nil, sdkerrors.Wrapf(types.ErrPaused, "seizing is paused")
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, addressBz, msg.Denom)
+	if !balance.IsPositive() {
+		return nil, sdkerrors.Wrapf(types.ErrNoFundsToSeize, "the specified address holds no %s", msg.Denom)
+	}
+
+	return &types.MsgSeizeBlacklistedFundsResponse{Amount: balance}, k.seizeBlacklistedFunds(ctx, msg, addressBz, balance)
+}
+
+// seizeBlacklistedFunds moves the balance of a blacklisted address into the
+// escrow account of the module.
+func (k Keeper) seizeBlacklistedFunds(ctx sdk.Context, msg *types.MsgSeizeBlacklistedFunds, addressBz []byte, balance sdk.Coin) error {
+	seizureCtx := ctx.WithValue(types.SeizureKey, true)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(seizureCtx, addressBz, types.EscrowName, sdk.NewCoins(balance)); err != nil {
+		return err
+	}
+
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  msg.Denom,
+		Actor:  msg.From,
+		Role:   types.RoleBlacklister,
+		Action: types.ActionSeizeBlacklistedFunds,
+		Target: msg.Address,
+		Before: balance.String(),
+		After:  sdk.NewInt64Coin(msg.Denom, 0).String(),
+	}); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventBlacklistedFundsSeized{
+		Denom:   msg.Denom,
+		Address: msg.Address,
+		Amount:  balance,
+		Escrow:  authtypes.NewModuleAddress(types.EscrowName).String(),
+	})
+}
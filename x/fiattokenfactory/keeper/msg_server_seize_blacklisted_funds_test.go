@@ -0,0 +1,90 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setupForSeizeTest(t *testing.T) (*keeper.Keeper, sdk.Context, types.MsgServer, sample.Account, sample.Account) {
+	minter, blacklister, user := sample.TestAccount(), sample.TestAccount(), sample.TestAccount()
+	ftf, ctx, msgServer := setupForMintTest("uusdc", minter, sdk.NewInt64Coin("uusdc", 1000))
+	ftf.SetBlacklister(ctx, types.Blacklister{Denom: "uusdc", Address: blacklister.Address})
+
+	_, err := msgServer.Mint(ctx, &types.MsgMint{From: minter.Address, Address: user.Address, Amount: sdk.NewInt64Coin("uusdc", 100)})
+	require.NoError(t, err)
+
+	return ftf, ctx, msgServer, blacklister, user
+}
+
+func TestSeizeBlacklistedFunds(t *testing.T) {
+	ftf, ctx, msgServer, blacklister, user := setupForSeizeTest(t)
+	ftf.SetBlacklisted(ctx, types.Blacklisted{Denom: "uusdc", AddressBz: user.AddressBz})
+
+	// ACT: Seize the funds of the blacklisted user
+	res, err := msgServer.SeizeBlacklistedFunds(ctx, &types.MsgSeizeBlacklistedFunds{From: blacklister.Address, Address: user.Address, Denom: "uusdc"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 100), res.Amount)
+
+	// ASSERT: The funds are held in escrow
+	escrow := authtypes.NewModuleAddress(types.EscrowName).String()
+	balance, err := ftf.EscrowBalance(ctx, &types.QueryEscrowBalanceRequest{Denom: "uusdc"})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryEscrowBalanceResponse{Escrow: escrow, Balance: sdk.NewInt64Coin("uusdc", 100)}, balance)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, "circle.fiattokenfactory.v1.EventBlacklistedFundsSeized", events[len(events)-1].Type)
+
+	entries := ftf.GetAllAuditEntries(ctx)
+	require.Equal(t, types.ActionSeizeBlacklistedFunds, entries[len(entries)-1].Action)
+	require.Equal(t, "100uusdc", entries[len(entries)-1].Before)
+
+	// ACT: Attempt to seize again once the balance is empty
+	_, err = msgServer.SeizeBlacklistedFunds(ctx, &types.MsgSeizeBlacklistedFunds{From: blacklister.Address, Address: user.Address, Denom: "uusdc"})
+	require.ErrorIs(t, err, types.ErrNoFundsToSeize)
+}
+
+func TestSeizeBlacklistedFunds_NotBlacklister(t *testing.T) {
+	ftf, ctx, msgServer, _, user := setupForSeizeTest(t)
+	ftf.SetBlacklisted(ctx, types.Blacklisted{Denom: "uusdc", AddressBz: user.AddressBz})
+
+	_, err := msgServer.SeizeBlacklistedFunds(ctx, &types.MsgSeizeBlacklistedFunds{From: sample.AccAddress(), Address: user.Address, Denom: "uusdc"})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
+
+func TestSeizeBlacklistedFunds_NotBlacklisted(t *testing.T) {
+	_, ctx, msgServer, blacklister, user := setupForSeizeTest(t)
+
+	_, err := msgServer.SeizeBlacklistedFunds(ctx, &types.MsgSeizeBlacklistedFunds{From: blacklister.Address, Address: user.Address, Denom: "uusdc"})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+}
+
+func TestSeizeBlacklistedFunds_Paused(t *testing.T) {
+	ftf, ctx, msgServer, blacklister, user := setupForSeizeTest(t)
+	ftf.SetBlacklisted(ctx, types.Blacklisted{Denom: "uusdc", AddressBz: user.AddressBz})
+	ftf.SetPaused(ctx, types.Paused{Denom: "uusdc", Paused: true})
+
+	_, err := msgServer.SeizeBlacklistedFunds(ctx, &types.MsgSeizeBlacklistedFunds{From: blacklister.Address, Address: user.Address, Denom: "uusdc"})
+	require.ErrorIs(t, err, types.ErrPaused)
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) SetAllowlistMode(goCtx context.Context, msg *types.MsgSetAllowlistMode) (*types.MsgSetAllowlistModeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, found := k.GetOwner(ctx, msg.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "owner is not set")
+	}
+
+	proposed, err := k.proposeAction(ctx, msg.Denom, types.RoleOwner, msg.From, types.ActionProposal{
+		Action: &types.ActionProposal_SetAllowlistMode{SetAllowlistMode: msg},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if proposed {
+		return &types.MsgSetAllowlistModeResponse{}, nil
+	}
+
+	if owner.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
+	}
+
+	return &types.MsgSetAllowlistModeResponse{}, k.setAllowlistMode(ctx, msg)
+}
+
+// setAllowlistMode enables or disables the allowlist of a minting denom.
+func (k Keeper) setAllowlistMode(ctx sdk.Context, msg *types.MsgSetAllowlistMode) error {
+	previous := k.IsAllowlistEnabled(ctx, msg.Denom)
+
+	k.SetAllowlistEnabled(ctx, msg.Denom, msg.Enabled)
+
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  msg.Denom,
+		Actor:  msg.From,
+		Role:   types.RoleOwner,
+		Action: types.ActionSetAllowlistMode,
+		Target: msg.Denom,
+		Before: strconv.FormatBool(previous),
+		After:  strconv.FormatBool(msg.Enabled),
+	}); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventAllowlistModeSet{
+		Denom:   msg.Denom,
+		Enabled: msg.Enabled,
+	})
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetAllowlistMode_OwnerNotSet(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	_, err := msgServer.SetAllowlistMode(ctx, &types.MsgSetAllowlistMode{From: sample.AccAddress(), Denom: "uusdc", Enabled: true})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+	require.ErrorContains(t, err, "owner is not set")
+}
+
+func TestSetAllowlistMode_FromAddressIsNotOwner(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: sample.AccAddress()})
+
+	_, err := msgServer.SetAllowlistMode(ctx, &types.MsgSetAllowlistMode{From: sample.AccAddress(), Denom: "uusdc", Enabled: true})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "you are not the owner")
+}
+
+func TestSetAllowlistMode_Success(t *testing.T) {
+	owner := sample.AccAddress()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner})
+
+	_, err := msgServer.SetAllowlistMode(ctx, &types.MsgSetAllowlistMode{From: owner, Denom: "uusdc", Enabled: true})
+	require.NoError(t, err)
+	require.True(t, ftf.IsAllowlistEnabled(ctx, "uusdc"))
+	require.False(t, ftf.IsAllowlistEnabled(ctx, "ueurc"))
+
+	res, err := ftf.AllowlistMode(ctx, &types.QueryAllowlistModeRequest{Denom: "uusdc"})
+	require.NoError(t, err)
+	require.True(t, res.Enabled)
+
+	_, err = msgServer.SetAllowlistMode(ctx, &types.MsgSetAllowlistMode{From: owner, Denom: "uusdc", Enabled: false})
+	require.NoError(t, err)
+	require.False(t, ftf.IsAllowlistEnabled(ctx, "uusdc"))
+	require.Empty(t, ftf.GetAllowlistEnabledDenoms(ctx))
+}
@@ -0,0 +1,78 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) SetChannelPolicy(goCtx context.Context, msg *types.MsgSetChannelPolicy) (*types.MsgSetChannelPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, found := k.GetOwner(ctx, msg.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "owner is not set")
+	}
+
+	proposed, err := k.proposeAction(ctx, msg.Denom, types.RoleOwner, msg.From, types.ActionProposal{
+		Action: &types.ActionProposal_SetChannelPolicy{SetChannelPolicy: msg},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if proposed {
+		return &types.MsgSetChannelPolicyResponse{}, nil
+	}
+
+	if owner.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
+	}
+
+	return &types.MsgSetChannelPolicyResponse{}, k.setChannelPolicy(ctx, msg)
+}
+
+// setChannelPolicy sets the transfer directions allowed over a channel for a
+// minting denom.
+func (k Keeper) setChannelPolicy(ctx sdk.Context, msg *types.MsgSetChannelPolicy) error {
+	policy := msg.Policy()
+	previous, found := k.GetChannelPolicy(ctx, msg.Denom, msg.ChannelId)
+	before := ""
+	if found {
+		before = previous.Directions()
+	}
+
+	k.SetChannelPolicy(ctx, policy)
+
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  msg.Denom,
+		Actor:  msg.From,
+		Role:   types.RoleOwner,
+		Action: types.ActionSetChannelPolicy,
+		Target: msg.ChannelId,
+		Before: before,
+		After:  policy.Directions(),
+	}); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventChannelPolicySet{Policy: policy})
+}
@@ -0,0 +1,83 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetChannelPolicy_OwnerNotSet(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	_, err := msgServer.SetChannelPolicy(ctx, &types.MsgSetChannelPolicy{Denom: "uusdc", ChannelId: "channel-0"})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+	require.ErrorContains(t, err, "owner is not set")
+}
+
+func TestSetChannelPolicy_FromAddressIsNotOwner(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: sample.AccAddress()})
+
+	_, err := msgServer.SetChannelPolicy(ctx, &types.MsgSetChannelPolicy{From: sample.AccAddress(), Denom: "uusdc", ChannelId: "channel-0"})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "you are not the owner")
+}
+
+func TestSetChannelPolicy(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	owner := sample.AccAddress()
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner})
+
+	// ASSERT: Without any channel policy, every channel is allowed.
+	require.True(t, ftf.IsInboundChannelAllowed(ctx, "uusdc", "channel-0"))
+	require.True(t, ftf.IsOutboundChannelAllowed(ctx, "uusdc", "channel-1"))
+
+	// ACT: Allowlist channel-0 for inbound transfers only.
+	_, err := msgServer.SetChannelPolicy(ctx, &types.MsgSetChannelPolicy{From: owner, Denom: "uusdc", ChannelId: "channel-0", AllowInbound: true})
+	require.NoError(t, err)
+
+	// ASSERT: The allowlist is enforced for both directions on every channel.
+	require.True(t, ftf.IsInboundChannelAllowed(ctx, "uusdc", "channel-0"))
+	require.False(t, ftf.IsOutboundChannelAllowed(ctx, "uusdc", "channel-0"))
+	require.False(t, ftf.IsInboundChannelAllowed(ctx, "uusdc", "channel-1"))
+	require.False(t, ftf.IsOutboundChannelAllowed(ctx, "uusdc", "channel-1"))
+
+	// ASSERT: Other minting denoms are unaffected.
+	require.True(t, ftf.IsInboundChannelAllowed(ctx, "ueurc", "channel-1"))
+
+	// ACT: Disallow both directions on channel-0.
+	_, err = msgServer.SetChannelPolicy(ctx, &types.MsgSetChannelPolicy{From: owner, Denom: "uusdc", ChannelId: "channel-0"})
+	require.NoError(t, err)
+
+	// ASSERT: The channel stays recorded, so the allowlist remains in force.
+	require.False(t, ftf.IsInboundChannelAllowed(ctx, "uusdc", "channel-0"))
+	require.False(t, ftf.IsInboundChannelAllowed(ctx, "uusdc", "channel-1"))
+
+	entries := ftf.GetAllAuditEntries(ctx)
+	require.Equal(t, types.ActionSetChannelPolicy, entries[len(entries)-1].Action)
+	require.Equal(t, "inbound", entries[len(entries)-1].Before)
+	require.Equal(t, "none", entries[len(entries)-1].After)
+}
@@ -0,0 +1,95 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) SetChannelRateLimit(goCtx context.Context, msg *types.MsgSetChannelRateLimit) (*types.MsgSetChannelRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, found := k.GetOwner(ctx, msg.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "owner is not set")
+	}
+
+	proposed, err := k.proposeAction(ctx, msg.Denom, types.RoleOwner, msg.From, types.ActionProposal{
+		Action: &types.ActionProposal_SetChannelRateLimit{SetChannelRateLimit: msg},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if proposed {
+		return &types.MsgSetChannelRateLimitResponse{}, nil
+	}
+
+	if owner.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
+	}
+
+	return &types.MsgSetChannelRateLimitResponse{}, k.setChannelRateLimit(ctx, msg)
+}
+
+// setChannelRateLimit sets, updates or removes the rate limit of a channel for
+// a minting denom.
+func (k Keeper) setChannelRateLimit(ctx sdk.Context, msg *types.MsgSetChannelRateLimit) error {
+	var previous string
+	var current *types.ChannelRateLimit
+	rateLimit, found := k.GetChannelRateLimit(ctx, msg.Denom, msg.ChannelId)
+	if found {
+		previous = rateLimit.Config()
+	}
+
+	switch {
+	case msg.IsRemoval():
+		k.RemoveChannelRateLimit(ctx, msg.Denom, msg.ChannelId)
+	case !found:
+		rateLimit = types.NewChannelRateLimit(msg.Denom, msg.ChannelId, msg.MaxInflow, msg.MaxOutflow, msg.WindowBlocks, msg.WindowDuration)
+		current = &rateLimit
+	default:
+		// the flow within the window still counts against the new caps
+		rateLimit.Reconfigure(msg.MaxInflow, msg.MaxOutflow, msg.WindowBlocks, msg.WindowDuration, ctx.BlockHeight(), ctx.BlockTime())
+		current = &rateLimit
+	}
+	if current != nil {
+		k.SetChannelRateLimit(ctx, *current)
+	}
+
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  msg.Denom,
+		Actor:  msg.From,
+		Role:   types.RoleOwner,
+		Action: types.ActionSetChannelRateLimit,
+		Target: msg.ChannelId,
+		Before: previous,
+		After:  current.Config(),
+	}); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventChannelRateLimitSet{
+		Denom:     msg.Denom,
+		ChannelId: msg.ChannelId,
+		RateLimit: current,
+	})
+}
@@ -0,0 +1,136 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetChannelRateLimit_FromAddressIsNotOwner(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: sample.AccAddress()})
+
+	_, err := msgServer.SetChannelRateLimit(ctx, &types.MsgSetChannelRateLimit{From: sample.AccAddress(), Denom: "uusdc", ChannelId: "channel-0"})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "you are not the owner")
+}
+
+func TestSetChannelRateLimit(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	owner := sample.AccAddress()
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner})
+	ctx = ctx.WithBlockHeight(5)
+
+	// ACT: Cap the outflow of channel-0.
+	_, err := msgServer.SetChannelRateLimit(ctx, &types.MsgSetChannelRateLimit{
+		From:         owner,
+		Denom:        "uusdc",
+		ChannelId:    "channel-0",
+		MaxInflow:    math.ZeroInt(),
+		MaxOutflow:   math.NewInt(100),
+		WindowBlocks: 10,
+	})
+	require.NoError(t, err)
+
+	rateLimit, found := ftf.GetChannelRateLimit(ctx, "uusdc", "channel-0")
+	require.True(t, found)
+	require.Empty(t, rateLimit.Buckets)
+
+	entries := ftf.GetAllAuditEntries(ctx)
+	require.Equal(t, types.ActionSetChannelRateLimit, entries[len(entries)-1].Action)
+	require.Equal(t, "in 0 out 100 per 10 blocks", entries[len(entries)-1].After)
+
+	// ACT: Remove the rate limit.
+	_, err = msgServer.SetChannelRateLimit(ctx, &types.MsgSetChannelRateLimit{From: owner, Denom: "uusdc", ChannelId: "channel-0"})
+	require.NoError(t, err)
+
+	_, found = ftf.GetChannelRateLimit(ctx, "uusdc", "channel-0")
+	require.False(t, found)
+}
+
+func TestSetChannelRateLimit_KeepsFlow(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	owner := sample.AccAddress()
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner})
+	ctx = ctx.WithBlockHeight(5)
+
+	msg := &types.MsgSetChannelRateLimit{
+		From:         owner,
+		Denom:        "uusdc",
+		ChannelId:    "channel-0",
+		MaxInflow:    math.ZeroInt(),
+		MaxOutflow:   math.NewInt(100),
+		WindowBlocks: 10,
+	}
+	_, err := msgServer.SetChannelRateLimit(ctx, msg)
+	require.NoError(t, err)
+	require.NoError(t, ftf.ConsumeChannelOutflow(ctx, "uusdc", "channel-0", math.NewInt(90)))
+
+	// ACT: Raise the outflow cap within the window.
+	msg.MaxOutflow = math.NewInt(120)
+	_, err = msgServer.SetChannelRateLimit(ctx.WithBlockHeight(6), msg)
+	require.NoError(t, err)
+
+	// ASSERT: The outflow sent so far still counts against the new cap.
+	ctx = ctx.WithBlockHeight(7)
+	require.ErrorIs(t, ftf.ConsumeChannelOutflow(ctx, "uusdc", "channel-0", math.NewInt(31)), types.ErrRateLimitExceeded)
+	require.NoError(t, ftf.ConsumeChannelOutflow(ctx, "uusdc", "channel-0", math.NewInt(30)))
+}
+
+func TestUndoChannelOutflow(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	ctx = ctx.WithBlockHeight(1)
+	ftf.SetChannelRateLimit(ctx, types.NewChannelRateLimit("uusdc", "channel-0", math.ZeroInt(), math.NewInt(100), 10, 0))
+
+	// ARRANGE: Send two transfers within the first window.
+	require.NoError(t, ftf.ConsumeChannelOutflow(ctx, "uusdc", "channel-0", math.NewInt(60)))
+	ftf.RecordPendingSendPacket(ctx, "uusdc", "channel-0", 1)
+	require.NoError(t, ftf.ConsumeChannelOutflow(ctx, "uusdc", "channel-0", math.NewInt(40)))
+	ftf.RecordPendingSendPacket(ctx, "uusdc", "channel-0", 2)
+	require.ErrorIs(t, ftf.ConsumeChannelOutflow(ctx, "uusdc", "channel-0", math.NewInt(1)), types.ErrRateLimitExceeded)
+
+	// ACT: The first transfer fails within its window.
+	ftf.UndoChannelOutflow(ctx, "channel-0", 1, math.NewInt(60))
+
+	// ASSERT: Its outflow is released.
+	rateLimit, _ := ftf.GetChannelRateLimit(ctx, "uusdc", "channel-0")
+	_, outflow := rateLimit.Flow(ctx.BlockHeight(), ctx.BlockTime())
+	require.Equal(t, math.NewInt(40), outflow)
+	_, found := ftf.GetPendingSendPacket(ctx, "channel-0", 1)
+	require.False(t, found)
+
+	// ACT: The second transfer leaves the window before it fails.
+	ctx = ctx.WithBlockHeight(11)
+	require.NoError(t, ftf.ConsumeChannelOutflow(ctx, "uusdc", "channel-0", math.NewInt(10)))
+	ftf.UndoChannelOutflow(ctx, "channel-0", 2, math.NewInt(40))
+
+	// ASSERT: The outflow of the current window is untouched.
+	rateLimit, _ = ftf.GetChannelRateLimit(ctx, "uusdc", "channel-0")
+	_, outflow = rateLimit.Flow(ctx.BlockHeight(), ctx.BlockTime())
+	require.Equal(t, math.NewInt(10), outflow)
+	require.Empty(t, ftf.GetAllPendingSendPackets(ctx))
+}
@@ -0,0 +1,104 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) SetSignerSet(goCtx context.Context, msg *types.MsgSetSignerSet) (*types.MsgSetSignerSetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, found := k.GetOwner(ctx, msg.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "owner is not set")
+	}
+
+	proposed, err := k.proposeAction(ctx, msg.Denom, types.RoleOwner, msg.From, types.ActionProposal{
+		Action: &types.ActionProposal_SetSignerSet{SetSignerSet: msg},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if proposed {
+		return &types.MsgSetSignerSetResponse{}, nil
+	}
+
+	if owner.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
+	}
+
+	// signer set changes are delayed like role changes, so that the owner can
+	// cancel a signer set change before it locks them out of the cancellation
+	return &types.MsgSetSignerSetResponse{}, k.scheduleAction(ctx, msg.From, types.RoleOwner, types.QueuedAction{
+		Denom:  msg.Denom,
+		Action: &types.QueuedAction_SetSignerSet{SetSignerSet: msg},
+	})
+}
+
+// setSignerSet configures a role of a minting denom as a signer set, or
+// removes its signer set when no signers are given.
+func (k Keeper) setSignerSet(ctx sdk.Context, msg *types.MsgSetSignerSet) error {
+	var before string
+	if previous, found := k.GetSignerSet(ctx, msg.Denom, msg.Role); found {
+		before = formatSignerSet(previous.Signers, previous.Threshold)
+	}
+
+	var after string
+	if len(msg.Signers) == 0 {
+		k.RemoveSignerSet(ctx, msg.Denom, msg.Role)
+	} else {
+		k.SetSignerSet(ctx, types.SignerSet{
+			Denom:     msg.Denom,
+			Role:      msg.Role,
+			Signers:   msg.Signers,
+			Threshold: msg.Threshold,
+		})
+		after = formatSignerSet(msg.Signers, msg.Threshold)
+	}
+
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  msg.Denom,
+		Actor:  msg.From,
+		Role:   types.RoleOwner,
+		Action: types.ActionSetSignerSet,
+		Target: msg.Role,
+		Before: before,
+		After:  after,
+	}); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventSignerSetUpdated{
+		Denom:     msg.Denom,
+		Role:      msg.Role,
+		Signers:   msg.Signers,
+		Threshold: msg.Threshold,
+	})
+}
+
+// formatSignerSet returns a readable description of a signer set.
+func formatSignerSet(signers []string, threshold uint32) string {
+	return fmt.Sprintf("%d of [%s]", threshold, strings.Join(signers, ","))
+}
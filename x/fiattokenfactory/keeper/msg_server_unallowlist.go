@@ -0,0 +1,68 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) Unallowlist(goCtx context.Context, msg *types.MsgUnallowlist) (*types.MsgUnallowlistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkAllowlister(ctx, msg.Denom, msg.From); err != nil {
+		return nil, err
+	}
+
+	addressBz, err := k.DecodeAddress(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	allowlisted, found := k.GetAllowlisted(ctx, msg.Denom, addressBz)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "the specified address is not allowlisted")
+	}
+
+	return &types.MsgUnallowlistResponse{}, k.unallowlist(ctx, msg, allowlisted.AddressBz)
+}
+
+// unallowlist removes an address from the allowlist of a minting denom.
+func (k Keeper) unallowlist(ctx sdk.Context, msg *types.MsgUnallowlist, addressBz []byte) error {
+	k.RemoveAllowlisted(ctx, msg.Denom, addressBz)
+
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  msg.Denom,
+		Actor:  msg.From,
+		Role:   types.RoleAllowlister,
+		Action: types.ActionUnallowlist,
+		Target: msg.Address,
+		Before: "true",
+		After:  "false",
+	}); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventUnallowlisted{
+		Denom:   msg.Denom,
+		Address: msg.Address,
+	})
+}
@@ -28,7 +28,7 @@ import (
 func (k msgServer) Unblacklist(goCtx context.Context, msg *types.MsgUnblacklist) (*types.MsgUnblacklistResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	blacklister, found := k.GetBlacklister(ctx)
+	blacklister, found := k.GetBlacklister(ctx, msg.Denom)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "blacklister is not set")
 	}
@@ -37,19 +37,37 @@ func (k msgServer) Unblacklist(goCtx context.Context, msg *types.MsgUnblacklist)
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the blacklister")
 	}
 
-	_, addressBz, err := DecodeNoLimitToBase256(msg.Address)
+	addressBz, err := k.DecodeAddress(msg.Address)
 	if err != nil {
 		return nil, err
 	}
 
-	blacklisted, found := k.GetBlacklisted(ctx, addressBz)
+	blacklisted, found := k.GetBlacklisted(ctx, msg.Denom, addressBz)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "the specified address is not blacklisted")
 	}
 
-	k.RemoveBlacklisted(ctx, blacklisted.AddressBz)
+	return &types.MsgUnblacklistResponse{}, k.unblacklist(ctx, msg, blacklisted.AddressBz)
+}
+
+// unblacklist removes an address from the blacklist of a minting denom.
+func (k Keeper) unblacklist(ctx sdk.Context, msg *types.MsgUnblacklist, addressBz []byte) error {
+	k.RemoveBlacklisted(ctx, msg.Denom, addressBz)
 
-	err = ctx.EventManager().EmitTypedEvent(msg)
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  msg.Denom,
+		Actor:  msg.From,
+		Role:   types.RoleBlacklister,
+		Action: types.ActionUnblacklist,
+		Target: msg.Address,
+		Before: "true",
+		After:  "false",
+	}); err != nil {
+		return err
+	}
 
-	return &types.MsgUnblacklistResponse{}, err
+	return ctx.EventManager().EmitTypedEvent(&types.EventUnblacklisted{
+		Denom:   msg.Denom,
+		Address: msg.Address,
+	})
 }
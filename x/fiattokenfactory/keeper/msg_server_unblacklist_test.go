@@ -32,7 +32,7 @@ func TestUnblacklist_BlacklisterNotSet(t *testing.T) {
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
 
-	_, err := msgServer.Unblacklist(sdk.WrapSDKContext(ctx), &types.MsgUnblacklist{From: sample.AccAddress()})
+	_, err := msgServer.Unblacklist(sdk.WrapSDKContext(ctx), &types.MsgUnblacklist{From: sample.AccAddress(), Denom: "uusdc"})
 	require.ErrorIs(t, err, types.ErrUserNotFound)
 	require.ErrorContains(t, err, "blacklister is not set")
 }
@@ -41,9 +41,9 @@ func TestUnblacklist_FromAddressIsNotBlacklister(t *testing.T) {
 	blacklister := sample.TestAccount()
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetBlacklister(ctx, types.Blacklister{Address: blacklister.Address})
+	ftf.SetBlacklister(ctx, types.Blacklister{Denom: "uusdc", Address: blacklister.Address})
 
-	_, err := msgServer.Unblacklist(sdk.WrapSDKContext(ctx), &types.MsgUnblacklist{From: sample.AccAddress()})
+	_, err := msgServer.Unblacklist(sdk.WrapSDKContext(ctx), &types.MsgUnblacklist{From: sample.AccAddress(), Denom: "uusdc"})
 	require.ErrorIs(t, err, types.ErrUnauthorized)
 }
 
@@ -51,9 +51,9 @@ func TestUnblacklist_AddressIsInvalid(t *testing.T) {
 	blacklister := sample.TestAccount()
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetBlacklister(ctx, types.Blacklister{Address: blacklister.Address})
+	ftf.SetBlacklister(ctx, types.Blacklister{Denom: "uusdc", Address: blacklister.Address})
 
-	_, err := msgServer.Unblacklist(sdk.WrapSDKContext(ctx), &types.MsgUnblacklist{From: blacklister.Address, Address: "invalid address"})
+	_, err := msgServer.Unblacklist(sdk.WrapSDKContext(ctx), &types.MsgUnblacklist{From: blacklister.Address, Address: "invalid address", Denom: "uusdc"})
 	require.ErrorIs(t, err, bech32.ErrInvalidCharacter(32))
 }
 
@@ -64,13 +64,13 @@ func TestUnblacklist_AddressIsNotBlacklisted(t *testing.T) {
 
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetBlacklister(ctx, types.Blacklister{Address: blacklister.Address})
+	ftf.SetBlacklister(ctx, types.Blacklister{Denom: "uusdc", Address: blacklister.Address})
 
-	_, err := msgServer.Unblacklist(sdk.WrapSDKContext(ctx), &types.MsgUnblacklist{From: blacklister.Address, Address: unblacklistedBech32User.Address})
+	_, err := msgServer.Unblacklist(sdk.WrapSDKContext(ctx), &types.MsgUnblacklist{From: blacklister.Address, Address: unblacklistedBech32User.Address, Denom: "uusdc"})
 	require.ErrorIs(t, types.ErrUserNotFound, err)
 	require.ErrorContains(t, err, "the specified address is not blacklisted")
 
-	_, err = msgServer.Unblacklist(sdk.WrapSDKContext(ctx), &types.MsgUnblacklist{From: blacklister.Address, Address: unblacklistedBech32mUser.Address})
+	_, err = msgServer.Unblacklist(sdk.WrapSDKContext(ctx), &types.MsgUnblacklist{From: blacklister.Address, Address: unblacklistedBech32mUser.Address, Denom: "uusdc"})
 	require.ErrorIs(t, types.ErrUserNotFound, err)
 	require.ErrorContains(t, err, "the specified address is not blacklisted")
 }
@@ -82,15 +82,15 @@ func TestUnblacklist_Success(t *testing.T) {
 
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetBlacklister(ctx, types.Blacklister{Address: blacklister.Address})
-	ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: blacklistedBech32User.AddressBz})
-	ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: blacklistedBech32mUser.AddressBz})
+	ftf.SetBlacklister(ctx, types.Blacklister{Denom: "uusdc", Address: blacklister.Address})
+	ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: blacklistedBech32User.AddressBz, Denom: "uusdc"})
+	ftf.SetBlacklisted(ctx, types.Blacklisted{AddressBz: blacklistedBech32mUser.AddressBz, Denom: "uusdc"})
 
-	res, err := msgServer.Unblacklist(sdk.WrapSDKContext(ctx), &types.MsgUnblacklist{From: blacklister.Address, Address: blacklistedBech32User.Address})
+	res, err := msgServer.Unblacklist(sdk.WrapSDKContext(ctx), &types.MsgUnblacklist{From: blacklister.Address, Address: blacklistedBech32User.Address, Denom: "uusdc"})
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgUnblacklistResponse{}, res)
 
-	res, err = msgServer.Unblacklist(sdk.WrapSDKContext(ctx), &types.MsgUnblacklist{From: blacklister.Address, Address: blacklistedBech32mUser.Address})
+	res, err = msgServer.Unblacklist(sdk.WrapSDKContext(ctx), &types.MsgUnblacklist{From: blacklister.Address, Address: blacklistedBech32mUser.Address, Denom: "uusdc"})
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgUnblacklistResponse{}, res)
 }
@@ -28,22 +28,61 @@ import (
 func (k msgServer) Unpause(goCtx context.Context, msg *types.MsgUnpause) (*types.MsgUnpauseResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	pauser, found := k.GetPauser(ctx)
+	pauser, found := k.GetPauser(ctx, msg.Denom)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "pauser is not set")
 	}
 
+	proposed, err := k.proposeAction(ctx, msg.Denom, types.RolePauser, msg.From, types.ActionProposal{
+		Action: &types.ActionProposal_Unpause{Unpause: msg},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if proposed {
+		return &types.MsgUnpauseResponse{}, nil
+	}
+
 	if pauser.Address != msg.From {
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the pauser")
 	}
 
+	return &types.MsgUnpauseResponse{}, k.unpause(ctx, msg)
+}
+
+// unpause unpauses scopes of a minting denom.
+func (k Keeper) unpause(ctx sdk.Context, msg *types.MsgUnpause) error {
+	scopes, err := types.PauseScopesBitset(msg.Scopes)
+	if err != nil {
+		return err
+	}
+
+	previous := k.GetPaused(ctx, msg.Denom)
+	// the scopes that remain paused keep their scheduled unpause
 	paused := types.Paused{
-		Paused: false,
+		Denom:           msg.Denom,
+		Scopes:          previous.Scopes &^ scopes,
+		UnpauseAtHeight: previous.UnpauseAtHeight,
+		UnpauseAtTime:   previous.UnpauseAtTime,
 	}
 
 	k.SetPaused(ctx, paused)
 
-	err := ctx.EventManager().EmitTypedEvent(msg)
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  msg.Denom,
+		Actor:  msg.From,
+		Role:   types.RolePauser,
+		Action: types.ActionUnpause,
+		Target: msg.Denom,
+		Before: types.FormatPauseScopes(previous.Scopes),
+		After:  types.FormatPauseScopes(paused.Scopes),
+	}); err != nil {
+		return err
+	}
 
-	return &types.MsgUnpauseResponse{}, err
+	return ctx.EventManager().EmitTypedEvent(&types.EventUnpaused{
+		Denom:  msg.Denom,
+		From:   msg.From,
+		Scopes: types.PauseScopesList(paused.Scopes),
+	})
 }
@@ -18,6 +18,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
 	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
@@ -31,7 +32,7 @@ func TestUnpause_PauserNotSet(t *testing.T) {
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
 
-	_, err := msgServer.Unpause(sdk.WrapSDKContext(ctx), &types.MsgUnpause{})
+	_, err := msgServer.Unpause(sdk.WrapSDKContext(ctx), &types.MsgUnpause{Denom: "uusdc"})
 	require.ErrorIs(t, err, types.ErrUserNotFound)
 	require.ErrorContains(t, err, "pauser is not set")
 }
@@ -40,9 +41,9 @@ func TestUnpause_FromAddressIsNotPauser(t *testing.T) {
 	pauser := sample.TestAccount()
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetPauser(ctx, types.Pauser{Address: pauser.Address})
+	ftf.SetPauser(ctx, types.Pauser{Denom: "uusdc", Address: pauser.Address})
 
-	_, err := msgServer.Unpause(sdk.WrapSDKContext(ctx), &types.MsgUnpause{From: sample.AccAddress()})
+	_, err := msgServer.Unpause(sdk.WrapSDKContext(ctx), &types.MsgUnpause{From: sample.AccAddress(), Denom: "uusdc"})
 	require.ErrorIs(t, err, types.ErrUnauthorized)
 	require.ErrorContains(t, err, "you are not the pauser")
 }
@@ -51,10 +52,10 @@ func TestUnpause_AlreadyUnpausedSuccess(t *testing.T) {
 	pauser := sample.TestAccount()
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetPauser(ctx, types.Pauser{Address: pauser.Address})
-	ftf.SetPaused(ctx, types.Paused{Paused: false})
+	ftf.SetPauser(ctx, types.Pauser{Denom: "uusdc", Address: pauser.Address})
+	ftf.SetPaused(ctx, types.Paused{Denom: "uusdc", Paused: false})
 
-	res, err := msgServer.Unpause(sdk.WrapSDKContext(ctx), &types.MsgUnpause{From: pauser.Address})
+	res, err := msgServer.Unpause(sdk.WrapSDKContext(ctx), &types.MsgUnpause{From: pauser.Address, Denom: "uusdc"})
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgUnpauseResponse{}, res)
 }
@@ -63,10 +64,56 @@ func TestUnpause_Success(t *testing.T) {
 	pauser := sample.TestAccount()
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetPauser(ctx, types.Pauser{Address: pauser.Address})
-	ftf.SetPaused(ctx, types.Paused{Paused: true})
+	ftf.SetPauser(ctx, types.Pauser{Denom: "uusdc", Address: pauser.Address})
+	ftf.SetPaused(ctx, types.Paused{Denom: "uusdc", Paused: true})
 
-	res, err := msgServer.Unpause(sdk.WrapSDKContext(ctx), &types.MsgUnpause{From: pauser.Address})
+	res, err := msgServer.Unpause(sdk.WrapSDKContext(ctx), &types.MsgUnpause{From: pauser.Address, Denom: "uusdc"})
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgUnpauseResponse{}, res)
 }
+
+func TestUnpause_Scopes(t *testing.T) {
+	pauser := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetPauser(ctx, types.Pauser{Denom: "uusdc", Address: pauser.Address})
+	ftf.SetPaused(ctx, types.Paused{Denom: "uusdc", Paused: true})
+
+	// ACT: Resume transfers only
+	_, err := msgServer.Unpause(ctx, &types.MsgUnpause{From: pauser.Address, Denom: "uusdc", Scopes: []types.PauseScope{types.PauseScope_PAUSE_SCOPE_TRANSFER}})
+	require.NoError(t, err)
+
+	paused := ftf.GetPaused(ctx, "uusdc")
+	require.True(t, paused.Paused)
+	require.False(t, paused.IsPaused(types.PauseScope_PAUSE_SCOPE_TRANSFER))
+	require.True(t, paused.IsPaused(types.PauseScope_PAUSE_SCOPE_MINT))
+
+	// ACT: Resume all scopes
+	_, err = msgServer.Unpause(ctx, &types.MsgUnpause{From: pauser.Address, Denom: "uusdc"})
+	require.NoError(t, err)
+	require.Equal(t, types.Paused{Denom: "uusdc"}, ftf.GetPaused(ctx, "uusdc"))
+}
+
+func TestUnpause_PartialKeepsSchedule(t *testing.T) {
+	pauser := sample.TestAccount()
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+	ftf.SetPauser(ctx, types.Pauser{Denom: "uusdc", Address: pauser.Address})
+
+	_, err := msgServer.Pause(ctx, &types.MsgPause{From: pauser.Address, Denom: "uusdc", Duration: time.Hour, UnpauseHeight: 100})
+	require.NoError(t, err)
+	unpauseAt := ctx.BlockTime().Add(time.Hour)
+
+	// ACT: Resume transfers only
+	_, err = msgServer.Unpause(ctx, &types.MsgUnpause{From: pauser.Address, Denom: "uusdc", Scopes: []types.PauseScope{types.PauseScope_PAUSE_SCOPE_TRANSFER}})
+	require.NoError(t, err)
+
+	// ASSERT: The remaining scopes keep their scheduled unpause
+	paused := ftf.GetPaused(ctx, "uusdc")
+	require.False(t, paused.IsPaused(types.PauseScope_PAUSE_SCOPE_TRANSFER))
+	require.Equal(t, int64(100), paused.UnpauseAtHeight)
+	require.Equal(t, &unpauseAt, paused.UnpauseAtTime)
+
+	require.NoError(t, ftf.UnpauseExpired(ctx.WithBlockHeight(100)))
+	require.Equal(t, types.Paused{Denom: "uusdc"}, ftf.GetPaused(ctx, "uusdc"))
+}
@@ -0,0 +1,100 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) UpdateAllowlister(goCtx context.Context, msg *types.MsgUpdateAllowlister) (*types.MsgUpdateAllowlisterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, found := k.GetOwner(ctx, msg.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "owner is not set")
+	}
+
+	proposed, err := k.proposeAction(ctx, msg.Denom, types.RoleOwner, msg.From, types.ActionProposal{
+		Action: &types.ActionProposal_UpdateAllowlister{UpdateAllowlister: msg},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if proposed {
+		return &types.MsgUpdateAllowlisterResponse{}, nil
+	}
+
+	if owner.Address != msg.From {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
+	}
+
+	return &types.MsgUpdateAllowlisterResponse{}, k.scheduleAction(ctx, msg.From, types.RoleOwner, types.QueuedAction{
+		Denom:  msg.Denom,
+		Action: &types.QueuedAction_UpdateAllowlister{UpdateAllowlister: msg},
+	})
+}
+
+// updateAllowlister sets the allowlister of a minting denom, or its pending
+// allowlister while two-step role transfers are enabled.
+func (k Keeper) updateAllowlister(ctx sdk.Context, msg *types.MsgUpdateAllowlister) error {
+	// ensure that the specified address is not already assigned to a privileged role
+	err := k.ValidatePrivileges(ctx, msg.Denom, msg.Address)
+	if err != nil {
+		return err
+	}
+
+	allowlister := types.Allowlister{
+		Address: msg.Address,
+		Denom:   msg.Denom,
+	}
+
+	previous, _ := k.GetAllowlister(ctx, msg.Denom)
+
+	if k.GetParams(ctx).TwoStepRoleTransfers {
+		return k.proposeRole(ctx, msg.From, types.ActionUpdateAllowlister, types.PendingRole{
+			Denom:   msg.Denom,
+			Role:    types.RoleAllowlister,
+			Address: msg.Address,
+		}, previous.Address)
+	}
+
+	k.SetAllowlister(ctx, allowlister)
+	k.DeletePendingRole(ctx, msg.Denom, types.RoleAllowlister)
+
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  msg.Denom,
+		Actor:  msg.From,
+		Role:   types.RoleOwner,
+		Action: types.ActionUpdateAllowlister,
+		Target: types.RoleAllowlister,
+		Before: previous.Address,
+		After:  msg.Address,
+	}); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventAllowlisterChanged{
+		Denom:               msg.Denom,
+		PreviousAllowlister: previous.Address,
+		NewAllowlister:      msg.Address,
+	})
+}
@@ -28,28 +28,73 @@ import (
 func (k msgServer) UpdateBlacklister(goCtx context.Context, msg *types.MsgUpdateBlacklister) (*types.MsgUpdateBlacklisterResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	owner, found := k.GetOwner(ctx)
+	owner, found := k.GetOwner(ctx, msg.Denom)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "owner is not set")
 	}
 
+	proposed, err := k.proposeAction(ctx, msg.Denom, types.RoleOwner, msg.From, types.ActionProposal{
+		Action: &types.ActionProposal_UpdateBlacklister{UpdateBlacklister: msg},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if proposed {
+		return &types.MsgUpdateBlacklisterResponse{}, nil
+	}
+
 	if owner.Address != msg.From {
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
 	}
 
+	return &types.MsgUpdateBlacklisterResponse{}, k.scheduleAction(ctx, msg.From, types.RoleOwner, types.QueuedAction{
+		Denom:  msg.Denom,
+		Action: &types.QueuedAction_UpdateBlacklister{UpdateBlacklister: msg},
+	})
+}
+
+// updateBlacklister sets the blacklister of a minting denom, or its pending
+// blacklister while two-step role transfers are enabled.
+func (k Keeper) updateBlacklister(ctx sdk.Context, msg *types.MsgUpdateBlacklister) error {
 	// ensure that the specified address is not already assigned to a privileged role
-	err := k.ValidatePrivileges(ctx, msg.Address)
+	err := k.ValidatePrivileges(ctx, msg.Denom, msg.Address)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	blacklister := types.Blacklister{
 		Address: msg.Address,
+		Denom:   msg.Denom,
+	}
+
+	previous, _ := k.GetBlacklister(ctx, msg.Denom)
+
+	if k.GetParams(ctx).TwoStepRoleTransfers {
+		return k.proposeRole(ctx, msg.From, types.ActionUpdateBlacklister, types.PendingRole{
+			Denom:   msg.Denom,
+			Role:    types.RoleBlacklister,
+			Address: msg.Address,
+		}, previous.Address)
 	}
 
 	k.SetBlacklister(ctx, blacklister)
+	k.DeletePendingRole(ctx, msg.Denom, types.RoleBlacklister)
 
-	err = ctx.EventManager().EmitTypedEvent(msg)
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  msg.Denom,
+		Actor:  msg.From,
+		Role:   types.RoleOwner,
+		Action: types.ActionUpdateBlacklister,
+		Target: types.RoleBlacklister,
+		Before: previous.Address,
+		After:  msg.Address,
+	}); err != nil {
+		return err
+	}
 
-	return &types.MsgUpdateBlacklisterResponse{}, err
+	return ctx.EventManager().EmitTypedEvent(&types.EventBlacklisterChanged{
+		Denom:               msg.Denom,
+		PreviousBlacklister: previous.Address,
+		NewBlacklister:      msg.Address,
+	})
 }
@@ -31,7 +31,7 @@ func TestUpdateBlacklister_OwnerNotSet(t *testing.T) {
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
 
-	_, err := msgServer.UpdateBlacklister(sdk.WrapSDKContext(ctx), &types.MsgUpdateBlacklister{})
+	_, err := msgServer.UpdateBlacklister(sdk.WrapSDKContext(ctx), &types.MsgUpdateBlacklister{Denom: "uusdc"})
 	require.ErrorIs(t, err, types.ErrUserNotFound)
 	require.ErrorContains(t, err, "owner is not set")
 }
@@ -40,9 +40,9 @@ func TestUpdateBlacklister_FromAddressIsNotOwner(t *testing.T) {
 	owner := sample.TestAccount()
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetOwner(ctx, types.Owner{Address: owner.Address})
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner.Address})
 
-	_, err := msgServer.UpdateBlacklister(sdk.WrapSDKContext(ctx), &types.MsgUpdateBlacklister{From: sample.AccAddress()})
+	_, err := msgServer.UpdateBlacklister(sdk.WrapSDKContext(ctx), &types.MsgUpdateBlacklister{From: sample.AccAddress(), Denom: "uusdc"})
 	require.ErrorIs(t, err, types.ErrUnauthorized)
 	require.ErrorContains(t, err, "you are not the owner")
 }
@@ -51,9 +51,9 @@ func TestUpdateBlacklister_AddressAlreadyPrivileged(t *testing.T) {
 	owner := sample.TestAccount()
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetOwner(ctx, types.Owner{Address: owner.Address})
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner.Address})
 
-	_, err := msgServer.UpdateBlacklister(sdk.WrapSDKContext(ctx), &types.MsgUpdateBlacklister{From: owner.Address, Address: owner.Address})
+	_, err := msgServer.UpdateBlacklister(sdk.WrapSDKContext(ctx), &types.MsgUpdateBlacklister{From: owner.Address, Address: owner.Address, Denom: "uusdc"})
 	require.ErrorIs(t, err, types.ErrAlreadyPrivileged)
 }
 
@@ -62,9 +62,9 @@ func TestUpdateBlacklister_Success(t *testing.T) {
 	newBlacklister := sample.TestAccount()
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetOwner(ctx, types.Owner{Address: owner.Address})
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner.Address})
 
-	res, err := msgServer.UpdateBlacklister(sdk.WrapSDKContext(ctx), &types.MsgUpdateBlacklister{From: owner.Address, Address: newBlacklister.Address})
+	res, err := msgServer.UpdateBlacklister(sdk.WrapSDKContext(ctx), &types.MsgUpdateBlacklister{From: owner.Address, Address: newBlacklister.Address, Denom: "uusdc"})
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgUpdateBlacklisterResponse{}, res)
 }
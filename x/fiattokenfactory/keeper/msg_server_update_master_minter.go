@@ -28,28 +28,73 @@ import (
 func (k msgServer) UpdateMasterMinter(goCtx context.Context, msg *types.MsgUpdateMasterMinter) (*types.MsgUpdateMasterMinterResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	owner, found := k.GetOwner(ctx)
+	owner, found := k.GetOwner(ctx, msg.Denom)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "owner is not set")
 	}
 
+	proposed, err := k.proposeAction(ctx, msg.Denom, types.RoleOwner, msg.From, types.ActionProposal{
+		Action: &types.ActionProposal_UpdateMasterMinter{UpdateMasterMinter: msg},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if proposed {
+		return &types.MsgUpdateMasterMinterResponse{}, nil
+	}
+
 	if owner.Address != msg.From {
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
 	}
 
+	return &types.MsgUpdateMasterMinterResponse{}, k.scheduleAction(ctx, msg.From, types.RoleOwner, types.QueuedAction{
+		Denom:  msg.Denom,
+		Action: &types.QueuedAction_UpdateMasterMinter{UpdateMasterMinter: msg},
+	})
+}
+
+// updateMasterMinter sets the master minter of a minting denom, or its pending
+// master minter while two-step role transfers are enabled.
+func (k Keeper) updateMasterMinter(ctx sdk.Context, msg *types.MsgUpdateMasterMinter) error {
 	// ensure that the specified address is not already assigned to a privileged role
-	err := k.ValidatePrivileges(ctx, msg.Address)
+	err := k.ValidatePrivileges(ctx, msg.Denom, msg.Address)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	masterMinter := types.MasterMinter{
 		Address: msg.Address,
+		Denom:   msg.Denom,
+	}
+
+	previous, _ := k.GetMasterMinter(ctx, msg.Denom)
+
+	if k.GetParams(ctx).TwoStepRoleTransfers {
+		return k.proposeRole(ctx, msg.From, types.ActionUpdateMasterMinter, types.PendingRole{
+			Denom:   msg.Denom,
+			Role:    types.RoleMasterMinter,
+			Address: msg.Address,
+		}, previous.Address)
 	}
 
 	k.SetMasterMinter(ctx, masterMinter)
+	k.DeletePendingRole(ctx, msg.Denom, types.RoleMasterMinter)
 
-	err = ctx.EventManager().EmitTypedEvent(msg)
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  msg.Denom,
+		Actor:  msg.From,
+		Role:   types.RoleOwner,
+		Action: types.ActionUpdateMasterMinter,
+		Target: types.RoleMasterMinter,
+		Before: previous.Address,
+		After:  msg.Address,
+	}); err != nil {
+		return err
+	}
 
-	return &types.MsgUpdateMasterMinterResponse{}, err
+	return ctx.EventManager().EmitTypedEvent(&types.EventMasterMinterChanged{
+		Denom:                msg.Denom,
+		PreviousMasterMinter: previous.Address,
+		NewMasterMinter:      msg.Address,
+	})
 }
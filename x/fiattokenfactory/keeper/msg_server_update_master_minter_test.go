@@ -31,7 +31,7 @@ func TestUpdateMasterMinter_OwnerNotSet(t *testing.T) {
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
 
-	_, err := msgServer.UpdateMasterMinter(sdk.WrapSDKContext(ctx), &types.MsgUpdateMasterMinter{})
+	_, err := msgServer.UpdateMasterMinter(sdk.WrapSDKContext(ctx), &types.MsgUpdateMasterMinter{Denom: "uusdc"})
 	require.ErrorIs(t, err, types.ErrUserNotFound)
 	require.ErrorContains(t, err, "owner is not set")
 }
@@ -40,9 +40,9 @@ func TestUpdateMasterMinter_FromAddressIsNotOwner(t *testing.T) {
 	owner := sample.TestAccount()
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetOwner(ctx, types.Owner{Address: owner.Address})
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner.Address})
 
-	_, err := msgServer.UpdateMasterMinter(sdk.WrapSDKContext(ctx), &types.MsgUpdateMasterMinter{From: sample.AccAddress()})
+	_, err := msgServer.UpdateMasterMinter(sdk.WrapSDKContext(ctx), &types.MsgUpdateMasterMinter{From: sample.AccAddress(), Denom: "uusdc"})
 	require.ErrorIs(t, err, types.ErrUnauthorized)
 	require.ErrorContains(t, err, "you are not the owner")
 }
@@ -51,9 +51,9 @@ func TestUpdateMasterMinter_AddressAlreadyPrivileged(t *testing.T) {
 	owner := sample.TestAccount()
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetOwner(ctx, types.Owner{Address: owner.Address})
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner.Address})
 
-	_, err := msgServer.UpdateMasterMinter(sdk.WrapSDKContext(ctx), &types.MsgUpdateMasterMinter{From: owner.Address, Address: owner.Address})
+	_, err := msgServer.UpdateMasterMinter(sdk.WrapSDKContext(ctx), &types.MsgUpdateMasterMinter{From: owner.Address, Address: owner.Address, Denom: "uusdc"})
 	require.ErrorIs(t, err, types.ErrAlreadyPrivileged)
 }
 
@@ -62,9 +62,9 @@ func TestUpdateMasterMinter_Success(t *testing.T) {
 	newMasterMinter := sample.TestAccount()
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetOwner(ctx, types.Owner{Address: owner.Address})
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner.Address})
 
-	res, err := msgServer.UpdateMasterMinter(sdk.WrapSDKContext(ctx), &types.MsgUpdateMasterMinter{From: owner.Address, Address: newMasterMinter.Address})
+	res, err := msgServer.UpdateMasterMinter(sdk.WrapSDKContext(ctx), &types.MsgUpdateMasterMinter{From: owner.Address, Address: newMasterMinter.Address, Denom: "uusdc"})
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgUpdateMasterMinterResponse{}, res)
 }
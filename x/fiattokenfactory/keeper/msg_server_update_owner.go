@@ -28,26 +28,64 @@ import (
 func (k msgServer) UpdateOwner(goCtx context.Context, msg *types.MsgUpdateOwner) (*types.MsgUpdateOwnerResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	owner, found := k.GetOwner(ctx)
+	owner, found := k.GetOwner(ctx, msg.Denom)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "owner is not set")
 	}
 
+	proposed, err := k.proposeAction(ctx, msg.Denom, types.RoleOwner, msg.From, types.ActionProposal{
+		Action: &types.ActionProposal_UpdateOwner{UpdateOwner: msg},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if proposed {
+		return &types.MsgUpdateOwnerResponse{}, nil
+	}
+
 	if owner.Address != msg.From {
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
 	}
 
+	return &types.MsgUpdateOwnerResponse{}, k.scheduleAction(ctx, msg.From, types.RoleOwner, types.QueuedAction{
+		Denom:  msg.Denom,
+		Action: &types.QueuedAction_UpdateOwner{UpdateOwner: msg},
+	})
+}
+
+// updateOwner sets the pending owner of a minting denom.
+func (k Keeper) updateOwner(ctx sdk.Context, msg *types.MsgUpdateOwner) error {
 	// ensure that the specified address is not already assigned to a privileged role
-	err := k.ValidatePrivileges(ctx, msg.Address)
+	err := k.ValidatePrivileges(ctx, msg.Denom, msg.Address)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	owner.Address = msg.Address
+	pendingOwner := types.Owner{
+		Address: msg.Address,
+		Denom:   msg.Denom,
+	}
 
-	k.SetPendingOwner(ctx, owner)
+	previous, _ := k.GetPendingOwner(ctx, msg.Denom)
+	owner, _ := k.GetOwner(ctx, msg.Denom)
 
-	err = ctx.EventManager().EmitTypedEvent(msg)
+	k.SetPendingOwner(ctx, pendingOwner)
+
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  msg.Denom,
+		Actor:  msg.From,
+		Role:   types.RoleOwner,
+		Action: types.ActionUpdateOwner,
+		Target: types.RolePendingOwner,
+		Before: previous.Address,
+		After:  msg.Address,
+	}); err != nil {
+		return err
+	}
 
-	return &types.MsgUpdateOwnerResponse{}, err
+	return ctx.EventManager().EmitTypedEvent(&types.EventPendingOwnerSet{
+		Denom:        msg.Denom,
+		Owner:        owner.Address,
+		PendingOwner: msg.Address,
+	})
 }
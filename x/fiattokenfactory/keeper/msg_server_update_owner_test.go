@@ -31,7 +31,7 @@ func TestUpdateOwner_OwnerNotSet(t *testing.T) {
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
 
-	_, err := msgServer.UpdateOwner(sdk.WrapSDKContext(ctx), &types.MsgUpdateOwner{})
+	_, err := msgServer.UpdateOwner(sdk.WrapSDKContext(ctx), &types.MsgUpdateOwner{Denom: "uusdc"})
 	require.ErrorIs(t, err, types.ErrUserNotFound)
 	require.ErrorContains(t, err, "owner is not set")
 }
@@ -40,9 +40,9 @@ func TestUpdateOwner_FromAddressIsNotOwner(t *testing.T) {
 	owner := sample.TestAccount()
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetOwner(ctx, types.Owner{Address: owner.Address})
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner.Address})
 
-	_, err := msgServer.UpdateOwner(sdk.WrapSDKContext(ctx), &types.MsgUpdateOwner{From: sample.AccAddress()})
+	_, err := msgServer.UpdateOwner(sdk.WrapSDKContext(ctx), &types.MsgUpdateOwner{From: sample.AccAddress(), Denom: "uusdc"})
 	require.ErrorIs(t, err, types.ErrUnauthorized)
 	require.ErrorContains(t, err, "you are not the owner")
 }
@@ -52,10 +52,10 @@ func TestUpdateOwner_AddressAlreadyPrivileged(t *testing.T) {
 	newOwner := sample.TestAccount()
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetOwner(ctx, types.Owner{Address: owner.Address})
-	ftf.SetMasterMinter(ctx, types.MasterMinter{Address: newOwner.Address})
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner.Address})
+	ftf.SetMasterMinter(ctx, types.MasterMinter{Denom: "uusdc", Address: newOwner.Address})
 
-	_, err := msgServer.UpdateOwner(sdk.WrapSDKContext(ctx), &types.MsgUpdateOwner{From: owner.Address, Address: newOwner.Address})
+	_, err := msgServer.UpdateOwner(sdk.WrapSDKContext(ctx), &types.MsgUpdateOwner{From: owner.Address, Address: newOwner.Address, Denom: "uusdc"})
 	require.ErrorIs(t, err, types.ErrAlreadyPrivileged)
 }
 
@@ -64,9 +64,10 @@ func TestUpdateOwner_Success(t *testing.T) {
 	newOwner := sample.TestAccount()
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetOwner(ctx, types.Owner{Address: owner.Address})
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner.Address})
 
-	res, err := msgServer.UpdateOwner(sdk.WrapSDKContext(ctx), &types.MsgUpdateOwner{From: owner.Address, Address: newOwner.Address})
+	res, err := msgServer.UpdateOwner(sdk.WrapSDKContext(ctx), &types.MsgUpdateOwner{From: owner.Address, Address: newOwner.Address, Denom: "uusdc"})
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgUpdateOwnerResponse{}, res)
+	requireTypedEvent(t, ctx, &types.EventPendingOwnerSet{Denom: "uusdc", Owner: owner.Address, PendingOwner: newOwner.Address})
 }
@@ -0,0 +1,67 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateParams_InvalidAuthority(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: sample.AccAddress(), Params: types.DefaultParams()})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.ErrorContains(t, err, "invalid authority")
+}
+
+func TestUpdateParams_InvalidParams(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	params := types.DefaultParams()
+	params.MaxBlacklistBatchSize = 0
+
+	_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: ftf.GetAuthority(), Params: params})
+	require.ErrorIs(t, err, types.ErrInvalidParams)
+}
+
+func TestUpdateParams_Success(t *testing.T) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	params := types.DefaultParams()
+	params.RoleChangeDelay = time.Hour
+	params.AuthzGranteeCheck = false
+
+	res, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: ftf.GetAuthority(), Params: params})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgUpdateParamsResponse{}, res)
+	require.Equal(t, params, ftf.GetParams(ctx))
+
+	entries := ftf.GetAllAuditEntries(ctx)
+	require.Len(t, entries, 1)
+	require.Equal(t, types.RoleAuthority, entries[0].Role)
+	require.Equal(t, types.ActionUpdateParams, entries[0].Action)
+}
@@ -28,28 +28,73 @@ import (
 func (k msgServer) UpdatePauser(goCtx context.Context, msg *types.MsgUpdatePauser) (*types.MsgUpdatePauserResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	owner, found := k.GetOwner(ctx)
+	owner, found := k.GetOwner(ctx, msg.Denom)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUserNotFound, "owner is not set")
 	}
 
+	proposed, err := k.proposeAction(ctx, msg.Denom, types.RoleOwner, msg.From, types.ActionProposal{
+		Action: &types.ActionProposal_UpdatePauser{UpdatePauser: msg},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if proposed {
+		return &types.MsgUpdatePauserResponse{}, nil
+	}
+
 	if owner.Address != msg.From {
 		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "you are not the owner")
 	}
 
+	return &types.MsgUpdatePauserResponse{}, k.scheduleAction(ctx, msg.From, types.RoleOwner, types.QueuedAction{
+		Denom:  msg.Denom,
+		Action: &types.QueuedAction_UpdatePauser{UpdatePauser: msg},
+	})
+}
+
+// updatePauser sets the pauser of a minting denom, or its pending pauser while
+// two-step role transfers are enabled.
+func (k Keeper) updatePauser(ctx sdk.Context, msg *types.MsgUpdatePauser) error {
 	// ensure that the specified address is not already assigned to a privileged role
-	err := k.ValidatePrivileges(ctx, msg.Address)
+	err := k.ValidatePrivileges(ctx, msg.Denom, msg.Address)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	pauser := types.Pauser{
 		Address: msg.Address,
+		Denom:   msg.Denom,
+	}
+
+	previous, _ := k.GetPauser(ctx, msg.Denom)
+
+	if k.GetParams(ctx).TwoStepRoleTransfers {
+		return k.proposeRole(ctx, msg.From, types.ActionUpdatePauser, types.PendingRole{
+			Denom:   msg.Denom,
+			Role:    types.RolePauser,
+			Address: msg.Address,
+		}, previous.Address)
 	}
 
 	k.SetPauser(ctx, pauser)
+	k.DeletePendingRole(ctx, msg.Denom, types.RolePauser)
 
-	err = ctx.EventManager().EmitTypedEvent(msg)
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  msg.Denom,
+		Actor:  msg.From,
+		Role:   types.RoleOwner,
+		Action: types.ActionUpdatePauser,
+		Target: types.RolePauser,
+		Before: previous.Address,
+		After:  msg.Address,
+	}); err != nil {
+		return err
+	}
 
-	return &types.MsgUpdatePauserResponse{}, err
+	return ctx.EventManager().EmitTypedEvent(&types.EventPauserChanged{
+		Denom:          msg.Denom,
+		PreviousPauser: previous.Address,
+		NewPauser:      msg.Address,
+	})
 }
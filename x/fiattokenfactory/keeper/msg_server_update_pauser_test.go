@@ -31,7 +31,7 @@ func TestUpdatePauser_OwnerNotSet(t *testing.T) {
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
 
-	_, err := msgServer.UpdatePauser(sdk.WrapSDKContext(ctx), &types.MsgUpdatePauser{})
+	_, err := msgServer.UpdatePauser(sdk.WrapSDKContext(ctx), &types.MsgUpdatePauser{Denom: "uusdc"})
 	require.ErrorIs(t, err, types.ErrUserNotFound)
 	require.ErrorContains(t, err, "owner is not set")
 }
@@ -40,9 +40,9 @@ func TestUpdatePauser_FromAddressIsNotOwner(t *testing.T) {
 	owner := sample.TestAccount()
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetOwner(ctx, types.Owner{Address: owner.Address})
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner.Address})
 
-	_, err := msgServer.UpdatePauser(sdk.WrapSDKContext(ctx), &types.MsgUpdatePauser{From: sample.AccAddress()})
+	_, err := msgServer.UpdatePauser(sdk.WrapSDKContext(ctx), &types.MsgUpdatePauser{From: sample.AccAddress(), Denom: "uusdc"})
 	require.ErrorIs(t, err, types.ErrUnauthorized)
 	require.ErrorContains(t, err, "you are not the owner")
 }
@@ -51,9 +51,9 @@ func TestUpdatePauser_AddressAlreadyPrivileged(t *testing.T) {
 	owner := sample.TestAccount()
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetOwner(ctx, types.Owner{Address: owner.Address})
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner.Address})
 
-	_, err := msgServer.UpdatePauser(sdk.WrapSDKContext(ctx), &types.MsgUpdatePauser{From: owner.Address, Address: owner.Address})
+	_, err := msgServer.UpdatePauser(sdk.WrapSDKContext(ctx), &types.MsgUpdatePauser{From: owner.Address, Address: owner.Address, Denom: "uusdc"})
 	require.ErrorIs(t, err, types.ErrAlreadyPrivileged)
 }
 
@@ -62,9 +62,10 @@ func TestUpdatePauser_Success(t *testing.T) {
 	newPauser := sample.TestAccount()
 	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
 	msgServer := keeper.NewMsgServerImpl(ftf)
-	ftf.SetOwner(ctx, types.Owner{Address: owner.Address})
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner.Address})
 
-	res, err := msgServer.UpdatePauser(sdk.WrapSDKContext(ctx), &types.MsgUpdatePauser{From: owner.Address, Address: newPauser.Address})
+	res, err := msgServer.UpdatePauser(sdk.WrapSDKContext(ctx), &types.MsgUpdatePauser{From: owner.Address, Address: newPauser.Address, Denom: "uusdc"})
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgUpdatePauserResponse{}, res)
+	requireTypedEvent(t, ctx, &types.EventPauserChanged{Denom: "uusdc", NewPauser: newPauser.Address})
 }
@@ -20,51 +20,39 @@ import (
 	"context"
 
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
-	"github.com/cosmos/cosmos-sdk/runtime"
 )
 
 // SetOwner set owner in the store
 func (k Keeper) SetOwner(ctx context.Context, owner types.Owner) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	b := k.cdc.MustMarshal(&owner)
-	store.Set(types.KeyPrefix(types.OwnerKey), b)
+	set(ctx, k.owners, owner.Denom, owner)
 }
 
-// GetOwner returns owner
-func (k Keeper) GetOwner(ctx context.Context) (val types.Owner, found bool) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-
-	b := store.Get(types.KeyPrefix(types.OwnerKey))
-	if b == nil {
-		return val, false
-	}
+// GetOwner returns the owner of a minting denom
+func (k Keeper) GetOwner(ctx context.Context, denom string) (val types.Owner, found bool) {
+	return get(ctx, k.owners, denom)
+}
 
-	k.cdc.MustUnmarshal(b, &val)
-	return val, true
+// GetAllOwners returns the owners of all minting denoms
+func (k Keeper) GetAllOwners(ctx context.Context) []types.Owner {
+	return values(ctx, k.owners, nil)
 }
 
 // SetPendingOwner set pending owner in the store
 func (k Keeper) SetPendingOwner(ctx context.Context, owner types.Owner) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	b := k.cdc.MustMarshal(&owner)
-	store.Set(types.KeyPrefix(types.PendingOwnerKey), b)
+	set(ctx, k.pendingOwners, owner.Denom, owner)
 }
 
-// DeletePendingOwner deletes the pending owner in the store
-func (k Keeper) DeletePendingOwner(ctx context.Context) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store.Delete(types.KeyPrefix(types.PendingOwnerKey))
+// DeletePendingOwner deletes the pending owner of a minting denom in the store
+func (k Keeper) DeletePendingOwner(ctx context.Context, denom string) {
+	remove(ctx, k.pendingOwners, denom)
 }
 
-// GetPendingOwner returns pending owner
-func (k Keeper) GetPendingOwner(ctx context.Context) (val types.Owner, found bool) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-
-	b := store.Get(types.KeyPrefix(types.PendingOwnerKey))
-	if b == nil {
-		return val, false
-	}
+// GetPendingOwner returns the pending owner of a minting denom
+func (k Keeper) GetPendingOwner(ctx context.Context, denom string) (val types.Owner, found bool) {
+	return get(ctx, k.pendingOwners, denom)
+}
 
-	k.cdc.MustUnmarshal(b, &val)
-	return val, true
+// GetAllPendingOwners returns the pending owners of all minting denoms
+func (k Keeper) GetAllPendingOwners(ctx context.Context) []types.Owner {
+	return values(ctx, k.pendingOwners, nil)
 }
@@ -33,7 +33,7 @@ func TestOwnerGetAndSet_Success(t *testing.T) {
 	owner := sample.TestAccount()
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	keeper.SetOwner(ctx, types.Owner{Address: owner.Address})
+	keeper.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner.Address})
 
 	assertOwner(t, *keeper, ctx, owner)
 }
@@ -41,18 +41,18 @@ func TestOwnerGetAndSet_Success(t *testing.T) {
 func TestOwnerSet_Overwrite(t *testing.T) {
 	owner := sample.TestAccount()
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
-	keeper.SetOwner(ctx, types.Owner{Address: owner.Address})
+	keeper.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner.Address})
 	assertOwner(t, *keeper, ctx, owner)
 
 	newOwner := sample.TestAccount()
-	keeper.SetOwner(ctx, types.Owner{Address: newOwner.Address})
+	keeper.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: newOwner.Address})
 	assertOwner(t, *keeper, ctx, newOwner)
 }
 
 func TestOwnerGet_Unset(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	_, found := keeper.GetOwner(ctx)
+	_, found := keeper.GetOwner(ctx, "uusdc")
 	require.False(t, found)
 }
 
@@ -60,7 +60,7 @@ func TestPendingOwnerGetAndSet_Success(t *testing.T) {
 	pendingOwner := sample.TestAccount()
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	keeper.SetPendingOwner(ctx, types.Owner{Address: pendingOwner.Address})
+	keeper.SetPendingOwner(ctx, types.Owner{Denom: "uusdc", Address: pendingOwner.Address})
 
 	assertPendingOwner(t, *keeper, ctx, pendingOwner)
 }
@@ -68,55 +68,55 @@ func TestPendingOwnerGetAndSet_Success(t *testing.T) {
 func TestPendingOwnerSet_Overwrite(t *testing.T) {
 	pendingOwner := sample.TestAccount()
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
-	keeper.SetPendingOwner(ctx, types.Owner{Address: pendingOwner.Address})
+	keeper.SetPendingOwner(ctx, types.Owner{Denom: "uusdc", Address: pendingOwner.Address})
 	assertPendingOwner(t, *keeper, ctx, pendingOwner)
 
 	newOwner := sample.TestAccount()
-	keeper.SetPendingOwner(ctx, types.Owner{Address: newOwner.Address})
+	keeper.SetPendingOwner(ctx, types.Owner{Denom: "uusdc", Address: newOwner.Address})
 	assertPendingOwner(t, *keeper, ctx, newOwner)
 }
 
 func TestPendingOwnerGet_Unset(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	_, found := keeper.GetPendingOwner(ctx)
+	_, found := keeper.GetPendingOwner(ctx, "uusdc")
 	require.False(t, found)
 }
 
 func TestPendingOwnerDelete_Unset(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	keeper.DeletePendingOwner(ctx)
+	keeper.DeletePendingOwner(ctx, "uusdc")
 
-	_, found := keeper.GetPendingOwner(ctx)
+	_, found := keeper.GetPendingOwner(ctx, "uusdc")
 	require.False(t, found)
 }
 
 func TestPendingOwnerDelete_Success(t *testing.T) {
 	pendingOwner := sample.TestAccount()
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
-	keeper.SetPendingOwner(ctx, types.Owner{Address: pendingOwner.Address})
+	keeper.SetPendingOwner(ctx, types.Owner{Denom: "uusdc", Address: pendingOwner.Address})
 
-	keeper.DeletePendingOwner(ctx)
+	keeper.DeletePendingOwner(ctx, "uusdc")
 
-	_, found := keeper.GetPendingOwner(ctx)
+	_, found := keeper.GetPendingOwner(ctx, "uusdc")
 	require.False(t, found)
 }
 
 func assertOwner(t *testing.T, keeper keeper.Keeper, ctx sdk.Context, expectedOwner sample.Account) {
-	rst, found := keeper.GetOwner(ctx)
+	rst, found := keeper.GetOwner(ctx, "uusdc")
 	require.True(t, found)
 	require.Equal(t,
-		nullify.Fill(&types.Owner{Address: expectedOwner.Address}),
+		nullify.Fill(&types.Owner{Denom: "uusdc", Address: expectedOwner.Address}),
 		nullify.Fill(&rst),
 	)
 }
 
 func assertPendingOwner(t *testing.T, keeper keeper.Keeper, ctx sdk.Context, expectedPendingOwner sample.Account) {
-	rst, found := keeper.GetPendingOwner(ctx)
+	rst, found := keeper.GetPendingOwner(ctx, "uusdc")
 	require.True(t, found)
 	require.Equal(t,
-		nullify.Fill(&types.Owner{Address: expectedPendingOwner.Address}),
+		nullify.Fill(&types.Owner{Denom: "uusdc", Address: expectedPendingOwner.Address}),
 		nullify.Fill(&rst),
 	)
 }
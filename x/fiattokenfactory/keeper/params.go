@@ -0,0 +1,70 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// SetParams set the module params in the store, and indexes their module
+// exemptions by module account address.
+func (k Keeper) SetParams(ctx context.Context, params types.Params) {
+	if err := k.params.Set(ctx, params); err != nil {
+		panic(err)
+	}
+
+	if err := k.moduleExemptions.Clear(ctx, nil); err != nil {
+		panic(err)
+	}
+	for _, exemption := range params.ModuleExemptions {
+		set(ctx, k.moduleExemptions, authtypes.NewModuleAddress(exemption.Module).Bytes(), exemption)
+	}
+}
+
+// GetParams returns the module params, or the default params if unset. Other
+// errors are unexpected and panic, so that corrupted params are never
+// silently replaced by the defaults.
+func (k Keeper) GetParams(ctx context.Context) types.Params {
+	params, err := k.params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DefaultParams()
+	}
+	if err != nil {
+		panic(err)
+	}
+	return params
+}
+
+// GetModuleExemption returns the exemption of the module account with the
+// given address. Addresses without an exemption get an empty one.
+func (k Keeper) GetModuleExemption(ctx context.Context, address []byte) types.ModuleExemption {
+	has, err := k.params.Has(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if !has {
+		return types.DefaultParams().GetModuleExemption(address)
+	}
+
+	exemption, _ := get(ctx, k.moduleExemptions, address)
+	return exemption
+}
@@ -20,25 +20,64 @@ import (
 	"context"
 
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
-	"github.com/cosmos/cosmos-sdk/runtime"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
-// SetPaused set paused in the store
+// SetPaused set paused in the store. A paused state that only has its flag
+// set pauses all scopes.
 func (k Keeper) SetPaused(ctx context.Context, paused types.Paused) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	b := k.cdc.MustMarshal(&paused)
-	store.Set(types.KeyPrefix(types.PausedKey), b)
+	set(ctx, k.paused, paused.Denom, paused.Normalize())
+}
+
+// GetPaused returns the paused state of a minting denom. A denom without a
+// stored paused state is considered unpaused.
+func (k Keeper) GetPaused(ctx context.Context, denom string) types.Paused {
+	val, found := get(ctx, k.paused, denom)
+	if !found {
+		return types.Paused{Denom: denom, Paused: false}
+	}
+	return val
 }
 
-// GetPaused returns paused
-func (k Keeper) GetPaused(ctx context.Context) (val types.Paused) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+// GetAllPaused returns the stored paused state of all minting denoms
+func (k Keeper) GetAllPaused(ctx context.Context) []types.Paused {
+	return values(ctx, k.paused, nil)
+}
 
-	b := store.Get(types.KeyPrefix(types.PausedKey))
-	if b == nil {
-		panic("Paused state is not set")
+// UnpauseExpired lifts the pauses whose scheduled unpause was reached.
+func (k Keeper) UnpauseExpired(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	module := authtypes.NewModuleAddress(types.ModuleName).String()
+
+	for _, paused := range k.GetAllPaused(ctx) {
+		if !paused.UnpauseDue(sdkCtx.BlockHeight(), sdkCtx.BlockTime()) {
+			continue
+		}
+
+		k.SetPaused(ctx, types.Paused{Denom: paused.Denom})
+
+		if err := k.recordAudit(ctx, types.AuditEntry{
+			Denom:  paused.Denom,
+			Actor:  module,
+			Role:   types.RoleModule,
+			Action: types.ActionUnpause,
+			Target: paused.Denom,
+			Before: types.FormatPauseScopes(paused.Scopes),
+			After:  types.FormatPauseScopes(0),
+		}); err != nil {
+			return err
+		}
+
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventUnpaused{
+			Denom:   paused.Denom,
+			From:    module,
+			Expired: true,
+		}); err != nil {
+			return err
+		}
 	}
 
-	k.cdc.MustUnmarshal(b, &val)
-	return val
+	return nil
 }
@@ -29,7 +29,10 @@ import (
 )
 
 func createTestPaused(keeper *keeper.Keeper, ctx sdk.Context, isPaused bool) types.Paused {
-	item := types.Paused{Paused: isPaused}
+	item := types.Paused{Denom: "uusdc", Paused: isPaused}
+	if isPaused {
+		item.Scopes = types.PauseScopesAll
+	}
 	keeper.SetPaused(ctx, item)
 	return item
 }
@@ -37,10 +40,10 @@ func createTestPaused(keeper *keeper.Keeper, ctx sdk.Context, isPaused bool) typ
 func TestPausedGetAndSet_Success(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	pauseState := types.Paused{Paused: true}
+	pauseState := types.Paused{Denom: "uusdc", Paused: true, Scopes: types.PauseScope_PAUSE_SCOPE_MINT.Bit()}
 	keeper.SetPaused(ctx, pauseState)
 
-	rst := keeper.GetPaused(ctx)
+	rst := keeper.GetPaused(ctx, "uusdc")
 	require.Equal(t,
 		nullify.Fill(&pauseState),
 		nullify.Fill(&rst),
@@ -50,27 +53,60 @@ func TestPausedGetAndSet_Success(t *testing.T) {
 func TestPausedSet_Overwrite(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	pausedState := types.Paused{Paused: true}
+	pausedState := types.Paused{Denom: "uusdc", Paused: true, Scopes: types.PauseScopesAll}
 	keeper.SetPaused(ctx, pausedState)
 
-	rst := keeper.GetPaused(ctx)
+	rst := keeper.GetPaused(ctx, "uusdc")
 	require.Equal(t,
 		nullify.Fill(&pausedState),
 		nullify.Fill(&rst),
 	)
 
-	unpausedState := types.Paused{Paused: true}
+	unpausedState := types.Paused{Denom: "uusdc", Paused: false}
 	keeper.SetPaused(ctx, unpausedState)
 
-	rst = keeper.GetPaused(ctx)
+	rst = keeper.GetPaused(ctx, "uusdc")
 	require.Equal(t,
 		nullify.Fill(&unpausedState),
 		nullify.Fill(&rst),
 	)
 }
 
-func TestPausedGet_UnsetPanics(t *testing.T) {
+func TestPausedGet_UnsetIsUnpaused(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	require.Panics(t, func() { keeper.GetPaused(ctx) })
+	rst := keeper.GetPaused(ctx, "uusdc")
+	require.Equal(t,
+		nullify.Fill(&types.Paused{Denom: "uusdc", Paused: false}),
+		nullify.Fill(&rst),
+	)
+}
+
+func TestPaused_ScopedToDenom(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	keeper.SetPaused(ctx, types.Paused{Denom: "uusdc", Paused: true})
+
+	require.True(t, keeper.GetPaused(ctx, "uusdc").Paused)
+	require.False(t, keeper.GetPaused(ctx, "ueurc").Paused)
+}
+
+func TestPausedSet_LegacyFlagPausesAllScopes(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	keeper.SetPaused(ctx, types.Paused{Denom: "uusdc", Paused: true})
+
+	rst := keeper.GetPaused(ctx, "uusdc")
+	require.Equal(t, types.Paused{Denom: "uusdc", Paused: true, Scopes: types.PauseScopesAll}, rst)
+}
+
+func TestPausedSet_FlagFollowsScopes(t *testing.T) {
+	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
+
+	keeper.SetPaused(ctx, types.Paused{Denom: "uusdc", Scopes: types.PauseScope_PAUSE_SCOPE_IBC_IN.Bit()})
+
+	rst := keeper.GetPaused(ctx, "uusdc")
+	require.True(t, rst.Paused)
+	require.True(t, rst.IsPaused(types.PauseScope_PAUSE_SCOPE_IBC_IN))
+	require.False(t, rst.IsPaused(types.PauseScope_PAUSE_SCOPE_TRANSFER))
 }
@@ -20,25 +20,19 @@ import (
 	"context"
 
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
-	"github.com/cosmos/cosmos-sdk/runtime"
 )
 
 // SetPauser set pauser in the store
 func (k Keeper) SetPauser(ctx context.Context, pauser types.Pauser) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	b := k.cdc.MustMarshal(&pauser)
-	store.Set(types.KeyPrefix(types.PauserKey), b)
+	set(ctx, k.pausers, pauser.Denom, pauser)
 }
 
-// GetPauser returns pauser
-func (k Keeper) GetPauser(ctx context.Context) (val types.Pauser, found bool) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-
-	b := store.Get(types.KeyPrefix(types.PauserKey))
-	if b == nil {
-		return val, false
-	}
+// GetPauser returns the pauser of a minting denom
+func (k Keeper) GetPauser(ctx context.Context, denom string) (val types.Pauser, found bool) {
+	return get(ctx, k.pausers, denom)
+}
 
-	k.cdc.MustUnmarshal(b, &val)
-	return val, true
+// GetAllPausers returns the pausers of all minting denoms
+func (k Keeper) GetAllPausers(ctx context.Context) []types.Pauser {
+	return values(ctx, k.pausers, nil)
 }
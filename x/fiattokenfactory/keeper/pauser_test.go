@@ -30,7 +30,7 @@ import (
 )
 
 func createTestPauser(keeper *keeper.Keeper, ctx sdk.Context) types.Pauser {
-	item := types.Pauser{}
+	item := types.Pauser{Denom: "uusdc"}
 	keeper.SetPauser(ctx, item)
 	return item
 }
@@ -39,7 +39,7 @@ func TestPauserGetAndSet_Success(t *testing.T) {
 	pauser := sample.TestAccount()
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	keeper.SetPauser(ctx, types.Pauser{Address: pauser.Address})
+	keeper.SetPauser(ctx, types.Pauser{Denom: "uusdc", Address: pauser.Address})
 
 	assertPauser(t, *keeper, ctx, pauser)
 }
@@ -47,26 +47,26 @@ func TestPauserGetAndSet_Success(t *testing.T) {
 func TestPauserSet_Overwrite(t *testing.T) {
 	pauser := sample.TestAccount()
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
-	keeper.SetPauser(ctx, types.Pauser{Address: pauser.Address})
+	keeper.SetPauser(ctx, types.Pauser{Denom: "uusdc", Address: pauser.Address})
 	assertPauser(t, *keeper, ctx, pauser)
 
 	newPauser := sample.TestAccount()
-	keeper.SetPauser(ctx, types.Pauser{Address: newPauser.Address})
+	keeper.SetPauser(ctx, types.Pauser{Denom: "uusdc", Address: newPauser.Address})
 	assertPauser(t, *keeper, ctx, newPauser)
 }
 
 func TestPauserGet_Unset(t *testing.T) {
 	keeper, ctx := keepertest.FiatTokenfactoryKeeper()
 
-	_, found := keeper.GetPauser(ctx)
+	_, found := keeper.GetPauser(ctx, "uusdc")
 	require.False(t, found)
 }
 
 func assertPauser(t *testing.T, keeper keeper.Keeper, ctx sdk.Context, expectedPauser sample.Account) {
-	rst, found := keeper.GetPauser(ctx)
+	rst, found := keeper.GetPauser(ctx, "uusdc")
 	require.True(t, found)
 	require.Equal(t,
-		nullify.Fill(&types.Pauser{Address: expectedPauser.Address}),
+		nullify.Fill(&types.Pauser{Denom: "uusdc", Address: expectedPauser.Address}),
 		nullify.Fill(&rst),
 	)
 }
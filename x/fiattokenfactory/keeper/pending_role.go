@@ -0,0 +1,141 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// SetPendingRole set the pending holder of a role of a minting denom in the store
+func (k Keeper) SetPendingRole(ctx context.Context, pendingRole types.PendingRole) {
+	set(ctx, k.pendingRoles, collections.Join(pendingRole.Denom, pendingRole.Role), pendingRole)
+}
+
+// GetPendingRole returns the pending holder of a role of a minting denom
+func (k Keeper) GetPendingRole(ctx context.Context, denom string, role string) (val types.PendingRole, found bool) {
+	return get(ctx, k.pendingRoles, collections.Join(denom, role))
+}
+
+// DeletePendingRole deletes the pending holder of a role of a minting denom in the store
+func (k Keeper) DeletePendingRole(ctx context.Context, denom string, role string) {
+	remove(ctx, k.pendingRoles, collections.Join(denom, role))
+}
+
+// GetAllPendingRoles returns the pending roles of all minting denoms
+func (k Keeper) GetAllPendingRoles(ctx context.Context) []types.PendingRole {
+	return values(ctx, k.pendingRoles, nil)
+}
+
+// proposeRole records the address the owner assigned a role to as pending,
+// until it accepts the role with MsgAcceptRole.
+func (k Keeper) proposeRole(ctx sdk.Context, from string, action string, pendingRole types.PendingRole, current string) error {
+	previous, _ := k.GetPendingRole(ctx, pendingRole.Denom, pendingRole.Role)
+
+	k.SetPendingRole(ctx, pendingRole)
+
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  pendingRole.Denom,
+		Actor:  from,
+		Role:   types.RoleOwner,
+		Action: action,
+		Target: types.PendingRoleName(pendingRole.Role),
+		Before: previous.Address,
+		After:  pendingRole.Address,
+	}); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventPendingRoleSet{
+		Denom:          pendingRole.Denom,
+		Role:           pendingRole.Role,
+		Current:        current,
+		PendingAddress: pendingRole.Address,
+	})
+}
+
+// roleHolder returns the address holding a role of a minting denom.
+func (k Keeper) roleHolder(ctx context.Context, denom string, role string) string {
+	switch role {
+	case types.RoleOwner:
+		owner, _ := k.GetOwner(ctx, denom)
+		return owner.Address
+	case types.RoleMasterMinter:
+		masterMinter, _ := k.GetMasterMinter(ctx, denom)
+		return masterMinter.Address
+	case types.RolePauser:
+		pauser, _ := k.GetPauser(ctx, denom)
+		return pauser.Address
+	case types.RoleBlacklister:
+		blacklister, _ := k.GetBlacklister(ctx, denom)
+		return blacklister.Address
+	case types.RoleAllowlister:
+		allowlister, _ := k.GetAllowlister(ctx, denom)
+		return allowlister.Address
+	default:
+		return ""
+	}
+}
+
+// actsForRole reports whether an address acts for a role of a minting denom,
+// either as its holder or as a member of its signer set.
+func (k Keeper) actsForRole(ctx context.Context, denom string, role string, address string) bool {
+	if signerSet, found := k.GetSignerSet(ctx, denom, role); found && signerSet.IsSigner(address) {
+		return true
+	}
+	return address != "" && k.roleHolder(ctx, denom, role) == address
+}
+
+// changeRole assigns a role of a minting denom that is not the owner to an
+// address and emits the event of the role change. It returns the previous
+// holder of the role.
+func (k Keeper) changeRole(ctx sdk.Context, denom string, role string, address string) (string, error) {
+	var previous string
+	var event proto.Message
+	switch role {
+	case types.RoleMasterMinter:
+		masterMinter, _ := k.GetMasterMinter(ctx, denom)
+		previous = masterMinter.Address
+		k.SetMasterMinter(ctx, types.MasterMinter{Denom: denom, Address: address})
+		event = &types.EventMasterMinterChanged{Denom: denom, PreviousMasterMinter: previous, NewMasterMinter: address}
+	case types.RolePauser:
+		pauser, _ := k.GetPauser(ctx, denom)
+		previous = pauser.Address
+		k.SetPauser(ctx, types.Pauser{Denom: denom, Address: address})
+		event = &types.EventPauserChanged{Denom: denom, PreviousPauser: previous, NewPauser: address}
+	case types.RoleBlacklister:
+		blacklister, _ := k.GetBlacklister(ctx, denom)
+		previous = blacklister.Address
+		k.SetBlacklister(ctx, types.Blacklister{Denom: denom, Address: address})
+		event = &types.EventBlacklisterChanged{Denom: denom, PreviousBlacklister: previous, NewBlacklister: address}
+	case types.RoleAllowlister:
+		allowlister, _ := k.GetAllowlister(ctx, denom)
+		previous = allowlister.Address
+		k.SetAllowlister(ctx, types.Allowlister{Denom: denom, Address: address})
+		event = &types.EventAllowlisterChanged{Denom: denom, PreviousAllowlister: previous, NewAllowlister: address}
+	default:
+		return "", sdkerrors.Wrapf(types.ErrInvalidType, "role %s cannot be accepted", role)
+	}
+
+	return previous, ctx.EventManager().EmitTypedEvent(event)
+}
@@ -0,0 +1,75 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+)
+
+// get returns the value stored under a key of a collection, reporting whether
+// it was found. Errors other than a missing key are unexpected and panic, as
+// they would for a direct store access.
+func get[K, V any](ctx context.Context, m collections.Map[K, V], key K) (V, bool) {
+	val, err := m.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return val, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return val, true
+}
+
+// set stores a value under a key of a collection.
+func set[K, V any](ctx context.Context, m collections.Map[K, V], key K, val V) {
+	if err := m.Set(ctx, key, val); err != nil {
+		panic(err)
+	}
+}
+
+// remove deletes a key of a collection.
+func remove[K, V any](ctx context.Context, m collections.Map[K, V], key K) {
+	if err := m.Remove(ctx, key); err != nil {
+		panic(err)
+	}
+}
+
+// values returns all values of a collection within the given range.
+func values[K, V any](ctx context.Context, m collections.Map[K, V], ranger collections.Ranger[K]) []V {
+	iter, err := m.Iterate(ctx, ranger)
+	if err != nil {
+		panic(err)
+	}
+	list, err := iter.Values()
+	if err != nil {
+		panic(err)
+	}
+	return list
+}
+
+// denomAddressKey returns the key of an entry indexed by a minting denom and
+// a Bech32 or Bech32m encoded address.
+func denomAddressKey(denom string, address string) (collections.Pair[string, []byte], error) {
+	_, addressBz, err := DecodeNoLimitToBase256(address)
+	if err != nil {
+		return collections.Pair[string, []byte]{}, err
+	}
+	return collections.Join(denom, addressBz), nil
+}
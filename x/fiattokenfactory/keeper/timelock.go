@@ -0,0 +1,183 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetQueuedAction set a queued action in the store, advancing the queued
+// action sequence past its id.
+func (k Keeper) SetQueuedAction(ctx context.Context, action types.QueuedAction) {
+	if prev, found := k.GetQueuedAction(ctx, action.Id); found {
+		k.removeQueuedActionTime(ctx, prev)
+	}
+	set(ctx, k.queuedActions, action.Id, action)
+	if err := k.queuedActionTimes.Set(ctx, collections.Join(action.ExecuteAt, action.Id)); err != nil {
+		panic(err)
+	}
+
+	next, err := k.queuedActionSeq.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if action.Id >= next {
+		if err := k.queuedActionSeq.Set(ctx, action.Id+1); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// GetQueuedAction returns a queued action from its id
+func (k Keeper) GetQueuedAction(ctx context.Context, id uint64) (val types.QueuedAction, found bool) {
+	return get(ctx, k.queuedActions, id)
+}
+
+// RemoveQueuedAction removes a queued action from the store
+func (k Keeper) RemoveQueuedAction(ctx context.Context, id uint64) {
+	if prev, found := k.GetQueuedAction(ctx, id); found {
+		k.removeQueuedActionTime(ctx, prev)
+	}
+	remove(ctx, k.queuedActions, id)
+}
+
+// removeQueuedActionTime removes a queued action from the execution time
+// index.
+func (k Keeper) removeQueuedActionTime(ctx context.Context, action types.QueuedAction) {
+	if err := k.queuedActionTimes.Remove(ctx, collections.Join(action.ExecuteAt, action.Id)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllQueuedActions returns all queued actions
+func (k Keeper) GetAllQueuedActions(ctx context.Context) []types.QueuedAction {
+	return values(ctx, k.queuedActions, nil)
+}
+
+// ExecuteQueuedActions executes the queued actions whose delay elapsed. An
+// action that no longer passes its checks, or whose sender no longer acts for
+// the role that queued it, is dropped without affecting the others. Only the
+// due part of the execution time index is iterated.
+func (k Keeper) ExecuteQueuedActions(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	iter, err := k.queuedActionTimes.Iterate(ctx, collections.NewPrefixUntilPairRange[time.Time, uint64](sdkCtx.BlockTime()))
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		action, found := k.GetQueuedAction(ctx, key.K2())
+		if !found {
+			return fmt.Errorf("queued action %d is indexed but does not exist", key.K2())
+		}
+		k.RemoveQueuedAction(ctx, action.Id)
+
+		// the role may have changed hands while the action was queued
+		if !k.actsForRole(ctx, action.Denom, action.Role(), action.From()) {
+			k.Logger(sdkCtx).Error("dropped queued action", "id", action.Id, "action", action.ActionName(), "err", fmt.Sprintf("%s no longer acts for the %s", action.From(), action.Role()))
+			continue
+		}
+
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := k.executeQueuedAction(cacheCtx, action); err != nil {
+			k.Logger(sdkCtx).Error("failed to execute queued action", "id", action.Id, "action", action.ActionName(), "err", err)
+			continue
+		}
+		write()
+	}
+
+	return nil
+}
+
+// scheduleAction queues a role change or signer set change for execution once
+// the role change delay elapsed, or executes it immediately when no delay is configured.
+func (k Keeper) scheduleAction(ctx sdk.Context, from string, role string, action types.QueuedAction) error {
+	delay := k.GetParams(ctx).RoleChangeDelay
+	if delay == 0 {
+		return k.executeQueuedAction(ctx, action)
+	}
+
+	// dry run the action so that it is rejected now rather than dropped once
+	// its delay elapsed
+	cacheCtx, _ := ctx.CacheContext()
+	if err := k.executeQueuedAction(cacheCtx, action); err != nil {
+		return err
+	}
+
+	id, err := k.queuedActionSeq.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	action.Id = id
+	action.ExecuteAt = ctx.BlockTime().Add(delay)
+	k.SetQueuedAction(ctx, action)
+
+	if err := k.recordAudit(ctx, types.AuditEntry{
+		Denom:  action.Denom,
+		Actor:  from,
+		Role:   role,
+		Action: types.ActionQueueAction,
+		Target: strconv.FormatUint(id, 10),
+		After:  action.ActionName(),
+	}); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventActionQueued{
+		Id:        id,
+		Denom:     action.Denom,
+		Action:    action.ActionName(),
+		ExecuteAt: action.ExecuteAt,
+	})
+}
+
+// executeQueuedAction executes the role change of a queued action.
+func (k Keeper) executeQueuedAction(ctx sdk.Context, action types.QueuedAction) error {
+	switch a := action.Action.(type) {
+	case *types.QueuedAction_UpdateOwner:
+		return k.updateOwner(ctx, a.UpdateOwner)
+	case *types.QueuedAction_UpdateMasterMinter:
+		return k.updateMasterMinter(ctx, a.UpdateMasterMinter)
+	case *types.QueuedAction_UpdatePauser:
+		return k.updatePauser(ctx, a.UpdatePauser)
+	case *types.QueuedAction_UpdateBlacklister:
+		return k.updateBlacklister(ctx, a.UpdateBlacklister)
+	case *types.QueuedAction_ConfigureMinterController:
+		return k.configureMinterController(ctx, a.ConfigureMinterController)
+	case *types.QueuedAction_RemoveMinterController:
+		return k.removeMinterController(ctx, a.RemoveMinterController)
+	case *types.QueuedAction_UpdateAllowlister:
+		return k.updateAllowlister(ctx, a.UpdateAllowlister)
+	case *types.QueuedAction_SetSignerSet:
+		return k.setSignerSet(ctx, a.SetSignerSet)
+	default:
+		return fmt.Errorf("unknown action of queued action %d", action.Id)
+	}
+}
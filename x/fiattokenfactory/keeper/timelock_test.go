@@ -0,0 +1,248 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	testkeeper "github.com/circlefin/noble-fiattokenfactory/testutil/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// setupTimelock configures a one hour role change delay for uusdc.
+func setupTimelock() (*keeper.Keeper, sdk.Context, types.MsgServer, string) {
+	ftf, ctx := testkeeper.FiatTokenfactoryKeeper()
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0).UTC())
+	msgServer := keeper.NewMsgServerImpl(ftf)
+
+	params := types.DefaultParams()
+	params.RoleChangeDelay = time.Hour
+	ftf.SetParams(ctx, params)
+
+	owner := sample.AccAddress()
+	ftf.SetMintingDenom(ctx, types.MintingDenom{Denom: "uusdc"})
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: owner})
+
+	return ftf, ctx, msgServer, owner
+}
+
+func TestUpdatePauser_Queued(t *testing.T) {
+	ftf, ctx, msgServer, owner := setupTimelock()
+	pauser := sample.AccAddress()
+
+	_, err := msgServer.UpdatePauser(ctx, &types.MsgUpdatePauser{From: owner, Address: pauser, Denom: "uusdc"})
+	require.NoError(t, err)
+
+	_, found := ftf.GetPauser(ctx, "uusdc")
+	require.False(t, found)
+
+	queued := ftf.GetAllQueuedActions(ctx)
+	require.Len(t, queued, 1)
+	require.Equal(t, ctx.BlockTime().Add(time.Hour), queued[0].ExecuteAt)
+
+	// the delay has not elapsed yet
+	require.NoError(t, ftf.ExecuteQueuedActions(ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))))
+	_, found = ftf.GetPauser(ctx, "uusdc")
+	require.False(t, found)
+
+	require.NoError(t, ftf.ExecuteQueuedActions(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))))
+	got, found := ftf.GetPauser(ctx, "uusdc")
+	require.True(t, found)
+	require.Equal(t, pauser, got.Address)
+	require.Empty(t, ftf.GetAllQueuedActions(ctx))
+}
+
+func TestUpdatePauser_QueuedRejectsPrivilegedAddress(t *testing.T) {
+	ftf, ctx, msgServer, owner := setupTimelock()
+	blacklister := sample.AccAddress()
+	ftf.SetBlacklister(ctx, types.Blacklister{Denom: "uusdc", Address: blacklister})
+
+	_, err := msgServer.UpdatePauser(ctx, &types.MsgUpdatePauser{From: owner, Address: blacklister, Denom: "uusdc"})
+	require.ErrorIs(t, err, types.ErrAlreadyPrivileged)
+	require.Empty(t, ftf.GetAllQueuedActions(ctx))
+}
+
+func TestExecuteQueuedActions_DropsFailingAction(t *testing.T) {
+	ftf, ctx, msgServer, owner := setupTimelock()
+	pauser := sample.AccAddress()
+	blacklister := sample.AccAddress()
+
+	_, err := msgServer.UpdatePauser(ctx, &types.MsgUpdatePauser{From: owner, Address: pauser, Denom: "uusdc"})
+	require.NoError(t, err)
+	_, err = msgServer.UpdateBlacklister(ctx, &types.MsgUpdateBlacklister{From: owner, Address: blacklister, Denom: "uusdc"})
+	require.NoError(t, err)
+
+	// the pauser became privileged while its update was queued
+	ftf.SetMasterMinter(ctx, types.MasterMinter{Denom: "uusdc", Address: pauser})
+
+	require.NoError(t, ftf.ExecuteQueuedActions(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))))
+
+	_, found := ftf.GetPauser(ctx, "uusdc")
+	require.False(t, found)
+	got, found := ftf.GetBlacklister(ctx, "uusdc")
+	require.True(t, found)
+	require.Equal(t, blacklister, got.Address)
+	require.Empty(t, ftf.GetAllQueuedActions(ctx))
+}
+
+func TestRemoveMinterController_NotQueued(t *testing.T) {
+	ftf, ctx, msgServer, _ := setupTimelock()
+	masterMinter := sample.AccAddress()
+	controller := sample.AccAddress()
+	ftf.SetMasterMinter(ctx, types.MasterMinter{Denom: "uusdc", Address: masterMinter})
+	ftf.SetMinterController(ctx, types.MinterController{Denom: "uusdc", Controller: controller, Minter: sample.AccAddress()})
+
+	_, err := msgServer.RemoveMinterController(ctx, &types.MsgRemoveMinterController{From: masterMinter, Controller: sample.AccAddress(), Denom: "uusdc"})
+	require.ErrorIs(t, err, types.ErrUserNotFound)
+
+	// revoking a minter controller applies without the role change delay
+	_, err = msgServer.RemoveMinterController(ctx, &types.MsgRemoveMinterController{From: masterMinter, Controller: controller, Denom: "uusdc"})
+	require.NoError(t, err)
+
+	_, found := ftf.GetMinterController(ctx, "uusdc", controller)
+	require.False(t, found)
+	require.Empty(t, ftf.GetAllQueuedActions(ctx))
+}
+
+func TestExecuteQueuedActions_DropsActionOfReplacedRole(t *testing.T) {
+	ftf, ctx, msgServer, owner := setupTimelock()
+	masterMinter := sample.AccAddress()
+	controller := sample.AccAddress()
+	ftf.SetMasterMinter(ctx, types.MasterMinter{Denom: "uusdc", Address: masterMinter})
+
+	_, err := msgServer.UpdatePauser(ctx, &types.MsgUpdatePauser{From: owner, Address: sample.AccAddress(), Denom: "uusdc"})
+	require.NoError(t, err)
+	_, err = msgServer.ConfigureMinterController(ctx, &types.MsgConfigureMinterController{From: masterMinter, Controller: controller, Minter: sample.AccAddress(), Denom: "uusdc"})
+	require.NoError(t, err)
+	require.Len(t, ftf.GetAllQueuedActions(ctx), 2)
+
+	// both roles changed hands while the actions were queued
+	ftf.SetOwner(ctx, types.Owner{Denom: "uusdc", Address: sample.AccAddress()})
+	ftf.SetMasterMinter(ctx, types.MasterMinter{Denom: "uusdc", Address: sample.AccAddress()})
+
+	require.NoError(t, ftf.ExecuteQueuedActions(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))))
+
+	_, found := ftf.GetPauser(ctx, "uusdc")
+	require.False(t, found)
+	_, found = ftf.GetMinterController(ctx, "uusdc", controller)
+	require.False(t, found)
+	require.Empty(t, ftf.GetAllQueuedActions(ctx))
+}
+
+func TestApproveAction_QueuesRoleChange(t *testing.T) {
+	ftf, ctx, msgServer, signers := setupSignerSet(t)
+	params := ftf.GetParams(ctx)
+	params.RoleChangeDelay = time.Hour
+	ftf.SetParams(ctx, params)
+	masterMinter := sample.AccAddress()
+
+	_, err := msgServer.UpdateMasterMinter(ctx, &types.MsgUpdateMasterMinter{From: signers[0], Address: masterMinter, Denom: "uusdc"})
+	require.NoError(t, err)
+	id := ftf.GetAllActionProposals(ctx)[0].Id
+
+	res, err := msgServer.ApproveAction(ctx, &types.MsgApproveAction{From: signers[1], Id: id})
+	require.NoError(t, err)
+	require.True(t, res.Executed)
+
+	_, found := ftf.GetMasterMinter(ctx, "uusdc")
+	require.False(t, found)
+	require.Len(t, ftf.GetAllQueuedActions(ctx), 1)
+
+	require.NoError(t, ftf.ExecuteQueuedActions(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))))
+	got, found := ftf.GetMasterMinter(ctx, "uusdc")
+	require.True(t, found)
+	require.Equal(t, masterMinter, got.Address)
+}
+
+func TestExecuteQueuedActions_DropsActionOfRemovedSigner(t *testing.T) {
+	ftf, ctx, msgServer, signers := setupSignerSet(t)
+	params := ftf.GetParams(ctx)
+	params.RoleChangeDelay = time.Hour
+	ftf.SetParams(ctx, params)
+
+	_, err := msgServer.UpdateMasterMinter(ctx, &types.MsgUpdateMasterMinter{From: signers[0], Address: sample.AccAddress(), Denom: "uusdc"})
+	require.NoError(t, err)
+	id := ftf.GetAllActionProposals(ctx)[0].Id
+	_, err = msgServer.ApproveAction(ctx, &types.MsgApproveAction{From: signers[1], Id: id})
+	require.NoError(t, err)
+
+	// the proposer left the signer set while the action was queued
+	ftf.SetSignerSet(ctx, types.SignerSet{Denom: "uusdc", Role: types.RoleOwner, Signers: signers[1:], Threshold: 2})
+
+	require.NoError(t, ftf.ExecuteQueuedActions(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))))
+	_, found := ftf.GetMasterMinter(ctx, "uusdc")
+	require.False(t, found)
+	require.Empty(t, ftf.GetAllQueuedActions(ctx))
+}
+
+func TestSetSignerSet_Queued(t *testing.T) {
+	ftf, ctx, msgServer, owner := setupTimelock()
+	signers := []string{sample.AccAddress(), sample.AccAddress()}
+
+	_, err := msgServer.SetSignerSet(ctx, &types.MsgSetSignerSet{From: owner, Denom: "uusdc", Role: types.RoleOwner, Signers: signers, Threshold: 2})
+	require.NoError(t, err)
+
+	_, found := ftf.GetSignerSet(ctx, "uusdc", types.RoleOwner)
+	require.False(t, found)
+	queued := ftf.GetAllQueuedActions(ctx)
+	require.Len(t, queued, 1)
+	require.Equal(t, types.ActionSetSignerSet, queued[0].ActionName())
+
+	require.NoError(t, ftf.ExecuteQueuedActions(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))))
+	got, found := ftf.GetSignerSet(ctx, "uusdc", types.RoleOwner)
+	require.True(t, found)
+	require.Equal(t, signers, got.Signers)
+	require.Empty(t, ftf.GetAllQueuedActions(ctx))
+}
+
+func TestExecuteQueuedActions_OnlyDue(t *testing.T) {
+	ftf, ctx, msgServer, owner := setupTimelock()
+	pauser, blacklister := sample.AccAddress(), sample.AccAddress()
+
+	_, err := msgServer.UpdatePauser(ctx, &types.MsgUpdatePauser{From: owner, Address: pauser, Denom: "uusdc"})
+	require.NoError(t, err)
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(30 * time.Minute))
+	_, err = msgServer.UpdateBlacklister(later, &types.MsgUpdateBlacklister{From: owner, Address: blacklister, Denom: "uusdc"})
+	require.NoError(t, err)
+
+	// only the first action is due
+	require.NoError(t, ftf.ExecuteQueuedActions(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))))
+	_, found := ftf.GetPauser(ctx, "uusdc")
+	require.True(t, found)
+	_, found = ftf.GetBlacklister(ctx, "uusdc")
+	require.False(t, found)
+	require.Len(t, ftf.GetAllQueuedActions(ctx), 1)
+
+	// rescheduling the remaining action moves it in the index
+	action := ftf.GetAllQueuedActions(ctx)[0]
+	action.ExecuteAt = action.ExecuteAt.Add(time.Hour)
+	ftf.SetQueuedAction(ctx, action)
+	require.NoError(t, ftf.ExecuteQueuedActions(ctx.WithBlockTime(ctx.BlockTime().Add(90*time.Minute))))
+	_, found = ftf.GetBlacklister(ctx, "uusdc")
+	require.False(t, found)
+
+	require.NoError(t, ftf.ExecuteQueuedActions(ctx.WithBlockTime(action.ExecuteAt)))
+	got, found := ftf.GetBlacklister(ctx, "uusdc")
+	require.True(t, found)
+	require.Equal(t, blacklister, got.Address)
+	require.Empty(t, ftf.GetAllQueuedActions(ctx))
+}
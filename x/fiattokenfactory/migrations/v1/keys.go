@@ -0,0 +1,53 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+// Store keys of the v1 layout of the module state, where a single minting
+// denom was supported and every entry was stored under a string prefix.
+const (
+	MintingDenomKey = "MintingDenom/value/"
+
+	PausedKey       = "Paused/value/"
+	MasterMinterKey = "MasterMinter/value/"
+	PauserKey       = "Pauser/value/"
+	BlacklisterKey  = "Blacklister/value/"
+	OwnerKey        = "Owner/value/"
+	PendingOwnerKey = "PendingOwner/value/"
+
+	BlacklistedKeyPrefix      = "Blacklisted/value/"
+	MintersKeyPrefix          = "Minters/value/"
+	MinterControllerKeyPrefix = "MinterController/value/"
+)
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
+
+// BlacklistedKey returns the v1 store key of a Blacklisted from the index fields
+func BlacklistedKey(addressBz []byte) []byte {
+	return append(addressBz, []byte("/")...)
+}
+
+// MintersKey returns the v1 store key of a Minters from the index fields
+func MintersKey(address string) []byte {
+	return append([]byte(address), []byte("/")...)
+}
+
+// MinterControllerKey returns the v1 store key of a MinterController from the index fields
+func MinterControllerKey(controllerAddress string) []byte {
+	return append([]byte(controllerAddress), []byte("/")...)
+}
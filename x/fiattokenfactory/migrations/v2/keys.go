@@ -0,0 +1,67 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import "github.com/cosmos/cosmos-sdk/types/address"
+
+// Store keys of the v2 layout of the module state, where every entry was
+// stored under a string prefix followed by the length-prefixed key of its
+// minting denom.
+const (
+	MintingDenomKeyPrefix = "MintingDenoms/value/"
+
+	PausedKeyPrefix       = "Paused/value/"
+	MasterMinterKeyPrefix = "MasterMinter/value/"
+	PauserKeyPrefix       = "Pauser/value/"
+	BlacklisterKeyPrefix  = "Blacklister/value/"
+	OwnerKeyPrefix        = "Owner/value/"
+	PendingOwnerKeyPrefix = "PendingOwner/value/"
+
+	BlacklistedKeyPrefix      = "Blacklisted/value/"
+	MintersKeyPrefix          = "Minters/value/"
+	MinterControllerKeyPrefix = "MinterController/value/"
+)
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
+
+// DenomKey returns the v2 length-prefixed store key of a minting denom.
+func DenomKey(denom string) []byte {
+	return address.MustLengthPrefix([]byte(denom))
+}
+
+// DenomKeyPrefix returns the v2 store prefix under which the entries of a
+// given minting denom are stored.
+func DenomKeyPrefix(p string, denom string) []byte {
+	return append(KeyPrefix(p), DenomKey(denom)...)
+}
+
+// BlacklistedKey returns the v2 store key of a Blacklisted from the index fields
+func BlacklistedKey(addressBz []byte) []byte {
+	return append(addressBz, []byte("/")...)
+}
+
+// MintersKey returns the v2 store key of a Minters from the index fields
+func MintersKey(address string) []byte {
+	return append([]byte(address), []byte("/")...)
+}
+
+// MinterControllerKey returns the v2 store key of a MinterController from the index fields
+func MinterControllerKey(controllerAddress string) []byte {
+	return append([]byte(controllerAddress), []byte("/")...)
+}
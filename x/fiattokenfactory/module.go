@@ -37,13 +37,15 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 )
 
 // ConsensusVersion defines the current x/fiattokenfactory module consensus version.
-const ConsensusVersion = 1
+const ConsensusVersion = 4
 
 var (
 	_ module.AppModuleBasic      = AppModule{}
@@ -51,6 +53,7 @@ var (
 	_ module.HasConsensusVersion = AppModule{}
 	_ module.HasGenesis          = AppModule{}
 	_ module.HasServices         = AppModule{}
+	_ appmodule.HasEndBlocker    = AppModule{}
 )
 
 // ----------------------------------------------------------------------------
@@ -140,17 +143,68 @@ func (m AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawM
 	return cdc.MustMarshalJSON(genesis)
 }
 
+// EndBlock executes the queued actions whose delay elapsed, lifts the
+// blacklistings and pauses that expired and prunes the audit log entries that
+// exceeded their retention and the action proposals that expired.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	if err := am.keeper.ExecuteQueuedActions(ctx); err != nil {
+		return err
+	}
+	if err := am.keeper.ExpireBlacklisted(ctx); err != nil {
+		return err
+	}
+	if err := am.keeper.UnpauseExpired(ctx); err != nil {
+		return err
+	}
+	if err := am.keeper.PruneAuditLog(ctx); err != nil {
+		return err
+	}
+	return am.keeper.PruneActionProposals(ctx)
+}
+
 // RegisterServices registers a gRPC query service to respond to the module-specific gRPC queries
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 3 to 4: %v", types.ModuleName, err))
+	}
 }
 
 func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 	return &autocliv1.ModuleOptions{
 		Tx: &autocliv1.ServiceCommandDescriptor{
-			Service:              fiattokenfactoryv1.Msg_ServiceDesc.ServiceName,
-			RpcCommandOptions:    []*autocliv1.RpcCommandOptions{},
+			Service: fiattokenfactoryv1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "ForceRoleAssignment",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RegisterMintingDenom",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "ReleaseEscrow",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "BurnEscrow",
+					Skip:      true, // skipped because authority gated
+				},
+			},
 			EnhanceCustomCommand: true,
 		},
 		Query: &autocliv1.ServiceCommandDescriptor{
@@ -195,10 +249,17 @@ type ModuleOutputs struct {
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
 	k := keeper.NewKeeper(
 		in.Cdc,
 		in.Logger,
 		in.StoreService,
+		authority.String(),
 		in.BankKeeper,
 	)
 	m := NewAppModule(k, in.BankKeeper)
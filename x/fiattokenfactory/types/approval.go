@@ -0,0 +1,118 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateSignerSetRole checks that a role can be configured as a signer set.
+// Only the roles authorizing sensitive actions support signer sets.
+func ValidateSignerSetRole(role string) error {
+	switch role {
+	case RoleOwner, RoleMasterMinter, RolePauser:
+		return nil
+	default:
+		return errors.Wrapf(ErrInvalidSignerSet, "role %s does not support signer sets", role)
+	}
+}
+
+// ValidateSigners checks that a set of signers holds unique valid addresses
+// and a threshold that the signers can meet.
+func ValidateSigners(signers []string, threshold uint32) error {
+	if len(signers) == 0 {
+		return errors.Wrap(ErrInvalidSignerSet, "signers cannot be empty")
+	}
+
+	seen := make(map[string]struct{})
+	for _, signer := range signers {
+		if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+			return errors.Wrapf(ErrInvalidAddress, "invalid signer address (%s)", err)
+		}
+		if _, ok := seen[signer]; ok {
+			return errors.Wrapf(ErrInvalidSignerSet, "duplicated signer %s", signer)
+		}
+		seen[signer] = struct{}{}
+	}
+
+	if threshold == 0 || int(threshold) > len(signers) {
+		return errors.Wrapf(ErrInvalidSignerSet, "threshold must be between 1 and %d", len(signers))
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the signer set.
+func (s SignerSet) Validate() error {
+	if err := ValidateSignerSetRole(s.Role); err != nil {
+		return err
+	}
+	return ValidateSigners(s.Signers, s.Threshold)
+}
+
+// IsSigner reports whether an address is a member of the signer set.
+func (s SignerSet) IsSigner(address string) bool {
+	return slices.Contains(s.Signers, address)
+}
+
+// ActionName returns the audit log action of the proposed action.
+func (p ActionProposal) ActionName() string {
+	switch p.Action.(type) {
+	case *ActionProposal_UpdateOwner:
+		return ActionUpdateOwner
+	case *ActionProposal_UpdateMasterMinter:
+		return ActionUpdateMasterMinter
+	case *ActionProposal_ConfigureMinterController:
+		return ActionConfigureMinterController
+	case *ActionProposal_Unpause:
+		return ActionUnpause
+	case *ActionProposal_SetSignerSet:
+		return ActionSetSignerSet
+	case *ActionProposal_UpdatePauser:
+		return ActionUpdatePauser
+	case *ActionProposal_UpdateBlacklister:
+		return ActionUpdateBlacklister
+	case *ActionProposal_UpdateAllowlister:
+		return ActionUpdateAllowlister
+	case *ActionProposal_SetChannelPolicy:
+		return ActionSetChannelPolicy
+	case *ActionProposal_SetChannelRateLimit:
+		return ActionSetChannelRateLimit
+	case *ActionProposal_SetAllowlistMode:
+		return ActionSetAllowlistMode
+	case *ActionProposal_CancelQueuedAction:
+		return ActionCancelQueuedAction
+	case *ActionProposal_CancelOwnershipTransfer:
+		return ActionCancelOwnershipTransfer
+	case *ActionProposal_RemoveChannelPolicy:
+		return ActionRemoveChannelPolicy
+	default:
+		return ""
+	}
+}
+
+// Validate performs a basic validation of the action proposal.
+func (p ActionProposal) Validate() error {
+	if p.ActionName() == "" {
+		return fmt.Errorf("action proposal %d has no action", p.Id)
+	}
+	return ValidateSignerSetRole(p.Role)
+}
@@ -0,0 +1,2103 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: circle/fiattokenfactory/v1/approval.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SignerSet configures a role of a minting denom as an M-of-N set of signers.
+// Sensitive actions of the role are then executed through an ActionProposal
+// once threshold signers approved it. Once the owner is a signer set, every
+// Msg of the owner goes through an ActionProposal and the owner address can
+// no longer act alone.
+type SignerSet struct {
+	Denom     string   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Role      string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Signers   []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+	Threshold uint32   `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (m *SignerSet) Reset()         { *m = SignerSet{} }
+func (m *SignerSet) String() string { return proto.CompactTextString(m) }
+func (*SignerSet) ProtoMessage()    {}
+func (*SignerSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75ce9e3013167b09, []int{0}
+}
+func (m *SignerSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignerSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSet.Merge(m, src)
+}
+func (m *SignerSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerSet proto.InternalMessageInfo
+
+func (m *SignerSet) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *SignerSet) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *SignerSet) GetSigners() []string {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *SignerSet) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+// ActionProposal is a sensitive action awaiting the approval of a signer set.
+type ActionProposal struct {
+	Id        uint64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Denom     string    `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Role      string    `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Proposer  string    `protobuf:"bytes,4,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Approvals []string  `protobuf:"bytes,5,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	Expiry    time.Time `protobuf:"bytes,6,opt,name=expiry,proto3,stdtime" json:"expiry"`
+	// Types that are valid to be assigned to Action:
+	//	*ActionProposal_UpdateOwner
+	//	*ActionProposal_UpdateMasterMinter
+	//	*ActionProposal_ConfigureMinterController
+	//	*ActionProposal_Unpause
+	//	*ActionProposal_SetSignerSet
+	//	*ActionProposal_UpdatePauser
+	//	*ActionProposal_UpdateBlacklister
+	//	*ActionProposal_UpdateAllowlister
+	//	*ActionProposal_SetChannelPolicy
+	//	*ActionProposal_SetChannelRateLimit
+	//	*ActionProposal_SetAllowlistMode
+	//	*ActionProposal_CancelQueuedAction
+	//	*ActionProposal_CancelOwnershipTransfer
+	//	*ActionProposal_RemoveChannelPolicy
+	Action isActionProposal_Action `protobuf_oneof:"action"`
+}
+
+func (m *ActionProposal) Reset()         { *m = ActionProposal{} }
+func (m *ActionProposal) String() string { return proto.CompactTextString(m) }
+func (*ActionProposal) ProtoMessage()    {}
+func (*ActionProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75ce9e3013167b09, []int{1}
+}
+func (m *ActionProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActionProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActionProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActionProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionProposal.Merge(m, src)
+}
+func (m *ActionProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActionProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionProposal proto.InternalMessageInfo
+
+type isActionProposal_Action interface {
+	isActionProposal_Action()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type ActionProposal_UpdateOwner struct {
+	UpdateOwner *MsgUpdateOwner `protobuf:"bytes,10,opt,name=updateOwner,proto3,oneof" json:"updateOwner,omitempty"`
+}
+type ActionProposal_UpdateMasterMinter struct {
+	UpdateMasterMinter *MsgUpdateMasterMinter `protobuf:"bytes,11,opt,name=updateMasterMinter,proto3,oneof" json:"updateMasterMinter,omitempty"`
+}
+type ActionProposal_ConfigureMinterController struct {
+	ConfigureMinterController *MsgConfigureMinterController `protobuf:"bytes,12,opt,name=configureMinterController,proto3,oneof" json:"configureMinterController,omitempty"`
+}
+type ActionProposal_Unpause struct {
+	Unpause *MsgUnpause `protobuf:"bytes,13,opt,name=unpause,proto3,oneof" json:"unpause,omitempty"`
+}
+type ActionProposal_SetSignerSet struct {
+	SetSignerSet *MsgSetSignerSet `protobuf:"bytes,14,opt,name=setSignerSet,proto3,oneof" json:"setSignerSet,omitempty"`
+}
+type ActionProposal_UpdatePauser struct {
+	UpdatePauser *MsgUpdatePauser `protobuf:"bytes,15,opt,name=updatePauser,proto3,oneof" json:"updatePauser,omitempty"`
+}
+type ActionProposal_UpdateBlacklister struct {
+	UpdateBlacklister *MsgUpdateBlacklister `protobuf:"bytes,16,opt,name=updateBlacklister,proto3,oneof" json:"updateBlacklister,omitempty"`
+}
+type ActionProposal_UpdateAllowlister struct {
+	UpdateAllowlister *MsgUpdateAllowlister `protobuf:"bytes,17,opt,name=updateAllowlister,proto3,oneof" json:"updateAllowlister,omitempty"`
+}
+type ActionProposal_SetChannelPolicy struct {
+	SetChannelPolicy *MsgSetChannelPolicy `protobuf:"bytes,18,opt,name=setChannelPolicy,proto3,oneof" json:"setChannelPolicy,omitempty"`
+}
+type ActionProposal_SetChannelRateLimit struct {
+	SetChannelRateLimit *MsgSetChannelRateLimit `protobuf:"bytes,19,opt,name=setChannelRateLimit,proto3,oneof" json:"setChannelRateLimit,omitempty"`
+}
+type ActionProposal_SetAllowlistMode struct {
+	SetAllowlistMode *MsgSetAllowlistMode `protobuf:"bytes,20,opt,name=setAllowlistMode,proto3,oneof" json:"setAllowlistMode,omitempty"`
+}
+type ActionProposal_CancelQueuedAction struct {
+	CancelQueuedAction *MsgCancelQueuedAction `protobuf:"bytes,21,opt,name=cancelQueuedAction,proto3,oneof" json:"cancelQueuedAction,omitempty"`
+}
+type ActionProposal_CancelOwnershipTransfer struct {
+	CancelOwnershipTransfer *MsgCancelOwnershipTransfer `protobuf:"bytes,22,opt,name=cancelOwnershipTransfer,proto3,oneof" json:"cancelOwnershipTransfer,omitempty"`
+}
+type ActionProposal_RemoveChannelPolicy struct {
+	RemoveChannelPolicy *MsgRemoveChannelPolicy `protobuf:"bytes,23,opt,name=removeChannelPolicy,proto3,oneof" json:"removeChannelPolicy,omitempty"`
+}
+
+func (*ActionProposal_UpdateOwner) isActionProposal_Action()               {}
+func (*ActionProposal_UpdateMasterMinter) isActionProposal_Action()        {}
+func (*ActionProposal_ConfigureMinterController) isActionProposal_Action() {}
+func (*ActionProposal_Unpause) isActionProposal_Action()                   {}
+func (*ActionProposal_SetSignerSet) isActionProposal_Action()              {}
+func (*ActionProposal_UpdatePauser) isActionProposal_Action()              {}
+func (*ActionProposal_UpdateBlacklister) isActionProposal_Action()         {}
+func (*ActionProposal_UpdateAllowlister) isActionProposal_Action()         {}
+func (*ActionProposal_SetChannelPolicy) isActionProposal_Action()          {}
+func (*ActionProposal_SetChannelRateLimit) isActionProposal_Action()       {}
+func (*ActionProposal_SetAllowlistMode) isActionProposal_Action()          {}
+func (*ActionProposal_CancelQueuedAction) isActionProposal_Action()        {}
+func (*ActionProposal_CancelOwnershipTransfer) isActionProposal_Action()   {}
+func (*ActionProposal_RemoveChannelPolicy) isActionProposal_Action()       {}
+
+func (m *ActionProposal) GetAction() isActionProposal_Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (m *ActionProposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ActionProposal) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ActionProposal) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *ActionProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *ActionProposal) GetApprovals() []string {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *ActionProposal) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
+func (m *ActionProposal) GetUpdateOwner() *MsgUpdateOwner {
+	if x, ok := m.GetAction().(*ActionProposal_UpdateOwner); ok {
+		return x.UpdateOwner
+	}
+	return nil
+}
+
+func (m *ActionProposal) GetUpdateMasterMinter() *MsgUpdateMasterMinter {
+	if x, ok := m.GetAction().(*ActionProposal_UpdateMasterMinter); ok {
+		return x.UpdateMasterMinter
+	}
+	return nil
+}
+
+func (m *ActionProposal) GetConfigureMinterController() *MsgConfigureMinterController {
+	if x, ok := m.GetAction().(*ActionProposal_ConfigureMinterController); ok {
+		return x.ConfigureMinterController
+	}
+	return nil
+}
+
+func (m *ActionProposal) GetUnpause() *MsgUnpause {
+	if x, ok := m.GetAction().(*ActionProposal_Unpause); ok {
+		return x.Unpause
+	}
+	return nil
+}
+
+func (m *ActionProposal) GetSetSignerSet() *MsgSetSignerSet {
+	if x, ok := m.GetAction().(*ActionProposal_SetSignerSet); ok {
+		return x.SetSignerSet
+	}
+	return nil
+}
+
+func (m *ActionProposal) GetUpdatePauser() *MsgUpdatePauser {
+	if x, ok := m.GetAction().(*ActionProposal_UpdatePauser); ok {
+		return x.UpdatePauser
+	}
+	return nil
+}
+
+func (m *ActionProposal) GetUpdateBlacklister() *MsgUpdateBlacklister {
+	if x, ok := m.GetAction().(*ActionProposal_UpdateBlacklister); ok {
+		return x.UpdateBlacklister
+	}
+	return nil
+}
+
+func (m *ActionProposal) GetUpdateAllowlister() *MsgUpdateAllowlister {
+	if x, ok := m.GetAction().(*ActionProposal_UpdateAllowlister); ok {
+		return x.UpdateAllowlister
+	}
+	return nil
+}
+
+func (m *ActionProposal) GetSetChannelPolicy() *MsgSetChannelPolicy {
+	if x, ok := m.GetAction().(*ActionProposal_SetChannelPolicy); ok {
+		return x.SetChannelPolicy
+	}
+	return nil
+}
+
+func (m *ActionProposal) GetSetChannelRateLimit() *MsgSetChannelRateLimit {
+	if x, ok := m.GetAction().(*ActionProposal_SetChannelRateLimit); ok {
+		return x.SetChannelRateLimit
+	}
+	return nil
+}
+
+func (m *ActionProposal) GetSetAllowlistMode() *MsgSetAllowlistMode {
+	if x, ok := m.GetAction().(*ActionProposal_SetAllowlistMode); ok {
+		return x.SetAllowlistMode
+	}
+	return nil
+}
+
+func (m *ActionProposal) GetCancelQueuedAction() *MsgCancelQueuedAction {
+	if x, ok := m.GetAction().(*ActionProposal_CancelQueuedAction); ok {
+		return x.CancelQueuedAction
+	}
+	return nil
+}
+
+func (m *ActionProposal) GetCancelOwnershipTransfer() *MsgCancelOwnershipTransfer {
+	if x, ok := m.GetAction().(*ActionProposal_CancelOwnershipTransfer); ok {
+		return x.CancelOwnershipTransfer
+	}
+	return nil
+}
+
+func (m *ActionProposal) GetRemoveChannelPolicy() *MsgRemoveChannelPolicy {
+	if x, ok := m.GetAction().(*ActionProposal_RemoveChannelPolicy); ok {
+		return x.RemoveChannelPolicy
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ActionProposal) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ActionProposal_UpdateOwner)(nil),
+		(*ActionProposal_UpdateMasterMinter)(nil),
+		(*ActionProposal_ConfigureMinterController)(nil),
+		(*ActionProposal_Unpause)(nil),
+		(*ActionProposal_SetSignerSet)(nil),
+		(*ActionProposal_UpdatePauser)(nil),
+		(*ActionProposal_UpdateBlacklister)(nil),
+		(*ActionProposal_UpdateAllowlister)(nil),
+		(*ActionProposal_SetChannelPolicy)(nil),
+		(*ActionProposal_SetChannelRateLimit)(nil),
+		(*ActionProposal_SetAllowlistMode)(nil),
+		(*ActionProposal_CancelQueuedAction)(nil),
+		(*ActionProposal_CancelOwnershipTransfer)(nil),
+		(*ActionProposal_RemoveChannelPolicy)(nil),
+	}
+}
+
+func init() {
+	proto.RegisterType((*SignerSet)(nil), "circle.fiattokenfactory.v1.SignerSet")
+	proto.RegisterType((*ActionProposal)(nil), "circle.fiattokenfactory.v1.ActionProposal")
+}
+
+func init() {
+	proto.RegisterFile("circle/fiattokenfactory/v1/approval.proto", fileDescriptor_75ce9e3013167b09)
+}
+
+var fileDescriptor_75ce9e3013167b09 = []byte{
+	// 721 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xcd, 0x6e, 0xeb, 0x44,
+	0x14, 0xc7, 0xed, 0xb4, 0x4d, 0x9b, 0xe9, 0x07, 0xed, 0xb4, 0xd0, 0x21, 0x42, 0x69, 0x54, 0x24,
+	0x14, 0x40, 0xd8, 0xb4, 0x48, 0x88, 0x05, 0x9b, 0x26, 0x2c, 0xbc, 0x20, 0xd0, 0x4e, 0xdb, 0x0d,
+	0x12, 0x08, 0xc7, 0x39, 0x71, 0x46, 0x9d, 0x78, 0xac, 0xf1, 0x38, 0x4d, 0xde, 0xa2, 0x8f, 0xd5,
+	0x65, 0x97, 0xac, 0x00, 0xb5, 0x2f, 0x72, 0xe5, 0x71, 0x3e, 0x9c, 0xeb, 0xe4, 0xc6, 0x77, 0x37,
+	0xe7, 0x68, 0xfe, 0xbf, 0xff, 0x39, 0x67, 0x3c, 0x63, 0xf4, 0xb5, 0xc7, 0xa4, 0xc7, 0xc1, 0xee,
+	0x31, 0x57, 0x29, 0xf1, 0x00, 0x41, 0xcf, 0xf5, 0x94, 0x90, 0x63, 0x7b, 0x78, 0x61, 0xbb, 0x61,
+	0x28, 0xc5, 0xd0, 0xe5, 0x56, 0x28, 0x85, 0x12, 0xb8, 0x9a, 0x6e, 0xb5, 0xde, 0xdf, 0x6a, 0x0d,
+	0x2f, 0xaa, 0x5f, 0x7e, 0x00, 0xa3, 0x46, 0x29, 0xa0, 0x7a, 0xe2, 0x0b, 0x5f, 0xe8, 0xa5, 0x9d,
+	0xac, 0x26, 0xd9, 0x33, 0x5f, 0x08, 0x9f, 0x83, 0xad, 0xa3, 0x4e, 0xdc, 0xb3, 0x15, 0x1b, 0x40,
+	0xa4, 0xdc, 0x41, 0x98, 0x6e, 0x38, 0x1f, 0xa0, 0xca, 0x2d, 0xf3, 0x03, 0x90, 0xb7, 0xa0, 0xf0,
+	0x09, 0xda, 0xea, 0x42, 0x20, 0x06, 0xc4, 0xac, 0x9b, 0x8d, 0x0a, 0x4d, 0x03, 0x8c, 0xd1, 0xa6,
+	0x14, 0x1c, 0x48, 0x49, 0x27, 0xf5, 0x1a, 0x13, 0xb4, 0x1d, 0x69, 0x59, 0x44, 0x36, 0xea, 0x1b,
+	0x8d, 0x0a, 0x9d, 0x86, 0xf8, 0x0b, 0x54, 0x51, 0x7d, 0x09, 0x51, 0x5f, 0xf0, 0x2e, 0xd9, 0xac,
+	0x9b, 0x8d, 0x7d, 0x3a, 0x4f, 0x9c, 0x3f, 0xed, 0xa1, 0x83, 0x2b, 0x4f, 0x31, 0x11, 0x5c, 0x4b,
+	0x11, 0x8a, 0xc8, 0xe5, 0xf8, 0x00, 0x95, 0x58, 0x57, 0x3b, 0x6e, 0xd2, 0x12, 0xeb, 0xce, 0x8b,
+	0x28, 0x2d, 0x2b, 0x62, 0x23, 0x53, 0x44, 0x15, 0xed, 0x84, 0x9a, 0x02, 0x52, 0x3b, 0x55, 0xe8,
+	0x2c, 0x4e, 0xca, 0x98, 0x4e, 0x38, 0x22, 0x5b, 0xba, 0xc4, 0x79, 0x02, 0xff, 0x8c, 0xca, 0x30,
+	0x0a, 0x99, 0x1c, 0x93, 0x72, 0xdd, 0x6c, 0xec, 0x5e, 0x56, 0xad, 0x74, 0x4e, 0xd6, 0x74, 0x4e,
+	0xd6, 0xdd, 0x74, 0x4e, 0xcd, 0x9d, 0xe7, 0x7f, 0xcf, 0x8c, 0xa7, 0xff, 0xce, 0x4c, 0x3a, 0xd1,
+	0xe0, 0xdf, 0xd0, 0x6e, 0x1c, 0x76, 0x5d, 0x05, 0xbf, 0x3f, 0x06, 0x20, 0x09, 0xd2, 0x88, 0x6f,
+	0xac, 0xd5, 0x27, 0x68, 0xb5, 0x23, 0xff, 0x7e, 0xae, 0x70, 0x0c, 0x9a, 0x05, 0x60, 0x0f, 0xe1,
+	0x34, 0x6c, 0xbb, 0x91, 0x02, 0xd9, 0x66, 0x81, 0x02, 0x49, 0x76, 0x35, 0xf6, 0xa2, 0x10, 0x36,
+	0x2b, 0x74, 0x0c, 0xba, 0x04, 0x87, 0x47, 0xe8, 0x73, 0x4f, 0x04, 0x3d, 0xe6, 0xc7, 0x12, 0xd2,
+	0x54, 0x4b, 0x04, 0x4a, 0x0a, 0xce, 0x41, 0x92, 0x3d, 0xed, 0xf5, 0xd3, 0x1a, 0xaf, 0xd6, 0x2a,
+	0xbd, 0x63, 0xd0, 0xd5, 0x70, 0xdc, 0x44, 0xdb, 0x71, 0x10, 0xba, 0x71, 0x04, 0x64, 0x5f, 0xfb,
+	0x7c, 0xb5, 0xae, 0xa7, 0x74, 0xb7, 0x63, 0xd0, 0xa9, 0x10, 0xdf, 0xa0, 0xbd, 0x08, 0xd4, 0xec,
+	0x4b, 0x25, 0x07, 0x1a, 0xf4, 0xed, 0x1a, 0xd0, 0x6d, 0x46, 0xe2, 0x18, 0x74, 0x01, 0x91, 0x20,
+	0xd3, 0x31, 0x5d, 0x27, 0x0e, 0x92, 0x7c, 0x52, 0x08, 0x79, 0x9f, 0x91, 0x24, 0xc8, 0x2c, 0x02,
+	0xff, 0x8d, 0x8e, 0xd2, 0xb8, 0xc9, 0x5d, 0xef, 0x81, 0xb3, 0x64, 0xfc, 0xe4, 0x50, 0x73, 0xbf,
+	0x2f, 0xc4, 0xcd, 0xe8, 0x1c, 0x83, 0xe6, 0x61, 0x73, 0x87, 0x2b, 0xce, 0xc5, 0xe3, 0xc4, 0xe1,
+	0xe8, 0x23, 0x1c, 0x32, 0xba, 0xb9, 0x43, 0x26, 0x89, 0xff, 0x44, 0x87, 0x11, 0xa8, 0x56, 0xdf,
+	0x0d, 0x02, 0xe0, 0xd7, 0x82, 0x33, 0x6f, 0x4c, 0xb0, 0x36, 0xb0, 0xd7, 0x4f, 0x7b, 0x41, 0xe6,
+	0x18, 0x34, 0x87, 0xc2, 0x3d, 0x74, 0x3c, 0xcf, 0x51, 0x57, 0xc1, 0xaf, 0x6c, 0xc0, 0x14, 0x39,
+	0xd6, 0x0e, 0x97, 0x85, 0x1d, 0x66, 0x4a, 0xc7, 0xa0, 0xcb, 0x80, 0x93, 0x36, 0x66, 0x8d, 0xb5,
+	0x45, 0x17, 0xc8, 0x49, 0xd1, 0x36, 0x16, 0x64, 0x93, 0x36, 0x16, 0x72, 0xc9, 0x95, 0xf5, 0xdc,
+	0xc0, 0x03, 0x7e, 0x13, 0x43, 0x0c, 0xdd, 0xf4, 0x49, 0x23, 0x9f, 0x16, 0xba, 0xb2, 0xad, 0x9c,
+	0x30, 0xb9, 0xb2, 0x79, 0x1c, 0x96, 0xe8, 0x34, 0xcd, 0xea, 0x67, 0x22, 0xea, 0xb3, 0xf0, 0x4e,
+	0xba, 0x41, 0xd4, 0x03, 0x49, 0x3e, 0xd3, 0x4e, 0x3f, 0x16, 0x72, 0xca, 0xa9, 0x1d, 0x83, 0xae,
+	0x02, 0x27, 0xe7, 0x23, 0x61, 0x20, 0x86, 0xb0, 0xf8, 0x05, 0x9c, 0x16, 0x3a, 0x1f, 0x9a, 0x57,
+	0x26, 0xe7, 0xb3, 0x04, 0xd8, 0xdc, 0x41, 0x65, 0x57, 0x77, 0xd9, 0xfc, 0xeb, 0xf9, 0xb5, 0x66,
+	0xbe, 0xbc, 0xd6, 0xcc, 0xff, 0x5f, 0x6b, 0xe6, 0xd3, 0x5b, 0xcd, 0x78, 0x79, 0xab, 0x19, 0xff,
+	0xbc, 0xd5, 0x8c, 0x3f, 0x7e, 0xf1, 0x99, 0xea, 0xc7, 0x1d, 0xcb, 0x13, 0x03, 0x3b, 0x35, 0xee,
+	0xb1, 0xc0, 0x0e, 0x44, 0x87, 0xc3, 0x77, 0xb9, 0x7f, 0xe1, 0x28, 0xff, 0x7b, 0x54, 0xe3, 0x10,
+	0xa2, 0x4e, 0x59, 0xbf, 0xe9, 0x3f, 0xbc, 0x1b, 0x00, 0xe8, 0xa5, 0x6d, 0x7b, 0x8d, 0x07, 0x00,
+	0x00,
+}
+
+func (m *SignerSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintApproval(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintApproval(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintApproval(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintApproval(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActionProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActionProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Action != nil {
+		{
+			size := m.Action.Size()
+			i -= size
+			if _, err := m.Action.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintApproval(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvals[iNdEx])
+			copy(dAtA[i:], m.Approvals[iNdEx])
+			i = encodeVarintApproval(dAtA, i, uint64(len(m.Approvals[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintApproval(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintApproval(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintApproval(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintApproval(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActionProposal_UpdateOwner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionProposal_UpdateOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UpdateOwner != nil {
+		{
+			size, err := m.UpdateOwner.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApproval(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ActionProposal_UpdateMasterMinter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionProposal_UpdateMasterMinter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UpdateMasterMinter != nil {
+		{
+			size, err := m.UpdateMasterMinter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApproval(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ActionProposal_ConfigureMinterController) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionProposal_ConfigureMinterController) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ConfigureMinterController != nil {
+		{
+			size, err := m.ConfigureMinterController.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApproval(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ActionProposal_Unpause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionProposal_Unpause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Unpause != nil {
+		{
+			size, err := m.Unpause.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApproval(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ActionProposal_SetSignerSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionProposal_SetSignerSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SetSignerSet != nil {
+		{
+			size, err := m.SetSignerSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApproval(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ActionProposal_UpdatePauser) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionProposal_UpdatePauser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UpdatePauser != nil {
+		{
+			size, err := m.UpdatePauser.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApproval(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ActionProposal_UpdateBlacklister) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionProposal_UpdateBlacklister) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UpdateBlacklister != nil {
+		{
+			size, err := m.UpdateBlacklister.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApproval(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ActionProposal_UpdateAllowlister) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionProposal_UpdateAllowlister) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UpdateAllowlister != nil {
+		{
+			size, err := m.UpdateAllowlister.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApproval(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ActionProposal_SetChannelPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionProposal_SetChannelPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SetChannelPolicy != nil {
+		{
+			size, err := m.SetChannelPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApproval(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ActionProposal_SetChannelRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionProposal_SetChannelRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SetChannelRateLimit != nil {
+		{
+			size, err := m.SetChannelRateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApproval(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ActionProposal_SetAllowlistMode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionProposal_SetAllowlistMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SetAllowlistMode != nil {
+		{
+			size, err := m.SetAllowlistMode.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApproval(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ActionProposal_CancelQueuedAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionProposal_CancelQueuedAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CancelQueuedAction != nil {
+		{
+			size, err := m.CancelQueuedAction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApproval(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ActionProposal_CancelOwnershipTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionProposal_CancelOwnershipTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CancelOwnershipTransfer != nil {
+		{
+			size, err := m.CancelOwnershipTransfer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApproval(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ActionProposal_RemoveChannelPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionProposal_RemoveChannelPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RemoveChannelPolicy != nil {
+		{
+			size, err := m.RemoveChannelPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApproval(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	return len(dAtA) - i, nil
+}
+func encodeVarintApproval(dAtA []byte, offset int, v uint64) int {
+	offset -= sovApproval(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SignerSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovApproval(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovApproval(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovApproval(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovApproval(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *ActionProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovApproval(uint64(m.Id))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovApproval(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovApproval(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovApproval(uint64(l))
+	}
+	if len(m.Approvals) > 0 {
+		for _, s := range m.Approvals {
+			l = len(s)
+			n += 1 + l + sovApproval(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovApproval(uint64(l))
+	if m.Action != nil {
+		n += m.Action.Size()
+	}
+	return n
+}
+
+func (m *ActionProposal_UpdateOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdateOwner != nil {
+		l = m.UpdateOwner.Size()
+		n += 1 + l + sovApproval(uint64(l))
+	}
+	return n
+}
+func (m *ActionProposal_UpdateMasterMinter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdateMasterMinter != nil {
+		l = m.UpdateMasterMinter.Size()
+		n += 1 + l + sovApproval(uint64(l))
+	}
+	return n
+}
+func (m *ActionProposal_ConfigureMinterController) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConfigureMinterController != nil {
+		l = m.ConfigureMinterController.Size()
+		n += 1 + l + sovApproval(uint64(l))
+	}
+	return n
+}
+func (m *ActionProposal_Unpause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Unpause != nil {
+		l = m.Unpause.Size()
+		n += 1 + l + sovApproval(uint64(l))
+	}
+	return n
+}
+func (m *ActionProposal_SetSignerSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SetSignerSet != nil {
+		l = m.SetSignerSet.Size()
+		n += 1 + l + sovApproval(uint64(l))
+	}
+	return n
+}
+func (m *ActionProposal_UpdatePauser) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdatePauser != nil {
+		l = m.UpdatePauser.Size()
+		n += 1 + l + sovApproval(uint64(l))
+	}
+	return n
+}
+func (m *ActionProposal_UpdateBlacklister) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdateBlacklister != nil {
+		l = m.UpdateBlacklister.Size()
+		n += 2 + l + sovApproval(uint64(l))
+	}
+	return n
+}
+func (m *ActionProposal_UpdateAllowlister) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdateAllowlister != nil {
+		l = m.UpdateAllowlister.Size()
+		n += 2 + l + sovApproval(uint64(l))
+	}
+	return n
+}
+func (m *ActionProposal_SetChannelPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SetChannelPolicy != nil {
+		l = m.SetChannelPolicy.Size()
+		n += 2 + l + sovApproval(uint64(l))
+	}
+	return n
+}
+func (m *ActionProposal_SetChannelRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SetChannelRateLimit != nil {
+		l = m.SetChannelRateLimit.Size()
+		n += 2 + l + sovApproval(uint64(l))
+	}
+	return n
+}
+func (m *ActionProposal_SetAllowlistMode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SetAllowlistMode != nil {
+		l = m.SetAllowlistMode.Size()
+		n += 2 + l + sovApproval(uint64(l))
+	}
+	return n
+}
+func (m *ActionProposal_CancelQueuedAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CancelQueuedAction != nil {
+		l = m.CancelQueuedAction.Size()
+		n += 2 + l + sovApproval(uint64(l))
+	}
+	return n
+}
+func (m *ActionProposal_CancelOwnershipTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CancelOwnershipTransfer != nil {
+		l = m.CancelOwnershipTransfer.Size()
+		n += 2 + l + sovApproval(uint64(l))
+	}
+	return n
+}
+func (m *ActionProposal_RemoveChannelPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RemoveChannelPolicy != nil {
+		l = m.RemoveChannelPolicy.Size()
+		n += 2 + l + sovApproval(uint64(l))
+	}
+	return n
+}
+
+func sovApproval(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozApproval(x uint64) (n int) {
+	return sovApproval(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SignerSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApproval
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApproval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApproval
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApproval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApproval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApproval
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApproval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApproval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApproval
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApproval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApproval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApproval(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApproval
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActionProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApproval
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActionProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApproval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApproval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApproval
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApproval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApproval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApproval
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApproval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApproval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApproval
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApproval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApproval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApproval
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApproval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApproval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApproval
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApproval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateOwner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApproval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApproval
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApproval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgUpdateOwner{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &ActionProposal_UpdateOwner{v}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMasterMinter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApproval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApproval
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApproval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgUpdateMasterMinter{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &ActionProposal_UpdateMasterMinter{v}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigureMinterController", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApproval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApproval
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApproval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgConfigureMinterController{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &ActionProposal_ConfigureMinterController{v}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unpause", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApproval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApproval
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApproval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgUnpause{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &ActionProposal_Unpause{v}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetSignerSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApproval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApproval
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApproval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgSetSignerSet{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &ActionProposal_SetSignerSet{v}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatePauser", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApproval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApproval
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApproval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgUpdatePauser{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &ActionProposal_UpdatePauser{v}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateBlacklister", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApproval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApproval
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApproval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgUpdateBlacklister{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &ActionProposal_UpdateBlacklister{v}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateAllowlister", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApproval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApproval
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApproval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgUpdateAllowlister{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &ActionProposal_UpdateAllowlister{v}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetChannelPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApproval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApproval
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApproval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgSetChannelPolicy{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &ActionProposal_SetChannelPolicy{v}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetChannelRateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApproval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApproval
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApproval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgSetChannelRateLimit{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &ActionProposal_SetChannelRateLimit{v}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetAllowlistMode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApproval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApproval
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApproval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgSetAllowlistMode{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &ActionProposal_SetAllowlistMode{v}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelQueuedAction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApproval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApproval
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApproval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgCancelQueuedAction{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &ActionProposal_CancelQueuedAction{v}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelOwnershipTransfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApproval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApproval
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApproval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgCancelOwnershipTransfer{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &ActionProposal_CancelOwnershipTransfer{v}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveChannelPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApproval
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApproval
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApproval
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgRemoveChannelPolicy{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &ActionProposal_RemoveChannelPolicy{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApproval(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApproval
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipApproval(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowApproval
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowApproval
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowApproval
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthApproval
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupApproval
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthApproval
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthApproval        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowApproval          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupApproval = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,78 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+// MaxAuditLogLimit caps the number of entries returned by a single page of
+// the audit log query.
+const MaxAuditLogLimit = 1000
+
+// Roles recorded in the audit log as the authority of an action.
+const (
+	RoleOwner            = "owner"
+	RolePendingOwner     = "pending_owner"
+	RoleMasterMinter     = "master_minter"
+	RolePauser           = "pauser"
+	RoleBlacklister      = "blacklister"
+	RoleAllowlister      = "allowlister"
+	RoleMinterController = "minter_controller"
+	RoleAuthority        = "authority"
+	RoleModule           = "module"
+)
+
+// PendingRoleName returns the role recorded in the audit log for the pending
+// holder of a role, such as "pending_pauser".
+func PendingRoleName(role string) string {
+	return "pending_" + role
+}
+
+// Actions recorded in the audit log.
+const (
+	ActionUpdateOwner               = "update_owner"
+	ActionAcceptOwner               = "accept_owner"
+	ActionUpdateMasterMinter        = "update_master_minter"
+	ActionUpdatePauser              = "update_pauser"
+	ActionUpdateBlacklister         = "update_blacklister"
+	ActionBlacklist                 = "blacklist"
+	ActionUnblacklist               = "unblacklist"
+	ActionPause                     = "pause"
+	ActionUnpause                   = "unpause"
+	ActionConfigureMinterController = "configure_minter_controller"
+	ActionRemoveMinterController    = "remove_minter_controller"
+	ActionConfigureMinter           = "configure_minter"
+	ActionRemoveMinter              = "remove_minter"
+	ActionConfigureMinterRateLimit  = "configure_minter_rate_limit"
+	ActionSetSignerSet              = "set_signer_set"
+	ActionProposeAction             = "propose_action"
+	ActionApproveAction             = "approve_action"
+	ActionQueueAction               = "queue_action"
+	ActionCancelQueuedAction        = "cancel_queued_action"
+	ActionUpdateParams              = "update_params"
+	ActionForceRoleAssignment       = "force_role_assignment"
+	ActionSeizeBlacklistedFunds     = "seize_blacklisted_funds"
+	ActionSetChannelPolicy          = "set_channel_policy"
+	ActionSetChannelRateLimit       = "set_channel_rate_limit"
+	ActionUpdateAllowlister         = "update_allowlister"
+	ActionAllowlist                 = "allowlist"
+	ActionUnallowlist               = "unallowlist"
+	ActionSetAllowlistMode          = "set_allowlist_mode"
+	ActionCancelOwnershipTransfer   = "cancel_ownership_transfer"
+	ActionAcceptRole                = "accept_role"
+	ActionRegisterMintingDenom      = "register_minting_denom"
+	ActionRemoveChannelPolicy       = "remove_channel_policy"
+	ActionReleaseEscrow             = "release_escrow"
+	ActionBurnEscrow                = "burn_escrow"
+)
@@ -0,0 +1,78 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// maxHrpLength is the maximum length of a bech32 human readable part.
+const maxHrpLength = 83
+
+// ValidateHrp checks that hrp is a lowercase bech32 human readable part.
+func ValidateHrp(hrp string) error {
+	if len(hrp) == 0 || len(hrp) > maxHrpLength {
+		return fmt.Errorf("human readable part %q must be between 1 and %d characters", hrp, maxHrpLength)
+	}
+	for _, c := range hrp {
+		if c < 33 || c > 126 || (c >= 'A' && c <= 'Z') {
+			return fmt.Errorf("invalid character %q in human readable part %q", c, hrp)
+		}
+	}
+	return nil
+}
+
+// HexAddress renders address bytes as 0x prefixed hex, which suits address
+// bytes of any chain.
+func HexAddress(addressBz []byte) string {
+	return "0x" + hex.EncodeToString(addressBz)
+}
+
+// NewAddressRendering renders address bytes as hex and as bech32 with each
+// of the human readable parts.
+func NewAddressRendering(addressBz []byte, hrps []string) (AddressRendering, error) {
+	rendering := AddressRendering{Hex: HexAddress(addressBz)}
+	for _, hrp := range hrps {
+		address, err := bech32.ConvertAndEncode(hrp, addressBz)
+		if err != nil {
+			return AddressRendering{}, err
+		}
+		rendering.Bech32 = append(rendering.Bech32, address)
+	}
+	return rendering, nil
+}
+
+// ParseAddressBytes decodes address bytes from their hex encoding, optionally
+// 0x prefixed, or else from their standard or URL safe base64 encoding.
+func ParseAddressBytes(address string) ([]byte, error) {
+	trimmed := strings.TrimPrefix(strings.TrimPrefix(address, "0x"), "0X")
+	if bz, err := hex.DecodeString(trimmed); err == nil && len(bz) > 0 {
+		return bz, nil
+	}
+	for _, encoding := range []*base64.Encoding{base64.StdEncoding, base64.URLEncoding} {
+		if bz, err := encoding.DecodeString(address); err == nil && len(bz) > 0 {
+			return bz, nil
+		}
+	}
+	return nil, errors.Wrapf(ErrInvalidAddress, "address bytes must be hex or base64 encoded (%s)", address)
+}
@@ -5,16 +5,21 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -24,6 +29,23 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Blacklisted struct {
 	AddressBz []byte `protobuf:"bytes,1,opt,name=addressBz,proto3" json:"addressBz,omitempty"`
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// reason is an optional reason code given when the address was
+	// blacklisted.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// referenceId optionally refers to the case the address was blacklisted
+	// for.
+	ReferenceId string `protobuf:"bytes,4,opt,name=referenceId,proto3" json:"referenceId,omitempty"`
+	// blacklistedAtHeight and blacklistedAtTime are the block the address was
+	// blacklisted at. They are empty for entries listed before they were
+	// recorded.
+	BlacklistedAtHeight int64      `protobuf:"varint,5,opt,name=blacklistedAtHeight,proto3" json:"blacklistedAtHeight,omitempty"`
+	BlacklistedAtTime   *time.Time `protobuf:"bytes,6,opt,name=blacklistedAtTime,proto3,stdtime" json:"blacklistedAtTime,omitempty"`
+	// expiresAtHeight and expiresAtTime optionally bound how long the address
+	// stays blacklisted. The entry is removed at the end of the first block
+	// reaching either of them.
+	ExpiresAtHeight int64      `protobuf:"varint,7,opt,name=expiresAtHeight,proto3" json:"expiresAtHeight,omitempty"`
+	ExpiresAtTime   *time.Time `protobuf:"bytes,8,opt,name=expiresAtTime,proto3,stdtime" json:"expiresAtTime,omitempty"`
 }
 
 func (m *Blacklisted) Reset()         { *m = Blacklisted{} }
@@ -66,8 +88,114 @@ func (m *Blacklisted) GetAddressBz() []byte {
 	return nil
 }
 
+func (m *Blacklisted) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Blacklisted) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Blacklisted) GetReferenceId() string {
+	if m != nil {
+		return m.ReferenceId
+	}
+	return ""
+}
+
+func (m *Blacklisted) GetBlacklistedAtHeight() int64 {
+	if m != nil {
+		return m.BlacklistedAtHeight
+	}
+	return 0
+}
+
+func (m *Blacklisted) GetBlacklistedAtTime() *time.Time {
+	if m != nil {
+		return m.BlacklistedAtTime
+	}
+	return nil
+}
+
+func (m *Blacklisted) GetExpiresAtHeight() int64 {
+	if m != nil {
+		return m.ExpiresAtHeight
+	}
+	return 0
+}
+
+func (m *Blacklisted) GetExpiresAtTime() *time.Time {
+	if m != nil {
+		return m.ExpiresAtTime
+	}
+	return nil
+}
+
+// AddressRendering holds human readable encodings of address bytes.
+type AddressRendering struct {
+	// hex is the 0x prefixed hex encoding of the address bytes.
+	Hex string `protobuf:"bytes,1,opt,name=hex,proto3" json:"hex,omitempty"`
+	// bech32 are the bech32 encodings of the address bytes, one per rendered
+	// human readable part.
+	Bech32 []string `protobuf:"bytes,2,rep,name=bech32,proto3" json:"bech32,omitempty"`
+}
+
+func (m *AddressRendering) Reset()         { *m = AddressRendering{} }
+func (m *AddressRendering) String() string { return proto.CompactTextString(m) }
+func (*AddressRendering) ProtoMessage()    {}
+func (*AddressRendering) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75ae79ec42bab5e2, []int{1}
+}
+func (m *AddressRendering) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressRendering) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressRendering.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddressRendering) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressRendering.Merge(m, src)
+}
+func (m *AddressRendering) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressRendering) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressRendering.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressRendering proto.InternalMessageInfo
+
+func (m *AddressRendering) GetHex() string {
+	if m != nil {
+		return m.Hex
+	}
+	return ""
+}
+
+func (m *AddressRendering) GetBech32() []string {
+	if m != nil {
+		return m.Bech32
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Blacklisted)(nil), "circle.fiattokenfactory.v1.Blacklisted")
+	proto.RegisterType((*AddressRendering)(nil), "circle.fiattokenfactory.v1.AddressRendering")
 }
 
 func init() {
@@ -75,19 +203,32 @@ func init() {
 }
 
 var fileDescriptor_75ae79ec42bab5e2 = []byte{
-	// 178 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x49, 0xce, 0x2c, 0x4a,
-	0xce, 0x49, 0xd5, 0x4f, 0xcb, 0x4c, 0x2c, 0x29, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e,
-	0xc9, 0x2f, 0xaa, 0xd4, 0x2f, 0x33, 0xd4, 0x4f, 0xca, 0x49, 0x4c, 0xce, 0xce, 0xc9, 0x2c, 0x2e,
-	0x49, 0x4d, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x82, 0xa8, 0xd6, 0x43, 0x57, 0xad,
-	0x57, 0x66, 0xa8, 0xa4, 0xcd, 0xc5, 0xed, 0x84, 0xd0, 0x20, 0x24, 0xc3, 0xc5, 0x99, 0x98, 0x92,
-	0x52, 0x94, 0x5a, 0x5c, 0xec, 0x54, 0x25, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x13, 0x84, 0x10, 0x70,
-	0x8a, 0x3b, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c,
-	0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x97, 0xf4, 0xcc, 0x92,
-	0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x88, 0x6d, 0x69, 0x99, 0x79, 0xfa, 0x79, 0xf9,
-	0x49, 0x39, 0xa9, 0xba, 0x18, 0x8e, 0xac, 0xc0, 0x74, 0x77, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12,
-	0x1b, 0xd8, 0xbd, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x86, 0x4a, 0x87, 0xbd, 0xdf, 0x00,
-	0x00, 0x00,
+	// 392 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x3d, 0x8f, 0xd3, 0x40,
+	0x14, 0xcc, 0xc6, 0x77, 0x81, 0x6c, 0x40, 0x1c, 0xcb, 0x09, 0x59, 0x16, 0xf2, 0x59, 0x57, 0xb9,
+	0x00, 0x2f, 0x77, 0xd7, 0xd2, 0x9c, 0x85, 0x10, 0x34, 0x14, 0xd6, 0x55, 0x14, 0x48, 0xfe, 0x78,
+	0xb6, 0x57, 0xb1, 0x77, 0xad, 0xf5, 0x26, 0x4a, 0xf8, 0x15, 0xf9, 0x59, 0x94, 0x29, 0xe9, 0x40,
+	0xc9, 0x0f, 0xe0, 0x2f, 0x20, 0xef, 0x92, 0x6f, 0x0a, 0xba, 0x37, 0xf3, 0xe6, 0x69, 0x34, 0xa3,
+	0x87, 0x5f, 0xa7, 0x4c, 0xa6, 0x15, 0xd0, 0x9c, 0xc5, 0x4a, 0x89, 0x31, 0xf0, 0x3c, 0x4e, 0x95,
+	0x90, 0x73, 0x3a, 0xbd, 0xa1, 0x49, 0x15, 0xa7, 0xe3, 0x8a, 0xb5, 0x0a, 0xb2, 0xa0, 0x91, 0x42,
+	0x09, 0xe2, 0x18, 0x75, 0x70, 0xac, 0x0e, 0xa6, 0x37, 0xce, 0x65, 0x21, 0x0a, 0xa1, 0x65, 0xb4,
+	0x9b, 0xcc, 0x85, 0x73, 0x55, 0x08, 0x51, 0x54, 0x40, 0x35, 0x4a, 0x26, 0x39, 0x55, 0xac, 0x86,
+	0x56, 0xc5, 0x75, 0x63, 0x04, 0xd7, 0xbf, 0xfb, 0x78, 0x14, 0xee, 0x8c, 0xc8, 0x2b, 0x3c, 0x8c,
+	0xb3, 0x4c, 0x42, 0xdb, 0x86, 0xdf, 0x6c, 0xe4, 0x21, 0xff, 0x49, 0xb4, 0x23, 0xc8, 0x25, 0x3e,
+	0xcf, 0x80, 0x8b, 0xda, 0xee, 0x7b, 0xc8, 0x1f, 0x46, 0x06, 0x90, 0x97, 0x78, 0x20, 0x21, 0x6e,
+	0x05, 0xb7, 0x2d, 0x4d, 0xff, 0x45, 0xc4, 0xc3, 0x23, 0x09, 0x39, 0x48, 0xe0, 0x29, 0x7c, 0xca,
+	0xec, 0x33, 0xbd, 0xdc, 0xa7, 0xc8, 0x5b, 0xfc, 0x62, 0x2f, 0xe5, 0xbd, 0xfa, 0x08, 0xac, 0x28,
+	0x95, 0x7d, 0xee, 0x21, 0xdf, 0x8a, 0xfe, 0xb5, 0x22, 0x9f, 0xf1, 0xf3, 0x03, 0xfa, 0x81, 0xd5,
+	0x60, 0x0f, 0x3c, 0xe4, 0x8f, 0x6e, 0x9d, 0xc0, 0x84, 0x0d, 0x36, 0x61, 0x83, 0x87, 0x4d, 0xd8,
+	0xf0, 0x6c, 0xf1, 0xf3, 0x0a, 0x45, 0xa7, 0xa7, 0xc4, 0xc7, 0xcf, 0x60, 0xd6, 0x30, 0x09, 0xed,
+	0xd6, 0xfd, 0x91, 0x76, 0x3f, 0xa6, 0xc9, 0x07, 0xfc, 0x74, 0x4b, 0x69, 0xd7, 0xc7, 0xff, 0xe9,
+	0x7a, 0x78, 0x76, 0xfd, 0x0e, 0x5f, 0xdc, 0x9b, 0x42, 0x23, 0xe0, 0x19, 0x48, 0xc6, 0x0b, 0x72,
+	0x81, 0xad, 0x12, 0x66, 0xba, 0xef, 0x61, 0xd4, 0x8d, 0x5d, 0xa7, 0x09, 0xa4, 0xe5, 0xdd, 0xad,
+	0xdd, 0xf7, 0xac, 0xae, 0x53, 0x83, 0xc2, 0xaf, 0xdf, 0x57, 0x2e, 0x5a, 0xae, 0x5c, 0xf4, 0x6b,
+	0xe5, 0xa2, 0xc5, 0xda, 0xed, 0x2d, 0xd7, 0x6e, 0xef, 0xc7, 0xda, 0xed, 0x7d, 0x79, 0x5f, 0x30,
+	0x55, 0x4e, 0x92, 0x20, 0x15, 0x35, 0x35, 0x7f, 0x92, 0x33, 0x4e, 0xb9, 0x48, 0x2a, 0x78, 0x73,
+	0xf2, 0x5e, 0xb3, 0xd3, 0x8f, 0x53, 0xf3, 0x06, 0xda, 0x64, 0xa0, 0x63, 0xdc, 0xfd, 0x19, 0x00,
+	0x86, 0x31, 0xd4, 0x8d, 0x99, 0x02, 0x00, 0x00,
 }
 
 func (m *Blacklisted) Marshal() (dAtA []byte, err error) {
@@ -110,6 +251,57 @@ func (m *Blacklisted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAtTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiresAtTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAtTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintBlacklisted(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintBlacklisted(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BlacklistedAtTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.BlacklistedAtTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.BlacklistedAtTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintBlacklisted(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BlacklistedAtHeight != 0 {
+		i = encodeVarintBlacklisted(dAtA, i, uint64(m.BlacklistedAtHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ReferenceId) > 0 {
+		i -= len(m.ReferenceId)
+		copy(dAtA[i:], m.ReferenceId)
+		i = encodeVarintBlacklisted(dAtA, i, uint64(len(m.ReferenceId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintBlacklisted(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBlacklisted(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.AddressBz) > 0 {
 		i -= len(m.AddressBz)
 		copy(dAtA[i:], m.AddressBz)
@@ -120,6 +312,45 @@ func (m *Blacklisted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AddressRendering) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressRendering) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressRendering) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bech32) > 0 {
+		for iNdEx := len(m.Bech32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Bech32[iNdEx])
+			copy(dAtA[i:], m.Bech32[iNdEx])
+			i = encodeVarintBlacklisted(dAtA, i, uint64(len(m.Bech32[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Hex) > 0 {
+		i -= len(m.Hex)
+		copy(dAtA[i:], m.Hex)
+		i = encodeVarintBlacklisted(dAtA, i, uint64(len(m.Hex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBlacklisted(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBlacklisted(v)
 	base := offset
@@ -141,6 +372,51 @@ func (m *Blacklisted) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBlacklisted(uint64(l))
 	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBlacklisted(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovBlacklisted(uint64(l))
+	}
+	l = len(m.ReferenceId)
+	if l > 0 {
+		n += 1 + l + sovBlacklisted(uint64(l))
+	}
+	if m.BlacklistedAtHeight != 0 {
+		n += 1 + sovBlacklisted(uint64(m.BlacklistedAtHeight))
+	}
+	if m.BlacklistedAtTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.BlacklistedAtTime)
+		n += 1 + l + sovBlacklisted(uint64(l))
+	}
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovBlacklisted(uint64(m.ExpiresAtHeight))
+	}
+	if m.ExpiresAtTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAtTime)
+		n += 1 + l + sovBlacklisted(uint64(l))
+	}
+	return n
+}
+
+func (m *AddressRendering) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hex)
+	if l > 0 {
+		n += 1 + l + sovBlacklisted(uint64(l))
+	}
+	if len(m.Bech32) > 0 {
+		for _, s := range m.Bech32 {
+			l = len(s)
+			n += 1 + l + sovBlacklisted(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -213,6 +489,326 @@ func (m *Blacklisted) Unmarshal(dAtA []byte) error {
 				m.AddressBz = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlacklisted
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlacklisted
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlacklisted
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistedAtHeight", wireType)
+			}
+			m.BlacklistedAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlacklisted
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlacklistedAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistedAtTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlacklisted
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlacklistedAtTime == nil {
+				m.BlacklistedAtTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.BlacklistedAtTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlacklisted
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlacklisted
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAtTime == nil {
+				m.ExpiresAtTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiresAtTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlacklisted(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressRendering) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlacklisted
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressRendering: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressRendering: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlacklisted
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bech32", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlacklisted
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlacklisted
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bech32 = append(m.Bech32, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBlacklisted(dAtA[iNdEx:])
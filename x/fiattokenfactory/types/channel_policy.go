@@ -0,0 +1,52 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"strings"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// Validate performs a basic validation of the channel policy.
+func (p ChannelPolicy) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return errors.Wrapf(ErrInvalidDenom, "invalid denom (%s)", err)
+	}
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return errors.Wrapf(ErrInvalidChannel, "invalid channel id (%s)", err)
+	}
+	return nil
+}
+
+// Directions returns the directions allowed by the channel policy for
+// recording in the audit log.
+func (p ChannelPolicy) Directions() string {
+	var directions []string
+	if p.AllowInbound {
+		directions = append(directions, "inbound")
+	}
+	if p.AllowOutbound {
+		directions = append(directions, "outbound")
+	}
+	if len(directions) == 0 {
+		return "none"
+	}
+	return strings.Join(directions, ",")
+}
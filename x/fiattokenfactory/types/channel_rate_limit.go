@@ -0,0 +1,193 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// NewChannelRateLimit returns a channel rate limit without any flow.
+func NewChannelRateLimit(denom string, channelID string, maxInflow math.Int, maxOutflow math.Int, windowBlocks int64, windowDuration time.Duration) ChannelRateLimit {
+	return ChannelRateLimit{
+		Denom:          denom,
+		ChannelId:      channelID,
+		MaxInflow:      maxInflow,
+		MaxOutflow:     maxOutflow,
+		WindowBlocks:   windowBlocks,
+		WindowDuration: windowDuration,
+	}
+}
+
+// ValidateChannelRateLimitConfig checks that a channel rate limit caps at
+// least one direction and has exactly one window length.
+func ValidateChannelRateLimitConfig(maxInflow math.Int, maxOutflow math.Int, windowBlocks int64, windowDuration time.Duration) error {
+	if maxInflow.IsNil() || maxInflow.IsNegative() || maxOutflow.IsNil() || maxOutflow.IsNegative() {
+		return errors.Wrap(ErrInvalidRateLimit, "caps cannot be nil or negative")
+	}
+
+	if maxInflow.IsZero() && maxOutflow.IsZero() {
+		return errors.Wrap(ErrInvalidRateLimit, "at least one of max inflow and max outflow must be positive")
+	}
+
+	return validateRateLimitWindow(windowBlocks, windowDuration)
+}
+
+// Validate performs a basic validation of the channel rate limit.
+func (r ChannelRateLimit) Validate() error {
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return errors.Wrapf(ErrInvalidDenom, "invalid denom (%s)", err)
+	}
+
+	if err := host.ChannelIdentifierValidator(r.ChannelId); err != nil {
+		return errors.Wrapf(ErrInvalidChannel, "invalid channel id (%s)", err)
+	}
+
+	if err := ValidateChannelRateLimitConfig(r.MaxInflow, r.MaxOutflow, r.WindowBlocks, r.WindowDuration); err != nil {
+		return err
+	}
+
+	for i, bucket := range r.Buckets {
+		if i > 0 && bucket.Index <= r.Buckets[i-1].Index {
+			return errors.Wrap(ErrInvalidRateLimit, "buckets must be ordered by index")
+		}
+		if bucket.Inflow.IsNil() || bucket.Inflow.IsNegative() || bucket.Outflow.IsNil() || bucket.Outflow.IsNegative() {
+			return errors.Wrap(ErrInvalidRateLimit, "flow cannot be nil or negative")
+		}
+	}
+
+	return nil
+}
+
+// Flow returns the amounts received and sent within the window at the given
+// block.
+func (r ChannelRateLimit) Flow(height int64, blockTime time.Time) (inflow math.Int, outflow math.Int) {
+	first := rateLimitFirstBucket(r.WindowBlocks, r.WindowDuration, height, blockTime)
+
+	inflow, outflow = math.ZeroInt(), math.ZeroInt()
+	for _, bucket := range r.Buckets {
+		if bucket.Index >= first {
+			inflow = inflow.Add(bucket.Inflow)
+			outflow = outflow.Add(bucket.Outflow)
+		}
+	}
+	return inflow, outflow
+}
+
+// Bucket returns the index of the bucket of the window at the given block.
+func (r ChannelRateLimit) Bucket(height int64, blockTime time.Time) int64 {
+	return rateLimitBucket(r.WindowBlocks, r.WindowDuration, height, blockTime)
+}
+
+// ConsumeInflow records an amount received at the given block. It fails when
+// the net inflow of the window would exceed its cap.
+func (r *ChannelRateLimit) ConsumeInflow(amount math.Int, height int64, blockTime time.Time) error {
+	inflow, outflow := r.Flow(height, blockTime)
+	if r.MaxInflow.IsPositive() && inflow.Add(amount).Sub(outflow).GT(r.MaxInflow) {
+		return errors.Wrapf(ErrRateLimitExceeded, "net inflow of %s through %s would exceed %s", r.Denom, r.ChannelId, r.MaxInflow)
+	}
+
+	bucket := r.currentBucket(height, blockTime)
+	bucket.Inflow = bucket.Inflow.Add(amount)
+	return nil
+}
+
+// ConsumeOutflow records an amount sent at the given block. It fails when the
+// net outflow of the window would exceed its cap.
+func (r *ChannelRateLimit) ConsumeOutflow(amount math.Int, height int64, blockTime time.Time) error {
+	inflow, outflow := r.Flow(height, blockTime)
+	if r.MaxOutflow.IsPositive() && outflow.Add(amount).Sub(inflow).GT(r.MaxOutflow) {
+		return errors.Wrapf(ErrRateLimitExceeded, "net outflow of %s through %s would exceed %s", r.Denom, r.ChannelId, r.MaxOutflow)
+	}
+
+	bucket := r.currentBucket(height, blockTime)
+	bucket.Outflow = bucket.Outflow.Add(amount)
+	return nil
+}
+
+// UndoOutflow reverts an amount sent in the given bucket whose transfer
+// failed. Nothing is reverted once the bucket left the window, as the window
+// no longer holds the amount.
+func (r *ChannelRateLimit) UndoOutflow(amount math.Int, index int64, height int64, blockTime time.Time) {
+	if index < rateLimitFirstBucket(r.WindowBlocks, r.WindowDuration, height, blockTime) {
+		return
+	}
+
+	for i := range r.Buckets {
+		if r.Buckets[i].Index == index {
+			r.Buckets[i].Outflow = math.MaxInt(r.Buckets[i].Outflow.Sub(amount), math.ZeroInt())
+			return
+		}
+	}
+}
+
+// Reconfigure updates the caps and window of the channel rate limit at the
+// given block while keeping its flow. When the window length changes, the
+// flow within the previous window is carried into the current bucket of the
+// new window.
+func (r *ChannelRateLimit) Reconfigure(maxInflow math.Int, maxOutflow math.Int, windowBlocks int64, windowDuration time.Duration, height int64, blockTime time.Time) {
+	inflow, outflow := r.Flow(height, blockTime)
+	sameWindow := r.WindowBlocks == windowBlocks && r.WindowDuration == windowDuration
+
+	r.MaxInflow = maxInflow
+	r.MaxOutflow = maxOutflow
+	r.WindowBlocks = windowBlocks
+	r.WindowDuration = windowDuration
+	if sameWindow {
+		return
+	}
+
+	r.Buckets = nil
+	if inflow.IsPositive() || outflow.IsPositive() {
+		bucket := r.currentBucket(height, blockTime)
+		bucket.Inflow, bucket.Outflow = inflow, outflow
+	}
+}
+
+// currentBucket returns the bucket of the window at the given block, opening
+// it if needed, and drops the buckets that left the window.
+func (r *ChannelRateLimit) currentBucket(height int64, blockTime time.Time) *ChannelFlowBucket {
+	first := rateLimitFirstBucket(r.WindowBlocks, r.WindowDuration, height, blockTime)
+	current := rateLimitBucket(r.WindowBlocks, r.WindowDuration, height, blockTime)
+
+	buckets := r.Buckets[:0]
+	for _, bucket := range r.Buckets {
+		if bucket.Index >= first {
+			buckets = append(buckets, bucket)
+		}
+	}
+	if n := len(buckets); n == 0 || buckets[n-1].Index != current {
+		buckets = append(buckets, ChannelFlowBucket{Index: current, Inflow: math.ZeroInt(), Outflow: math.ZeroInt()})
+	}
+	r.Buckets = buckets
+
+	return &r.Buckets[len(r.Buckets)-1]
+}
+
+// Config returns a readable description of the channel rate limit
+// configuration, or an empty string for a nil channel rate limit.
+func (r *ChannelRateLimit) Config() string {
+	if r == nil {
+		return ""
+	}
+	return fmt.Sprintf("in %s out %s per %s", r.MaxInflow, r.MaxOutflow, rateLimitWindow(r.WindowBlocks, r.WindowDuration))
+}
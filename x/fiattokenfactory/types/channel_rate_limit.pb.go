@@ -0,0 +1,1191 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: circle/fiattokenfactory/v1/channel_rate_limit.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ChannelRateLimit caps the net flow of a minting denom through an IBC
+// channel over a sliding window, along with the flow within the window. The
+// window lasts either windowBlocks blocks or windowDuration of block time and
+// is split into buckets like the rate limit of a minter. A zero cap leaves
+// that direction uncapped.
+type ChannelRateLimit struct {
+	Denom          string                `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	ChannelId      string                `protobuf:"bytes,2,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	MaxInflow      cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=maxInflow,proto3,customtype=cosmossdk.io/math.Int" json:"maxInflow"`
+	MaxOutflow     cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=maxOutflow,proto3,customtype=cosmossdk.io/math.Int" json:"maxOutflow"`
+	WindowBlocks   int64                 `protobuf:"varint,5,opt,name=windowBlocks,proto3" json:"windowBlocks,omitempty"`
+	WindowDuration time.Duration         `protobuf:"bytes,6,opt,name=windowDuration,proto3,stdduration" json:"windowDuration"`
+	// buckets hold the amounts received and sent within the window, ordered
+	// by index.
+	Buckets []ChannelFlowBucket `protobuf:"bytes,11,rep,name=buckets,proto3" json:"buckets"`
+}
+
+func (m *ChannelRateLimit) Reset()         { *m = ChannelRateLimit{} }
+func (m *ChannelRateLimit) String() string { return proto.CompactTextString(m) }
+func (*ChannelRateLimit) ProtoMessage()    {}
+func (*ChannelRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc2ec15bd8529fb1, []int{0}
+}
+func (m *ChannelRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelRateLimit.Merge(m, src)
+}
+func (m *ChannelRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelRateLimit proto.InternalMessageInfo
+
+func (m *ChannelRateLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ChannelRateLimit) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelRateLimit) GetWindowBlocks() int64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+func (m *ChannelRateLimit) GetWindowDuration() time.Duration {
+	if m != nil {
+		return m.WindowDuration
+	}
+	return 0
+}
+
+func (m *ChannelRateLimit) GetBuckets() []ChannelFlowBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+// ChannelFlowBucket holds the amounts received and sent within one bucket of
+// a channel rate limit window.
+type ChannelFlowBucket struct {
+	Index   int64                 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Inflow  cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=inflow,proto3,customtype=cosmossdk.io/math.Int" json:"inflow"`
+	Outflow cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=outflow,proto3,customtype=cosmossdk.io/math.Int" json:"outflow"`
+}
+
+func (m *ChannelFlowBucket) Reset()         { *m = ChannelFlowBucket{} }
+func (m *ChannelFlowBucket) String() string { return proto.CompactTextString(m) }
+func (*ChannelFlowBucket) ProtoMessage()    {}
+func (*ChannelFlowBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc2ec15bd8529fb1, []int{1}
+}
+func (m *ChannelFlowBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelFlowBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelFlowBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelFlowBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelFlowBucket.Merge(m, src)
+}
+func (m *ChannelFlowBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelFlowBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelFlowBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelFlowBucket proto.InternalMessageInfo
+
+func (m *ChannelFlowBucket) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+// PendingSendPacket is an outgoing transfer counted against a channel rate
+// limit, awaiting its acknowledgement or timeout. Its outflow is undone when
+// the transfer fails while the bucket it was sent in is within the window.
+type PendingSendPacket struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	Sequence  uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Denom     string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	Bucket    int64  `protobuf:"varint,5,opt,name=bucket,proto3" json:"bucket,omitempty"`
+}
+
+func (m *PendingSendPacket) Reset()         { *m = PendingSendPacket{} }
+func (m *PendingSendPacket) String() string { return proto.CompactTextString(m) }
+func (*PendingSendPacket) ProtoMessage()    {}
+func (*PendingSendPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc2ec15bd8529fb1, []int{2}
+}
+func (m *PendingSendPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingSendPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingSendPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingSendPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingSendPacket.Merge(m, src)
+}
+func (m *PendingSendPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingSendPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingSendPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingSendPacket proto.InternalMessageInfo
+
+func (m *PendingSendPacket) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PendingSendPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PendingSendPacket) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PendingSendPacket) GetBucket() int64 {
+	if m != nil {
+		return m.Bucket
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ChannelRateLimit)(nil), "circle.fiattokenfactory.v1.ChannelRateLimit")
+	proto.RegisterType((*ChannelFlowBucket)(nil), "circle.fiattokenfactory.v1.ChannelFlowBucket")
+	proto.RegisterType((*PendingSendPacket)(nil), "circle.fiattokenfactory.v1.PendingSendPacket")
+}
+
+func init() {
+	proto.RegisterFile("circle/fiattokenfactory/v1/channel_rate_limit.proto", fileDescriptor_bc2ec15bd8529fb1)
+}
+
+var fileDescriptor_bc2ec15bd8529fb1 = []byte{
+	// 533 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x41, 0x6e, 0xd3, 0x40,
+	0x14, 0xcd, 0xd4, 0x6e, 0xe2, 0x4c, 0x10, 0x72, 0xad, 0x82, 0xdc, 0x08, 0x39, 0x51, 0x56, 0x91,
+	0x50, 0xc6, 0x6a, 0x7b, 0x03, 0xb7, 0x20, 0x25, 0x05, 0x51, 0x99, 0x1d, 0x0b, 0x22, 0xc7, 0x9e,
+	0x38, 0xa3, 0xd8, 0xf3, 0x8b, 0x3d, 0x6e, 0xd2, 0x25, 0x37, 0x60, 0xc9, 0x41, 0x80, 0x33, 0x74,
+	0x59, 0xb1, 0x42, 0x2c, 0x0a, 0x4a, 0x2e, 0x82, 0xec, 0x71, 0x48, 0x9b, 0x88, 0x45, 0xd9, 0xcd,
+	0xfb, 0x7f, 0xde, 0xd7, 0x9b, 0xf7, 0xfe, 0xe0, 0x63, 0x9f, 0x25, 0x7e, 0x44, 0xed, 0x31, 0xf3,
+	0x84, 0x80, 0x29, 0xe5, 0x63, 0xcf, 0x17, 0x90, 0x5c, 0xd9, 0x97, 0x87, 0xb6, 0x3f, 0xf1, 0x38,
+	0xa7, 0xd1, 0x30, 0xf1, 0x04, 0x1d, 0x46, 0x2c, 0x66, 0x82, 0x5c, 0x24, 0x20, 0xc0, 0x68, 0x4a,
+	0x12, 0xd9, 0x24, 0x91, 0xcb, 0xc3, 0xe6, 0x81, 0x0f, 0x69, 0x0c, 0xe9, 0xb0, 0xb8, 0x69, 0x4b,
+	0x20, 0x69, 0xcd, 0xfd, 0x10, 0x42, 0x90, 0xf5, 0xfc, 0x54, 0x56, 0xad, 0x10, 0x20, 0x8c, 0xa8,
+	0x5d, 0xa0, 0x51, 0x36, 0xb6, 0x83, 0x2c, 0xf1, 0x04, 0x03, 0x2e, 0xfb, 0x9d, 0x6f, 0x0a, 0xd6,
+	0x4f, 0xa4, 0x12, 0xd7, 0x13, 0xf4, 0x55, 0xae, 0xc3, 0xd8, 0xc7, 0xbb, 0x01, 0xe5, 0x10, 0x9b,
+	0xa8, 0x8d, 0xba, 0x75, 0x57, 0x02, 0xe3, 0x19, 0xae, 0x97, 0x9a, 0xfb, 0x81, 0xb9, 0x53, 0x74,
+	0xd6, 0x05, 0xa3, 0x8f, 0xeb, 0xb1, 0x37, 0xef, 0xf3, 0x71, 0x04, 0x33, 0x53, 0xc9, 0xbb, 0xce,
+	0xf3, 0xeb, 0xdb, 0x56, 0xe5, 0xe7, 0x6d, 0xeb, 0x89, 0xd4, 0x99, 0x06, 0x53, 0xc2, 0xc0, 0x8e,
+	0x3d, 0x31, 0x21, 0x7d, 0x2e, 0xbe, 0x7f, 0xe9, 0xe1, 0xf2, 0x01, 0x7d, 0x2e, 0xdc, 0x35, 0xdb,
+	0x38, 0xc3, 0x38, 0xf6, 0xe6, 0x6f, 0x32, 0x51, 0xcc, 0x52, 0x1f, 0x3e, 0xeb, 0x0e, 0xdd, 0xe8,
+	0xe0, 0x47, 0x33, 0xc6, 0x03, 0x98, 0x39, 0x11, 0xf8, 0xd3, 0xd4, 0xdc, 0x6d, 0xa3, 0xae, 0xe2,
+	0xde, 0xab, 0x19, 0x67, 0xf8, 0xb1, 0xc4, 0xa7, 0xa5, 0x39, 0x66, 0xb5, 0x8d, 0xba, 0x8d, 0xa3,
+	0x03, 0x22, 0xdd, 0x23, 0x2b, 0xf7, 0xc8, 0xea, 0x82, 0xa3, 0xe5, 0x7a, 0x3e, 0xff, 0x6a, 0x21,
+	0x77, 0x83, 0x6a, 0xbc, 0xc6, 0xb5, 0x51, 0xe6, 0x4f, 0xa9, 0x48, 0xcd, 0x46, 0x5b, 0xe9, 0x36,
+	0x8e, 0x7a, 0xe4, 0xdf, 0x81, 0x92, 0xd2, 0xfb, 0x97, 0x11, 0xcc, 0x9c, 0x82, 0xe5, 0xa8, 0xf9,
+	0x64, 0x77, 0x35, 0x63, 0xa0, 0x6a, 0x35, 0x5d, 0x1b, 0xa8, 0x9a, 0xa6, 0xd7, 0x07, 0xaa, 0x56,
+	0xd7, 0xf1, 0x40, 0xd5, 0xb0, 0xde, 0xe8, 0x7c, 0x45, 0x78, 0x6f, 0x8b, 0x9c, 0x27, 0xc7, 0x78,
+	0x40, 0xe7, 0x45, 0x72, 0x8a, 0x2b, 0x81, 0x71, 0x82, 0xab, 0x4c, 0x06, 0xb3, 0xf3, 0x70, 0x33,
+	0x4b, 0xaa, 0xf1, 0x02, 0xd7, 0x20, 0x13, 0xff, 0x1b, 0xef, 0x8a, 0xdb, 0xf9, 0x88, 0xf0, 0xde,
+	0x39, 0xe5, 0x01, 0xe3, 0xe1, 0x5b, 0xca, 0x83, 0x73, 0xaf, 0xd0, 0x7d, 0x6f, 0xb7, 0xd0, 0xe6,
+	0x6e, 0x35, 0xb1, 0x96, 0xd2, 0x0f, 0x19, 0xe5, 0x3e, 0x2d, 0x5e, 0xa0, 0xba, 0x7f, 0xf1, 0x7a,
+	0x57, 0x95, 0xbb, 0xbb, 0xfa, 0x14, 0x57, 0xa5, 0x81, 0x65, 0xde, 0x25, 0x1a, 0xa8, 0x9a, 0xaa,
+	0xef, 0x3a, 0xef, 0xaf, 0x17, 0x16, 0xba, 0x59, 0x58, 0xe8, 0xf7, 0xc2, 0x42, 0x9f, 0x96, 0x56,
+	0xe5, 0x66, 0x69, 0x55, 0x7e, 0x2c, 0xad, 0xca, 0xbb, 0xd3, 0x90, 0x89, 0x49, 0x36, 0x22, 0x3e,
+	0xc4, 0xb6, 0x4c, 0x6d, 0xcc, 0xb8, 0xcd, 0x61, 0x14, 0xd1, 0xde, 0xd6, 0x27, 0x9e, 0x6f, 0xff,
+	0x6b, 0x71, 0x75, 0x41, 0xd3, 0x51, 0xb5, 0xd8, 0x97, 0xe3, 0x3f, 0x03, 0x00, 0x63, 0x83, 0x79,
+	0x78, 0xff, 0x03, 0x00, 0x00,
+}
+
+func (m *ChannelRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintChannelRateLimit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.WindowDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.WindowDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintChannelRateLimit(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if m.WindowBlocks != 0 {
+		i = encodeVarintChannelRateLimit(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MaxOutflow.Size()
+		i -= size
+		if _, err := m.MaxOutflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintChannelRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxInflow.Size()
+		i -= size
+		if _, err := m.MaxInflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintChannelRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintChannelRateLimit(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintChannelRateLimit(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelFlowBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelFlowBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelFlowBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Outflow.Size()
+		i -= size
+		if _, err := m.Outflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintChannelRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Inflow.Size()
+		i -= size
+		if _, err := m.Inflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintChannelRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Index != 0 {
+		i = encodeVarintChannelRateLimit(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingSendPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingSendPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingSendPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Bucket != 0 {
+		i = encodeVarintChannelRateLimit(dAtA, i, uint64(m.Bucket))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintChannelRateLimit(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintChannelRateLimit(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintChannelRateLimit(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintChannelRateLimit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovChannelRateLimit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ChannelRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovChannelRateLimit(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovChannelRateLimit(uint64(l))
+	}
+	l = m.MaxInflow.Size()
+	n += 1 + l + sovChannelRateLimit(uint64(l))
+	l = m.MaxOutflow.Size()
+	n += 1 + l + sovChannelRateLimit(uint64(l))
+	if m.WindowBlocks != 0 {
+		n += 1 + sovChannelRateLimit(uint64(m.WindowBlocks))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.WindowDuration)
+	n += 1 + l + sovChannelRateLimit(uint64(l))
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovChannelRateLimit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChannelFlowBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovChannelRateLimit(uint64(m.Index))
+	}
+	l = m.Inflow.Size()
+	n += 1 + l + sovChannelRateLimit(uint64(l))
+	l = m.Outflow.Size()
+	n += 1 + l + sovChannelRateLimit(uint64(l))
+	return n
+}
+
+func (m *PendingSendPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovChannelRateLimit(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovChannelRateLimit(uint64(m.Sequence))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovChannelRateLimit(uint64(l))
+	}
+	if m.Bucket != 0 {
+		n += 1 + sovChannelRateLimit(uint64(m.Bucket))
+	}
+	return n
+}
+
+func sovChannelRateLimit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozChannelRateLimit(x uint64) (n int) {
+	return sovChannelRateLimit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ChannelRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxInflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOutflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.WindowDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, ChannelFlowBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelFlowBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelFlowBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelFlowBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingSendPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingSendPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingSendPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			m.Bucket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bucket |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipChannelRateLimit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowChannelRateLimit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChannelRateLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthChannelRateLimit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupChannelRateLimit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthChannelRateLimit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthChannelRateLimit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowChannelRateLimit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupChannelRateLimit = fmt.Errorf("proto: unexpected end of group")
+)
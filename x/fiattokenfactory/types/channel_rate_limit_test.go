@@ -0,0 +1,125 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+func TestChannelRateLimit_NetFlow(t *testing.T) {
+	start := time.Unix(1000, 0).UTC()
+	rateLimit := types.NewChannelRateLimit("uusdc", "channel-0", math.NewInt(100), math.NewInt(50), 10, 0)
+
+	require.NoError(t, rateLimit.ConsumeOutflow(math.NewInt(50), 6, start))
+	require.ErrorIs(t, rateLimit.ConsumeOutflow(math.NewInt(1), 6, start), types.ErrRateLimitExceeded)
+
+	// inflow offsets the outflow of the window
+	require.NoError(t, rateLimit.ConsumeInflow(math.NewInt(150), 7, start))
+	require.ErrorIs(t, rateLimit.ConsumeInflow(math.NewInt(1), 7, start), types.ErrRateLimitExceeded)
+	require.NoError(t, rateLimit.ConsumeOutflow(math.NewInt(150), 8, start))
+	_, outflow := rateLimit.Flow(8, start)
+	require.Equal(t, math.NewInt(200), outflow)
+
+	// only the outflow of the bucket the transfer was sent in is reverted
+	rateLimit.UndoOutflow(math.NewInt(500), rateLimit.Bucket(8, start), 8, start)
+	_, outflow = rateLimit.Flow(8, start)
+	require.Equal(t, math.NewInt(50), outflow)
+}
+
+func TestChannelRateLimit_Uncapped(t *testing.T) {
+	start := time.Unix(1000, 0).UTC()
+	rateLimit := types.NewChannelRateLimit("uusdc", "channel-0", math.ZeroInt(), math.NewInt(50), 10, 0)
+
+	require.NoError(t, rateLimit.ConsumeInflow(math.NewInt(1_000_000), 6, start))
+}
+
+func TestChannelRateLimit_Window(t *testing.T) {
+	start := time.Unix(1000, 0).UTC()
+	rateLimit := types.NewChannelRateLimit("uusdc", "channel-0", math.NewInt(100), math.NewInt(100), 0, time.Hour)
+
+	require.NoError(t, rateLimit.ConsumeOutflow(math.NewInt(100), 2, start))
+	require.ErrorIs(t, rateLimit.ConsumeOutflow(math.NewInt(1), 3, start.Add(50*time.Minute)), types.ErrRateLimitExceeded)
+
+	// the outflow leaves the window an hour after it was sent
+	require.NoError(t, rateLimit.ConsumeOutflow(math.NewInt(100), 4, start.Add(time.Hour)))
+	_, outflow := rateLimit.Flow(4, start.Add(time.Hour))
+	require.Equal(t, math.NewInt(100), outflow)
+	require.Len(t, rateLimit.Buckets, 1)
+}
+
+func TestChannelRateLimit_SlidingWindow(t *testing.T) {
+	start := time.Unix(1000, 0).UTC()
+	rateLimit := types.NewChannelRateLimit("uusdc", "channel-0", math.ZeroInt(), math.NewInt(100), 10, 0)
+
+	require.NoError(t, rateLimit.ConsumeOutflow(math.NewInt(50), 10, start))
+	require.NoError(t, rateLimit.ConsumeOutflow(math.NewInt(50), 14, start))
+	require.ErrorIs(t, rateLimit.ConsumeOutflow(math.NewInt(1), 19, start), types.ErrRateLimitExceeded)
+
+	// the first transfer left the window, the second one still counts
+	require.NoError(t, rateLimit.ConsumeOutflow(math.NewInt(50), 20, start))
+	require.ErrorIs(t, rateLimit.ConsumeOutflow(math.NewInt(1), 23, start), types.ErrRateLimitExceeded)
+}
+
+func TestChannelRateLimit_Reconfigure(t *testing.T) {
+	start := time.Unix(1000, 0).UTC()
+	rateLimit := types.NewChannelRateLimit("uusdc", "channel-0", math.NewInt(100), math.NewInt(100), 10, 0)
+	require.NoError(t, rateLimit.ConsumeInflow(math.NewInt(30), 5, start))
+	require.NoError(t, rateLimit.ConsumeOutflow(math.NewInt(90), 6, start))
+
+	// changing the caps keeps the flow within the window
+	rateLimit.Reconfigure(math.NewInt(100), math.NewInt(70), 10, 0, 7, start)
+	require.ErrorIs(t, rateLimit.ConsumeOutflow(math.NewInt(11), 7, start), types.ErrRateLimitExceeded)
+	require.NoError(t, rateLimit.ConsumeOutflow(math.NewInt(10), 7, start))
+
+	// changing the window carries the flow into the new window
+	rateLimit.Reconfigure(math.NewInt(100), math.NewInt(70), 0, time.Hour, 8, start)
+	inflow, outflow := rateLimit.Flow(8, start)
+	require.Equal(t, math.NewInt(30), inflow)
+	require.Equal(t, math.NewInt(100), outflow)
+	require.ErrorIs(t, rateLimit.ConsumeOutflow(math.NewInt(1), 9, start), types.ErrRateLimitExceeded)
+}
+
+func TestChannelRateLimit_Validate(t *testing.T) {
+	valid := types.NewChannelRateLimit("uusdc", "channel-0", math.NewInt(100), math.ZeroInt(), 10, 0)
+	require.NoError(t, valid.Validate())
+
+	uncapped := valid
+	uncapped.MaxInflow = math.ZeroInt()
+	require.ErrorIs(t, uncapped.Validate(), types.ErrInvalidRateLimit)
+
+	negative := valid
+	negative.MaxOutflow = math.NewInt(-1)
+	require.ErrorIs(t, negative.Validate(), types.ErrInvalidRateLimit)
+
+	bothWindows := valid
+	bothWindows.WindowDuration = time.Hour
+	require.ErrorIs(t, bothWindows.Validate(), types.ErrInvalidRateLimit)
+
+	invalidChannel := valid
+	invalidChannel.ChannelId = "not a channel"
+	require.ErrorIs(t, invalidChannel.Validate(), types.ErrInvalidChannel)
+
+	negativeFlow := valid
+	negativeFlow.Buckets = []types.ChannelFlowBucket{{Index: 1, Inflow: math.ZeroInt(), Outflow: math.NewInt(-1)}}
+	require.ErrorIs(t, negativeFlow.Validate(), types.ErrInvalidRateLimit)
+}
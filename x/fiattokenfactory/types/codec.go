@@ -43,6 +43,29 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUnpause{}, "fiattokenfactory/Unpause", nil)
 	cdc.RegisterConcrete(&MsgConfigureMinterController{}, "fiattokenfactory/ConfigureMinterController", nil)
 	cdc.RegisterConcrete(&MsgRemoveMinterController{}, "fiattokenfactory/RemoveMinterController", nil)
+	cdc.RegisterConcrete(&MsgConfigureMinterRateLimit{}, "fiattokenfactory/ConfigureMinterRateLimit", nil)
+	cdc.RegisterConcrete(&MsgSetSignerSet{}, "fiattokenfactory/SetSignerSet", nil)
+	cdc.RegisterConcrete(&MsgApproveAction{}, "fiattokenfactory/ApproveAction", nil)
+	cdc.RegisterConcrete(&MsgCancelQueuedAction{}, "fiattokenfactory/CancelQueuedAction", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "fiattokenfactory/UpdateParams", nil)
+	cdc.RegisterConcrete(&MsgForceRoleAssignment{}, "fiattokenfactory/ForceRoleAssignment", nil)
+	cdc.RegisterConcrete(&MsgBlacklistBatch{}, "fiattokenfactory/BlacklistBatch", nil)
+	cdc.RegisterConcrete(&MsgUnblacklistBatch{}, "fiattokenfactory/UnblacklistBatch", nil)
+	cdc.RegisterConcrete(&MsgSeizeBlacklistedFunds{}, "fiattokenfactory/SeizeBlacklistedFunds", nil)
+	cdc.RegisterConcrete(&MsgSetChannelPolicy{}, "fiattokenfactory/SetChannelPolicy", nil)
+	cdc.RegisterConcrete(&MsgSetChannelRateLimit{}, "fiattokenfactory/SetChannelRateLimit", nil)
+	cdc.RegisterConcrete(&MsgUpdateAllowlister{}, "fiattokenfactory/UpdateAllowlister", nil)
+	cdc.RegisterConcrete(&MsgAllowlist{}, "fiattokenfactory/Allowlist", nil)
+	cdc.RegisterConcrete(&MsgUnallowlist{}, "fiattokenfactory/Unallowlist", nil)
+	cdc.RegisterConcrete(&MsgAllowlistBatch{}, "fiattokenfactory/AllowlistBatch", nil)
+	cdc.RegisterConcrete(&MsgUnallowlistBatch{}, "fiattokenfactory/UnallowlistBatch", nil)
+	cdc.RegisterConcrete(&MsgSetAllowlistMode{}, "fiattokenfactory/SetAllowlistMode", nil)
+	cdc.RegisterConcrete(&MsgCancelOwnershipTransfer{}, "fiattokenfactory/CancelOwnershipTransfer", nil)
+	cdc.RegisterConcrete(&MsgAcceptRole{}, "fiattokenfactory/AcceptRole", nil)
+	cdc.RegisterConcrete(&MsgRegisterMintingDenom{}, "fiattokenfactory/RegisterMintingDenom", nil)
+	cdc.RegisterConcrete(&MsgRemoveChannelPolicy{}, "fiattokenfactory/RemoveChannelPolicy", nil)
+	cdc.RegisterConcrete(&MsgReleaseEscrow{}, "fiattokenfactory/ReleaseEscrow", nil)
+	cdc.RegisterConcrete(&MsgBurnEscrow{}, "fiattokenfactory/BurnEscrow", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -61,6 +84,29 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUnpause{},
 		&MsgConfigureMinterController{},
 		&MsgRemoveMinterController{},
+		&MsgConfigureMinterRateLimit{},
+		&MsgSetSignerSet{},
+		&MsgApproveAction{},
+		&MsgCancelQueuedAction{},
+		&MsgUpdateParams{},
+		&MsgForceRoleAssignment{},
+		&MsgBlacklistBatch{},
+		&MsgUnblacklistBatch{},
+		&MsgSeizeBlacklistedFunds{},
+		&MsgSetChannelPolicy{},
+		&MsgSetChannelRateLimit{},
+		&MsgUpdateAllowlister{},
+		&MsgAllowlist{},
+		&MsgUnallowlist{},
+		&MsgAllowlistBatch{},
+		&MsgUnallowlistBatch{},
+		&MsgSetAllowlistMode{},
+		&MsgCancelOwnershipTransfer{},
+		&MsgAcceptRole{},
+		&MsgRegisterMintingDenom{},
+		&MsgRemoveChannelPolicy{},
+		&MsgReleaseEscrow{},
+		&MsgBurnEscrow{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
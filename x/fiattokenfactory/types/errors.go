@@ -24,18 +24,35 @@ import (
 
 // x/fiattokenfactory module sentinel errors
 var (
-	ErrUnauthorized       = errors.Register(ModuleName, 2, "unauthorized")
-	ErrUserNotFound       = errors.Register(ModuleName, 3, "user not found")
-	ErrMint               = errors.Register(ModuleName, 4, "tokens can not be minted")
-	ErrSendCoinsToAccount = errors.Register(ModuleName, 5, "can't send tokens to account")
-	ErrBurn               = errors.Register(ModuleName, 6, "tokens can not be burned")
-	ErrPaused             = errors.Register(ModuleName, 7, "the chain is paused")
-	ErrMintingDenomSet    = errors.Register(ModuleName, 9, "the minting denom has already been set")
-	ErrUserBlacklisted    = errors.Register(ModuleName, 10, "user is already blacklisted")
-	ErrAlreadyPrivileged  = errors.Register(ModuleName, 11, "address is already assigned to privileged role")
-	ErrDenomNotRegistered = errors.Register(ModuleName, 12, "denom not registered in bank module")
+	ErrUnauthorized         = errors.Register(ModuleName, 2, "unauthorized")
+	ErrUserNotFound         = errors.Register(ModuleName, 3, "user not found")
+	ErrMint                 = errors.Register(ModuleName, 4, "tokens can not be minted")
+	ErrSendCoinsToAccount   = errors.Register(ModuleName, 5, "can't send tokens to account")
+	ErrBurn                 = errors.Register(ModuleName, 6, "tokens can not be burned")
+	ErrPaused               = errors.Register(ModuleName, 7, "the chain is paused")
+	ErrMintingDenomSet      = errors.Register(ModuleName, 9, "the minting denom has already been set")
+	ErrUserBlacklisted      = errors.Register(ModuleName, 10, "user is already blacklisted")
+	ErrAlreadyPrivileged    = errors.Register(ModuleName, 11, "address is already assigned to privileged role")
+	ErrDenomNotRegistered   = errors.Register(ModuleName, 12, "denom not registered in bank module")
+	ErrRateLimitExceeded    = errors.Register(ModuleName, 13, "minting rate limit exceeded")
+	ErrProposalNotFound     = errors.Register(ModuleName, 14, "action proposal not found")
+	ErrProposalExpired      = errors.Register(ModuleName, 15, "action proposal has expired")
+	ErrAlreadyApproved      = errors.Register(ModuleName, 16, "action proposal is already approved by signer")
+	ErrQueuedActionNotFound = errors.Register(ModuleName, 17, "queued action not found")
+	ErrBatchTooLarge        = errors.Register(ModuleName, 18, "batch exceeds the maximum size")
+	ErrNoFundsToSeize       = errors.Register(ModuleName, 19, "no funds to seize")
+	ErrChannelNotAllowed    = errors.Register(ModuleName, 20, "channel is not allowed")
+	ErrUserAllowlisted      = errors.Register(ModuleName, 21, "user is already allowlisted")
+	ErrNotAllowlisted       = errors.Register(ModuleName, 22, "address is not allowlisted")
+	ErrInsufficientEscrow   = errors.Register(ModuleName, 23, "insufficient escrow balance")
 
-	ErrInvalidAddress = errors.Register(ModuleName, 100, "invalid address")
-	ErrInvalidCoins   = errors.Register(ModuleName, 101, "invalid coins")
-	ErrInvalidType    = errors.Register(ModuleName, 102, "invalid type")
+	ErrInvalidAddress   = errors.Register(ModuleName, 100, "invalid address")
+	ErrInvalidCoins     = errors.Register(ModuleName, 101, "invalid coins")
+	ErrInvalidType      = errors.Register(ModuleName, 102, "invalid type")
+	ErrInvalidDenom     = errors.Register(ModuleName, 103, "invalid denom")
+	ErrInvalidRateLimit = errors.Register(ModuleName, 104, "invalid rate limit")
+	ErrInvalidSignerSet = errors.Register(ModuleName, 105, "invalid signer set")
+	ErrInvalidParams    = errors.Register(ModuleName, 106, "invalid params")
+	ErrInvalidExpiry    = errors.Register(ModuleName, 107, "invalid expiry")
+	ErrInvalidChannel   = errors.Register(ModuleName, 108, "invalid channel")
 )
@@ -27,35 +27,135 @@ import (
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
+		Params:               DefaultParams(),
 		BlacklistedList:      []Blacklisted{},
-		Paused:               nil,
-		MasterMinter:         nil,
 		MintersList:          []Minters{},
-		Pauser:               nil,
-		Blacklister:          nil,
-		Owner:                nil,
 		MinterControllerList: []MinterController{},
-		MintingDenom:         nil,
+		MintingDenomList:     []MintingDenom{},
+		PausedList:           []Paused{},
+		MasterMinterList:     []MasterMinter{},
+		PauserList:           []Pauser{},
+		BlacklisterList:      []Blacklister{},
+		OwnerList:            []Owner{},
+		PendingOwnerList:     []Owner{},
+		AllowlisterList:      []Allowlister{},
+		AllowlistedList:      []Allowlisted{},
 	}
 }
 
+// MigrateSingleDenom moves the fields of the legacy single-denom layout into
+// the per-denom lists. Blacklisted and MinterController entries without a
+// denom are assigned to the legacy minting denom.
+func (gs *GenesisState) MigrateSingleDenom() {
+	if gs.MintingDenom == nil {
+		return
+	}
+
+	denom := gs.MintingDenom.Denom
+	gs.MintingDenomList = append(gs.MintingDenomList, *gs.MintingDenom)
+	gs.MintingDenom = nil
+
+	if gs.Paused != nil {
+		gs.PausedList = append(gs.PausedList, Paused{Paused: gs.Paused.Paused, Denom: denom})
+		gs.Paused = nil
+	}
+
+	if gs.MasterMinter != nil {
+		gs.MasterMinterList = append(gs.MasterMinterList, MasterMinter{Address: gs.MasterMinter.Address, Denom: denom})
+		gs.MasterMinter = nil
+	}
+
+	if gs.Pauser != nil {
+		gs.PauserList = append(gs.PauserList, Pauser{Address: gs.Pauser.Address, Denom: denom})
+		gs.Pauser = nil
+	}
+
+	if gs.Blacklister != nil {
+		gs.BlacklisterList = append(gs.BlacklisterList, Blacklister{Address: gs.Blacklister.Address, Denom: denom})
+		gs.Blacklister = nil
+	}
+
+	if gs.Owner != nil {
+		gs.OwnerList = append(gs.OwnerList, Owner{Address: gs.Owner.Address, Denom: denom})
+		gs.Owner = nil
+	}
+
+	blacklistedList := make([]Blacklisted, len(gs.BlacklistedList))
+	for i, elem := range gs.BlacklistedList {
+		if elem.Denom == "" {
+			elem.Denom = denom
+		}
+		blacklistedList[i] = elem
+	}
+	gs.BlacklistedList = blacklistedList
+
+	minterControllerList := make([]MinterController, len(gs.MinterControllerList))
+	for i, elem := range gs.MinterControllerList {
+		if elem.Denom == "" {
+			elem.Denom = denom
+		}
+		minterControllerList[i] = elem
+	}
+	gs.MinterControllerList = minterControllerList
+}
+
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	gs.MigrateSingleDenom()
+
+	if gs.Paused != nil || gs.MasterMinter != nil || gs.Pauser != nil || gs.Blacklister != nil || gs.Owner != nil {
+		return fmt.Errorf("single-denom state cannot be provided without a minting denom")
+	}
+
+	// Check for duplicated and empty minting denoms
+	mintingDenoms := make(map[string]struct{})
+	for _, elem := range gs.MintingDenomList {
+		if elem.Denom == "" {
+			return fmt.Errorf("minting denom cannot be an empty string")
+		}
+		if _, ok := mintingDenoms[elem.Denom]; ok {
+			return fmt.Errorf("duplicated index for mintingDenom")
+		}
+		mintingDenoms[elem.Denom] = struct{}{}
+	}
+
+	validateDenom := func(denom string) error {
+		if _, ok := mintingDenoms[denom]; !ok {
+			return errors.Wrapf(ErrDenomNotRegistered, "%s is not a minting denom", denom)
+		}
+		return nil
+	}
+
+	// denomIndex identifies an entry indexed by both a minting denom and a key
+	type denomIndex struct{ denom, key string }
+
 	// Check for duplicated index in blacklisted
-	blacklistedIndexMap := make(map[string]struct{})
+	blacklistedIndexMap := make(map[denomIndex]struct{})
 	for _, elem := range gs.BlacklistedList {
-		index := string(BlacklistedKey(elem.AddressBz))
+		index := denomIndex{elem.Denom, string(elem.AddressBz)}
 		if _, ok := blacklistedIndexMap[index]; ok {
 			return fmt.Errorf("duplicated index for blacklisted")
 		}
 		blacklistedIndexMap[index] = struct{}{}
+
+		if err := validateDenom(elem.Denom); err != nil {
+			return err
+		}
+
+		if err := ValidateBlacklistMetadata(elem.Reason, elem.ReferenceId); err != nil {
+			return err
+		}
+
+		if elem.ExpiresAtHeight < 0 {
+			return fmt.Errorf("blacklisted expiry height cannot be negative")
+		}
 	}
 
 	// Check for duplicated index in minters and validate minter addr and allowance
-	mintersIndexMap := make(map[string]struct{})
+	mintersIndexMap := make(map[denomIndex]struct{})
 	for _, elem := range gs.MintersList {
-		index := string(MintersKey(elem.Address))
+		index := denomIndex{elem.Allowance.Denom, elem.Address}
 		if _, ok := mintersIndexMap[index]; ok {
 			return fmt.Errorf("duplicated index for minters")
 		}
@@ -68,12 +168,22 @@ func (gs GenesisState) Validate() error {
 		if elem.Allowance.IsNil() || elem.Allowance.IsNegative() {
 			return errors.Wrap(ErrInvalidCoins, "minter allowance cannot be nil or negative")
 		}
+
+		if err := validateDenom(elem.Allowance.Denom); err != nil {
+			return err
+		}
+
+		if elem.RateLimit != nil {
+			if err := elem.RateLimit.Validate(); err != nil {
+				return err
+			}
+		}
 	}
 
 	// Check for duplicated index in minterController and validate both controller and minter addresses
-	minterControllerIndexMap := make(map[string]struct{})
+	minterControllerIndexMap := make(map[denomIndex]struct{})
 	for _, elem := range gs.MinterControllerList {
-		index := string(MinterControllerKey(elem.Controller))
+		index := denomIndex{elem.Denom, elem.Controller}
 		if _, ok := minterControllerIndexMap[index]; ok {
 			return fmt.Errorf("duplicated index for minterController")
 		}
@@ -86,51 +196,273 @@ func (gs GenesisState) Validate() error {
 		if _, err := sdk.AccAddressFromBech32(elem.Controller); err != nil {
 			return errors.Wrapf(ErrInvalidAddress, "minter controller has invalid controller address (%s)", err)
 		}
+
+		if err := validateDenom(elem.Denom); err != nil {
+			return err
+		}
 	}
 
-	var addresses []sdk.AccAddress
+	// Check for duplicated paused states
+	pausedIndexMap := make(map[string]struct{})
+	for _, elem := range gs.PausedList {
+		if _, ok := pausedIndexMap[elem.Denom]; ok {
+			return fmt.Errorf("duplicated index for paused")
+		}
+		pausedIndexMap[elem.Denom] = struct{}{}
 
-	if gs.Owner != nil {
-		owner, err := sdk.AccAddressFromBech32(gs.Owner.Address)
-		if err != nil {
-			return errors.Wrapf(ErrInvalidAddress, "invalid owner address (%s)", err)
+		if err := validateDenom(elem.Denom); err != nil {
+			return err
+		}
+
+		if err := elem.Validate(); err != nil {
+			return err
 		}
-		addresses = append(addresses, owner)
 	}
 
-	if gs.MasterMinter != nil {
-		masterMinter, err := sdk.AccAddressFromBech32(gs.MasterMinter.Address)
+	addresses := make(map[string][]sdk.AccAddress)
+
+	validateRole := func(role string, indexMap map[string]struct{}, address string, denom string) error {
+		if _, ok := indexMap[denom]; ok {
+			return fmt.Errorf("duplicated index for %s", role)
+		}
+		indexMap[denom] = struct{}{}
+
+		acc, err := sdk.AccAddressFromBech32(address)
 		if err != nil {
-			return errors.Wrapf(ErrInvalidAddress, "invalid master minter address (%s)", err)
+			return errors.Wrapf(ErrInvalidAddress, "invalid %s address (%s)", role, err)
+		}
+
+		if err := validateDenom(denom); err != nil {
+			return err
 		}
-		addresses = append(addresses, masterMinter)
+
+		addresses[denom] = append(addresses[denom], acc)
+		return nil
 	}
 
-	if gs.Pauser != nil {
-		pauser, err := sdk.AccAddressFromBech32(gs.Pauser.Address)
-		if err != nil {
-			return errors.Wrapf(ErrInvalidAddress, "invalid pauser address (%s)", err)
+	ownerIndexMap := make(map[string]struct{})
+	for _, elem := range gs.OwnerList {
+		if err := validateRole("owner", ownerIndexMap, elem.Address, elem.Denom); err != nil {
+			return err
 		}
-		addresses = append(addresses, pauser)
 	}
 
-	if gs.Blacklister != nil {
-		blacklister, err := sdk.AccAddressFromBech32(gs.Blacklister.Address)
-		if err != nil {
-			return errors.Wrapf(ErrInvalidAddress, "invalid black lister address (%s)", err)
+	masterMinterIndexMap := make(map[string]struct{})
+	for _, elem := range gs.MasterMinterList {
+		if err := validateRole("master minter", masterMinterIndexMap, elem.Address, elem.Denom); err != nil {
+			return err
 		}
-		addresses = append(addresses, blacklister)
 	}
 
-	if err := validatePrivileges(addresses); err != nil {
-		return err
+	pauserIndexMap := make(map[string]struct{})
+	for _, elem := range gs.PauserList {
+		if err := validateRole("pauser", pauserIndexMap, elem.Address, elem.Denom); err != nil {
+			return err
+		}
 	}
 
-	if gs.MintingDenom != nil && gs.MintingDenom.Denom == "" {
-		return fmt.Errorf("minting denom cannot be an empty string")
+	blacklisterIndexMap := make(map[string]struct{})
+	for _, elem := range gs.BlacklisterList {
+		if err := validateRole("black lister", blacklisterIndexMap, elem.Address, elem.Denom); err != nil {
+			return err
+		}
 	}
 
-	return nil
+	allowlisterIndexMap := make(map[string]struct{})
+	for _, elem := range gs.AllowlisterList {
+		if err := validateRole("allowlister", allowlisterIndexMap, elem.Address, elem.Denom); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range gs.MintingDenomList {
+		if err := validatePrivileges(addresses[elem.Denom]); err != nil {
+			return err
+		}
+	}
+
+	// Check for duplicated pending owners. They are not privileged until they
+	// accept the ownership, so they are left out of the privileges check.
+	pendingOwnerIndexMap := make(map[string]struct{})
+	for _, elem := range gs.PendingOwnerList {
+		if _, ok := pendingOwnerIndexMap[elem.Denom]; ok {
+			return fmt.Errorf("duplicated index for pending owner")
+		}
+		pendingOwnerIndexMap[elem.Denom] = struct{}{}
+
+		if _, err := sdk.AccAddressFromBech32(elem.Address); err != nil {
+			return errors.Wrapf(ErrInvalidAddress, "invalid pending owner address (%s)", err)
+		}
+
+		if err := validateDenom(elem.Denom); err != nil {
+			return err
+		}
+	}
+
+	// Check for duplicated pending roles. Like pending owners, they are left
+	// out of the privileges check.
+	pendingRoleIndexMap := make(map[denomIndex]struct{})
+	for _, elem := range gs.PendingRoles {
+		index := denomIndex{elem.Denom, elem.Role}
+		if _, ok := pendingRoleIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for pending role")
+		}
+		pendingRoleIndexMap[index] = struct{}{}
+
+		switch elem.Role {
+		case RoleMasterMinter, RolePauser, RoleBlacklister, RoleAllowlister:
+		default:
+			return errors.Wrapf(ErrInvalidType, "role %s cannot be pending", elem.Role)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(elem.Address); err != nil {
+			return errors.Wrapf(ErrInvalidAddress, "invalid pending %s address (%s)", elem.Role, err)
+		}
+
+		if err := validateDenom(elem.Denom); err != nil {
+			return err
+		}
+	}
+
+	// Check for duplicated audit log entries
+	auditLogIndexMap := make(map[uint64]struct{})
+	for _, elem := range gs.AuditLog {
+		if _, ok := auditLogIndexMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for audit log entry")
+		}
+		auditLogIndexMap[elem.Id] = struct{}{}
+	}
+
+	// Check for duplicated signer sets and validate their signers
+	signerSetIndexMap := make(map[denomIndex]struct{})
+	for _, elem := range gs.SignerSets {
+		index := denomIndex{elem.Denom, elem.Role}
+		if _, ok := signerSetIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for signer set")
+		}
+		signerSetIndexMap[index] = struct{}{}
+
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+
+		if err := validateDenom(elem.Denom); err != nil {
+			return err
+		}
+	}
+
+	// Check for duplicated action proposals
+	actionProposalIndexMap := make(map[uint64]struct{})
+	for _, elem := range gs.ActionProposals {
+		if _, ok := actionProposalIndexMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for action proposal")
+		}
+		actionProposalIndexMap[elem.Id] = struct{}{}
+
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Check for duplicated queued actions
+	queuedActionIndexMap := make(map[uint64]struct{})
+	for _, elem := range gs.QueuedActions {
+		if _, ok := queuedActionIndexMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for queued action")
+		}
+		queuedActionIndexMap[elem.Id] = struct{}{}
+
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+
+		if err := validateDenom(elem.Denom); err != nil {
+			return err
+		}
+	}
+
+	// Check for duplicated channel policies
+	channelPolicyIndexMap := make(map[denomIndex]struct{})
+	for _, elem := range gs.ChannelPolicies {
+		index := denomIndex{elem.Denom, elem.ChannelId}
+		if _, ok := channelPolicyIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for channel policy")
+		}
+		channelPolicyIndexMap[index] = struct{}{}
+
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+
+		if err := validateDenom(elem.Denom); err != nil {
+			return err
+		}
+	}
+
+	// Check for duplicated channel rate limits
+	channelRateLimitIndexMap := make(map[denomIndex]struct{})
+	for _, elem := range gs.ChannelRateLimits {
+		index := denomIndex{elem.Denom, elem.ChannelId}
+		if _, ok := channelRateLimitIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for channel rate limit")
+		}
+		channelRateLimitIndexMap[index] = struct{}{}
+
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+
+		if err := validateDenom(elem.Denom); err != nil {
+			return err
+		}
+	}
+
+	// Check for duplicated pending send packets
+	type packetIndex struct {
+		channelID string
+		sequence  uint64
+	}
+	pendingSendPacketIndexMap := make(map[packetIndex]struct{})
+	for _, elem := range gs.PendingSendPackets {
+		index := packetIndex{elem.ChannelId, elem.Sequence}
+		if _, ok := pendingSendPacketIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for pending send packet")
+		}
+		pendingSendPacketIndexMap[index] = struct{}{}
+
+		if err := validateDenom(elem.Denom); err != nil {
+			return err
+		}
+	}
+
+	// Check for duplicated index in allowlisted
+	allowlistedIndexMap := make(map[denomIndex]struct{})
+	for _, elem := range gs.AllowlistedList {
+		index := denomIndex{elem.Denom, string(elem.AddressBz)}
+		if _, ok := allowlistedIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for allowlisted")
+		}
+		allowlistedIndexMap[index] = struct{}{}
+
+		if err := validateDenom(elem.Denom); err != nil {
+			return err
+		}
+	}
+
+	// Check for duplicated allowlist enabled denoms
+	allowlistEnabledIndexMap := make(map[string]struct{})
+	for _, denom := range gs.AllowlistEnabledDenoms {
+		if _, ok := allowlistEnabledIndexMap[denom]; ok {
+			return fmt.Errorf("duplicated index for allowlist enabled denom")
+		}
+		allowlistEnabledIndexMap[denom] = struct{}{}
+
+		if err := validateDenom(denom); err != nil {
+			return err
+		}
+	}
+
+	return gs.Params.Validate()
 }
 
 // validatePrivileges ensures that the same address is not being assigned to more than one privileged role.
@@ -25,15 +25,38 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the fiattokenfactory module's genesis state.
 type GenesisState struct {
+	Params               Params             `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	BlacklistedList      []Blacklisted      `protobuf:"bytes,2,rep,name=blacklistedList,proto3" json:"blacklistedList"`
-	Paused               *Paused            `protobuf:"bytes,3,opt,name=paused,proto3" json:"paused,omitempty"`
-	MasterMinter         *MasterMinter      `protobuf:"bytes,4,opt,name=masterMinter,proto3" json:"masterMinter,omitempty"`
 	MintersList          []Minters          `protobuf:"bytes,5,rep,name=mintersList,proto3" json:"mintersList"`
-	Pauser               *Pauser            `protobuf:"bytes,6,opt,name=pauser,proto3" json:"pauser,omitempty"`
-	Blacklister          *Blacklister       `protobuf:"bytes,7,opt,name=blacklister,proto3" json:"blacklister,omitempty"`
-	Owner                *Owner             `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
 	MinterControllerList []MinterController `protobuf:"bytes,9,rep,name=minterControllerList,proto3" json:"minterControllerList"`
-	MintingDenom         *MintingDenom      `protobuf:"bytes,10,opt,name=mintingDenom,proto3" json:"mintingDenom,omitempty"`
+	// The following fields describe the legacy single-denom layout. They are
+	// only read on import, where they are assigned to mintingDenom.
+	Paused             *Paused             `protobuf:"bytes,3,opt,name=paused,proto3" json:"paused,omitempty"`
+	MasterMinter       *MasterMinter       `protobuf:"bytes,4,opt,name=masterMinter,proto3" json:"masterMinter,omitempty"`
+	Pauser             *Pauser             `protobuf:"bytes,6,opt,name=pauser,proto3" json:"pauser,omitempty"`
+	Blacklister        *Blacklister        `protobuf:"bytes,7,opt,name=blacklister,proto3" json:"blacklister,omitempty"`
+	Owner              *Owner              `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
+	MintingDenom       *MintingDenom       `protobuf:"bytes,10,opt,name=mintingDenom,proto3" json:"mintingDenom,omitempty"`
+	MintingDenomList   []MintingDenom      `protobuf:"bytes,11,rep,name=mintingDenomList,proto3" json:"mintingDenomList"`
+	PausedList         []Paused            `protobuf:"bytes,12,rep,name=pausedList,proto3" json:"pausedList"`
+	MasterMinterList   []MasterMinter      `protobuf:"bytes,13,rep,name=masterMinterList,proto3" json:"masterMinterList"`
+	PauserList         []Pauser            `protobuf:"bytes,14,rep,name=pauserList,proto3" json:"pauserList"`
+	BlacklisterList    []Blacklister       `protobuf:"bytes,15,rep,name=blacklisterList,proto3" json:"blacklisterList"`
+	OwnerList          []Owner             `protobuf:"bytes,16,rep,name=ownerList,proto3" json:"ownerList"`
+	AuditLog           []AuditEntry        `protobuf:"bytes,17,rep,name=auditLog,proto3" json:"auditLog"`
+	SignerSets         []SignerSet         `protobuf:"bytes,18,rep,name=signerSets,proto3" json:"signerSets"`
+	ActionProposals    []ActionProposal    `protobuf:"bytes,19,rep,name=actionProposals,proto3" json:"actionProposals"`
+	QueuedActions      []QueuedAction      `protobuf:"bytes,20,rep,name=queuedActions,proto3" json:"queuedActions"`
+	ChannelPolicies    []ChannelPolicy     `protobuf:"bytes,21,rep,name=channelPolicies,proto3" json:"channelPolicies"`
+	ChannelRateLimits  []ChannelRateLimit  `protobuf:"bytes,22,rep,name=channelRateLimits,proto3" json:"channelRateLimits"`
+	PendingSendPackets []PendingSendPacket `protobuf:"bytes,23,rep,name=pendingSendPackets,proto3" json:"pendingSendPackets"`
+	AllowlisterList    []Allowlister       `protobuf:"bytes,24,rep,name=allowlisterList,proto3" json:"allowlisterList"`
+	AllowlistedList    []Allowlisted       `protobuf:"bytes,25,rep,name=allowlistedList,proto3" json:"allowlistedList"`
+	// allowlistEnabledDenoms are the minting denoms whose allowlist mode is
+	// enabled.
+	AllowlistEnabledDenoms []string      `protobuf:"bytes,26,rep,name=allowlistEnabledDenoms,proto3" json:"allowlistEnabledDenoms,omitempty"`
+	PendingOwnerList       []Owner       `protobuf:"bytes,27,rep,name=pendingOwnerList,proto3" json:"pendingOwnerList"`
+	PendingRoles           []PendingRole `protobuf:"bytes,28,rep,name=pendingRoles,proto3" json:"pendingRoles"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +92,13 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func (m *GenesisState) GetBlacklistedList() []Blacklisted {
 	if m != nil {
 		return m.BlacklistedList
@@ -76,23 +106,30 @@ func (m *GenesisState) GetBlacklistedList() []Blacklisted {
 	return nil
 }
 
-func (m *GenesisState) GetPaused() *Paused {
+func (m *GenesisState) GetMintersList() []Minters {
 	if m != nil {
-		return m.Paused
+		return m.MintersList
 	}
 	return nil
 }
 
-func (m *GenesisState) GetMasterMinter() *MasterMinter {
+func (m *GenesisState) GetMinterControllerList() []MinterController {
 	if m != nil {
-		return m.MasterMinter
+		return m.MinterControllerList
 	}
 	return nil
 }
 
-func (m *GenesisState) GetMintersList() []Minters {
+func (m *GenesisState) GetPaused() *Paused {
 	if m != nil {
-		return m.MintersList
+		return m.Paused
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMasterMinter() *MasterMinter {
+	if m != nil {
+		return m.MasterMinter
 	}
 	return nil
 }
@@ -118,16 +155,135 @@ func (m *GenesisState) GetOwner() *Owner {
 	return nil
 }
 
-func (m *GenesisState) GetMinterControllerList() []MinterController {
+func (m *GenesisState) GetMintingDenom() *MintingDenom {
 	if m != nil {
-		return m.MinterControllerList
+		return m.MintingDenom
 	}
 	return nil
 }
 
-func (m *GenesisState) GetMintingDenom() *MintingDenom {
+func (m *GenesisState) GetMintingDenomList() []MintingDenom {
 	if m != nil {
-		return m.MintingDenom
+		return m.MintingDenomList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPausedList() []Paused {
+	if m != nil {
+		return m.PausedList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMasterMinterList() []MasterMinter {
+	if m != nil {
+		return m.MasterMinterList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPauserList() []Pauser {
+	if m != nil {
+		return m.PauserList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBlacklisterList() []Blacklister {
+	if m != nil {
+		return m.BlacklisterList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOwnerList() []Owner {
+	if m != nil {
+		return m.OwnerList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuditLog() []AuditEntry {
+	if m != nil {
+		return m.AuditLog
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSignerSets() []SignerSet {
+	if m != nil {
+		return m.SignerSets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetActionProposals() []ActionProposal {
+	if m != nil {
+		return m.ActionProposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQueuedActions() []QueuedAction {
+	if m != nil {
+		return m.QueuedActions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChannelPolicies() []ChannelPolicy {
+	if m != nil {
+		return m.ChannelPolicies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChannelRateLimits() []ChannelRateLimit {
+	if m != nil {
+		return m.ChannelRateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingSendPackets() []PendingSendPacket {
+	if m != nil {
+		return m.PendingSendPackets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAllowlisterList() []Allowlister {
+	if m != nil {
+		return m.AllowlisterList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAllowlistedList() []Allowlisted {
+	if m != nil {
+		return m.AllowlistedList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAllowlistEnabledDenoms() []string {
+	if m != nil {
+		return m.AllowlistEnabledDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingOwnerList() []Owner {
+	if m != nil {
+		return m.PendingOwnerList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingRoles() []PendingRole {
+	if m != nil {
+		return m.PendingRoles
 	}
 	return nil
 }
@@ -141,36 +297,63 @@ func init() {
 }
 
 var fileDescriptor_9bceddd93438df2a = []byte{
-	// 462 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x1c, 0xc5, 0x93, 0xad, 0x2d, 0xc3, 0x99, 0x04, 0xb2, 0x76, 0x88, 0x76, 0x08, 0x63, 0x48, 0x2c,
-	0x87, 0x91, 0x68, 0xe5, 0x80, 0xc4, 0xb1, 0x4c, 0x42, 0x40, 0x2b, 0x50, 0x38, 0x20, 0x71, 0xa0,
-	0x4a, 0x52, 0x27, 0x58, 0x4d, 0xec, 0xca, 0x76, 0x0b, 0xfd, 0x16, 0x7c, 0x26, 0x4e, 0x3d, 0xf6,
-	0xc8, 0x09, 0xa1, 0xf6, 0x8b, 0xa0, 0xd8, 0x21, 0x4d, 0x5b, 0xe6, 0xe6, 0x66, 0x45, 0xbf, 0xf7,
-	0xe2, 0xff, 0x7b, 0xfe, 0x03, 0x37, 0xc6, 0x2c, 0xce, 0x90, 0x9f, 0xe0, 0x50, 0x08, 0x3a, 0x46,
-	0x24, 0x09, 0x63, 0x41, 0xd9, 0xdc, 0x9f, 0xdd, 0xf8, 0x29, 0x22, 0x88, 0x63, 0xee, 0x4d, 0x18,
-	0x15, 0x14, 0x9e, 0x2b, 0xd2, 0xdb, 0x25, 0xbd, 0xd9, 0xcd, 0xf9, 0xb5, 0xc6, 0x25, 0xca, 0xc2,
-	0x78, 0x9c, 0x61, 0x2e, 0xd0, 0x48, 0x39, 0x35, 0xa4, 0x59, 0x49, 0x7b, 0x1a, 0x3a, 0x0f, 0x0b,
-	0x70, 0x98, 0x63, 0xb2, 0xe1, 0xbb, 0x3a, 0x5e, 0x82, 0xc3, 0x98, 0x12, 0xc1, 0x68, 0x96, 0x55,
-	0x1a, 0xf7, 0xa0, 0x86, 0x37, 0xb9, 0x0d, 0x26, 0x02, 0x93, 0x74, 0x38, 0x42, 0x84, 0xe6, 0x25,
-	0xff, 0x54, 0xc3, 0xd3, 0x6f, 0xa4, 0xba, 0xc1, 0x95, 0x86, 0x9b, 0x84, 0x53, 0x5e, 0x85, 0x77,
-	0x10, 0xfc, 0xe7, 0x78, 0x96, 0xd2, 0x94, 0xca, 0xa3, 0x5f, 0x9c, 0xd4, 0xd7, 0xcb, 0x9f, 0x6d,
-	0x70, 0xfa, 0x5a, 0xf5, 0xfa, 0x51, 0x84, 0x02, 0xc1, 0x4f, 0xe0, 0x41, 0xad, 0xa1, 0x3e, 0xe6,
-	0xc2, 0x3e, 0xba, 0x38, 0x76, 0xad, 0xee, 0x95, 0x77, 0x77, 0xe1, 0x5e, 0x6f, 0x23, 0xe9, 0xb5,
-	0x16, 0xbf, 0x1f, 0x19, 0xc1, 0xae, 0x0b, 0x7c, 0x09, 0x3a, 0xea, 0xe2, 0xf6, 0xf1, 0x85, 0xe9,
-	0x5a, 0xdd, 0x4b, 0x9d, 0xdf, 0x07, 0x49, 0x06, 0xa5, 0x02, 0xf6, 0xc1, 0xa9, 0xaa, 0x76, 0x20,
-	0xc3, 0xb7, 0x5b, 0xd2, 0xc1, 0xd5, 0x39, 0x0c, 0x6a, 0x7c, 0xb0, 0xa5, 0x86, 0xef, 0x80, 0x55,
-	0x96, 0x28, 0xc7, 0x6b, 0xcb, 0xf1, 0x9e, 0x68, 0xcd, 0x14, 0x5e, 0x8e, 0x56, 0x57, 0x57, 0x63,
-	0x31, 0xbb, 0xd3, 0x70, 0x2c, 0x56, 0x8e, 0xc5, 0xe0, 0x1b, 0x60, 0xd5, 0xde, 0xb7, 0x7d, 0x4f,
-	0x1a, 0x34, 0xcc, 0x99, 0x05, 0x75, 0x2d, 0x7c, 0x01, 0xda, 0xf2, 0xf9, 0xd8, 0x27, 0xd2, 0xe4,
-	0xb1, 0xce, 0xe4, 0x7d, 0x01, 0x06, 0x8a, 0x87, 0x09, 0x38, 0x53, 0xe3, 0xbc, 0xaa, 0x96, 0x40,
-	0xa6, 0x72, 0x5f, 0xa6, 0x72, 0x7d, 0x38, 0x95, 0x8d, 0xae, 0x8c, 0xe7, 0xbf, 0x7e, 0xb2, 0x42,
-	0xb5, 0x0f, 0xb7, 0xc5, 0x3a, 0xd8, 0xa0, 0x41, 0x85, 0x35, 0x3e, 0xd8, 0x52, 0xbf, 0x6d, 0x9d,
-	0x98, 0x0f, 0x8f, 0x8a, 0x1c, 0x59, 0x98, 0xf3, 0xde, 0x97, 0xc5, 0xca, 0x31, 0x97, 0x2b, 0xc7,
-	0xfc, 0xb3, 0x72, 0xcc, 0x1f, 0x6b, 0xc7, 0x58, 0xae, 0x1d, 0xe3, 0xd7, 0xda, 0x31, 0x3e, 0xdf,
-	0xa6, 0x58, 0x7c, 0x9d, 0x46, 0x5e, 0x4c, 0x73, 0x5f, 0xfd, 0x29, 0xc1, 0xc4, 0x27, 0x34, 0xca,
-	0xd0, 0xb3, 0xbd, 0x8d, 0xf9, 0xbe, 0xbf, 0x44, 0x62, 0x3e, 0x41, 0x3c, 0xea, 0xc8, 0x5d, 0x79,
-	0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x6a, 0x13, 0x66, 0x1d, 0x05, 0x00, 0x00,
+	// 885 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0x5f, 0x6f, 0x5b, 0x35,
+	0x18, 0xc6, 0x1b, 0xb2, 0x95, 0xd5, 0xe9, 0x68, 0x6b, 0xca, 0x30, 0x05, 0x85, 0x30, 0xc4, 0x96,
+	0xa1, 0x35, 0xd1, 0x3a, 0x09, 0x24, 0xae, 0x58, 0xbb, 0x8a, 0xa1, 0x15, 0x2d, 0x3b, 0x41, 0x42,
+	0xf4, 0x82, 0xe0, 0x9c, 0xe3, 0x64, 0x56, 0x1c, 0x3b, 0xd8, 0x4e, 0x47, 0xbe, 0x05, 0x1f, 0x6b,
+	0x97, 0xbb, 0xe4, 0x0a, 0x50, 0xfb, 0x45, 0xd0, 0xb1, 0x9d, 0xf3, 0x27, 0x59, 0x1d, 0xe7, 0x2e,
+	0x39, 0x79, 0x9e, 0xdf, 0x79, 0xec, 0xf7, 0x7d, 0x1d, 0x83, 0x66, 0x4c, 0x65, 0xcc, 0x48, 0x7b,
+	0x40, 0xb1, 0xd6, 0x62, 0x44, 0xf8, 0x00, 0xc7, 0x5a, 0xc8, 0x59, 0xfb, 0xe2, 0x51, 0x7b, 0x48,
+	0x38, 0x51, 0x54, 0xb5, 0x26, 0x52, 0x68, 0x01, 0x0f, 0xac, 0xb2, 0xb5, 0xa8, 0x6c, 0x5d, 0x3c,
+	0x3a, 0x78, 0xe8, 0xa1, 0x60, 0xc6, 0xc4, 0x6b, 0x46, 0x95, 0x26, 0x89, 0x25, 0x05, 0xaa, 0xa5,
+	0x53, 0x3f, 0xf0, 0xa9, 0x27, 0x13, 0x29, 0x2e, 0x30, 0x73, 0xd2, 0x7b, 0x3e, 0xe9, 0x34, 0xa1,
+	0x3a, 0x20, 0x40, 0x9f, 0xe1, 0x78, 0x14, 0x1c, 0x37, 0x57, 0xcf, 0xe3, 0xb6, 0x3d, 0xea, 0xf8,
+	0x15, 0xe6, 0x9c, 0xb0, 0xde, 0x44, 0x30, 0x1a, 0xcf, 0x9c, 0xe1, 0x71, 0x80, 0x41, 0x62, 0x4d,
+	0x7a, 0x8c, 0x8e, 0xb3, 0x15, 0xb4, 0x3c, 0xa6, 0x31, 0x4e, 0xe3, 0xf4, 0xc6, 0x94, 0xe7, 0xa9,
+	0x8e, 0x7c, 0x7a, 0x23, 0xec, 0xc5, 0x82, 0x6b, 0x29, 0x18, 0xcb, 0x3c, 0xcd, 0x95, 0x1e, 0x15,
+	0x92, 0x86, 0x72, 0x4d, 0xf9, 0xb0, 0x97, 0x10, 0x2e, 0xc6, 0x01, 0x75, 0x12, 0xaf, 0x79, 0x96,
+	0xe0, 0xbe, 0x47, 0x37, 0xc1, 0x12, 0x8f, 0x55, 0x90, 0x70, 0xaa, 0x48, 0x12, 0x2a, 0x9c, 0xbf,
+	0xfa, 0xd0, 0x27, 0x24, 0x3c, 0x49, 0x97, 0x24, 0x05, 0x23, 0x01, 0x4d, 0xaa, 0xe9, 0x98, 0x30,
+	0x11, 0x8f, 0x9c, 0x74, 0x7f, 0x28, 0x86, 0xc2, 0x7c, 0x6c, 0xa7, 0x9f, 0xec, 0xd3, 0xbb, 0xff,
+	0xee, 0x81, 0xed, 0x1f, 0xec, 0xbc, 0x75, 0x35, 0xd6, 0x04, 0x7e, 0x0f, 0x36, 0xed, 0x12, 0x51,
+	0xa5, 0x51, 0x69, 0xd6, 0x8e, 0xee, 0xb6, 0xae, 0x9f, 0xbf, 0x56, 0xc7, 0x28, 0x8f, 0x6f, 0xbc,
+	0xf9, 0xe7, 0xf3, 0x8d, 0xc8, 0xf9, 0xe0, 0x2f, 0x60, 0xa7, 0xd0, 0xcc, 0x67, 0x54, 0x69, 0xf4,
+	0x5e, 0xa3, 0xda, 0xac, 0x1d, 0xdd, 0xf7, 0xa1, 0x8e, 0x73, 0x8b, 0xe3, 0x2d, 0x52, 0xe0, 0x73,
+	0x50, 0x73, 0xf5, 0x37, 0xd0, 0x9b, 0x06, 0xfa, 0xa5, 0x0f, 0xfa, 0x93, 0x95, 0x3b, 0x60, 0xd1,
+	0x0d, 0x07, 0x60, 0xdf, 0x7e, 0x3d, 0xc9, 0xfa, 0xcf, 0x50, 0xb7, 0x0c, 0xf5, 0xe1, 0x6a, 0x6a,
+	0xee, 0x73, 0xf8, 0x77, 0xf2, 0xe0, 0x77, 0x60, 0xd3, 0x76, 0x02, 0xaa, 0x86, 0xec, 0x67, 0xaa,
+	0x8c, 0x9c, 0x03, 0x9e, 0x81, 0x6d, 0x3b, 0x54, 0xf6, 0x8d, 0xe8, 0x86, 0x21, 0x34, 0xbd, 0xd9,
+	0x0a, 0xfa, 0xa8, 0xe4, 0xce, 0x92, 0x48, 0xb4, 0x19, 0x98, 0x44, 0xba, 0x24, 0x12, 0xfe, 0x08,
+	0x6a, 0x85, 0x23, 0x07, 0xbd, 0xdf, 0xa8, 0x84, 0xd7, 0x53, 0x46, 0x45, 0x2f, 0xfc, 0x16, 0xdc,
+	0x34, 0xb3, 0x86, 0x6e, 0x19, 0xc8, 0x17, 0x3e, 0xc8, 0x8b, 0x54, 0x18, 0x59, 0xbd, 0xd9, 0x0d,
+	0x3b, 0xd4, 0x4f, 0xd3, 0x99, 0x46, 0x20, 0x60, 0x37, 0x0a, 0xfa, 0xa8, 0xe4, 0x86, 0xe7, 0x60,
+	0xb7, 0xf8, 0xdd, 0xd4, 0xbe, 0xd6, 0xa8, 0xae, 0x43, 0x74, 0x75, 0x5f, 0xe2, 0xc0, 0x67, 0x00,
+	0xd8, 0x0a, 0x1a, 0xea, 0x76, 0xa3, 0x1a, 0xb4, 0xdb, 0xf3, 0xbe, 0x2f, 0x78, 0x4d, 0xca, 0x42,
+	0x0d, 0x0d, 0xef, 0x76, 0x40, 0xca, 0x82, 0x27, 0x4b, 0xb9, 0xc0, 0xc9, 0x52, 0x5a, 0xea, 0x07,
+	0x81, 0x29, 0x65, 0x29, 0xa5, 0x25, 0x95, 0x26, 0xde, 0xe2, 0x76, 0xd6, 0x99, 0x78, 0xb9, 0x3c,
+	0xf1, 0x16, 0x7c, 0x0a, 0xb6, 0x4c, 0xed, 0x0d, 0x72, 0xb7, 0x51, 0x0d, 0xea, 0x17, 0x07, 0xcb,
+	0x9d, 0xf0, 0x19, 0xb8, 0x65, 0xfe, 0x86, 0xcf, 0xc4, 0x10, 0xed, 0x19, 0xca, 0x3d, 0x1f, 0xe5,
+	0x49, 0xaa, 0x3d, 0xe5, 0x5a, 0xce, 0x1c, 0x2a, 0x73, 0xc3, 0xe7, 0x00, 0x28, 0x3a, 0xe4, 0x44,
+	0x76, 0x89, 0x56, 0x08, 0x1a, 0xd6, 0x57, 0x3e, 0x56, 0x77, 0xae, 0x9e, 0x6f, 0x5b, 0x6e, 0x87,
+	0xe7, 0x60, 0x07, 0xc7, 0x9a, 0x0a, 0xde, 0x91, 0x62, 0x22, 0x14, 0x66, 0x0a, 0x7d, 0x68, 0x88,
+	0x5f, 0x7b, 0xd3, 0x95, 0x2c, 0xf3, 0x9d, 0x5b, 0x00, 0xc1, 0x9f, 0xc1, 0xed, 0x3f, 0xa6, 0x64,
+	0x4a, 0x12, 0x2b, 0x57, 0x68, 0x7f, 0x75, 0xd7, 0xbc, 0x2c, 0x18, 0x1c, 0xb7, 0x0c, 0x81, 0xbf,
+	0x82, 0x1d, 0x77, 0x35, 0xe8, 0x08, 0x46, 0x63, 0x4a, 0x14, 0xfa, 0xc8, 0x70, 0x1f, 0xf8, 0xb8,
+	0x27, 0x05, 0xcb, 0x7c, 0x4b, 0x17, 0x39, 0xf0, 0x77, 0xb0, 0xe7, 0x1e, 0x45, 0x58, 0x93, 0xb3,
+	0xf4, 0xce, 0xa1, 0xd0, 0x9d, 0xd5, 0x87, 0xf1, 0xc9, 0x82, 0xc9, 0xf1, 0x97, 0x61, 0x30, 0x06,
+	0xd0, 0xfd, 0x83, 0x76, 0x09, 0x4f, 0x3a, 0x38, 0x1e, 0xa5, 0x35, 0xfc, 0xd8, 0xbc, 0xe2, 0xd0,
+	0xdb, 0xf7, 0x8b, 0x2e, 0xf7, 0x8e, 0x77, 0xe0, 0xd2, 0x51, 0x28, 0x5c, 0x25, 0x4d, 0xdf, 0xa2,
+	0xd5, 0xa3, 0xf0, 0x24, 0xb7, 0x64, 0x05, 0x2d, 0x53, 0xca, 0x60, 0x7b, 0xb0, 0x7c, 0xb2, 0x0e,
+	0x38, 0x59, 0x06, 0xdb, 0x23, 0xe6, 0x1b, 0x70, 0x27, 0x7b, 0x74, 0xca, 0x71, 0x9f, 0x91, 0xc4,
+	0x9c, 0x64, 0x0a, 0x1d, 0x34, 0xaa, 0xcd, 0xad, 0xe8, 0x9a, 0x5f, 0x61, 0x17, 0xec, 0xba, 0xf5,
+	0xbf, 0xc8, 0x46, 0xf4, 0xd3, 0xf5, 0x46, 0x74, 0x09, 0x00, 0x5f, 0x82, 0x6d, 0xf7, 0x2c, 0x12,
+	0x8c, 0x28, 0xf4, 0xd9, 0xea, 0x25, 0x76, 0x72, 0xbd, 0xc3, 0x96, 0x10, 0xc7, 0xbf, 0xbd, 0xb9,
+	0xac, 0x57, 0xde, 0x5e, 0xd6, 0x2b, 0xff, 0x5d, 0xd6, 0x2b, 0x7f, 0x5d, 0xd5, 0x37, 0xde, 0x5e,
+	0xd5, 0x37, 0xfe, 0xbe, 0xaa, 0x6f, 0x9c, 0x3f, 0x1d, 0x52, 0xfd, 0x6a, 0xda, 0x6f, 0xc5, 0x62,
+	0xec, 0x6e, 0xcf, 0x03, 0xca, 0xdb, 0x5c, 0xf4, 0x19, 0x39, 0x5c, 0xba, 0x50, 0xfd, 0xb9, 0x7c,
+	0xc7, 0xd2, 0xb3, 0x09, 0x51, 0xfd, 0x4d, 0x73, 0x91, 0x7a, 0xfc, 0xff, 0x00, 0x79, 0x71, 0x6a,
+	0x85, 0xd2, 0x0c, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -193,22 +376,26 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.MintingDenom != nil {
-		{
-			size, err := m.MintingDenom.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.PendingRoles) > 0 {
+		for iNdEx := len(m.PendingRoles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRoles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
 		}
-		i--
-		dAtA[i] = 0x52
 	}
-	if len(m.MinterControllerList) > 0 {
-		for iNdEx := len(m.MinterControllerList) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PendingOwnerList) > 0 {
+		for iNdEx := len(m.PendingOwnerList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.MinterControllerList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PendingOwnerList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -216,200 +403,1212 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
 		}
 	}
-	if m.Owner != nil {
-		{
-			size, err := m.Owner.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.AllowlistEnabledDenoms) > 0 {
+		for iNdEx := len(m.AllowlistEnabledDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowlistEnabledDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowlistEnabledDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AllowlistEnabledDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.AllowlistedList) > 0 {
+		for iNdEx := len(m.AllowlistedList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowlistedList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
 		}
-		i--
-		dAtA[i] = 0x42
 	}
-	if m.Blacklister != nil {
-		{
-			size, err := m.Blacklister.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.AllowlisterList) > 0 {
+		for iNdEx := len(m.AllowlisterList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowlisterList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
 		}
-		i--
-		dAtA[i] = 0x3a
 	}
-	if m.Pauser != nil {
-		{
-			size, err := m.Pauser.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.PendingSendPackets) > 0 {
+		for iNdEx := len(m.PendingSendPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingSendPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
 		}
-		i--
-		dAtA[i] = 0x32
 	}
-	if len(m.MintersList) > 0 {
-		for iNdEx := len(m.MintersList) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ChannelRateLimits) > 0 {
+		for iNdEx := len(m.ChannelRateLimits) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.MintersList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ChannelRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.ChannelPolicies) > 0 {
+		for iNdEx := len(m.ChannelPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.QueuedActions) > 0 {
+		for iNdEx := len(m.QueuedActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.ActionProposals) > 0 {
+		for iNdEx := len(m.ActionProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActionProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.SignerSets) > 0 {
+		for iNdEx := len(m.SignerSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignerSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.AuditLog) > 0 {
+		for iNdEx := len(m.AuditLog) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuditLog[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.OwnerList) > 0 {
+		for iNdEx := len(m.OwnerList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OwnerList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.BlacklisterList) > 0 {
+		for iNdEx := len(m.BlacklisterList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlacklisterList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.PauserList) > 0 {
+		for iNdEx := len(m.PauserList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PauserList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.MasterMinterList) > 0 {
+		for iNdEx := len(m.MasterMinterList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MasterMinterList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.PausedList) > 0 {
+		for iNdEx := len(m.PausedList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.MintingDenomList) > 0 {
+		for iNdEx := len(m.MintingDenomList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintingDenomList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.MintingDenom != nil {
+		{
+			size, err := m.MintingDenom.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.MinterControllerList) > 0 {
+		for iNdEx := len(m.MinterControllerList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinterControllerList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Owner != nil {
+		{
+			size, err := m.Owner.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Blacklister != nil {
+		{
+			size, err := m.Blacklister.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Pauser != nil {
+		{
+			size, err := m.Pauser.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MintersList) > 0 {
+		for iNdEx := len(m.MintersList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintersList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MasterMinter != nil {
+		{
+			size, err := m.MasterMinter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Paused != nil {
+		{
+			size, err := m.Paused.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BlacklistedList) > 0 {
+		for iNdEx := len(m.BlacklistedList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlacklistedList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BlacklistedList) > 0 {
+		for _, e := range m.BlacklistedList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Paused != nil {
+		l = m.Paused.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.MasterMinter != nil {
+		l = m.MasterMinter.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.MintersList) > 0 {
+		for _, e := range m.MintersList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Pauser != nil {
+		l = m.Pauser.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Blacklister != nil {
+		l = m.Blacklister.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Owner != nil {
+		l = m.Owner.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.MinterControllerList) > 0 {
+		for _, e := range m.MinterControllerList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MintingDenom != nil {
+		l = m.MintingDenom.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.MintingDenomList) > 0 {
+		for _, e := range m.MintingDenomList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PausedList) > 0 {
+		for _, e := range m.PausedList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MasterMinterList) > 0 {
+		for _, e := range m.MasterMinterList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PauserList) > 0 {
+		for _, e := range m.PauserList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BlacklisterList) > 0 {
+		for _, e := range m.BlacklisterList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OwnerList) > 0 {
+		for _, e := range m.OwnerList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuditLog) > 0 {
+		for _, e := range m.AuditLog {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SignerSets) > 0 {
+		for _, e := range m.SignerSets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ActionProposals) > 0 {
+		for _, e := range m.ActionProposals {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QueuedActions) > 0 {
+		for _, e := range m.QueuedActions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChannelPolicies) > 0 {
+		for _, e := range m.ChannelPolicies {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChannelRateLimits) > 0 {
+		for _, e := range m.ChannelRateLimits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingSendPackets) > 0 {
+		for _, e := range m.PendingSendPackets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllowlisterList) > 0 {
+		for _, e := range m.AllowlisterList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllowlistedList) > 0 {
+		for _, e := range m.AllowlistedList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllowlistEnabledDenoms) > 0 {
+		for _, s := range m.AllowlistEnabledDenoms {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingOwnerList) > 0 {
+		for _, e := range m.PendingOwnerList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingRoles) > 0 {
+		for _, e := range m.PendingRoles {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistedList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlacklistedList = append(m.BlacklistedList, Blacklisted{})
+			if err := m.BlacklistedList[len(m.BlacklistedList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Paused == nil {
+				m.Paused = &Paused{}
+			}
+			if err := m.Paused.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterMinter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MasterMinter == nil {
+				m.MasterMinter = &MasterMinter{}
+			}
+			if err := m.MasterMinter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintersList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintersList = append(m.MintersList, Minters{})
+			if err := m.MintersList[len(m.MintersList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pauser", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pauser == nil {
+				m.Pauser = &Pauser{}
+			}
+			if err := m.Pauser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blacklister", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Blacklister == nil {
+				m.Blacklister = &Blacklister{}
+			}
+			if err := m.Blacklister.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Owner == nil {
+				m.Owner = &Owner{}
+			}
+			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterControllerList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterControllerList = append(m.MinterControllerList, MinterController{})
+			if err := m.MinterControllerList[len(m.MinterControllerList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingDenom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MintingDenom == nil {
+				m.MintingDenom = &MintingDenom{}
+			}
+			if err := m.MintingDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingDenomList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintingDenomList = append(m.MintingDenomList, MintingDenom{})
+			if err := m.MintingDenomList[len(m.MintingDenomList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedList = append(m.PausedList, Paused{})
+			if err := m.PausedList[len(m.PausedList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterMinterList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MasterMinterList = append(m.MasterMinterList, MasterMinter{})
+			if err := m.MasterMinterList[len(m.MasterMinterList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauserList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PauserList = append(m.PauserList, Pauser{})
+			if err := m.PauserList[len(m.PauserList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlacklisterList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlacklisterList = append(m.BlacklisterList, Blacklister{})
+			if err := m.BlacklisterList[len(m.BlacklisterList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerList = append(m.OwnerList, Owner{})
+			if err := m.OwnerList[len(m.OwnerList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditLog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if m.MasterMinter != nil {
-		{
-			size, err := m.MasterMinter.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Paused != nil {
-		{
-			size, err := m.Paused.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BlacklistedList) > 0 {
-		for iNdEx := len(m.BlacklistedList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BlacklistedList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuditLog = append(m.AuditLog, AuditEntry{})
+			if err := m.AuditLog[len(m.AuditLog)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.BlacklistedList) > 0 {
-		for _, e := range m.BlacklistedList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.Paused != nil {
-		l = m.Paused.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.MasterMinter != nil {
-		l = m.MasterMinter.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if len(m.MintersList) > 0 {
-		for _, e := range m.MintersList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.Pauser != nil {
-		l = m.Pauser.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.Blacklister != nil {
-		l = m.Blacklister.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.Owner != nil {
-		l = m.Owner.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if len(m.MinterControllerList) > 0 {
-		for _, e := range m.MinterControllerList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.MintingDenom != nil {
-		l = m.MintingDenom.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.SignerSets = append(m.SignerSets, SignerSet{})
+			if err := m.SignerSets[len(m.SignerSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
+			iNdEx = postIndex
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistedList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionProposals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -436,14 +1635,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlacklistedList = append(m.BlacklistedList, Blacklisted{})
-			if err := m.BlacklistedList[len(m.BlacklistedList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ActionProposals = append(m.ActionProposals, ActionProposal{})
+			if err := m.ActionProposals[len(m.ActionProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedActions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -470,16 +1669,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Paused == nil {
-				m.Paused = &Paused{}
-			}
-			if err := m.Paused.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.QueuedActions = append(m.QueuedActions, QueuedAction{})
+			if err := m.QueuedActions[len(m.QueuedActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterMinter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelPolicies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -506,16 +1703,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MasterMinter == nil {
-				m.MasterMinter = &MasterMinter{}
-			}
-			if err := m.MasterMinter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChannelPolicies = append(m.ChannelPolicies, ChannelPolicy{})
+			if err := m.ChannelPolicies[len(m.ChannelPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintersList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelRateLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -542,14 +1737,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintersList = append(m.MintersList, Minters{})
-			if err := m.MintersList[len(m.MintersList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChannelRateLimits = append(m.ChannelRateLimits, ChannelRateLimit{})
+			if err := m.ChannelRateLimits[len(m.ChannelRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pauser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSendPackets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -576,16 +1771,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pauser == nil {
-				m.Pauser = &Pauser{}
-			}
-			if err := m.Pauser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingSendPackets = append(m.PendingSendPackets, PendingSendPacket{})
+			if err := m.PendingSendPackets[len(m.PendingSendPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blacklister", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlisterList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -612,16 +1805,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Blacklister == nil {
-				m.Blacklister = &Blacklister{}
-			}
-			if err := m.Blacklister.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AllowlisterList = append(m.AllowlisterList, Allowlister{})
+			if err := m.AllowlisterList[len(m.AllowlisterList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistedList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -648,16 +1839,46 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Owner == nil {
-				m.Owner = &Owner{}
-			}
-			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AllowlistedList = append(m.AllowlistedList, Allowlisted{})
+			if err := m.AllowlistedList[len(m.AllowlistedList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinterControllerList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistEnabledDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowlistEnabledDenoms = append(m.AllowlistEnabledDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwnerList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -684,14 +1905,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinterControllerList = append(m.MinterControllerList, MinterController{})
-			if err := m.MinterControllerList[len(m.MinterControllerList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingOwnerList = append(m.PendingOwnerList, Owner{})
+			if err := m.PendingOwnerList[len(m.PendingOwnerList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintingDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRoles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -718,10 +1939,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MintingDenom == nil {
-				m.MintingDenom = &MintingDenom{}
-			}
-			if err := m.MintingDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingRoles = append(m.PendingRoles, PendingRole{})
+			if err := m.PendingRoles[len(m.PendingRoles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18,6 +18,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
@@ -29,6 +30,7 @@ import (
 
 func createValidGenesis() *types.GenesisState {
 	return &types.GenesisState{
+		Params: types.DefaultParams(),
 		BlacklistedList: []types.Blacklisted{
 			{
 				AddressBz: sample.AddressBz(),
@@ -78,6 +80,53 @@ func createValidGenesis() *types.GenesisState {
 	}
 }
 
+func createValidMultiDenomGenesis() *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	for _, denom := range []string{"uusdc", "ueurc"} {
+		genesis.MintingDenomList = append(genesis.MintingDenomList, types.MintingDenom{Denom: denom})
+		genesis.PausedList = append(genesis.PausedList, types.Paused{Denom: denom})
+		genesis.OwnerList = append(genesis.OwnerList, types.Owner{Denom: denom, Address: sample.AccAddress()})
+		genesis.MasterMinterList = append(genesis.MasterMinterList, types.MasterMinter{Denom: denom, Address: sample.AccAddress()})
+		genesis.PauserList = append(genesis.PauserList, types.Pauser{Denom: denom, Address: sample.AccAddress()})
+		genesis.BlacklisterList = append(genesis.BlacklisterList, types.Blacklister{Denom: denom, Address: sample.AccAddress()})
+		genesis.BlacklistedList = append(genesis.BlacklistedList, types.Blacklisted{Denom: denom, AddressBz: sample.AddressBz()})
+		genesis.MintersList = append(genesis.MintersList, types.Minters{Address: sample.AccAddress(), Allowance: sdk.NewCoin(denom, math.NewInt(1))})
+		genesis.MinterControllerList = append(genesis.MinterControllerList, types.MinterController{Denom: denom, Controller: sample.AccAddress(), Minter: sample.AccAddress()})
+	}
+	return genesis
+}
+
+func TestGenesisState_MigrateSingleDenom(t *testing.T) {
+	genesis := createValidGenesis()
+	legacy := *genesis
+
+	genesis.MigrateSingleDenom()
+
+	require.Nil(t, genesis.MintingDenom)
+	require.Nil(t, genesis.Paused)
+	require.Nil(t, genesis.Owner)
+	require.Nil(t, genesis.MasterMinter)
+	require.Nil(t, genesis.Pauser)
+	require.Nil(t, genesis.Blacklister)
+
+	require.Equal(t, []types.MintingDenom{{Denom: "uusdc"}}, genesis.MintingDenomList)
+	require.Equal(t, []types.Paused{{Denom: "uusdc", Paused: true}}, genesis.PausedList)
+	require.Equal(t, []types.Owner{{Denom: "uusdc", Address: legacy.Owner.Address}}, genesis.OwnerList)
+	require.Equal(t, []types.MasterMinter{{Denom: "uusdc", Address: legacy.MasterMinter.Address}}, genesis.MasterMinterList)
+	require.Equal(t, []types.Pauser{{Denom: "uusdc", Address: legacy.Pauser.Address}}, genesis.PauserList)
+	require.Equal(t, []types.Blacklister{{Denom: "uusdc", Address: legacy.Blacklister.Address}}, genesis.BlacklisterList)
+	for _, elem := range genesis.BlacklistedList {
+		require.Equal(t, "uusdc", elem.Denom)
+	}
+	for _, elem := range genesis.MinterControllerList {
+		require.Equal(t, "uusdc", elem.Denom)
+	}
+
+	// the legacy state is left untouched
+	require.Empty(t, legacy.BlacklistedList[0].Denom)
+	require.Empty(t, legacy.MinterControllerList[0].Denom)
+}
+
 func TestGenesisState_Validate(t *testing.T) {
 	for _, tc := range []struct {
 		desc     string
@@ -112,6 +161,37 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid: false,
 			error: "duplicated index for blacklisted",
 		},
+		{
+			desc: "unknown paused scope",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.PausedList = []types.Paused{
+					{
+						Denom:  "uusdc",
+						Scopes: types.PauseScopesAll + 1,
+					},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "unknown pause scopes",
+		},
+		{
+			desc: "negative blacklisted expiry height",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.BlacklistedList = []types.Blacklisted{
+					{
+						AddressBz:       sample.AddressBz(),
+						Denom:           "uusdc",
+						ExpiresAtHeight: -1,
+					},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "blacklisted expiry height cannot be negative",
+		},
 		{
 			desc: "duplicated minter entries",
 			genState: func() *types.GenesisState {
@@ -374,6 +454,427 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid: false,
 			error: "minting denom cannot be an empty string",
 		},
+		{
+			desc:     "multi-denom happy path",
+			genState: createValidMultiDenomGenesis,
+			valid:    true,
+		},
+		{
+			desc: "same address holds roles for different denoms",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				genesis.OwnerList[1].Address = genesis.OwnerList[0].Address
+				return genesis
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated minting denom",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				genesis.MintingDenomList = append(genesis.MintingDenomList, types.MintingDenom{Denom: "uusdc"})
+				return genesis
+			},
+			valid: false,
+			error: "duplicated index for mintingDenom",
+		},
+		{
+			desc: "duplicated owner for a denom",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				genesis.OwnerList = append(genesis.OwnerList, types.Owner{Denom: "uusdc", Address: sample.AccAddress()})
+				return genesis
+			},
+			valid: false,
+			error: "duplicated index for owner",
+		},
+		{
+			desc: "role for an unregistered denom",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				genesis.PauserList = append(genesis.PauserList, types.Pauser{Denom: "ustake", Address: sample.AccAddress()})
+				return genesis
+			},
+			valid: false,
+			error: "ustake is not a minting denom",
+		},
+		{
+			desc: "minter allowance for an unregistered denom",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				genesis.MintersList = append(genesis.MintersList, types.Minters{Address: sample.AccAddress(), Allowance: sdk.NewCoin("ustake", math.NewInt(1))})
+				return genesis
+			},
+			valid: false,
+			error: "ustake is not a minting denom",
+		},
+		{
+			desc: "insufficient separation of privileges within a denom",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				genesis.PauserList[1].Address = genesis.OwnerList[1].Address
+				return genesis
+			},
+			valid: false,
+			error: "address is already assigned to privileged role",
+		},
+		{
+			desc: "single-denom state without a minting denom",
+			genState: func() *types.GenesisState {
+				genesis := createValidGenesis()
+				genesis.MintingDenom = nil
+				return genesis
+			},
+			valid: false,
+			error: "single-denom state cannot be provided without a minting denom",
+		},
+		{
+			desc: "duplicated audit log entries",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				genesis.AuditLog = []types.AuditEntry{
+					{Id: 1, Height: 1, Denom: "uusdc"},
+					{Id: 1, Height: 2, Denom: "uusdc"},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "duplicated id for audit log entry",
+		},
+		{
+			desc: "valid signer sets and action proposals",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				signers := []string{sample.AccAddress(), sample.AccAddress()}
+				genesis.SignerSets = []types.SignerSet{
+					{Denom: "uusdc", Role: types.RoleOwner, Signers: signers, Threshold: 2},
+					{Denom: "ueurc", Role: types.RoleOwner, Signers: signers, Threshold: 1},
+				}
+				genesis.ActionProposals = []types.ActionProposal{
+					{
+						Id:        1,
+						Denom:     "uusdc",
+						Role:      types.RoleOwner,
+						Proposer:  signers[0],
+						Approvals: []string{signers[0]},
+						Action:    &types.ActionProposal_UpdateOwner{UpdateOwner: &types.MsgUpdateOwner{From: signers[0], Address: sample.AccAddress(), Denom: "uusdc"}},
+					},
+				}
+				return genesis
+			},
+			valid: true,
+		},
+		{
+			desc: "valid channel policies",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				genesis.ChannelPolicies = []types.ChannelPolicy{
+					{Denom: "uusdc", ChannelId: "channel-0", AllowInbound: true, AllowOutbound: true},
+					{Denom: "ueurc", ChannelId: "channel-0", AllowOutbound: true},
+				}
+				return genesis
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated channel policy",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				genesis.ChannelPolicies = []types.ChannelPolicy{
+					{Denom: "uusdc", ChannelId: "channel-0", AllowInbound: true},
+					{Denom: "uusdc", ChannelId: "channel-0", AllowOutbound: true},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "duplicated index for channel policy",
+		},
+		{
+			desc: "invalid channel policy channel id",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				genesis.ChannelPolicies = []types.ChannelPolicy{
+					{Denom: "uusdc", ChannelId: "", AllowInbound: true},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "invalid channel",
+		},
+		{
+			desc: "valid channel rate limits and pending send packets",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				genesis.ChannelRateLimits = []types.ChannelRateLimit{
+					types.NewChannelRateLimit("uusdc", "channel-0", math.NewInt(100), math.ZeroInt(), 10, 0),
+				}
+				genesis.PendingSendPackets = []types.PendingSendPacket{
+					{ChannelId: "channel-0", Sequence: 1, Denom: "uusdc", Bucket: 1},
+				}
+				return genesis
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated channel rate limit",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				rateLimit := types.NewChannelRateLimit("uusdc", "channel-0", math.NewInt(100), math.ZeroInt(), 10, 0)
+				genesis.ChannelRateLimits = []types.ChannelRateLimit{rateLimit, rateLimit}
+				return genesis
+			},
+			valid: false,
+			error: "duplicated index for channel rate limit",
+		},
+		{
+			desc: "invalid channel rate limit",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				genesis.ChannelRateLimits = []types.ChannelRateLimit{
+					types.NewChannelRateLimit("uusdc", "channel-0", math.ZeroInt(), math.ZeroInt(), 10, 0),
+				}
+				return genesis
+			},
+			valid: false,
+			error: "invalid rate limit",
+		},
+		{
+			desc: "duplicated pending send packet",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				packet := types.PendingSendPacket{ChannelId: "channel-0", Sequence: 1, Denom: "uusdc"}
+				genesis.PendingSendPackets = []types.PendingSendPacket{packet, packet}
+				return genesis
+			},
+			valid: false,
+			error: "duplicated index for pending send packet",
+		},
+		{
+			desc: "duplicated signer set",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				signers := []string{sample.AccAddress()}
+				genesis.SignerSets = []types.SignerSet{
+					{Denom: "uusdc", Role: types.RoleOwner, Signers: signers, Threshold: 1},
+					{Denom: "uusdc", Role: types.RoleOwner, Signers: signers, Threshold: 1},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "duplicated index for signer set",
+		},
+		{
+			desc: "signer set threshold exceeds signers",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				genesis.SignerSets = []types.SignerSet{
+					{Denom: "uusdc", Role: types.RoleOwner, Signers: []string{sample.AccAddress()}, Threshold: 2},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "threshold",
+		},
+		{
+			desc: "signer set of unregistered denom",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				genesis.SignerSets = []types.SignerSet{
+					{Denom: "ujpyc", Role: types.RoleOwner, Signers: []string{sample.AccAddress()}, Threshold: 1},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "ujpyc",
+		},
+		{
+			desc: "duplicated action proposal",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				proposal := types.ActionProposal{
+					Id:        1,
+					Denom:     "uusdc",
+					Role:      types.RolePauser,
+					Proposer:  sample.AccAddress(),
+					Approvals: []string{sample.AccAddress()},
+					Action:    &types.ActionProposal_Unpause{Unpause: &types.MsgUnpause{From: sample.AccAddress(), Denom: "uusdc"}},
+				}
+				genesis.ActionProposals = []types.ActionProposal{proposal, proposal}
+				return genesis
+			},
+			valid: false,
+			error: "duplicated id for action proposal",
+		},
+		{
+			desc: "duplicated queued action",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				action := types.QueuedAction{
+					Id:     1,
+					Denom:  "uusdc",
+					Action: &types.QueuedAction_UpdatePauser{UpdatePauser: &types.MsgUpdatePauser{From: sample.AccAddress(), Address: sample.AccAddress(), Denom: "uusdc"}},
+				}
+				genesis.QueuedActions = []types.QueuedAction{action, action}
+				return genesis
+			},
+			valid: false,
+			error: "duplicated id for queued action",
+		},
+		{
+			desc: "queued action without action",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				genesis.QueuedActions = []types.QueuedAction{{Id: 1, Denom: "uusdc"}}
+				return genesis
+			},
+			valid: false,
+			error: "queued action 1 has no action",
+		},
+		{
+			desc: "valid allowlist state",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				genesis.AllowlisterList = []types.Allowlister{{Denom: "uusdc", Address: sample.AccAddress()}}
+				genesis.AllowlistedList = []types.Allowlisted{{Denom: "uusdc", AddressBz: sample.AddressBz()}}
+				genesis.AllowlistEnabledDenoms = []string{"uusdc"}
+				return genesis
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated allowlisted",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				address := sample.AddressBz()
+				genesis.AllowlistedList = []types.Allowlisted{
+					{Denom: "uusdc", AddressBz: address},
+					{Denom: "uusdc", AddressBz: address},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "duplicated index for allowlisted",
+		},
+		{
+			desc: "duplicated allowlist enabled denom",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				genesis.AllowlistEnabledDenoms = []string{"uusdc", "uusdc"}
+				return genesis
+			},
+			valid: false,
+			error: "duplicated index for allowlist enabled denom",
+		},
+		{
+			desc: "duplicated allowlister for a denom",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				genesis.AllowlisterList = []types.Allowlister{
+					{Denom: "uusdc", Address: sample.AccAddress()},
+					{Denom: "uusdc", Address: sample.AccAddress()},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "duplicated index for allowlister",
+		},
+		{
+			desc: "valid pending owner",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				genesis.PendingOwnerList = []types.Owner{{Denom: "uusdc", Address: sample.AccAddress()}}
+				return genesis
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated pending owner for a denom",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				genesis.PendingOwnerList = []types.Owner{
+					{Denom: "uusdc", Address: sample.AccAddress()},
+					{Denom: "uusdc", Address: sample.AccAddress()},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "duplicated index for pending owner",
+		},
+		{
+			desc: "invalid pending owner address",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				genesis.PendingOwnerList = []types.Owner{{Denom: "uusdc", Address: "invalid"}}
+				return genesis
+			},
+			valid: false,
+			error: "invalid pending owner address",
+		},
+		{
+			desc: "pending owner of an unknown denom",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				genesis.PendingOwnerList = []types.Owner{{Denom: "ujpyc", Address: sample.AccAddress()}}
+				return genesis
+			},
+			valid: false,
+			error: "ujpyc is not a minting denom",
+		},
+		{
+			desc: "valid pending roles",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				genesis.PendingRoles = []types.PendingRole{
+					{Denom: "uusdc", Role: types.RolePauser, Address: sample.AccAddress()},
+					{Denom: "uusdc", Role: types.RoleBlacklister, Address: sample.AccAddress()},
+				}
+				return genesis
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated pending role for a denom",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				genesis.PendingRoles = []types.PendingRole{
+					{Denom: "uusdc", Role: types.RolePauser, Address: sample.AccAddress()},
+					{Denom: "uusdc", Role: types.RolePauser, Address: sample.AccAddress()},
+				}
+				return genesis
+			},
+			valid: false,
+			error: "duplicated index for pending role",
+		},
+		{
+			desc: "pending owner role",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				genesis.PendingRoles = []types.PendingRole{{Denom: "uusdc", Role: types.RoleOwner, Address: sample.AccAddress()}}
+				return genesis
+			},
+			valid: false,
+			error: "role owner cannot be pending",
+		},
+		{
+			desc: "invalid pending role address",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				genesis.PendingRoles = []types.PendingRole{{Denom: "uusdc", Role: types.RolePauser, Address: "invalid"}}
+				return genesis
+			},
+			valid: false,
+			error: "invalid pending pauser address",
+		},
+		{
+			desc: "negative role change delay",
+			genState: func() *types.GenesisState {
+				genesis := createValidMultiDenomGenesis()
+				genesis.Params.RoleChangeDelay = -time.Second
+				return genesis
+			},
+			valid: false,
+			error: "role change delay cannot be negative",
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState().Validate()
@@ -16,10 +16,15 @@
 
 package types
 
+import "cosmossdk.io/collections"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "fiat-tokenfactory"
 
+	// EscrowName defines the name of the module account holding seized funds
+	EscrowName = ModuleName + "-escrow"
+
 	// StoreKey defines the primary module store key
 	StoreKey = "fiattokenfactory"
 
@@ -29,38 +34,47 @@ const (
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_" + StoreKey
 
-	PausedKey                 = "Paused/value/"
-	MasterMinterKey           = "MasterMinter/value/"
-	PauserKey                 = "Pauser/value/"
-	BlacklisterKey            = "Blacklister/value/"
-	OwnerKey                  = "Owner/value/"
-	PendingOwnerKey           = "PendingOwner/value/"
-	BlacklistedKeyPrefix      = "Blacklisted/value/"
-	MintersKeyPrefix          = "Minters/value/"
-	MinterControllerKeyPrefix = "MinterController/value/"
-
 	GranteeKey = "SendRestrictionGrantees"
+
+	// SeizureKey marks the context of a transfer seizing the funds of a
+	// blacklisted address into the escrow account of the module.
+	SeizureKey = "SendRestrictionSeizure"
 )
 
-func KeyPrefix(p string) []byte {
-	return []byte(p)
-}
+var (
+	MintingDenomPrefix      = collections.NewPrefix(1)
+	PausedPrefix            = collections.NewPrefix(2)
+	MasterMinterPrefix      = collections.NewPrefix(3)
+	PauserPrefix            = collections.NewPrefix(4)
+	BlacklisterPrefix       = collections.NewPrefix(5)
+	OwnerPrefix             = collections.NewPrefix(6)
+	PendingOwnerPrefix      = collections.NewPrefix(7)
+	BlacklistedPrefix       = collections.NewPrefix(8)
+	MintersPrefix           = collections.NewPrefix(9)
+	MinterControllerPrefix  = collections.NewPrefix(10)
+	ParamsPrefix            = collections.NewPrefix(11)
+	AuditLogPrefix          = collections.NewPrefix(12)
+	AuditLogSeqPrefix       = collections.NewPrefix(13)
+	SignerSetsPrefix        = collections.NewPrefix(14)
+	ActionProposalsPrefix   = collections.NewPrefix(15)
+	ActionProposalSeqPrefix = collections.NewPrefix(16)
+	QueuedActionsPrefix     = collections.NewPrefix(17)
+	QueuedActionSeqPrefix   = collections.NewPrefix(18)
 
-// BlacklistedKey returns the store key to retrieve a Blacklisted from the index fields
-func BlacklistedKey(addressBz []byte) []byte {
-	return append(addressBz, []byte("/")...)
-}
+	BlacklistExpiryHeightsPrefix = collections.NewPrefix(19)
+	BlacklistExpiryTimesPrefix   = collections.NewPrefix(20)
 
-// MintersKey returns the store key to retrieve a Minters from the index fields
-func MintersKey(address string) []byte {
-	return append([]byte(address), []byte("/")...)
-}
+	ChannelPoliciesPrefix    = collections.NewPrefix(21)
+	ChannelRateLimitsPrefix  = collections.NewPrefix(22)
+	PendingSendPacketsPrefix = collections.NewPrefix(23)
 
-// MinterControllerKey returns the store key to retrieve a MinterController from the index fields
-func MinterControllerKey(controllerAddress string) []byte {
-	return append([]byte(controllerAddress), []byte("/")...)
-}
+	AllowlisterPrefix      = collections.NewPrefix(24)
+	AllowlistedPrefix      = collections.NewPrefix(25)
+	AllowlistEnabledPrefix = collections.NewPrefix(26)
 
-const (
-	MintingDenomKey = "MintingDenom/value/"
+	PendingRolesPrefix = collections.NewPrefix(27)
+
+	ModuleExemptionsPrefix = collections.NewPrefix(28)
+
+	QueuedActionTimesPrefix = collections.NewPrefix(29)
 )
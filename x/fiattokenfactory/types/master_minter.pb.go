@@ -24,6 +24,7 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type MasterMinter struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *MasterMinter) Reset()         { *m = MasterMinter{} }
@@ -66,6 +67,13 @@ func (m *MasterMinter) GetAddress() string {
 	return ""
 }
 
+func (m *MasterMinter) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*MasterMinter)(nil), "circle.fiattokenfactory.v1.MasterMinter")
 }
@@ -75,19 +83,19 @@ func init() {
 }
 
 var fileDescriptor_d041b0933f15c33b = []byte{
-	// 181 bytes of a gzipped FileDescriptorProto
+	// 192 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4b, 0xce, 0x2c, 0x4a,
 	0xce, 0x49, 0xd5, 0x4f, 0xcb, 0x4c, 0x2c, 0x29, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e,
 	0xc9, 0x2f, 0xaa, 0xd4, 0x2f, 0x33, 0xd4, 0xcf, 0x4d, 0x2c, 0x2e, 0x49, 0x2d, 0x8a, 0xcf, 0xcd,
 	0xcc, 0x2b, 0x49, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x82, 0xa8, 0xd7, 0x43,
-	0x57, 0xaf, 0x57, 0x66, 0xa8, 0xa4, 0xc1, 0xc5, 0xe3, 0x0b, 0xd6, 0xe2, 0x0b, 0xd6, 0x21, 0x24,
+	0x57, 0xaf, 0x57, 0x66, 0xa8, 0x64, 0xc7, 0xc5, 0xe3, 0x0b, 0xd6, 0xe2, 0x0b, 0xd6, 0x21, 0x24,
 	0xc1, 0xc5, 0x9e, 0x98, 0x92, 0x52, 0x94, 0x5a, 0x5c, 0x2c, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x19,
-	0x04, 0xe3, 0x3a, 0xc5, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72,
-	0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x4b,
-	0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xc4, 0xaa, 0xb4, 0xcc, 0x3c,
-	0xfd, 0xbc, 0xfc, 0xa4, 0x9c, 0x54, 0x5d, 0x0c, 0x37, 0x56, 0x60, 0x3a, 0xbb, 0xa4, 0xb2, 0x20,
-	0xb5, 0x38, 0x89, 0x0d, 0xec, 0x58, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xaa, 0xee, 0x0c,
-	0xa7, 0xde, 0x00, 0x00, 0x00,
+	0x04, 0xe3, 0x0a, 0x89, 0x70, 0xb1, 0xa6, 0xa4, 0xe6, 0xe5, 0xe7, 0x4a, 0x30, 0x81, 0xc5, 0x21,
+	0x1c, 0xa7, 0xb8, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71,
+	0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x72, 0x49, 0xcf,
+	0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0x38, 0x20, 0x2d, 0x33, 0x4f, 0x3f,
+	0x2f, 0x3f, 0x29, 0x27, 0x55, 0x17, 0xc3, 0xe5, 0x15, 0x98, 0x9e, 0x29, 0xa9, 0x2c, 0x48, 0x2d,
+	0x4e, 0x62, 0x03, 0x7b, 0xc1, 0x18, 0x30, 0x00, 0x91, 0x66, 0x98, 0x94, 0xf4, 0x00, 0x00, 0x00,
 }
 
 func (m *MasterMinter) Marshal() (dAtA []byte, err error) {
@@ -110,6 +118,13 @@ func (m *MasterMinter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMasterMinter(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -141,6 +156,10 @@ func (m *MasterMinter) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMasterMinter(uint64(l))
 	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMasterMinter(uint64(l))
+	}
 	return n
 }
 
@@ -211,6 +230,38 @@ func (m *MasterMinter) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMasterMinter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMasterMinter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMasterMinter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMasterMinter(dAtA[iNdEx:])
@@ -26,5 +26,8 @@ func (msg *MsgAcceptOwner) ValidateBasic() error {
 	if err != nil {
 		return errors.Wrapf(ErrInvalidAddress, "invalid from address (%s)", err)
 	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errors.Wrapf(ErrInvalidDenom, "invalid denom (%s)", err)
+	}
 	return nil
 }
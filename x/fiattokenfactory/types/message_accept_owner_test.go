@@ -28,24 +28,33 @@ func TestValidateMsgAcceptOwner(t *testing.T) {
 	testCases := []struct {
 		name    string
 		address string
+		denom   string
 		err     error
 	}{
 		{
 			name:    "happy path",
 			address: sample.AccAddress(),
+			denom:   "uusdc",
 		},
 		{
 			name:    "invalid address",
 			address: "invalid address",
+			denom:   "uusdc",
 			err:     types.ErrInvalidAddress,
 		},
+		{
+			name:    "invalid denom",
+			address: sample.AccAddress(),
+			denom:   "",
+			err:     types.ErrInvalidDenom,
+		},
 	}
 
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			mockMsg := types.MsgAcceptOwner{From: tt.address}
+			mockMsg := types.MsgAcceptOwner{From: tt.address, Denom: tt.denom}
 			err := mockMsg.ValidateBasic()
 
 			if tt.err != nil {
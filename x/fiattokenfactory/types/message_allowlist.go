@@ -0,0 +1,37 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (msg *MsgAllowlist) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+
+	if len(msg.Address) <= 0 {
+		return errors.Wrap(ErrInvalidAddress, "address length cannot be less than or equal to 0")
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errors.Wrapf(ErrInvalidDenom, "invalid denom (%s)", err)
+	}
+	return nil
+}
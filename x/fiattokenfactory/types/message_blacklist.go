@@ -30,5 +30,31 @@ func (msg *MsgBlacklist) ValidateBasic() error {
 	if len(msg.Address) <= 0 {
 		return errors.Wrap(ErrInvalidAddress, "address length cannot be less than or equal to 0")
 	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errors.Wrapf(ErrInvalidDenom, "invalid denom (%s)", err)
+	}
+	if msg.ExpiryHeight < 0 {
+		return errors.Wrap(ErrInvalidExpiry, "expiry height cannot be negative")
+	}
+	return ValidateBlacklistMetadata(msg.Reason, msg.ReferenceId)
+}
+
+const (
+	// MaxBlacklistReasonLength is the maximum length of the reason of a
+	// blacklist entry.
+	MaxBlacklistReasonLength = 256
+	// MaxBlacklistReferenceIdLength is the maximum length of the reference id
+	// of a blacklist entry.
+	MaxBlacklistReferenceIdLength = 128
+)
+
+// ValidateBlacklistMetadata checks the optional metadata of a blacklist entry.
+func ValidateBlacklistMetadata(reason string, referenceId string) error {
+	if len(reason) > MaxBlacklistReasonLength {
+		return errors.Wrapf(ErrInvalidType, "reason cannot be longer than %d characters", MaxBlacklistReasonLength)
+	}
+	if len(referenceId) > MaxBlacklistReferenceIdLength {
+		return errors.Wrapf(ErrInvalidType, "reference id cannot be longer than %d characters", MaxBlacklistReferenceIdLength)
+	}
 	return nil
 }
@@ -0,0 +1,105 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgBlacklistBatch_ValidateBasic(t *testing.T) {
+	address := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgBlacklistBatch
+		err  error
+	}{
+		{
+			name: "invalid from",
+			msg: MsgBlacklistBatch{
+				From:      "invalid_address",
+				Addresses: []string{sample.AccAddress()},
+				Denom:     "uusdc",
+			},
+			err: ErrInvalidAddress,
+		},
+		{
+			name: "no addresses",
+			msg: MsgBlacklistBatch{
+				From:  sample.AccAddress(),
+				Denom: "uusdc",
+			},
+			err: ErrInvalidAddress,
+		},
+		{
+			name: "empty address",
+			msg: MsgBlacklistBatch{
+				From:      sample.AccAddress(),
+				Addresses: []string{sample.AccAddress(), ""},
+				Denom:     "uusdc",
+			},
+			err: ErrInvalidAddress,
+		},
+		{
+			name: "duplicated address",
+			msg: MsgBlacklistBatch{
+				From:      sample.AccAddress(),
+				Addresses: []string{address, address},
+				Denom:     "uusdc",
+			},
+			err: ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			msg: MsgBlacklistBatch{
+				From:      sample.AccAddress(),
+				Addresses: []string{sample.AccAddress()},
+			},
+			err: ErrInvalidDenom,
+		},
+		{
+			name: "negative expiry height",
+			msg: MsgBlacklistBatch{
+				From:         sample.AccAddress(),
+				Addresses:    []string{sample.AccAddress()},
+				Denom:        "uusdc",
+				ExpiryHeight: -1,
+			},
+			err: ErrInvalidExpiry,
+		},
+		{
+			name: "valid",
+			msg: MsgBlacklistBatch{
+				From:      sample.AccAddress(),
+				Addresses: []string{sample.AccAddress(), sample.AccAddress()},
+				Denom:     "uusdc",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -17,6 +17,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
@@ -45,11 +46,51 @@ func TestMsgBlacklist_ValidateBasic(t *testing.T) {
 			},
 			err: ErrInvalidAddress,
 		},
+		{
+			name: "invalid denom",
+			msg: MsgBlacklist{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Denom:   "",
+			},
+			err: ErrInvalidDenom,
+		},
+		{
+			name: "reason too long",
+			msg: MsgBlacklist{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Denom:   "uusdc",
+				Reason:  strings.Repeat("a", MaxBlacklistReasonLength+1),
+			},
+			err: ErrInvalidType,
+		},
+		{
+			name: "reference id too long",
+			msg: MsgBlacklist{
+				From:        sample.AccAddress(),
+				Address:     sample.AccAddress(),
+				Denom:       "uusdc",
+				ReferenceId: strings.Repeat("a", MaxBlacklistReferenceIdLength+1),
+			},
+			err: ErrInvalidType,
+		},
+		{
+			name: "negative expiry height",
+			msg: MsgBlacklist{
+				From:         sample.AccAddress(),
+				Address:      sample.AccAddress(),
+				Denom:        "uusdc",
+				ExpiryHeight: -1,
+			},
+			err: ErrInvalidExpiry,
+		},
 		{
 			name: "happy path",
 			msg: MsgBlacklist{
 				From:    sample.AccAddress(),
 				Address: sample.AccAddress(),
+				Denom:   "uusdc",
 			},
 		},
 	}
@@ -0,0 +1,67 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateMsgCancelOwnershipTransfer(t *testing.T) {
+	testCases := []struct {
+		name    string
+		address string
+		denom   string
+		err     error
+	}{
+		{
+			name:    "happy path",
+			address: sample.AccAddress(),
+			denom:   "uusdc",
+		},
+		{
+			name:    "invalid address",
+			address: "invalid address",
+			denom:   "uusdc",
+			err:     types.ErrInvalidAddress,
+		},
+		{
+			name:    "invalid denom",
+			address: sample.AccAddress(),
+			denom:   "",
+			err:     types.ErrInvalidDenom,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockMsg := types.MsgCancelOwnershipTransfer{From: tt.address, Denom: tt.denom}
+			err := mockMsg.ValidateBasic()
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -34,5 +34,8 @@ func (msg *MsgConfigureMinterController) ValidateBasic() error {
 	if err != nil {
 		return errors.Wrapf(ErrInvalidAddress, "invalid minter address (%s)", err)
 	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errors.Wrapf(ErrInvalidDenom, "invalid denom (%s)", err)
+	}
 	return nil
 }
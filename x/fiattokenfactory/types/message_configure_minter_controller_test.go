@@ -74,12 +74,23 @@ func TestMsgConfigureMinterController_ValidateBasic(t *testing.T) {
 			},
 			err: ErrInvalidAddress,
 		},
+		{
+			name: "invalid denom",
+			msg: MsgConfigureMinterController{
+				From:       sample.AccAddress(),
+				Controller: sample.AccAddress(),
+				Minter:     sample.AccAddress(),
+				Denom:      "",
+			},
+			err: ErrInvalidDenom,
+		},
 		{
 			name: "happy path",
 			msg: MsgConfigureMinterController{
 				From:       sample.AccAddress(),
 				Controller: sample.AccAddress(),
 				Minter:     sample.AccAddress(),
+				Denom:      "uusdc",
 			},
 		},
 	}
@@ -0,0 +1,144 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgConfigureMinterRateLimit_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgConfigureMinterRateLimit
+		err  error
+	}{
+		{
+			name: "invalid from",
+			msg: MsgConfigureMinterRateLimit{
+				From:    "invalid_address",
+				Address: sample.AccAddress(),
+				Denom:   "uusdc",
+			},
+			err: ErrInvalidAddress,
+		},
+		{
+			name: "invalid minter address",
+			msg: MsgConfigureMinterRateLimit{
+				From:    sample.AccAddress(),
+				Address: "invalid_address",
+				Denom:   "uusdc",
+			},
+			err: ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			msg: MsgConfigureMinterRateLimit{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Denom:   "",
+			},
+			err: ErrInvalidDenom,
+		},
+		{
+			name: "limit is missing",
+			msg: MsgConfigureMinterRateLimit{
+				From:         sample.AccAddress(),
+				Address:      sample.AccAddress(),
+				Denom:        "uusdc",
+				WindowBlocks: 10,
+			},
+			err: ErrInvalidRateLimit,
+		},
+		{
+			name: "limit is zero",
+			msg: MsgConfigureMinterRateLimit{
+				From:         sample.AccAddress(),
+				Address:      sample.AccAddress(),
+				Denom:        "uusdc",
+				Limit:        math.ZeroInt(),
+				WindowBlocks: 10,
+			},
+			err: ErrInvalidRateLimit,
+		},
+		{
+			name: "negative window",
+			msg: MsgConfigureMinterRateLimit{
+				From:         sample.AccAddress(),
+				Address:      sample.AccAddress(),
+				Denom:        "uusdc",
+				Limit:        math.NewInt(1),
+				WindowBlocks: -1,
+			},
+			err: ErrInvalidRateLimit,
+		},
+		{
+			name: "both windows set",
+			msg: MsgConfigureMinterRateLimit{
+				From:           sample.AccAddress(),
+				Address:        sample.AccAddress(),
+				Denom:          "uusdc",
+				Limit:          math.NewInt(1),
+				WindowBlocks:   10,
+				WindowDuration: time.Hour,
+			},
+			err: ErrInvalidRateLimit,
+		},
+		{
+			name: "happy path with window blocks",
+			msg: MsgConfigureMinterRateLimit{
+				From:         sample.AccAddress(),
+				Address:      sample.AccAddress(),
+				Denom:        "uusdc",
+				Limit:        math.NewInt(1),
+				WindowBlocks: 10,
+			},
+		},
+		{
+			name: "happy path with window duration",
+			msg: MsgConfigureMinterRateLimit{
+				From:           sample.AccAddress(),
+				Address:        sample.AccAddress(),
+				Denom:          "uusdc",
+				Limit:          math.NewInt(1),
+				WindowDuration: 24 * time.Hour,
+			},
+		},
+		{
+			name: "removal",
+			msg: MsgConfigureMinterRateLimit{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Denom:   "uusdc",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -26,5 +26,15 @@ func (msg *MsgPause) ValidateBasic() error {
 	if err != nil {
 		return errors.Wrapf(ErrInvalidAddress, "invalid from address (%s)", err)
 	}
-	return nil
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errors.Wrapf(ErrInvalidDenom, "invalid denom (%s)", err)
+	}
+	if msg.Duration < 0 {
+		return errors.Wrap(ErrInvalidExpiry, "duration cannot be negative")
+	}
+	if msg.UnpauseHeight < 0 {
+		return errors.Wrap(ErrInvalidExpiry, "unpause height cannot be negative")
+	}
+	_, err = PauseScopesBitset(msg.Scopes)
+	return err
 }
@@ -18,6 +18,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
 	"github.com/stretchr/testify/require"
@@ -36,10 +37,46 @@ func TestMsgPause_ValidateBasic(t *testing.T) {
 			},
 			err: ErrInvalidAddress,
 		},
+		{
+			name: "invalid denom",
+			msg: MsgPause{
+				From:  sample.AccAddress(),
+				Denom: "",
+			},
+			err: ErrInvalidDenom,
+		},
+		{
+			name: "invalid scope",
+			msg: MsgPause{
+				From:   sample.AccAddress(),
+				Denom:  "uusdc",
+				Scopes: []PauseScope{PauseScope_PAUSE_SCOPE_UNSPECIFIED},
+			},
+			err: ErrInvalidType,
+		},
+		{
+			name: "negative duration",
+			msg: MsgPause{
+				From:     sample.AccAddress(),
+				Denom:    "uusdc",
+				Duration: -time.Second,
+			},
+			err: ErrInvalidExpiry,
+		},
+		{
+			name: "negative unpause height",
+			msg: MsgPause{
+				From:          sample.AccAddress(),
+				Denom:         "uusdc",
+				UnpauseHeight: -1,
+			},
+			err: ErrInvalidExpiry,
+		},
 		{
 			name: "happy path",
 			msg: MsgPause{
-				From: sample.AccAddress(),
+				From:  sample.AccAddress(),
+				Denom: "uusdc",
 			},
 		},
 	}
@@ -0,0 +1,97 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgReleaseEscrow_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgReleaseEscrow
+		err  error
+	}{
+		{
+			name: "invalid authority",
+			msg: MsgReleaseEscrow{
+				Authority: "invalid_address",
+				Recipient: sample.AccAddress(),
+				Amount:    sdk.NewInt64Coin("uusdc", 1),
+			},
+			err: ErrInvalidAddress,
+		},
+		{
+			name: "invalid recipient",
+			msg: MsgReleaseEscrow{
+				Authority: sample.AccAddress(),
+				Recipient: "invalid_address",
+				Amount:    sdk.NewInt64Coin("uusdc", 1),
+			},
+			err: ErrInvalidAddress,
+		},
+		{
+			name: "nil amount",
+			msg: MsgReleaseEscrow{
+				Authority: sample.AccAddress(),
+				Recipient: sample.AccAddress(),
+			},
+			err: ErrInvalidCoins,
+		},
+		{
+			name: "negative amount",
+			msg: MsgReleaseEscrow{
+				Authority: sample.AccAddress(),
+				Recipient: sample.AccAddress(),
+				Amount:    sdk.Coin{Denom: "uusdc", Amount: math.NewInt(-1)},
+			},
+			err: ErrInvalidCoins,
+		},
+		{
+			name: "zero amount",
+			msg: MsgReleaseEscrow{
+				Authority: sample.AccAddress(),
+				Recipient: sample.AccAddress(),
+				Amount:    sdk.NewInt64Coin("uusdc", 0),
+			},
+			err: ErrInvalidCoins,
+		},
+		{
+			name: "happy path",
+			msg: MsgReleaseEscrow{
+				Authority: sample.AccAddress(),
+				Recipient: sample.AccAddress(),
+				Amount:    sdk.NewInt64Coin("uusdc", 1),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -30,5 +30,8 @@ func (msg *MsgRemoveMinterController) ValidateBasic() error {
 	if err != nil {
 		return errors.Wrapf(ErrInvalidAddress, "invalid minter controller address (%s)", err)
 	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errors.Wrapf(ErrInvalidDenom, "invalid denom (%s)", err)
+	}
 	return nil
 }
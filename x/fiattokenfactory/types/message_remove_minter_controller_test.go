@@ -53,11 +53,21 @@ func TestMsgRemoveMinterController_ValidateBasic(t *testing.T) {
 			},
 			err: ErrInvalidAddress,
 		},
+		{
+			name: "invalid denom",
+			msg: MsgRemoveMinterController{
+				From:       sample.AccAddress(),
+				Controller: sample.AccAddress(),
+				Denom:      "",
+			},
+			err: ErrInvalidDenom,
+		},
 		{
 			name: "happy path",
 			msg: MsgRemoveMinterController{
 				From:       sample.AccAddress(),
 				Controller: sample.AccAddress(),
+				Denom:      "uusdc",
 			},
 		},
 	}
@@ -53,11 +53,21 @@ func TestMsgRemoveMinter_ValidateBasic(t *testing.T) {
 			},
 			err: ErrInvalidAddress,
 		},
+		{
+			name: "invalid denom",
+			msg: MsgRemoveMinter{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Denom:   "",
+			},
+			err: ErrInvalidDenom,
+		},
 		{
 			name: "happy path",
 			msg: MsgRemoveMinter{
 				From:    sample.AccAddress(),
 				Address: sample.AccAddress(),
+				Denom:   "uusdc",
 			},
 		},
 	}
@@ -0,0 +1,143 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetSignerSet_ValidateBasic(t *testing.T) {
+	signer := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgSetSignerSet
+		err  error
+	}{
+		{
+			name: "invalid from",
+			msg: MsgSetSignerSet{
+				From:  "invalid_address",
+				Denom: "uusdc",
+				Role:  RoleOwner,
+			},
+			err: ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			msg: MsgSetSignerSet{
+				From: sample.AccAddress(),
+				Role: RoleOwner,
+			},
+			err: ErrInvalidDenom,
+		},
+		{
+			name: "unsupported role",
+			msg: MsgSetSignerSet{
+				From:      sample.AccAddress(),
+				Denom:     "uusdc",
+				Role:      RoleBlacklister,
+				Signers:   []string{sample.AccAddress()},
+				Threshold: 1,
+			},
+			err: ErrInvalidSignerSet,
+		},
+		{
+			name: "invalid signer",
+			msg: MsgSetSignerSet{
+				From:      sample.AccAddress(),
+				Denom:     "uusdc",
+				Role:      RoleOwner,
+				Signers:   []string{"invalid_address"},
+				Threshold: 1,
+			},
+			err: ErrInvalidAddress,
+		},
+		{
+			name: "duplicated signer",
+			msg: MsgSetSignerSet{
+				From:      sample.AccAddress(),
+				Denom:     "uusdc",
+				Role:      RoleOwner,
+				Signers:   []string{signer, signer},
+				Threshold: 1,
+			},
+			err: ErrInvalidSignerSet,
+		},
+		{
+			name: "threshold exceeds signers",
+			msg: MsgSetSignerSet{
+				From:      sample.AccAddress(),
+				Denom:     "uusdc",
+				Role:      RoleOwner,
+				Signers:   []string{sample.AccAddress()},
+				Threshold: 2,
+			},
+			err: ErrInvalidSignerSet,
+		},
+		{
+			name: "zero threshold",
+			msg: MsgSetSignerSet{
+				From:    sample.AccAddress(),
+				Denom:   "uusdc",
+				Role:    RoleOwner,
+				Signers: []string{sample.AccAddress()},
+			},
+			err: ErrInvalidSignerSet,
+		},
+		{
+			name: "removal with threshold",
+			msg: MsgSetSignerSet{
+				From:      sample.AccAddress(),
+				Denom:     "uusdc",
+				Role:      RoleOwner,
+				Threshold: 1,
+			},
+			err: ErrInvalidSignerSet,
+		},
+		{
+			name: "valid removal",
+			msg: MsgSetSignerSet{
+				From:  sample.AccAddress(),
+				Denom: "uusdc",
+				Role:  RolePauser,
+			},
+		},
+		{
+			name: "valid",
+			msg: MsgSetSignerSet{
+				From:      sample.AccAddress(),
+				Denom:     "uusdc",
+				Role:      RoleMasterMinter,
+				Signers:   []string{sample.AccAddress(), sample.AccAddress()},
+				Threshold: 2,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -30,5 +30,8 @@ func (msg *MsgUnblacklist) ValidateBasic() error {
 	if len(msg.Address) <= 0 {
 		return errors.Wrap(ErrInvalidAddress, "address length cannot be less than or equal to 0")
 	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errors.Wrapf(ErrInvalidDenom, "invalid denom (%s)", err)
+	}
 	return nil
 }
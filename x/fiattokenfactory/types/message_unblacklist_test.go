@@ -45,11 +45,21 @@ func TestMsgUnblacklist_ValidateBasic(t *testing.T) {
 			},
 			err: ErrInvalidAddress,
 		},
+		{
+			name: "invalid denom",
+			msg: MsgUnblacklist{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Denom:   "",
+			},
+			err: ErrInvalidDenom,
+		},
 		{
 			name: "happy path",
 			msg: MsgUnblacklist{
 				From:    sample.AccAddress(),
 				Address: sample.AccAddress(),
+				Denom:   "uusdc",
 			},
 		},
 	}
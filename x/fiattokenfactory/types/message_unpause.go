@@ -26,5 +26,9 @@ func (msg *MsgUnpause) ValidateBasic() error {
 	if err != nil {
 		return errors.Wrapf(ErrInvalidAddress, "invalid from address (%s)", err)
 	}
-	return nil
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errors.Wrapf(ErrInvalidDenom, "invalid denom (%s)", err)
+	}
+	_, err = PauseScopesBitset(msg.Scopes)
+	return err
 }
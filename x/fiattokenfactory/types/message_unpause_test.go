@@ -36,10 +36,28 @@ func TestMsgUnpause_ValidateBasic(t *testing.T) {
 			},
 			err: ErrInvalidAddress,
 		},
+		{
+			name: "invalid denom",
+			msg: MsgUnpause{
+				From:  sample.AccAddress(),
+				Denom: "",
+			},
+			err: ErrInvalidDenom,
+		},
+		{
+			name: "invalid scope",
+			msg: MsgUnpause{
+				From:   sample.AccAddress(),
+				Denom:  "uusdc",
+				Scopes: []PauseScope{PauseScope_PAUSE_SCOPE_UNSPECIFIED},
+			},
+			err: ErrInvalidType,
+		},
 		{
 			name: "happy path",
 			msg: MsgUnpause{
-				From: sample.AccAddress(),
+				From:  sample.AccAddress(),
+				Denom: "uusdc",
 			},
 		},
 	}
@@ -53,11 +53,21 @@ func TestMsgUpdateBlacklister_ValidateBasic(t *testing.T) {
 			},
 			err: ErrInvalidAddress,
 		},
+		{
+			name: "invalid denom",
+			msg: MsgUpdateBlacklister{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Denom:   "",
+			},
+			err: ErrInvalidDenom,
+		},
 		{
 			name: "happy path",
 			msg: MsgUpdateBlacklister{
 				From:    sample.AccAddress(),
 				Address: sample.AccAddress(),
+				Denom:   "uusdc",
 			},
 		},
 	}
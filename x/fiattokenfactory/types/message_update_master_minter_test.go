@@ -53,11 +53,21 @@ func TestMsgUpdateMasterMinter_ValidateBasic(t *testing.T) {
 			},
 			err: ErrInvalidAddress,
 		},
+		{
+			name: "invalid denom",
+			msg: MsgUpdateMasterMinter{
+				From:    sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Denom:   "",
+			},
+			err: ErrInvalidDenom,
+		},
 		{
 			name: "happy path",
 			msg: MsgUpdateMasterMinter{
 				From:    sample.AccAddress(),
 				Address: sample.AccAddress(),
+				Denom:   "uusdc",
 			},
 		},
 	}
@@ -0,0 +1,149 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+
+	"github.com/circlefin/noble-fiattokenfactory/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUpdateParams
+		err  error
+	}{
+		{
+			name: "invalid authority",
+			msg: MsgUpdateParams{
+				Authority: "invalid_address",
+				Params:    DefaultParams(),
+			},
+			err: ErrInvalidAddress,
+		},
+		{
+			name: "invalid params",
+			msg: MsgUpdateParams{
+				Authority: sample.AccAddress(),
+			},
+			err: ErrInvalidParams,
+		},
+		{
+			name: "invalid memo recipient path",
+			msg: MsgUpdateParams{
+				Authority: sample.AccAddress(),
+				Params: func() Params {
+					params := DefaultParams()
+					params.MemoRecipientPaths = []string{"wasm..recipient"}
+					return params
+				}(),
+			},
+			err: ErrInvalidParams,
+		},
+		{
+			name: "duplicated memo recipient path",
+			msg: MsgUpdateParams{
+				Authority: sample.AccAddress(),
+				Params: func() Params {
+					params := DefaultParams()
+					params.MemoRecipientPaths = []string{"wasm.msg.recipient", "wasm.msg.recipient"}
+					return params
+				}(),
+			},
+			err: ErrInvalidParams,
+		},
+		{
+			name: "invalid address render hrp",
+			msg: MsgUpdateParams{
+				Authority: sample.AccAddress(),
+				Params: func() Params {
+					params := DefaultParams()
+					params.AddressRenderHrps = []string{"Noble"}
+					return params
+				}(),
+			},
+			err: ErrInvalidParams,
+		},
+		{
+			name: "empty address render hrp",
+			msg: MsgUpdateParams{
+				Authority: sample.AccAddress(),
+				Params: func() Params {
+					params := DefaultParams()
+					params.AddressRenderHrps = []string{""}
+					return params
+				}(),
+			},
+			err: ErrInvalidParams,
+		},
+		{
+			name: "duplicated address render hrp",
+			msg: MsgUpdateParams{
+				Authority: sample.AccAddress(),
+				Params: func() Params {
+					params := DefaultParams()
+					params.AddressRenderHrps = []string{"noble", "noble"}
+					return params
+				}(),
+			},
+			err: ErrInvalidParams,
+		},
+		{
+			name: "empty module exemption module",
+			msg: MsgUpdateParams{
+				Authority: sample.AccAddress(),
+				Params: func() Params {
+					params := DefaultParams()
+					params.ModuleExemptions = []ModuleExemption{{Pause: true}}
+					return params
+				}(),
+			},
+			err: ErrInvalidParams,
+		},
+		{
+			name: "duplicated module exemption",
+			msg: MsgUpdateParams{
+				Authority: sample.AccAddress(),
+				Params: func() Params {
+					params := DefaultParams()
+					params.ModuleExemptions = []ModuleExemption{{Module: ModuleName, Pause: true}, {Module: ModuleName, Blacklist: true}}
+					return params
+				}(),
+			},
+			err: ErrInvalidParams,
+		},
+		{
+			name: "valid",
+			msg: MsgUpdateParams{
+				Authority: sample.AccAddress(),
+				Params:    DefaultParams(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
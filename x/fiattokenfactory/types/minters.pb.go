@@ -4,19 +4,25 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -27,6 +33,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Minters struct {
 	Address   string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Allowance types.Coin `protobuf:"bytes,2,opt,name=allowance,proto3" json:"allowance"`
+	// rateLimit caps the amount minted within a window, in addition to the
+	// allowance. A nil rate limit leaves the minter only bound by its allowance.
+	RateLimit *RateLimit `protobuf:"bytes,3,opt,name=rateLimit,proto3" json:"rateLimit,omitempty"`
 }
 
 func (m *Minters) Reset()         { *m = Minters{} }
@@ -76,8 +85,131 @@ func (m *Minters) GetAllowance() types.Coin {
 	return types.Coin{}
 }
 
+func (m *Minters) GetRateLimit() *RateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return nil
+}
+
+// RateLimit defines a mint cap of a minter over a sliding window, along with
+// its usage. The window lasts either windowBlocks blocks or windowDuration of
+// block time and is split into buckets, see RateLimitBucket. An amount counts
+// against the cap until the bucket it was minted in leaves the window.
+type RateLimit struct {
+	Limit          cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=limit,proto3,customtype=cosmossdk.io/math.Int" json:"limit"`
+	WindowBlocks   int64                 `protobuf:"varint,2,opt,name=windowBlocks,proto3" json:"windowBlocks,omitempty"`
+	WindowDuration time.Duration         `protobuf:"bytes,3,opt,name=windowDuration,proto3,stdduration" json:"windowDuration"`
+	// buckets hold the amounts minted within the window, ordered by index.
+	Buckets []RateLimitBucket `protobuf:"bytes,7,rep,name=buckets,proto3" json:"buckets"`
+}
+
+func (m *RateLimit) Reset()         { *m = RateLimit{} }
+func (m *RateLimit) String() string { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()    {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f322cd9b64781e, []int{1}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
+func (m *RateLimit) GetWindowBlocks() int64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+func (m *RateLimit) GetWindowDuration() time.Duration {
+	if m != nil {
+		return m.WindowDuration
+	}
+	return 0
+}
+
+func (m *RateLimit) GetBuckets() []RateLimitBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+// RateLimitBucket holds the amount minted within one bucket of a rate limit
+// window. A window is split into ten buckets, numbered by block height or
+// block time divided by the bucket length.
+type RateLimitBucket struct {
+	Index  int64                 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *RateLimitBucket) Reset()         { *m = RateLimitBucket{} }
+func (m *RateLimitBucket) String() string { return proto.CompactTextString(m) }
+func (*RateLimitBucket) ProtoMessage()    {}
+func (*RateLimitBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f322cd9b64781e, []int{2}
+}
+func (m *RateLimitBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitBucket.Merge(m, src)
+}
+func (m *RateLimitBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitBucket proto.InternalMessageInfo
+
+func (m *RateLimitBucket) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Minters)(nil), "circle.fiattokenfactory.v1.Minters")
+	proto.RegisterType((*RateLimit)(nil), "circle.fiattokenfactory.v1.RateLimit")
+	proto.RegisterType((*RateLimitBucket)(nil), "circle.fiattokenfactory.v1.RateLimitBucket")
 }
 
 func init() {
@@ -85,23 +217,38 @@ func init() {
 }
 
 var fileDescriptor_55f322cd9b64781e = []byte{
-	// 254 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xb1, 0x4e, 0xf3, 0x30,
-	0x14, 0x85, 0xe3, 0x5f, 0xbf, 0xa8, 0x1a, 0xb6, 0x88, 0x21, 0x64, 0x30, 0x15, 0x53, 0x16, 0x7c,
-	0x15, 0x98, 0x59, 0x0a, 0x2b, 0x4b, 0x47, 0x06, 0x24, 0xdb, 0xbd, 0x09, 0x16, 0x89, 0x6f, 0x65,
-	0x9b, 0x40, 0xdf, 0x82, 0xc7, 0xea, 0xd8, 0x91, 0x09, 0xa1, 0xe4, 0x45, 0x50, 0x6b, 0x10, 0x12,
-	0xdd, 0xce, 0xbd, 0x3a, 0x47, 0x9f, 0xce, 0x49, 0x4b, 0x6d, 0x9c, 0x6e, 0x11, 0x6a, 0x23, 0x43,
-	0xa0, 0x27, 0xb4, 0xb5, 0xd4, 0x81, 0xdc, 0x1a, 0xfa, 0x0a, 0x3a, 0x63, 0x03, 0x3a, 0x2f, 0x56,
-	0x8e, 0x02, 0x65, 0x45, 0x74, 0x8a, 0xbf, 0x4e, 0xd1, 0x57, 0x05, 0xd7, 0xe4, 0x3b, 0xf2, 0xa0,
-	0xa4, 0x47, 0xe8, 0x2b, 0x85, 0x41, 0x56, 0xa0, 0xc9, 0xd8, 0x98, 0x2d, 0x4e, 0x1a, 0x6a, 0x68,
-	0x2f, 0x61, 0xa7, 0xe2, 0xf7, 0x5c, 0xa5, 0x93, 0xbb, 0x88, 0xc8, 0xf2, 0x74, 0x22, 0x97, 0x4b,
-	0x87, 0xde, 0xe7, 0x6c, 0xc6, 0xca, 0xe9, 0xe2, 0xe7, 0xcc, 0xae, 0xd3, 0xa9, 0x6c, 0x5b, 0x7a,
-	0x91, 0x56, 0x63, 0xfe, 0x6f, 0xc6, 0xca, 0xe3, 0xcb, 0x53, 0x11, 0x71, 0x62, 0x87, 0x13, 0xdf,
-	0x38, 0x71, 0x43, 0xc6, 0xce, 0xff, 0x6f, 0x3e, 0xce, 0x92, 0xc5, 0x6f, 0x62, 0xfe, 0xb0, 0x19,
-	0x38, 0xdb, 0x0e, 0x9c, 0x7d, 0x0e, 0x9c, 0xbd, 0x8d, 0x3c, 0xd9, 0x8e, 0x3c, 0x79, 0x1f, 0x79,
-	0x72, 0x7f, 0xdb, 0x98, 0xf0, 0xf8, 0xac, 0x84, 0xa6, 0x0e, 0x62, 0xb5, 0xda, 0x58, 0xb0, 0xa4,
-	0x5a, 0xbc, 0x38, 0x58, 0xe3, 0xf5, 0x70, 0xa0, 0xb0, 0x5e, 0xa1, 0x57, 0x47, 0xfb, 0x2a, 0x57,
-	0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x23, 0x66, 0x53, 0x98, 0x48, 0x01, 0x00, 0x00,
+	// 484 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x3f, 0x6f, 0x13, 0x31,
+	0x18, 0xc6, 0x73, 0xf9, 0x1f, 0x17, 0x41, 0x64, 0x15, 0xe9, 0x92, 0xe1, 0x12, 0x45, 0x42, 0x8a,
+	0x54, 0xd5, 0x56, 0xca, 0xcc, 0xc0, 0xa5, 0x4b, 0x53, 0x58, 0x6e, 0x64, 0x00, 0xf9, 0xee, 0x9c,
+	0xd4, 0xca, 0x9d, 0xdf, 0xea, 0xec, 0x4b, 0xda, 0x6f, 0xc1, 0xc8, 0xc4, 0xca, 0x17, 0xe0, 0x43,
+	0x74, 0xac, 0x98, 0x10, 0x43, 0x41, 0xc9, 0x17, 0x41, 0x39, 0xfb, 0xa8, 0x48, 0x85, 0x44, 0x37,
+	0x3f, 0xef, 0xfb, 0x3e, 0xaf, 0x1f, 0xff, 0x24, 0xa3, 0x71, 0x24, 0xb2, 0x28, 0xe1, 0x74, 0x2e,
+	0x98, 0xd6, 0xb0, 0xe4, 0x72, 0xce, 0x22, 0x0d, 0xd9, 0x35, 0x5d, 0x4d, 0x68, 0x2a, 0xa4, 0xe6,
+	0x99, 0x22, 0x97, 0x19, 0x68, 0xc0, 0x7d, 0x33, 0x49, 0xf6, 0x27, 0xc9, 0x6a, 0xd2, 0xf7, 0x22,
+	0x50, 0x29, 0x28, 0x1a, 0x32, 0xc5, 0xe9, 0x6a, 0x12, 0x72, 0xcd, 0x26, 0x34, 0x02, 0x21, 0x8d,
+	0xb7, 0xdf, 0x33, 0xfd, 0x0f, 0x85, 0xa2, 0x46, 0xd8, 0xd6, 0xe1, 0x02, 0x16, 0x60, 0xea, 0xbb,
+	0x93, 0xad, 0x7a, 0x0b, 0x80, 0x45, 0xc2, 0x69, 0xa1, 0xc2, 0x7c, 0x4e, 0xe3, 0x3c, 0x63, 0x5a,
+	0x80, 0x5d, 0x38, 0xfa, 0xe2, 0xa0, 0xd6, 0x5b, 0x13, 0x0f, 0xbb, 0xa8, 0xc5, 0xe2, 0x38, 0xe3,
+	0x4a, 0xb9, 0xce, 0xd0, 0x19, 0x77, 0x82, 0x52, 0xe2, 0x57, 0xa8, 0xc3, 0x92, 0x04, 0xd6, 0x4c,
+	0x46, 0xdc, 0xad, 0x0e, 0x9d, 0xf1, 0xc1, 0x49, 0x8f, 0xd8, 0xdb, 0x77, 0x51, 0x89, 0x8d, 0x4a,
+	0xa6, 0x20, 0xa4, 0x5f, 0xbf, 0xb9, 0x1b, 0x54, 0x82, 0x7b, 0x07, 0x9e, 0xa2, 0x4e, 0xc6, 0x34,
+	0x7f, 0x23, 0x52, 0xa1, 0xdd, 0x5a, 0x61, 0x7f, 0x41, 0xfe, 0x4d, 0x81, 0x04, 0xe5, 0x70, 0x70,
+	0xef, 0x1b, 0x7d, 0xae, 0xa2, 0xce, 0x9f, 0x06, 0x7e, 0x8d, 0x1a, 0x49, 0xb1, 0xae, 0x48, 0xea,
+	0x1f, 0xed, 0xae, 0xfc, 0x71, 0x37, 0x78, 0x6e, 0x42, 0xa9, 0x78, 0x49, 0x04, 0xd0, 0x94, 0xe9,
+	0x0b, 0x72, 0x26, 0xf5, 0xb7, 0xaf, 0xc7, 0xc8, 0xa6, 0x3d, 0x93, 0x3a, 0x30, 0x4e, 0x3c, 0x42,
+	0x4f, 0xd6, 0x42, 0xc6, 0xb0, 0xf6, 0x13, 0x88, 0x96, 0xaa, 0x78, 0x57, 0x2d, 0xf8, 0xab, 0x86,
+	0xcf, 0xd1, 0x53, 0xa3, 0x4f, 0x2d, 0x36, 0x1b, 0xbf, 0x47, 0x0c, 0x57, 0x52, 0x72, 0x25, 0xe5,
+	0x80, 0xdf, 0xde, 0x45, 0xf9, 0xf4, 0x73, 0xe0, 0x04, 0x7b, 0x56, 0x7c, 0x8e, 0x5a, 0x61, 0x1e,
+	0x2d, 0xb9, 0x56, 0x6e, 0x6b, 0x58, 0x1b, 0x1f, 0x9c, 0x1c, 0xfd, 0x17, 0x04, 0xbf, 0xf0, 0x58,
+	0xaa, 0xe5, 0x86, 0x59, 0xbd, 0x5d, 0xef, 0x36, 0x66, 0xf5, 0x76, 0xa3, 0xdb, 0x9c, 0xd5, 0xdb,
+	0xcd, 0x6e, 0x6b, 0x94, 0xa0, 0x67, 0x7b, 0x1e, 0x7c, 0x88, 0x1a, 0x42, 0xc6, 0xfc, 0xaa, 0xa0,
+	0x54, 0x0b, 0x8c, 0xc0, 0x53, 0xd4, 0x64, 0x29, 0xe4, 0x52, 0xbb, 0xd5, 0xc7, 0xc3, 0xb3, 0x56,
+	0xff, 0xfd, 0xcd, 0xc6, 0x73, 0x6e, 0x37, 0x9e, 0xf3, 0x6b, 0xe3, 0x39, 0x1f, 0xb7, 0x5e, 0xe5,
+	0x76, 0xeb, 0x55, 0xbe, 0x6f, 0xbd, 0xca, 0xbb, 0xd3, 0x85, 0xd0, 0x17, 0x79, 0x48, 0x22, 0x48,
+	0xa9, 0x79, 0xdf, 0x5c, 0x48, 0x2a, 0x21, 0x4c, 0xf8, 0xf1, 0x83, 0xdf, 0x71, 0xf5, 0xf0, 0xc3,
+	0xe8, 0xeb, 0x4b, 0xae, 0xc2, 0x66, 0x41, 0xf6, 0xe5, 0xef, 0x01, 0x00, 0x22, 0xe2, 0xbc, 0xe3,
+	0x58, 0x03, 0x00, 0x00,
 }
 
 func (m *Minters) Marshal() (dAtA []byte, err error) {
@@ -124,6 +271,18 @@ func (m *Minters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RateLimit != nil {
+		{
+			size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMinters(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -144,6 +303,104 @@ func (m *Minters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMinters(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.WindowDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.WindowDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintMinters(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	if m.WindowBlocks != 0 {
+		i = encodeVarintMinters(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMinters(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMinters(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Index != 0 {
+		i = encodeVarintMinters(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMinters(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMinters(v)
 	base := offset
@@ -167,6 +424,46 @@ func (m *Minters) Size() (n int) {
 	}
 	l = m.Allowance.Size()
 	n += 1 + l + sovMinters(uint64(l))
+	if m.RateLimit != nil {
+		l = m.RateLimit.Size()
+		n += 1 + l + sovMinters(uint64(l))
+	}
+	return n
+}
+
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limit.Size()
+	n += 1 + l + sovMinters(uint64(l))
+	if m.WindowBlocks != 0 {
+		n += 1 + sovMinters(uint64(m.WindowBlocks))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.WindowDuration)
+	n += 1 + l + sovMinters(uint64(l))
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovMinters(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RateLimitBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovMinters(uint64(m.Index))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMinters(uint64(l))
 	return n
 }
 
@@ -270,6 +567,315 @@ func (m *Minters) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinters
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMinters
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinters
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RateLimit == nil {
+				m.RateLimit = &RateLimit{}
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMinters(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMinters
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMinters
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinters
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMinters
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinters
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinters
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinters
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMinters
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinters
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.WindowDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinters
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMinters
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinters
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, RateLimitBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMinters(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMinters
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMinters
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinters
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinters
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMinters
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinters
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMinters(dAtA[iNdEx:])
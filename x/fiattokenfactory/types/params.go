@@ -0,0 +1,113 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const (
+	// DefaultActionProposalLifetime is the default time an action proposal can
+	// collect approvals for.
+	DefaultActionProposalLifetime = 72 * time.Hour
+	// DefaultMaxBlacklistBatchSize is the default maximum number of addresses a
+	// single blacklist message can carry.
+	DefaultMaxBlacklistBatchSize = 100
+	// DefaultAddressRenderHrp is the default human readable part blacklisted
+	// addresses are rendered with.
+	DefaultAddressRenderHrp = "noble"
+)
+
+// DefaultParams returns the default parameters of the module.
+func DefaultParams() Params {
+	return Params{
+		AuditLogRetention:      0,
+		ActionProposalLifetime: DefaultActionProposalLifetime,
+		RoleChangeDelay:        0,
+		AuthzGranteeCheck:      true,
+		MaxBlacklistBatchSize:  DefaultMaxBlacklistBatchSize,
+		AddressRenderHrps:      []string{DefaultAddressRenderHrp},
+		ModuleExemptions:       []ModuleExemption{{Module: ModuleName, Allowlist: true}},
+	}
+}
+
+// Validate performs a basic validation of the module parameters.
+func (p Params) Validate() error {
+	if p.ActionProposalLifetime <= 0 {
+		return fmt.Errorf("action proposal lifetime must be positive")
+	}
+
+	if p.RoleChangeDelay < 0 {
+		return fmt.Errorf("role change delay cannot be negative")
+	}
+
+	if p.MaxBlacklistBatchSize == 0 {
+		return fmt.Errorf("max blacklist batch size must be positive")
+	}
+
+	paths := make(map[string]struct{})
+	for _, path := range p.MemoRecipientPaths {
+		if slices.Contains(strings.Split(path, "."), "") {
+			return fmt.Errorf("invalid memo recipient path %q", path)
+		}
+		if _, ok := paths[path]; ok {
+			return fmt.Errorf("duplicated memo recipient path %q", path)
+		}
+		paths[path] = struct{}{}
+	}
+
+	hrps := make(map[string]struct{})
+	for _, hrp := range p.AddressRenderHrps {
+		if err := ValidateHrp(hrp); err != nil {
+			return err
+		}
+		if _, ok := hrps[hrp]; ok {
+			return fmt.Errorf("duplicated address render hrp %q", hrp)
+		}
+		hrps[hrp] = struct{}{}
+	}
+
+	modules := make(map[string]struct{})
+	for _, exemption := range p.ModuleExemptions {
+		if exemption.Module == "" {
+			return fmt.Errorf("module exemption module cannot be empty")
+		}
+		if _, ok := modules[exemption.Module]; ok {
+			return fmt.Errorf("duplicated module exemption %q", exemption.Module)
+		}
+		modules[exemption.Module] = struct{}{}
+	}
+
+	return nil
+}
+
+// GetModuleExemption returns the exemption of the module account with the
+// given address. Addresses without an exemption get an empty one.
+func (p Params) GetModuleExemption(address []byte) ModuleExemption {
+	for _, exemption := range p.ModuleExemptions {
+		if bytes.Equal(authtypes.NewModuleAddress(exemption.Module), address) {
+			return exemption
+		}
+	}
+	return ModuleExemption{}
+}
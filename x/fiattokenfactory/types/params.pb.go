@@ -0,0 +1,1046 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: circle/fiattokenfactory/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters of the fiattokenfactory module.
+type Params struct {
+	// auditLogRetention is the number of blocks an audit log entry is kept
+	// for. Zero keeps entries indefinitely.
+	AuditLogRetention uint64 `protobuf:"varint,1,opt,name=auditLogRetention,proto3" json:"auditLogRetention,omitempty"`
+	// actionProposalLifetime is the time an action proposal can collect
+	// approvals before it expires.
+	ActionProposalLifetime time.Duration `protobuf:"bytes,2,opt,name=actionProposalLifetime,proto3,stdduration" json:"actionProposalLifetime"`
+	// roleChangeDelay is the time role changes and minter controller assignments
+	// are queued for before they are executed. Zero executes them immediately.
+	RoleChangeDelay time.Duration `protobuf:"bytes,3,opt,name=roleChangeDelay,proto3,stdduration" json:"roleChangeDelay"`
+	// authzGranteeCheck enables checking the grantees of an authz execution
+	// against the blacklist.
+	AuthzGranteeCheck bool `protobuf:"varint,4,opt,name=authzGranteeCheck,proto3" json:"authzGranteeCheck,omitempty"`
+	// maxBlacklistBatchSize is the maximum number of addresses a single
+	// blacklist message can carry.
+	MaxBlacklistBatchSize uint32 `protobuf:"varint,6,opt,name=maxBlacklistBatchSize,proto3" json:"maxBlacklistBatchSize,omitempty"`
+	// memoRecipientPaths are dot separated paths of the ICS-20 memo fields that
+	// hold the eventual recipient of an inbound transfer, such as
+	// "wasm.msg.recipient". They are checked against the blacklist along with
+	// the receivers of packet forward middleware forwards.
+	MemoRecipientPaths []string `protobuf:"bytes,7,rep,name=memoRecipientPaths,proto3" json:"memoRecipientPaths,omitempty"`
+	// addressRenderHrps are the human readable parts blacklisted addresses are
+	// rendered with in query responses, unless the query requests others.
+	AddressRenderHrps []string `protobuf:"bytes,8,rep,name=addressRenderHrps,proto3" json:"addressRenderHrps,omitempty"`
+	// moduleExemptions relax the send restrictions of the minting denoms for
+	// module accounts, so that module to module flows such as fee collection
+	// and distribution keep a predictable behaviour.
+	ModuleExemptions []ModuleExemption `protobuf:"bytes,11,rep,name=moduleExemptions,proto3" json:"moduleExemptions"`
+	// twoStepRoleTransfers makes the master minter, pauser, blacklister and
+	// allowlister updates of the owner take effect only once the new address
+	// accepts the role with MsgAcceptRole, as the ownership does.
+	TwoStepRoleTransfers bool `protobuf:"varint,10,opt,name=twoStepRoleTransfers,proto3" json:"twoStepRoleTransfers,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7328f0f936de304b, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetAuditLogRetention() uint64 {
+	if m != nil {
+		return m.AuditLogRetention
+	}
+	return 0
+}
+
+func (m *Params) GetActionProposalLifetime() time.Duration {
+	if m != nil {
+		return m.ActionProposalLifetime
+	}
+	return 0
+}
+
+func (m *Params) GetRoleChangeDelay() time.Duration {
+	if m != nil {
+		return m.RoleChangeDelay
+	}
+	return 0
+}
+
+func (m *Params) GetAuthzGranteeCheck() bool {
+	if m != nil {
+		return m.AuthzGranteeCheck
+	}
+	return false
+}
+
+func (m *Params) GetMaxBlacklistBatchSize() uint32 {
+	if m != nil {
+		return m.MaxBlacklistBatchSize
+	}
+	return 0
+}
+
+func (m *Params) GetMemoRecipientPaths() []string {
+	if m != nil {
+		return m.MemoRecipientPaths
+	}
+	return nil
+}
+
+func (m *Params) GetAddressRenderHrps() []string {
+	if m != nil {
+		return m.AddressRenderHrps
+	}
+	return nil
+}
+
+func (m *Params) GetModuleExemptions() []ModuleExemption {
+	if m != nil {
+		return m.ModuleExemptions
+	}
+	return nil
+}
+
+func (m *Params) GetTwoStepRoleTransfers() bool {
+	if m != nil {
+		return m.TwoStepRoleTransfers
+	}
+	return false
+}
+
+// ModuleExemption relaxes the send restrictions of the minting denoms for the
+// account of a module.
+type ModuleExemption struct {
+	// module is the name of the module whose account is exempted.
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// pause lets transfers between two module accounts that are both exempted
+	// from the pause go through while the transfers of a minting denom are
+	// paused. Transfers involving any other account stay paused.
+	Pause bool `protobuf:"varint,2,opt,name=pause,proto3" json:"pause,omitempty"`
+	// blacklist stops the module account from being treated as blacklisted.
+	// The other party of a transfer is still checked against the blacklist.
+	Blacklist bool `protobuf:"varint,3,opt,name=blacklist,proto3" json:"blacklist,omitempty"`
+	// allowlist treats the module account as allowlisted while the allowlist
+	// mode of a minting denom is enabled.
+	Allowlist bool `protobuf:"varint,4,opt,name=allowlist,proto3" json:"allowlist,omitempty"`
+}
+
+func (m *ModuleExemption) Reset()         { *m = ModuleExemption{} }
+func (m *ModuleExemption) String() string { return proto.CompactTextString(m) }
+func (*ModuleExemption) ProtoMessage()    {}
+func (*ModuleExemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7328f0f936de304b, []int{1}
+}
+func (m *ModuleExemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleExemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleExemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleExemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleExemption.Merge(m, src)
+}
+func (m *ModuleExemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleExemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleExemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleExemption proto.InternalMessageInfo
+
+func (m *ModuleExemption) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *ModuleExemption) GetPause() bool {
+	if m != nil {
+		return m.Pause
+	}
+	return false
+}
+
+func (m *ModuleExemption) GetBlacklist() bool {
+	if m != nil {
+		return m.Blacklist
+	}
+	return false
+}
+
+func (m *ModuleExemption) GetAllowlist() bool {
+	if m != nil {
+		return m.Allowlist
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "circle.fiattokenfactory.v1.Params")
+	proto.RegisterType((*ModuleExemption)(nil), "circle.fiattokenfactory.v1.ModuleExemption")
+}
+
+func init() {
+	proto.RegisterFile("circle/fiattokenfactory/v1/params.proto", fileDescriptor_7328f0f936de304b)
+}
+
+var fileDescriptor_7328f0f936de304b = []byte{
+	// 544 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcd, 0x4e, 0xd5, 0x40,
+	0x14, 0xbe, 0x95, 0x72, 0xed, 0x1d, 0x62, 0xc0, 0x09, 0x92, 0x42, 0x4c, 0x69, 0xd8, 0xd8, 0x44,
+	0x6d, 0x03, 0xfa, 0x04, 0x17, 0x8c, 0x4a, 0x20, 0xb9, 0x19, 0x4c, 0x4c, 0x34, 0x9a, 0x4c, 0xa7,
+	0xa7, 0xed, 0xe4, 0x4e, 0x3b, 0xcd, 0xcc, 0x94, 0x3f, 0x5f, 0xc2, 0xa5, 0x8f, 0xc4, 0x92, 0xa5,
+	0x2b, 0x35, 0xf0, 0x0e, 0xae, 0x4d, 0x5b, 0x90, 0x48, 0x2f, 0x89, 0xbb, 0x39, 0xf3, 0x7d, 0xe7,
+	0x7c, 0x67, 0xce, 0x7c, 0x07, 0x3d, 0x61, 0x5c, 0x31, 0x01, 0x51, 0xca, 0xa9, 0x31, 0x72, 0x0a,
+	0x65, 0x4a, 0x99, 0x91, 0xea, 0x24, 0x3a, 0xdc, 0x8c, 0x2a, 0xaa, 0x68, 0xa1, 0xc3, 0x4a, 0x49,
+	0x23, 0xf1, 0x5a, 0x47, 0x0c, 0x6f, 0x13, 0xc3, 0xc3, 0xcd, 0xb5, 0xe5, 0x4c, 0x66, 0xb2, 0xa5,
+	0x45, 0xcd, 0xa9, 0xcb, 0x58, 0xf3, 0x32, 0x29, 0x33, 0x01, 0x51, 0x1b, 0xc5, 0x75, 0x1a, 0x25,
+	0xb5, 0xa2, 0x86, 0xcb, 0xb2, 0xc3, 0x37, 0x7e, 0xdb, 0x68, 0x38, 0x69, 0x25, 0xf0, 0x33, 0xf4,
+	0x90, 0xd6, 0x09, 0x37, 0x7b, 0x32, 0x23, 0x60, 0xa0, 0x6c, 0x58, 0xae, 0xe5, 0x5b, 0x81, 0x4d,
+	0xfa, 0x00, 0xfe, 0x88, 0x56, 0x28, 0x6b, 0x4e, 0x13, 0x25, 0x2b, 0xa9, 0xa9, 0xd8, 0xe3, 0x29,
+	0x18, 0x5e, 0x80, 0x7b, 0xcf, 0xb7, 0x82, 0x85, 0xad, 0xd5, 0xb0, 0x53, 0x0e, 0xaf, 0x95, 0xc3,
+	0x9d, 0x2b, 0xe5, 0xb1, 0x73, 0xf6, 0x63, 0x7d, 0xf0, 0xed, 0xe7, 0xba, 0x45, 0xee, 0x28, 0x81,
+	0xf7, 0xd1, 0xa2, 0x92, 0x02, 0xb6, 0x73, 0x5a, 0x66, 0xb0, 0x03, 0x82, 0x9e, 0xb8, 0x73, 0xff,
+	0x5f, 0xf5, 0x76, 0x6e, 0xf7, 0x32, 0x93, 0x9f, 0xbe, 0x56, 0xb4, 0x34, 0x00, 0xdb, 0x39, 0xb0,
+	0xa9, 0x6b, 0xfb, 0x56, 0xe0, 0x90, 0x3e, 0x80, 0x5f, 0xa2, 0x47, 0x05, 0x3d, 0x1e, 0x0b, 0xca,
+	0xa6, 0x82, 0x6b, 0x33, 0xa6, 0x86, 0xe5, 0x07, 0xfc, 0x14, 0xdc, 0xa1, 0x6f, 0x05, 0x0f, 0xc8,
+	0x6c, 0x10, 0x87, 0x08, 0x17, 0x50, 0x48, 0x02, 0x8c, 0x57, 0x1c, 0x4a, 0x33, 0xa1, 0x26, 0xd7,
+	0xee, 0x7d, 0x7f, 0x2e, 0x18, 0x91, 0x19, 0x48, 0xdb, 0x53, 0x92, 0x28, 0xd0, 0x9a, 0x40, 0x99,
+	0x80, 0x7a, 0xa3, 0x2a, 0xed, 0x3a, 0x2d, 0xbd, 0x0f, 0xe0, 0x4f, 0x68, 0xa9, 0x90, 0x49, 0x2d,
+	0xe0, 0xd5, 0x31, 0x14, 0x55, 0xf3, 0x5e, 0xed, 0x2e, 0xf8, 0x73, 0xc1, 0xc2, 0xd6, 0xd3, 0xf0,
+	0x6e, 0x4f, 0x84, 0xfb, 0xff, 0xe6, 0x8c, 0xed, 0x66, 0x46, 0xa4, 0x57, 0x0a, 0x6f, 0xa1, 0x65,
+	0x73, 0x24, 0x0f, 0x0c, 0x54, 0x44, 0x0a, 0x78, 0xa7, 0x68, 0xa9, 0x53, 0x50, 0xda, 0x45, 0xed,
+	0x8c, 0x66, 0x62, 0xbb, 0xb6, 0x33, 0xbf, 0x34, 0xdc, 0xb5, 0x9d, 0xd1, 0x12, 0x22, 0xab, 0xb1,
+	0x90, 0x6c, 0xfa, 0x36, 0x66, 0x04, 0x18, 0xf0, 0x43, 0x78, 0x9f, 0x43, 0x39, 0xa1, 0xb5, 0x86,
+	0x84, 0xac, 0x50, 0x21, 0xe4, 0x51, 0x33, 0xab, 0x4e, 0xaf, 0x6b, 0x49, 0x6f, 0x7c, 0x41, 0x8b,
+	0xb7, 0xba, 0xc3, 0x2b, 0x68, 0xd8, 0x75, 0xd6, 0xba, 0x6e, 0x44, 0xae, 0x22, 0xbc, 0x8c, 0xe6,
+	0xab, 0xa6, 0x58, 0xeb, 0x2c, 0x87, 0x74, 0x01, 0x7e, 0x8c, 0x46, 0xf1, 0xf5, 0x37, 0xb4, 0xee,
+	0x70, 0xc8, 0xcd, 0x45, 0x83, 0xfe, 0x15, 0xbe, 0xfa, 0xea, 0x9b, 0x8b, 0xf1, 0xe7, 0xb3, 0x0b,
+	0xcf, 0x3a, 0xbf, 0xf0, 0xac, 0x5f, 0x17, 0x9e, 0xf5, 0xf5, 0xd2, 0x1b, 0x9c, 0x5f, 0x7a, 0x83,
+	0xef, 0x97, 0xde, 0xe0, 0xc3, 0x4e, 0xc6, 0x4d, 0x5e, 0xc7, 0x21, 0x93, 0x45, 0xd4, 0x0d, 0x36,
+	0xe5, 0x65, 0x54, 0xca, 0x58, 0xc0, 0xf3, 0xde, 0x7a, 0x1e, 0xf7, 0x37, 0xd6, 0x9c, 0x54, 0xa0,
+	0xe3, 0x61, 0x6b, 0xcf, 0x17, 0x7f, 0x06, 0x00, 0x6b, 0x28, 0x13, 0x0c, 0xd9, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleExemptions) > 0 {
+		for iNdEx := len(m.ModuleExemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModuleExemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.TwoStepRoleTransfers {
+		i--
+		if m.TwoStepRoleTransfers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.AddressRenderHrps) > 0 {
+		for iNdEx := len(m.AddressRenderHrps) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddressRenderHrps[iNdEx])
+			copy(dAtA[i:], m.AddressRenderHrps[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AddressRenderHrps[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.MemoRecipientPaths) > 0 {
+		for iNdEx := len(m.MemoRecipientPaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MemoRecipientPaths[iNdEx])
+			copy(dAtA[i:], m.MemoRecipientPaths[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.MemoRecipientPaths[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.MaxBlacklistBatchSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBlacklistBatchSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AuthzGranteeCheck {
+		i--
+		if m.AuthzGranteeCheck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RoleChangeDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RoleChangeDelay):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ActionProposalLifetime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ActionProposalLifetime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.AuditLogRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AuditLogRetention))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleExemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleExemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleExemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Allowlist {
+		i--
+		if m.Allowlist {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Blacklist {
+		i--
+		if m.Blacklist {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pause {
+		i--
+		if m.Pause {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuditLogRetention != 0 {
+		n += 1 + sovParams(uint64(m.AuditLogRetention))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ActionProposalLifetime)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RoleChangeDelay)
+	n += 1 + l + sovParams(uint64(l))
+	if m.AuthzGranteeCheck {
+		n += 2
+	}
+	if m.MaxBlacklistBatchSize != 0 {
+		n += 1 + sovParams(uint64(m.MaxBlacklistBatchSize))
+	}
+	if len(m.MemoRecipientPaths) > 0 {
+		for _, s := range m.MemoRecipientPaths {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.AddressRenderHrps) > 0 {
+		for _, s := range m.AddressRenderHrps {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.TwoStepRoleTransfers {
+		n += 2
+	}
+	if len(m.ModuleExemptions) > 0 {
+		for _, e := range m.ModuleExemptions {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModuleExemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Pause {
+		n += 2
+	}
+	if m.Blacklist {
+		n += 2
+	}
+	if m.Allowlist {
+		n += 2
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditLogRetention", wireType)
+			}
+			m.AuditLogRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuditLogRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionProposalLifetime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ActionProposalLifetime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleChangeDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RoleChangeDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthzGranteeCheck", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AuthzGranteeCheck = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlacklistBatchSize", wireType)
+			}
+			m.MaxBlacklistBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlacklistBatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoRecipientPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemoRecipientPaths = append(m.MemoRecipientPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressRenderHrps", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressRenderHrps = append(m.AddressRenderHrps, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwoStepRoleTransfers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TwoStepRoleTransfers = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleExemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleExemptions = append(m.ModuleExemptions, ModuleExemption{})
+			if err := m.ModuleExemptions[len(m.ModuleExemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleExemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleExemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleExemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pause = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blacklist", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Blacklist = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowlist = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
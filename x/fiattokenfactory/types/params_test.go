@@ -0,0 +1,47 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParams_LegacyAllowlistExemptModules(t *testing.T) {
+	// ARRANGE: Encode params holding the removed allowlistExemptModules in field 9.
+	bz := append([]byte{0x4a, byte(len("transfer"))}, "transfer"...)
+
+	// ACT: Decode the params.
+	var params Params
+	err := params.Unmarshal(bz)
+
+	// ASSERT: The reserved field is ignored instead of being read as a module exemption.
+	require.NoError(t, err)
+	require.Empty(t, params.ModuleExemptions)
+}
+
+func TestParams_GetModuleExemption(t *testing.T) {
+	// ARRANGE: Exempt the transfer module from the pause.
+	params := DefaultParams()
+	params.ModuleExemptions = []ModuleExemption{{Module: "transfer", Pause: true}}
+
+	// ACT & ASSERT: The exemption is found by the module address.
+	require.Equal(t, params.ModuleExemptions[0], params.GetModuleExemption(authtypes.NewModuleAddress("transfer")))
+	require.Equal(t, ModuleExemption{}, params.GetModuleExemption(authtypes.NewModuleAddress("bank")))
+}
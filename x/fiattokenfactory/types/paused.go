@@ -0,0 +1,129 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"cosmossdk.io/errors"
+)
+
+// PauseScopesAll is the bitset of all pause scopes.
+const PauseScopesAll = uint32(1)<<PauseScope_PAUSE_SCOPE_AUTHZ - 1
+
+// Bit returns the bit representing a scope in the paused scopes bitset.
+func (s PauseScope) Bit() uint32 {
+	return 1 << (uint32(s) - 1)
+}
+
+// Name returns the short name of a scope, such as "ibc_in".
+func (s PauseScope) Name() string {
+	return strings.ToLower(strings.TrimPrefix(s.String(), "PAUSE_SCOPE_"))
+}
+
+// ParsePauseScope returns the scope of a short name, such as "ibc_in".
+func ParsePauseScope(name string) (PauseScope, error) {
+	scope, ok := PauseScope_value["PAUSE_SCOPE_"+strings.ToUpper(name)]
+	if !ok || scope == int32(PauseScope_PAUSE_SCOPE_UNSPECIFIED) {
+		return PauseScope_PAUSE_SCOPE_UNSPECIFIED, fmt.Errorf("unknown pause scope %q", name)
+	}
+	return PauseScope(scope), nil
+}
+
+// PauseScopesBitset returns the bitset of a list of scopes. An empty list
+// represents all scopes.
+func PauseScopesBitset(scopes []PauseScope) (uint32, error) {
+	if len(scopes) == 0 {
+		return PauseScopesAll, nil
+	}
+
+	var bitset uint32
+	for _, scope := range scopes {
+		if scope <= PauseScope_PAUSE_SCOPE_UNSPECIFIED || scope > PauseScope_PAUSE_SCOPE_AUTHZ {
+			return 0, errors.Wrapf(ErrInvalidType, "invalid pause scope %d", scope)
+		}
+		bitset |= scope.Bit()
+	}
+	return bitset, nil
+}
+
+// PauseScopesList returns the scopes of a bitset in ascending order.
+func PauseScopesList(bitset uint32) []PauseScope {
+	var scopes []PauseScope
+	for scope := PauseScope_PAUSE_SCOPE_MINT; scope <= PauseScope_PAUSE_SCOPE_AUTHZ; scope++ {
+		if bitset&scope.Bit() != 0 {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes
+}
+
+// FormatPauseScopes renders a bitset of scopes as a comma separated list of
+// their names.
+func FormatPauseScopes(bitset uint32) string {
+	var names []string
+	for _, scope := range PauseScopesList(bitset) {
+		names = append(names, scope.Name())
+	}
+	return strings.Join(names, ",")
+}
+
+// IsPaused reports whether a scope is paused.
+func (p Paused) IsPaused(scope PauseScope) bool {
+	return p.Scopes&scope.Bit() != 0
+}
+
+// Normalize keeps the paused flag in line with the paused scopes. A paused
+// state that predates the scopes, and only has its flag set, pauses all
+// scopes. An unpaused state has no scheduled unpause.
+func (p Paused) Normalize() Paused {
+	if p.Paused && p.Scopes == 0 {
+		p.Scopes = PauseScopesAll
+	}
+	p.Paused = p.Scopes != 0
+	if !p.Paused {
+		p.UnpauseAtHeight = 0
+		p.UnpauseAtTime = nil
+	}
+	return p
+}
+
+// HasScheduledUnpause reports whether the pause is lifted automatically.
+func (p Paused) HasScheduledUnpause() bool {
+	return p.UnpauseAtHeight > 0 || p.UnpauseAtTime != nil
+}
+
+// UnpauseDue reports whether the scheduled unpause is reached at a block.
+func (p Paused) UnpauseDue(height int64, blockTime time.Time) bool {
+	if p.UnpauseAtHeight > 0 && height >= p.UnpauseAtHeight {
+		return true
+	}
+	return p.UnpauseAtTime != nil && !blockTime.Before(*p.UnpauseAtTime)
+}
+
+// Validate checks that only known scopes are paused.
+func (p Paused) Validate() error {
+	if p.Scopes&^PauseScopesAll != 0 {
+		return errors.Wrapf(ErrInvalidType, "unknown pause scopes %b", p.Scopes&^PauseScopesAll)
+	}
+	if p.UnpauseAtHeight < 0 {
+		return errors.Wrap(ErrInvalidExpiry, "unpause height cannot be negative")
+	}
+	return nil
+}
@@ -5,16 +5,21 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -22,8 +27,65 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PauseScope is an operation of a minting denom that can be paused on its own.
+type PauseScope int32
+
+const (
+	PauseScope_PAUSE_SCOPE_UNSPECIFIED PauseScope = 0
+	// PAUSE_SCOPE_MINT pauses minting and minter configuration.
+	PauseScope_PAUSE_SCOPE_MINT PauseScope = 1
+	// PAUSE_SCOPE_BURN pauses burning.
+	PauseScope_PAUSE_SCOPE_BURN PauseScope = 2
+	// PAUSE_SCOPE_TRANSFER pauses every bank transfer of the denom.
+	PauseScope_PAUSE_SCOPE_TRANSFER PauseScope = 3
+	// PAUSE_SCOPE_IBC_IN pauses receiving the denom over IBC.
+	PauseScope_PAUSE_SCOPE_IBC_IN PauseScope = 4
+	// PAUSE_SCOPE_IBC_OUT pauses sending the denom over IBC.
+	PauseScope_PAUSE_SCOPE_IBC_OUT PauseScope = 5
+	// PAUSE_SCOPE_AUTHZ pauses granting send authorizations of the denom.
+	PauseScope_PAUSE_SCOPE_AUTHZ PauseScope = 6
+)
+
+var PauseScope_name = map[int32]string{
+	0: "PAUSE_SCOPE_UNSPECIFIED",
+	1: "PAUSE_SCOPE_MINT",
+	2: "PAUSE_SCOPE_BURN",
+	3: "PAUSE_SCOPE_TRANSFER",
+	4: "PAUSE_SCOPE_IBC_IN",
+	5: "PAUSE_SCOPE_IBC_OUT",
+	6: "PAUSE_SCOPE_AUTHZ",
+}
+
+var PauseScope_value = map[string]int32{
+	"PAUSE_SCOPE_UNSPECIFIED": 0,
+	"PAUSE_SCOPE_MINT":        1,
+	"PAUSE_SCOPE_BURN":        2,
+	"PAUSE_SCOPE_TRANSFER":    3,
+	"PAUSE_SCOPE_IBC_IN":      4,
+	"PAUSE_SCOPE_IBC_OUT":     5,
+	"PAUSE_SCOPE_AUTHZ":       6,
+}
+
+func (x PauseScope) String() string {
+	return proto.EnumName(PauseScope_name, int32(x))
+}
+
+func (PauseScope) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e36d0fde6b443e83, []int{0}
+}
+
 type Paused struct {
-	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	// paused is set when at least one scope is paused.
+	Paused bool   `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// scopes is the bitset of the paused scopes, where a scope is represented
+	// by the bit 1 << (scope - 1).
+	Scopes uint32 `protobuf:"varint,3,opt,name=scopes,proto3" json:"scopes,omitempty"`
+	// unpauseAtHeight and unpauseAtTime optionally schedule the lifting of the
+	// pause. All scopes are unpaused at the end of the first block reaching
+	// either of them.
+	UnpauseAtHeight int64      `protobuf:"varint,4,opt,name=unpauseAtHeight,proto3" json:"unpauseAtHeight,omitempty"`
+	UnpauseAtTime   *time.Time `protobuf:"bytes,5,opt,name=unpauseAtTime,proto3,stdtime" json:"unpauseAtTime,omitempty"`
 }
 
 func (m *Paused) Reset()         { *m = Paused{} }
@@ -66,7 +128,36 @@ func (m *Paused) GetPaused() bool {
 	return false
 }
 
+func (m *Paused) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Paused) GetScopes() uint32 {
+	if m != nil {
+		return m.Scopes
+	}
+	return 0
+}
+
+func (m *Paused) GetUnpauseAtHeight() int64 {
+	if m != nil {
+		return m.UnpauseAtHeight
+	}
+	return 0
+}
+
+func (m *Paused) GetUnpauseAtTime() *time.Time {
+	if m != nil {
+		return m.UnpauseAtTime
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("circle.fiattokenfactory.v1.PauseScope", PauseScope_name, PauseScope_value)
 	proto.RegisterType((*Paused)(nil), "circle.fiattokenfactory.v1.Paused")
 }
 
@@ -75,18 +166,33 @@ func init() {
 }
 
 var fileDescriptor_e36d0fde6b443e83 = []byte{
-	// 166 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4f, 0xce, 0x2c, 0x4a,
-	0xce, 0x49, 0xd5, 0x4f, 0xcb, 0x4c, 0x2c, 0x29, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e,
-	0xc9, 0x2f, 0xaa, 0xd4, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x2d, 0x4e, 0x4d, 0xd1, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0x92, 0x82, 0x28, 0xd4, 0x43, 0x57, 0xa8, 0x57, 0x66, 0xa8, 0xa4, 0xc0,
-	0xc5, 0x16, 0x00, 0x56, 0x2b, 0x24, 0xc6, 0xc5, 0x06, 0xd1, 0x25, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1,
-	0x11, 0x04, 0xe5, 0x39, 0xc5, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47,
-	0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94,
-	0x4b, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xc4, 0x8a, 0xb4, 0xcc,
-	0x3c, 0xfd, 0xbc, 0xfc, 0xa4, 0x9c, 0x54, 0x5d, 0x0c, 0x47, 0x55, 0x60, 0xba, 0xb3, 0xa4, 0xb2,
-	0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x48, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x07, 0x94,
-	0x80, 0xc1, 0xcf, 0x00, 0x00, 0x00,
+	// 401 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x52, 0xcd, 0x6e, 0x94, 0x40,
+	0x1c, 0xdf, 0xe9, 0x7e, 0x44, 0xc7, 0x34, 0x8e, 0x23, 0xb6, 0x04, 0x13, 0x4a, 0xbc, 0x48, 0x4c,
+	0x64, 0x52, 0x7d, 0x02, 0xd8, 0xb2, 0x29, 0x07, 0x29, 0x19, 0xe0, 0xd2, 0x83, 0x1b, 0xa0, 0x03,
+	0x25, 0x2e, 0x0c, 0x59, 0x86, 0xc6, 0xbe, 0x45, 0xdf, 0xc6, 0xab, 0x47, 0x8f, 0x3d, 0x7a, 0xd3,
+	0xec, 0xbe, 0x88, 0x59, 0x68, 0x0d, 0x65, 0x6f, 0xf3, 0xfb, 0xca, 0xff, 0x37, 0x33, 0x7f, 0xf8,
+	0x3e, 0xc9, 0xd7, 0xc9, 0x8a, 0x91, 0x34, 0x8f, 0x84, 0xe0, 0xdf, 0x58, 0x99, 0x46, 0x89, 0xe0,
+	0xeb, 0x5b, 0x72, 0x73, 0x4a, 0xaa, 0xa8, 0xa9, 0xd9, 0x95, 0x51, 0xad, 0xb9, 0xe0, 0x58, 0xe9,
+	0x8c, 0xc6, 0xd0, 0x68, 0xdc, 0x9c, 0x2a, 0x52, 0xc6, 0x33, 0xde, 0xda, 0xc8, 0xee, 0xd4, 0x25,
+	0x94, 0x93, 0x8c, 0xf3, 0x6c, 0xc5, 0x48, 0x8b, 0xe2, 0x26, 0x25, 0x22, 0x2f, 0x58, 0x2d, 0xa2,
+	0xa2, 0xea, 0x0c, 0xef, 0x7e, 0x02, 0x38, 0xf3, 0xda, 0x19, 0xf8, 0x08, 0xce, 0xba, 0x69, 0x32,
+	0xd0, 0x80, 0xfe, 0x8c, 0x3e, 0x20, 0x2c, 0xc1, 0xe9, 0x15, 0x2b, 0x79, 0x21, 0x1f, 0x68, 0x40,
+	0x7f, 0x4e, 0x3b, 0xb0, 0x73, 0xd7, 0x09, 0xaf, 0x58, 0x2d, 0x8f, 0x35, 0xa0, 0x1f, 0xd2, 0x07,
+	0x84, 0x75, 0xf8, 0xb2, 0x29, 0xdb, 0xa4, 0x29, 0xce, 0x59, 0x9e, 0x5d, 0x0b, 0x79, 0xa2, 0x01,
+	0x7d, 0x4c, 0x87, 0x34, 0x5e, 0xc0, 0xc3, 0xff, 0x54, 0x90, 0x17, 0x4c, 0x9e, 0x6a, 0x40, 0x7f,
+	0xf1, 0x49, 0x31, 0xba, 0xce, 0xc6, 0x63, 0x67, 0x23, 0x78, 0xec, 0x6c, 0x4d, 0xee, 0xfe, 0x9c,
+	0x00, 0xfa, 0x34, 0xf6, 0xe1, 0x07, 0x80, 0xb0, 0xbd, 0x82, 0xbf, 0x6b, 0x80, 0xdf, 0xc2, 0x63,
+	0xcf, 0x0c, 0x7d, 0x7b, 0xe9, 0xcf, 0x2f, 0x3c, 0x7b, 0x19, 0xba, 0xbe, 0x67, 0xcf, 0x9d, 0x85,
+	0x63, 0x9f, 0xa1, 0x11, 0x96, 0x20, 0xea, 0x8b, 0x5f, 0x1c, 0x37, 0x40, 0x60, 0xc8, 0x5a, 0x21,
+	0x75, 0xd1, 0x01, 0x96, 0xa1, 0xd4, 0x67, 0x03, 0x6a, 0xba, 0xfe, 0xc2, 0xa6, 0x68, 0x8c, 0x8f,
+	0x20, 0xee, 0x2b, 0x8e, 0x35, 0x5f, 0x3a, 0x2e, 0x9a, 0xe0, 0x63, 0xf8, 0x7a, 0xc8, 0x5f, 0x84,
+	0x01, 0x9a, 0xe2, 0x37, 0xf0, 0x55, 0x5f, 0x30, 0xc3, 0xe0, 0xfc, 0x12, 0xcd, 0xac, 0xaf, 0xbf,
+	0x36, 0x2a, 0xb8, 0xdf, 0xa8, 0xe0, 0xef, 0x46, 0x05, 0x77, 0x5b, 0x75, 0x74, 0xbf, 0x55, 0x47,
+	0xbf, 0xb7, 0xea, 0xe8, 0xf2, 0x2c, 0xcb, 0xc5, 0x75, 0x13, 0x1b, 0x09, 0x2f, 0x48, 0xf7, 0xe9,
+	0x69, 0x5e, 0x92, 0x92, 0xc7, 0x2b, 0xf6, 0x71, 0x6f, 0x4d, 0xbe, 0xef, 0x6f, 0x8e, 0xb8, 0xad,
+	0x58, 0x1d, 0xcf, 0xda, 0x27, 0xfc, 0xfc, 0x6f, 0x00, 0xa4, 0x31, 0x26, 0xba, 0x61, 0x02, 0x00,
+	0x00,
 }
 
 func (m *Paused) Marshal() (dAtA []byte, err error) {
@@ -109,6 +215,33 @@ func (m *Paused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UnpauseAtTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.UnpauseAtTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.UnpauseAtTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintPaused(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.UnpauseAtHeight != 0 {
+		i = encodeVarintPaused(dAtA, i, uint64(m.UnpauseAtHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Scopes != 0 {
+		i = encodeVarintPaused(dAtA, i, uint64(m.Scopes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintPaused(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Paused {
 		i--
 		if m.Paused {
@@ -142,6 +275,20 @@ func (m *Paused) Size() (n int) {
 	if m.Paused {
 		n += 2
 	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovPaused(uint64(l))
+	}
+	if m.Scopes != 0 {
+		n += 1 + sovPaused(uint64(m.Scopes))
+	}
+	if m.UnpauseAtHeight != 0 {
+		n += 1 + sovPaused(uint64(m.UnpauseAtHeight))
+	}
+	if m.UnpauseAtTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.UnpauseAtTime)
+		n += 1 + l + sovPaused(uint64(l))
+	}
 	return n
 }
 
@@ -200,6 +347,112 @@ func (m *Paused) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Paused = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaused
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPaused
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaused
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			m.Scopes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaused
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scopes |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpauseAtHeight", wireType)
+			}
+			m.UnpauseAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaused
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnpauseAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpauseAtTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaused
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPaused
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaused
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UnpauseAtTime == nil {
+				m.UnpauseAtTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.UnpauseAtTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPaused(dAtA[iNdEx:])
@@ -0,0 +1,75 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPauseScopesBitset(t *testing.T) {
+	bitset, err := PauseScopesBitset(nil)
+	require.NoError(t, err)
+	require.Equal(t, PauseScopesAll, bitset)
+
+	bitset, err = PauseScopesBitset([]PauseScope{PauseScope_PAUSE_SCOPE_MINT, PauseScope_PAUSE_SCOPE_AUTHZ})
+	require.NoError(t, err)
+	require.Equal(t, uint32(0b100001), bitset)
+
+	_, err = PauseScopesBitset([]PauseScope{PauseScope_PAUSE_SCOPE_UNSPECIFIED})
+	require.ErrorIs(t, err, ErrInvalidType)
+}
+
+func TestParsePauseScope(t *testing.T) {
+	scope, err := ParsePauseScope("ibc_in")
+	require.NoError(t, err)
+	require.Equal(t, PauseScope_PAUSE_SCOPE_IBC_IN, scope)
+
+	_, err = ParsePauseScope("unspecified")
+	require.Error(t, err)
+	_, err = ParsePauseScope("everything")
+	require.Error(t, err)
+}
+
+func TestFormatPauseScopes(t *testing.T) {
+	require.Equal(t, "", FormatPauseScopes(0))
+	require.Equal(t, "burn,ibc_out", FormatPauseScopes(PauseScope_PAUSE_SCOPE_BURN.Bit()|PauseScope_PAUSE_SCOPE_IBC_OUT.Bit()))
+	require.Equal(t, "mint,burn,transfer,ibc_in,ibc_out,authz", FormatPauseScopes(PauseScopesAll))
+}
+
+func TestPaused_Validate(t *testing.T) {
+	require.NoError(t, Paused{Scopes: PauseScopesAll}.Validate())
+	require.ErrorIs(t, Paused{Scopes: PauseScopesAll + 1}.Validate(), ErrInvalidType)
+}
+
+func TestPaused_UnpausedHasNoSchedule(t *testing.T) {
+	unpauseAt := time.Unix(10, 0)
+	paused := Paused{Scopes: 0, UnpauseAtHeight: 5, UnpauseAtTime: &unpauseAt}.Normalize()
+	require.False(t, paused.HasScheduledUnpause())
+}
+
+func TestPaused_UnpauseDue(t *testing.T) {
+	unpauseAt := time.Unix(10, 0)
+	paused := Paused{Scopes: PauseScopesAll, UnpauseAtHeight: 5, UnpauseAtTime: &unpauseAt}
+
+	require.False(t, paused.UnpauseDue(4, unpauseAt.Add(-time.Second)))
+	require.True(t, paused.UnpauseDue(5, unpauseAt.Add(-time.Second)))
+	require.True(t, paused.UnpauseDue(4, unpauseAt))
+	require.False(t, Paused{Scopes: PauseScopesAll}.UnpauseDue(100, unpauseAt))
+}
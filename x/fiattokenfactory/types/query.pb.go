@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -32,6 +33,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type QueryGetBlacklistedRequest struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// hrps are the human readable parts the address is rendered with. The
+	// addressRenderHrps param is used when empty.
+	Hrps []string `protobuf:"bytes,3,rep,name=hrps,proto3" json:"hrps,omitempty"`
 }
 
 func (m *QueryGetBlacklistedRequest) Reset()         { *m = QueryGetBlacklistedRequest{} }
@@ -74,8 +79,23 @@ func (m *QueryGetBlacklistedRequest) GetAddress() string {
 	return ""
 }
 
+func (m *QueryGetBlacklistedRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryGetBlacklistedRequest) GetHrps() []string {
+	if m != nil {
+		return m.Hrps
+	}
+	return nil
+}
+
 type QueryGetBlacklistedResponse struct {
-	Blacklisted Blacklisted `protobuf:"bytes,1,opt,name=blacklisted,proto3" json:"blacklisted"`
+	Blacklisted Blacklisted      `protobuf:"bytes,1,opt,name=blacklisted,proto3" json:"blacklisted"`
+	Address     AddressRendering `protobuf:"bytes,2,opt,name=address,proto3" json:"address"`
 }
 
 func (m *QueryGetBlacklistedResponse) Reset()         { *m = QueryGetBlacklistedResponse{} }
@@ -118,8 +138,19 @@ func (m *QueryGetBlacklistedResponse) GetBlacklisted() Blacklisted {
 	return Blacklisted{}
 }
 
+func (m *QueryGetBlacklistedResponse) GetAddress() AddressRendering {
+	if m != nil {
+		return m.Address
+	}
+	return AddressRendering{}
+}
+
 type QueryAllBlacklistedRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Denom      string             `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// hrps are the human readable parts the addresses are rendered with. The
+	// addressRenderHrps param is used when empty.
+	Hrps []string `protobuf:"bytes,3,rep,name=hrps,proto3" json:"hrps,omitempty"`
 }
 
 func (m *QueryAllBlacklistedRequest) Reset()         { *m = QueryAllBlacklistedRequest{} }
@@ -162,9 +193,26 @@ func (m *QueryAllBlacklistedRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryAllBlacklistedRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryAllBlacklistedRequest) GetHrps() []string {
+	if m != nil {
+		return m.Hrps
+	}
+	return nil
+}
+
 type QueryAllBlacklistedResponse struct {
 	Blacklisted []Blacklisted       `protobuf:"bytes,1,rep,name=blacklisted,proto3" json:"blacklisted"`
 	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// addresses are the renderings of the blacklisted addresses, in the same
+	// order as blacklisted.
+	Addresses []AddressRendering `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses"`
 }
 
 func (m *QueryAllBlacklistedResponse) Reset()         { *m = QueryAllBlacklistedResponse{} }
@@ -214,14 +262,138 @@ func (m *QueryAllBlacklistedResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+func (m *QueryAllBlacklistedResponse) GetAddresses() []AddressRendering {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+type QueryBlacklistedByBytesRequest struct {
+	// address is the hex, optionally 0x prefixed, or base64 encoding of the
+	// address bytes.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// hrps are the human readable parts the address is rendered with. The
+	// addressRenderHrps param is used when empty.
+	Hrps []string `protobuf:"bytes,3,rep,name=hrps,proto3" json:"hrps,omitempty"`
+}
+
+func (m *QueryBlacklistedByBytesRequest) Reset()         { *m = QueryBlacklistedByBytesRequest{} }
+func (m *QueryBlacklistedByBytesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlacklistedByBytesRequest) ProtoMessage()    {}
+func (*QueryBlacklistedByBytesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{4}
+}
+func (m *QueryBlacklistedByBytesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlacklistedByBytesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlacklistedByBytesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlacklistedByBytesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlacklistedByBytesRequest.Merge(m, src)
+}
+func (m *QueryBlacklistedByBytesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlacklistedByBytesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlacklistedByBytesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlacklistedByBytesRequest proto.InternalMessageInfo
+
+func (m *QueryBlacklistedByBytesRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryBlacklistedByBytesRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryBlacklistedByBytesRequest) GetHrps() []string {
+	if m != nil {
+		return m.Hrps
+	}
+	return nil
+}
+
+type QueryBlacklistedByBytesResponse struct {
+	Blacklisted Blacklisted      `protobuf:"bytes,1,opt,name=blacklisted,proto3" json:"blacklisted"`
+	Address     AddressRendering `protobuf:"bytes,2,opt,name=address,proto3" json:"address"`
+}
+
+func (m *QueryBlacklistedByBytesResponse) Reset()         { *m = QueryBlacklistedByBytesResponse{} }
+func (m *QueryBlacklistedByBytesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlacklistedByBytesResponse) ProtoMessage()    {}
+func (*QueryBlacklistedByBytesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{5}
+}
+func (m *QueryBlacklistedByBytesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlacklistedByBytesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlacklistedByBytesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlacklistedByBytesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlacklistedByBytesResponse.Merge(m, src)
+}
+func (m *QueryBlacklistedByBytesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlacklistedByBytesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlacklistedByBytesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlacklistedByBytesResponse proto.InternalMessageInfo
+
+func (m *QueryBlacklistedByBytesResponse) GetBlacklisted() Blacklisted {
+	if m != nil {
+		return m.Blacklisted
+	}
+	return Blacklisted{}
+}
+
+func (m *QueryBlacklistedByBytesResponse) GetAddress() AddressRendering {
+	if m != nil {
+		return m.Address
+	}
+	return AddressRendering{}
+}
+
 type QueryGetPausedRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryGetPausedRequest) Reset()         { *m = QueryGetPausedRequest{} }
 func (m *QueryGetPausedRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetPausedRequest) ProtoMessage()    {}
 func (*QueryGetPausedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{4}
+	return fileDescriptor_0e79ac8fb1676620, []int{6}
 }
 func (m *QueryGetPausedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -250,6 +422,13 @@ func (m *QueryGetPausedRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryGetPausedRequest proto.InternalMessageInfo
 
+func (m *QueryGetPausedRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type QueryGetPausedResponse struct {
 	Paused Paused `protobuf:"bytes,1,opt,name=paused,proto3" json:"paused"`
 }
@@ -258,7 +437,7 @@ func (m *QueryGetPausedResponse) Reset()         { *m = QueryGetPausedResponse{}
 func (m *QueryGetPausedResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetPausedResponse) ProtoMessage()    {}
 func (*QueryGetPausedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{5}
+	return fileDescriptor_0e79ac8fb1676620, []int{7}
 }
 func (m *QueryGetPausedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -295,13 +474,14 @@ func (m *QueryGetPausedResponse) GetPaused() Paused {
 }
 
 type QueryGetMasterMinterRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryGetMasterMinterRequest) Reset()         { *m = QueryGetMasterMinterRequest{} }
 func (m *QueryGetMasterMinterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetMasterMinterRequest) ProtoMessage()    {}
 func (*QueryGetMasterMinterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{6}
+	return fileDescriptor_0e79ac8fb1676620, []int{8}
 }
 func (m *QueryGetMasterMinterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -330,6 +510,13 @@ func (m *QueryGetMasterMinterRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryGetMasterMinterRequest proto.InternalMessageInfo
 
+func (m *QueryGetMasterMinterRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type QueryGetMasterMinterResponse struct {
 	MasterMinter MasterMinter `protobuf:"bytes,1,opt,name=masterMinter,proto3" json:"masterMinter"`
 }
@@ -338,7 +525,7 @@ func (m *QueryGetMasterMinterResponse) Reset()         { *m = QueryGetMasterMint
 func (m *QueryGetMasterMinterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetMasterMinterResponse) ProtoMessage()    {}
 func (*QueryGetMasterMinterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{7}
+	return fileDescriptor_0e79ac8fb1676620, []int{9}
 }
 func (m *QueryGetMasterMinterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -376,13 +563,14 @@ func (m *QueryGetMasterMinterResponse) GetMasterMinter() MasterMinter {
 
 type QueryGetMintersRequest struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryGetMintersRequest) Reset()         { *m = QueryGetMintersRequest{} }
 func (m *QueryGetMintersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetMintersRequest) ProtoMessage()    {}
 func (*QueryGetMintersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{8}
+	return fileDescriptor_0e79ac8fb1676620, []int{10}
 }
 func (m *QueryGetMintersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -418,15 +606,25 @@ func (m *QueryGetMintersRequest) GetAddress() string {
 	return ""
 }
 
+func (m *QueryGetMintersRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type QueryGetMintersResponse struct {
 	Minters Minters `protobuf:"bytes,1,opt,name=minters,proto3" json:"minters"`
+	// windowRemaining is the amount the minter can still mint within its rate
+	// limit window at the current block. It is nil without a rate limit.
+	WindowRemaining *types.Coin `protobuf:"bytes,2,opt,name=windowRemaining,proto3" json:"windowRemaining,omitempty"`
 }
 
 func (m *QueryGetMintersResponse) Reset()         { *m = QueryGetMintersResponse{} }
 func (m *QueryGetMintersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetMintersResponse) ProtoMessage()    {}
 func (*QueryGetMintersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{9}
+	return fileDescriptor_0e79ac8fb1676620, []int{11}
 }
 func (m *QueryGetMintersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -462,15 +660,23 @@ func (m *QueryGetMintersResponse) GetMinters() Minters {
 	return Minters{}
 }
 
+func (m *QueryGetMintersResponse) GetWindowRemaining() *types.Coin {
+	if m != nil {
+		return m.WindowRemaining
+	}
+	return nil
+}
+
 type QueryAllMintersRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Denom      string             `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryAllMintersRequest) Reset()         { *m = QueryAllMintersRequest{} }
 func (m *QueryAllMintersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllMintersRequest) ProtoMessage()    {}
 func (*QueryAllMintersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{10}
+	return fileDescriptor_0e79ac8fb1676620, []int{12}
 }
 func (m *QueryAllMintersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -506,6 +712,13 @@ func (m *QueryAllMintersRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryAllMintersRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type QueryAllMintersResponse struct {
 	Minters    []Minters           `protobuf:"bytes,1,rep,name=minters,proto3" json:"minters"`
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -515,7 +728,7 @@ func (m *QueryAllMintersResponse) Reset()         { *m = QueryAllMintersResponse
 func (m *QueryAllMintersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllMintersResponse) ProtoMessage()    {}
 func (*QueryAllMintersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{11}
+	return fileDescriptor_0e79ac8fb1676620, []int{13}
 }
 func (m *QueryAllMintersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -559,13 +772,14 @@ func (m *QueryAllMintersResponse) GetPagination() *query.PageResponse {
 }
 
 type QueryGetPauserRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryGetPauserRequest) Reset()         { *m = QueryGetPauserRequest{} }
 func (m *QueryGetPauserRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetPauserRequest) ProtoMessage()    {}
 func (*QueryGetPauserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{12}
+	return fileDescriptor_0e79ac8fb1676620, []int{14}
 }
 func (m *QueryGetPauserRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -594,6 +808,13 @@ func (m *QueryGetPauserRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryGetPauserRequest proto.InternalMessageInfo
 
+func (m *QueryGetPauserRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type QueryGetPauserResponse struct {
 	Pauser Pauser `protobuf:"bytes,1,opt,name=pauser,proto3" json:"pauser"`
 }
@@ -602,7 +823,7 @@ func (m *QueryGetPauserResponse) Reset()         { *m = QueryGetPauserResponse{}
 func (m *QueryGetPauserResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetPauserResponse) ProtoMessage()    {}
 func (*QueryGetPauserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{13}
+	return fileDescriptor_0e79ac8fb1676620, []int{15}
 }
 func (m *QueryGetPauserResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,13 +860,14 @@ func (m *QueryGetPauserResponse) GetPauser() Pauser {
 }
 
 type QueryGetBlacklisterRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryGetBlacklisterRequest) Reset()         { *m = QueryGetBlacklisterRequest{} }
 func (m *QueryGetBlacklisterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetBlacklisterRequest) ProtoMessage()    {}
 func (*QueryGetBlacklisterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{14}
+	return fileDescriptor_0e79ac8fb1676620, []int{16}
 }
 func (m *QueryGetBlacklisterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -674,6 +896,13 @@ func (m *QueryGetBlacklisterRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryGetBlacklisterRequest proto.InternalMessageInfo
 
+func (m *QueryGetBlacklisterRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type QueryGetBlacklisterResponse struct {
 	Blacklister Blacklister `protobuf:"bytes,1,opt,name=blacklister,proto3" json:"blacklister"`
 }
@@ -682,7 +911,7 @@ func (m *QueryGetBlacklisterResponse) Reset()         { *m = QueryGetBlacklister
 func (m *QueryGetBlacklisterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetBlacklisterResponse) ProtoMessage()    {}
 func (*QueryGetBlacklisterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{15}
+	return fileDescriptor_0e79ac8fb1676620, []int{17}
 }
 func (m *QueryGetBlacklisterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -719,13 +948,14 @@ func (m *QueryGetBlacklisterResponse) GetBlacklister() Blacklister {
 }
 
 type QueryGetOwnerRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryGetOwnerRequest) Reset()         { *m = QueryGetOwnerRequest{} }
 func (m *QueryGetOwnerRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetOwnerRequest) ProtoMessage()    {}
 func (*QueryGetOwnerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{16}
+	return fileDescriptor_0e79ac8fb1676620, []int{18}
 }
 func (m *QueryGetOwnerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -754,6 +984,13 @@ func (m *QueryGetOwnerRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryGetOwnerRequest proto.InternalMessageInfo
 
+func (m *QueryGetOwnerRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type QueryGetOwnerResponse struct {
 	Owner Owner `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner"`
 }
@@ -762,7 +999,7 @@ func (m *QueryGetOwnerResponse) Reset()         { *m = QueryGetOwnerResponse{} }
 func (m *QueryGetOwnerResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetOwnerResponse) ProtoMessage()    {}
 func (*QueryGetOwnerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{17}
+	return fileDescriptor_0e79ac8fb1676620, []int{19}
 }
 func (m *QueryGetOwnerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -798,22 +1035,22 @@ func (m *QueryGetOwnerResponse) GetOwner() Owner {
 	return Owner{}
 }
 
-type QueryGetMinterControllerRequest struct {
-	ControllerAddress string `protobuf:"bytes,1,opt,name=controllerAddress,proto3" json:"controllerAddress,omitempty"`
+type QueryGetPendingOwnerRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryGetMinterControllerRequest) Reset()         { *m = QueryGetMinterControllerRequest{} }
-func (m *QueryGetMinterControllerRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetMinterControllerRequest) ProtoMessage()    {}
-func (*QueryGetMinterControllerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{18}
+func (m *QueryGetPendingOwnerRequest) Reset()         { *m = QueryGetPendingOwnerRequest{} }
+func (m *QueryGetPendingOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPendingOwnerRequest) ProtoMessage()    {}
+func (*QueryGetPendingOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{20}
 }
-func (m *QueryGetMinterControllerRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetPendingOwnerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetMinterControllerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetPendingOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetMinterControllerRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetPendingOwnerRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -823,41 +1060,41 @@ func (m *QueryGetMinterControllerRequest) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryGetMinterControllerRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetMinterControllerRequest.Merge(m, src)
+func (m *QueryGetPendingOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPendingOwnerRequest.Merge(m, src)
 }
-func (m *QueryGetMinterControllerRequest) XXX_Size() int {
+func (m *QueryGetPendingOwnerRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetMinterControllerRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetMinterControllerRequest.DiscardUnknown(m)
+func (m *QueryGetPendingOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPendingOwnerRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetMinterControllerRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetPendingOwnerRequest proto.InternalMessageInfo
 
-func (m *QueryGetMinterControllerRequest) GetControllerAddress() string {
+func (m *QueryGetPendingOwnerRequest) GetDenom() string {
 	if m != nil {
-		return m.ControllerAddress
+		return m.Denom
 	}
 	return ""
 }
 
-type QueryGetMinterControllerResponse struct {
-	MinterController MinterController `protobuf:"bytes,1,opt,name=minterController,proto3" json:"minterController"`
+type QueryGetPendingOwnerResponse struct {
+	PendingOwner Owner `protobuf:"bytes,1,opt,name=pendingOwner,proto3" json:"pendingOwner"`
 }
 
-func (m *QueryGetMinterControllerResponse) Reset()         { *m = QueryGetMinterControllerResponse{} }
-func (m *QueryGetMinterControllerResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetMinterControllerResponse) ProtoMessage()    {}
-func (*QueryGetMinterControllerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{19}
+func (m *QueryGetPendingOwnerResponse) Reset()         { *m = QueryGetPendingOwnerResponse{} }
+func (m *QueryGetPendingOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPendingOwnerResponse) ProtoMessage()    {}
+func (*QueryGetPendingOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{21}
 }
-func (m *QueryGetMinterControllerResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetPendingOwnerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetMinterControllerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetPendingOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetMinterControllerResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetPendingOwnerResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -867,41 +1104,42 @@ func (m *QueryGetMinterControllerResponse) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryGetMinterControllerResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetMinterControllerResponse.Merge(m, src)
+func (m *QueryGetPendingOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPendingOwnerResponse.Merge(m, src)
 }
-func (m *QueryGetMinterControllerResponse) XXX_Size() int {
+func (m *QueryGetPendingOwnerResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetMinterControllerResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetMinterControllerResponse.DiscardUnknown(m)
+func (m *QueryGetPendingOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPendingOwnerResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetMinterControllerResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetPendingOwnerResponse proto.InternalMessageInfo
 
-func (m *QueryGetMinterControllerResponse) GetMinterController() MinterController {
+func (m *QueryGetPendingOwnerResponse) GetPendingOwner() Owner {
 	if m != nil {
-		return m.MinterController
+		return m.PendingOwner
 	}
-	return MinterController{}
+	return Owner{}
 }
 
-type QueryAllMinterControllerRequest struct {
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryPendingRolesRequest struct {
+	Denom      string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllMinterControllerRequest) Reset()         { *m = QueryAllMinterControllerRequest{} }
-func (m *QueryAllMinterControllerRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllMinterControllerRequest) ProtoMessage()    {}
-func (*QueryAllMinterControllerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{20}
+func (m *QueryPendingRolesRequest) Reset()         { *m = QueryPendingRolesRequest{} }
+func (m *QueryPendingRolesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRolesRequest) ProtoMessage()    {}
+func (*QueryPendingRolesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{22}
 }
-func (m *QueryAllMinterControllerRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPendingRolesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllMinterControllerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPendingRolesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllMinterControllerRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPendingRolesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -911,42 +1149,49 @@ func (m *QueryAllMinterControllerRequest) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryAllMinterControllerRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllMinterControllerRequest.Merge(m, src)
+func (m *QueryPendingRolesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRolesRequest.Merge(m, src)
 }
-func (m *QueryAllMinterControllerRequest) XXX_Size() int {
+func (m *QueryPendingRolesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllMinterControllerRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllMinterControllerRequest.DiscardUnknown(m)
+func (m *QueryPendingRolesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRolesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllMinterControllerRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPendingRolesRequest proto.InternalMessageInfo
 
-func (m *QueryAllMinterControllerRequest) GetPagination() *query.PageRequest {
+func (m *QueryPendingRolesRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryPendingRolesRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryAllMinterControllerResponse struct {
-	MinterController []MinterController  `protobuf:"bytes,1,rep,name=minterController,proto3" json:"minterController"`
-	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryPendingRolesResponse struct {
+	PendingRoles []PendingRole       `protobuf:"bytes,1,rep,name=pendingRoles,proto3" json:"pendingRoles"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllMinterControllerResponse) Reset()         { *m = QueryAllMinterControllerResponse{} }
-func (m *QueryAllMinterControllerResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllMinterControllerResponse) ProtoMessage()    {}
-func (*QueryAllMinterControllerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{21}
+func (m *QueryPendingRolesResponse) Reset()         { *m = QueryPendingRolesResponse{} }
+func (m *QueryPendingRolesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRolesResponse) ProtoMessage()    {}
+func (*QueryPendingRolesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{23}
 }
-func (m *QueryAllMinterControllerResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPendingRolesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllMinterControllerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPendingRolesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllMinterControllerResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPendingRolesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -956,40 +1201,241 @@ func (m *QueryAllMinterControllerResponse) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryAllMinterControllerResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllMinterControllerResponse.Merge(m, src)
+func (m *QueryPendingRolesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRolesResponse.Merge(m, src)
 }
-func (m *QueryAllMinterControllerResponse) XXX_Size() int {
+func (m *QueryPendingRolesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllMinterControllerResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllMinterControllerResponse.DiscardUnknown(m)
+func (m *QueryPendingRolesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRolesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllMinterControllerResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPendingRolesResponse proto.InternalMessageInfo
 
-func (m *QueryAllMinterControllerResponse) GetMinterController() []MinterController {
+func (m *QueryPendingRolesResponse) GetPendingRoles() []PendingRole {
 	if m != nil {
-		return m.MinterController
+		return m.PendingRoles
 	}
 	return nil
 }
 
-func (m *QueryAllMinterControllerResponse) GetPagination() *query.PageResponse {
+func (m *QueryPendingRolesResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryGetMintingDenomRequest struct {
-}
+type QueryGetMinterControllerRequest struct {
+	ControllerAddress string `protobuf:"bytes,1,opt,name=controllerAddress,proto3" json:"controllerAddress,omitempty"`
+	Denom             string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryGetMinterControllerRequest) Reset()         { *m = QueryGetMinterControllerRequest{} }
+func (m *QueryGetMinterControllerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMinterControllerRequest) ProtoMessage()    {}
+func (*QueryGetMinterControllerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{24}
+}
+func (m *QueryGetMinterControllerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMinterControllerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMinterControllerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetMinterControllerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMinterControllerRequest.Merge(m, src)
+}
+func (m *QueryGetMinterControllerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMinterControllerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMinterControllerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetMinterControllerRequest proto.InternalMessageInfo
+
+func (m *QueryGetMinterControllerRequest) GetControllerAddress() string {
+	if m != nil {
+		return m.ControllerAddress
+	}
+	return ""
+}
+
+func (m *QueryGetMinterControllerRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type QueryGetMinterControllerResponse struct {
+	MinterController MinterController `protobuf:"bytes,1,opt,name=minterController,proto3" json:"minterController"`
+}
+
+func (m *QueryGetMinterControllerResponse) Reset()         { *m = QueryGetMinterControllerResponse{} }
+func (m *QueryGetMinterControllerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMinterControllerResponse) ProtoMessage()    {}
+func (*QueryGetMinterControllerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{25}
+}
+func (m *QueryGetMinterControllerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMinterControllerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMinterControllerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetMinterControllerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMinterControllerResponse.Merge(m, src)
+}
+func (m *QueryGetMinterControllerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMinterControllerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMinterControllerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetMinterControllerResponse proto.InternalMessageInfo
+
+func (m *QueryGetMinterControllerResponse) GetMinterController() MinterController {
+	if m != nil {
+		return m.MinterController
+	}
+	return MinterController{}
+}
+
+type QueryAllMinterControllerRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Denom      string             `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryAllMinterControllerRequest) Reset()         { *m = QueryAllMinterControllerRequest{} }
+func (m *QueryAllMinterControllerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMinterControllerRequest) ProtoMessage()    {}
+func (*QueryAllMinterControllerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{26}
+}
+func (m *QueryAllMinterControllerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllMinterControllerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllMinterControllerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllMinterControllerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMinterControllerRequest.Merge(m, src)
+}
+func (m *QueryAllMinterControllerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllMinterControllerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMinterControllerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllMinterControllerRequest proto.InternalMessageInfo
+
+func (m *QueryAllMinterControllerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryAllMinterControllerRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type QueryAllMinterControllerResponse struct {
+	MinterController []MinterController  `protobuf:"bytes,1,rep,name=minterController,proto3" json:"minterController"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllMinterControllerResponse) Reset()         { *m = QueryAllMinterControllerResponse{} }
+func (m *QueryAllMinterControllerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMinterControllerResponse) ProtoMessage()    {}
+func (*QueryAllMinterControllerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{27}
+}
+func (m *QueryAllMinterControllerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllMinterControllerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllMinterControllerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllMinterControllerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMinterControllerResponse.Merge(m, src)
+}
+func (m *QueryAllMinterControllerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllMinterControllerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMinterControllerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllMinterControllerResponse proto.InternalMessageInfo
+
+func (m *QueryAllMinterControllerResponse) GetMinterController() []MinterController {
+	if m != nil {
+		return m.MinterController
+	}
+	return nil
+}
+
+func (m *QueryAllMinterControllerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetMintingDenomRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
 
 func (m *QueryGetMintingDenomRequest) Reset()         { *m = QueryGetMintingDenomRequest{} }
 func (m *QueryGetMintingDenomRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetMintingDenomRequest) ProtoMessage()    {}
 func (*QueryGetMintingDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{22}
+	return fileDescriptor_0e79ac8fb1676620, []int{28}
 }
 func (m *QueryGetMintingDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1018,6 +1464,13 @@ func (m *QueryGetMintingDenomRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryGetMintingDenomRequest proto.InternalMessageInfo
 
+func (m *QueryGetMintingDenomRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type QueryGetMintingDenomResponse struct {
 	MintingDenom MintingDenom `protobuf:"bytes,1,opt,name=mintingDenom,proto3" json:"mintingDenom"`
 }
@@ -1026,7 +1479,7 @@ func (m *QueryGetMintingDenomResponse) Reset()         { *m = QueryGetMintingDen
 func (m *QueryGetMintingDenomResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetMintingDenomResponse) ProtoMessage()    {}
 func (*QueryGetMintingDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e79ac8fb1676620, []int{23}
+	return fileDescriptor_0e79ac8fb1676620, []int{29}
 }
 func (m *QueryGetMintingDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1062,1692 +1515,10415 @@ func (m *QueryGetMintingDenomResponse) GetMintingDenom() MintingDenom {
 	return MintingDenom{}
 }
 
-func init() {
-	proto.RegisterType((*QueryGetBlacklistedRequest)(nil), "circle.fiattokenfactory.v1.QueryGetBlacklistedRequest")
-	proto.RegisterType((*QueryGetBlacklistedResponse)(nil), "circle.fiattokenfactory.v1.QueryGetBlacklistedResponse")
-	proto.RegisterType((*QueryAllBlacklistedRequest)(nil), "circle.fiattokenfactory.v1.QueryAllBlacklistedRequest")
-	proto.RegisterType((*QueryAllBlacklistedResponse)(nil), "circle.fiattokenfactory.v1.QueryAllBlacklistedResponse")
-	proto.RegisterType((*QueryGetPausedRequest)(nil), "circle.fiattokenfactory.v1.QueryGetPausedRequest")
-	proto.RegisterType((*QueryGetPausedResponse)(nil), "circle.fiattokenfactory.v1.QueryGetPausedResponse")
-	proto.RegisterType((*QueryGetMasterMinterRequest)(nil), "circle.fiattokenfactory.v1.QueryGetMasterMinterRequest")
-	proto.RegisterType((*QueryGetMasterMinterResponse)(nil), "circle.fiattokenfactory.v1.QueryGetMasterMinterResponse")
-	proto.RegisterType((*QueryGetMintersRequest)(nil), "circle.fiattokenfactory.v1.QueryGetMintersRequest")
-	proto.RegisterType((*QueryGetMintersResponse)(nil), "circle.fiattokenfactory.v1.QueryGetMintersResponse")
-	proto.RegisterType((*QueryAllMintersRequest)(nil), "circle.fiattokenfactory.v1.QueryAllMintersRequest")
-	proto.RegisterType((*QueryAllMintersResponse)(nil), "circle.fiattokenfactory.v1.QueryAllMintersResponse")
-	proto.RegisterType((*QueryGetPauserRequest)(nil), "circle.fiattokenfactory.v1.QueryGetPauserRequest")
-	proto.RegisterType((*QueryGetPauserResponse)(nil), "circle.fiattokenfactory.v1.QueryGetPauserResponse")
-	proto.RegisterType((*QueryGetBlacklisterRequest)(nil), "circle.fiattokenfactory.v1.QueryGetBlacklisterRequest")
-	proto.RegisterType((*QueryGetBlacklisterResponse)(nil), "circle.fiattokenfactory.v1.QueryGetBlacklisterResponse")
-	proto.RegisterType((*QueryGetOwnerRequest)(nil), "circle.fiattokenfactory.v1.QueryGetOwnerRequest")
-	proto.RegisterType((*QueryGetOwnerResponse)(nil), "circle.fiattokenfactory.v1.QueryGetOwnerResponse")
-	proto.RegisterType((*QueryGetMinterControllerRequest)(nil), "circle.fiattokenfactory.v1.QueryGetMinterControllerRequest")
-	proto.RegisterType((*QueryGetMinterControllerResponse)(nil), "circle.fiattokenfactory.v1.QueryGetMinterControllerResponse")
-	proto.RegisterType((*QueryAllMinterControllerRequest)(nil), "circle.fiattokenfactory.v1.QueryAllMinterControllerRequest")
-	proto.RegisterType((*QueryAllMinterControllerResponse)(nil), "circle.fiattokenfactory.v1.QueryAllMinterControllerResponse")
-	proto.RegisterType((*QueryGetMintingDenomRequest)(nil), "circle.fiattokenfactory.v1.QueryGetMintingDenomRequest")
-	proto.RegisterType((*QueryGetMintingDenomResponse)(nil), "circle.fiattokenfactory.v1.QueryGetMintingDenomResponse")
+type QueryAllMintingDenomRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("circle/fiattokenfactory/v1/query.proto", fileDescriptor_0e79ac8fb1676620)
+func (m *QueryAllMintingDenomRequest) Reset()         { *m = QueryAllMintingDenomRequest{} }
+func (m *QueryAllMintingDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMintingDenomRequest) ProtoMessage()    {}
+func (*QueryAllMintingDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{30}
 }
-
-var fileDescriptor_0e79ac8fb1676620 = []byte{
-	// 1083 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6f, 0xdc, 0x44,
-	0x14, 0xcf, 0x34, 0x24, 0x11, 0x93, 0x0a, 0x85, 0xa1, 0xb4, 0x95, 0x49, 0x37, 0xe9, 0x54, 0xf9,
-	0x03, 0x4d, 0x6c, 0x76, 0x2b, 0x0a, 0x52, 0xa9, 0x20, 0x69, 0xa1, 0xa7, 0x2a, 0x65, 0x0f, 0x1c,
-	0x7a, 0x48, 0xf0, 0xee, 0x3a, 0xc6, 0xaa, 0xd7, 0xb3, 0x1d, 0x3b, 0x81, 0xa8, 0xea, 0xa5, 0x9f,
-	0x00, 0xa9, 0x07, 0x6e, 0xdc, 0x10, 0x07, 0x24, 0xc4, 0x01, 0xc4, 0x07, 0xe0, 0x52, 0x89, 0x4b,
-	0x24, 0x38, 0x70, 0x42, 0x28, 0xe1, 0x83, 0x20, 0xcf, 0x3c, 0xaf, 0xc7, 0xeb, 0x3f, 0x3b, 0x5e,
-	0xc2, 0x6d, 0x33, 0x7e, 0xbf, 0xf7, 0x7e, 0xef, 0xf9, 0x37, 0xef, 0xbd, 0x18, 0xaf, 0x76, 0x3d,
-	0xde, 0xf5, 0x1d, 0x6b, 0xdf, 0xb3, 0xa3, 0x88, 0x3d, 0x72, 0x82, 0x7d, 0xbb, 0x1b, 0x31, 0x7e,
-	0x64, 0x1d, 0x36, 0xad, 0xc7, 0x07, 0x0e, 0x3f, 0x32, 0x07, 0x9c, 0x45, 0x8c, 0x18, 0xd2, 0xce,
-	0x1c, 0xb5, 0x33, 0x0f, 0x9b, 0xc6, 0x46, 0x85, 0x8f, 0x8e, 0x6f, 0x77, 0x1f, 0xf9, 0x5e, 0x18,
-	0x39, 0x3d, 0xe9, 0x49, 0xd3, 0x9a, 0x83, 0xb5, 0x59, 0x61, 0xdd, 0xb7, 0x63, 0xc3, 0xbd, 0xbe,
-	0x17, 0xa4, 0xf6, 0xad, 0x2a, 0x7b, 0x61, 0xb8, 0xd7, 0x65, 0x41, 0xc4, 0x99, 0xef, 0x0f, 0x31,
-	0xeb, 0x63, 0x31, 0xa1, 0x0e, 0x1b, 0x2f, 0x88, 0xbc, 0xc0, 0xdd, 0xeb, 0x39, 0x01, 0xeb, 0x83,
-	0x7d, 0x55, 0x75, 0xd9, 0x17, 0xc1, 0x90, 0xc1, 0x5a, 0x85, 0xdd, 0xc0, 0x3e, 0x08, 0x87, 0xc5,
-	0x1b, 0x6b, 0x98, 0x78, 0x7c, 0xab, 0xcb, 0xc2, 0x3e, 0x0b, 0xad, 0x8e, 0x1d, 0x3a, 0xf2, 0x45,
-	0x5a, 0x87, 0xcd, 0x8e, 0x13, 0xd9, 0xb1, 0x9d, 0xeb, 0x05, 0x76, 0xe4, 0xb1, 0x00, 0x6c, 0x2f,
-	0xb8, 0xcc, 0x65, 0xe2, 0xa7, 0x15, 0xff, 0x82, 0xd3, 0x45, 0x97, 0x31, 0xd7, 0x77, 0x2c, 0x7b,
-	0xe0, 0x59, 0x76, 0x10, 0xb0, 0x48, 0x40, 0xa0, 0x12, 0xf4, 0x26, 0x36, 0x3e, 0x89, 0xbd, 0xde,
-	0x73, 0xa2, 0xed, 0xf4, 0x15, 0xb7, 0x9d, 0xc7, 0x07, 0x4e, 0x18, 0x91, 0xcb, 0x78, 0xce, 0xee,
-	0xf5, 0xb8, 0x13, 0x86, 0x97, 0xd1, 0x32, 0x5a, 0x7f, 0xb9, 0x9d, 0xfc, 0x49, 0x03, 0xfc, 0x46,
-	0x21, 0x2e, 0x1c, 0xb0, 0x20, 0x74, 0xc8, 0x0e, 0x9e, 0x57, 0x14, 0x23, 0xc0, 0xf3, 0xad, 0x35,
-	0xb3, 0x5c, 0x7c, 0xa6, 0xe2, 0x65, 0xfb, 0xa5, 0x17, 0x7f, 0x2d, 0x4d, 0xb5, 0x55, 0x0f, 0xb4,
-	0x07, 0x3c, 0xb7, 0x7c, 0xbf, 0x80, 0xe7, 0xc7, 0x18, 0xa7, 0xd5, 0x80, 0x68, 0xab, 0xa6, 0x2c,
-	0x9d, 0x19, 0x97, 0xce, 0x94, 0x77, 0x00, 0x4a, 0x67, 0x3e, 0xb0, 0x5d, 0x07, 0xb0, 0x6d, 0x05,
-	0x49, 0x7f, 0x41, 0x90, 0xd6, 0x68, 0x98, 0xb2, 0xb4, 0xa6, 0xff, 0x5b, 0x5a, 0xe4, 0x5e, 0x86,
-	0xf8, 0xb9, 0xa4, 0x4c, 0x63, 0x88, 0x4b, 0x36, 0x19, 0xe6, 0x97, 0xf0, 0xeb, 0xc9, 0xfb, 0x78,
-	0x20, 0x84, 0x06, 0xe9, 0xd1, 0x87, 0xf8, 0xe2, 0xe8, 0x03, 0x48, 0xe6, 0x43, 0x3c, 0x2b, 0x35,
-	0x09, 0x05, 0xa3, 0x55, 0x79, 0x48, 0x2c, 0xa4, 0x00, 0x38, 0x7a, 0x25, 0x15, 0xc1, 0x7d, 0x71,
-	0x87, 0xef, 0x8b, 0x5b, 0x96, 0x84, 0xe6, 0x78, 0xb1, 0xf8, 0x31, 0x10, 0x68, 0xe3, 0xf3, 0x7d,
-	0xe5, 0x1c, 0x68, 0xac, 0x57, 0xd1, 0x50, 0xfd, 0x00, 0x99, 0x8c, 0x0f, 0xda, 0x4a, 0xd3, 0x95,
-	0x27, 0xe1, 0x78, 0x2d, 0xef, 0xe2, 0x4b, 0x39, 0x0c, 0x50, 0xbc, 0x83, 0xe7, 0xa0, 0x73, 0x00,
-	0xbb, 0x6b, 0x95, 0xec, 0xa4, 0x29, 0x10, 0x4b, 0x90, 0xf4, 0x33, 0xe0, 0xb4, 0xe5, 0xfb, 0x23,
-	0x9c, 0xce, 0x4a, 0xb7, 0xdf, 0x21, 0x48, 0x41, 0x0d, 0x51, 0x94, 0xc2, 0xf4, 0x64, 0x29, 0xfc,
-	0x7f, 0x3a, 0xe5, 0x65, 0x3a, 0xe5, 0x39, 0x9d, 0x72, 0x6d, 0x9d, 0xf2, 0x8c, 0x4e, 0x39, 0x5d,
-	0x2c, 0x6a, 0x72, 0xc3, 0xc8, 0x85, 0xad, 0x8c, 0x17, 0xdf, 0x79, 0x5e, 0xaf, 0x95, 0xf1, 0xfc,
-	0x9d, 0xe7, 0xf4, 0x22, 0xbe, 0x90, 0xc4, 0xdb, 0x89, 0x67, 0x47, 0xc2, 0xe3, 0xd3, 0xb4, 0x34,
-	0x70, 0x0e, 0x0c, 0x6e, 0xe3, 0x19, 0x31, 0x64, 0x20, 0xf6, 0xd5, 0xaa, 0xd8, 0x02, 0x09, 0x51,
-	0x25, 0x8a, 0xee, 0xe0, 0xa5, 0xac, 0xbc, 0xef, 0x0c, 0x07, 0x67, 0xa2, 0xc3, 0x0d, 0xfc, 0x6a,
-	0x3a, 0x4d, 0xb7, 0x32, 0xb7, 0x24, 0xff, 0x80, 0x3e, 0x43, 0x78, 0xb9, 0xdc, 0x23, 0x90, 0xde,
-	0xc5, 0x0b, 0xfd, 0x91, 0x67, 0xc0, 0x7f, 0x63, 0xbc, 0xfe, 0x52, 0x0c, 0xa4, 0x92, 0xf3, 0x45,
-	0x3d, 0xc8, 0x6a, 0xa8, 0xf8, 0x7c, 0x56, 0x67, 0x75, 0xbb, 0x7e, 0x4b, 0xf2, 0x2d, 0x8c, 0x55,
-	0x99, 0xef, 0xf4, 0x59, 0xe5, 0x7b, 0x76, 0x37, 0x50, 0x6d, 0xda, 0x72, 0xd5, 0xb9, 0x1b, 0x6f,
-	0x3a, 0x45, 0x4d, 0x3b, 0xf3, 0x58, 0x69, 0xda, 0xca, 0xb9, 0x56, 0xd3, 0x56, 0xec, 0x87, 0x4d,
-	0x5b, 0x39, 0x6b, 0xfd, 0xb4, 0x80, 0x67, 0x44, 0x50, 0xf2, 0x33, 0xc2, 0xf3, 0xca, 0xc8, 0x24,
-	0x37, 0xab, 0xfc, 0x96, 0x2f, 0x2e, 0xc6, 0xbb, 0xb5, 0x71, 0x32, 0x3d, 0xfa, 0xce, 0xb3, 0xdf,
-	0xff, 0x79, 0x7e, 0xce, 0x22, 0x9b, 0x56, 0xc0, 0x3a, 0x45, 0x0b, 0x9a, 0x32, 0xbd, 0xad, 0x27,
-	0x30, 0x41, 0x9e, 0x92, 0x1f, 0x10, 0x7e, 0x45, 0x71, 0xb7, 0xe5, 0xfb, 0x1a, 0xd4, 0x0b, 0x77,
-	0x19, 0x0d, 0xea, 0xc5, 0xcb, 0x09, 0xbd, 0x2e, 0xa8, 0xaf, 0x90, 0x6b, 0x1a, 0xd4, 0xc9, 0xd7,
-	0x08, 0xcf, 0xca, 0x99, 0x4e, 0x9a, 0x3a, 0xb5, 0xca, 0x2c, 0x15, 0x46, 0xab, 0x0e, 0x04, 0xe8,
-	0xad, 0x0a, 0x7a, 0xcb, 0xa4, 0x51, 0x46, 0x4f, 0x2e, 0x15, 0xe4, 0x47, 0x84, 0xcf, 0xab, 0x63,
-	0x9e, 0x68, 0xbd, 0xcb, 0x82, 0xfd, 0xc3, 0x78, 0xaf, 0x3e, 0x10, 0xb8, 0x6e, 0x0a, 0xae, 0x6b,
-	0x64, 0xa5, 0x8c, 0x6b, 0xe6, 0x5f, 0x16, 0xf2, 0x2d, 0xc2, 0x73, 0x30, 0x38, 0x89, 0x56, 0x69,
-	0xb2, 0x6b, 0x80, 0x71, 0xa3, 0x16, 0x06, 0x38, 0x36, 0x05, 0xc7, 0xeb, 0xe4, 0xcd, 0x52, 0x8e,
-	0x12, 0xa0, 0xa8, 0xf4, 0x1b, 0x84, 0x31, 0xb8, 0x89, 0x15, 0xda, 0xd2, 0x51, 0x5a, 0x6d, 0xaa,
-	0xf9, 0x15, 0x84, 0xae, 0x09, 0xaa, 0x57, 0xc9, 0xd2, 0x18, 0xaa, 0xa9, 0x2a, 0x79, 0x0d, 0x55,
-	0xf2, 0xfa, 0xaa, 0xe4, 0x35, 0x55, 0xc9, 0xc9, 0xf7, 0x99, 0xc6, 0xc4, 0xeb, 0x36, 0x26, 0x3e,
-	0x61, 0x63, 0xe2, 0x93, 0xdc, 0x6e, 0x4e, 0x9e, 0x23, 0x3c, 0x23, 0x36, 0x01, 0xf2, 0xb6, 0x4e,
-	0x3c, 0x75, 0x0d, 0x31, 0x9a, 0x35, 0x10, 0xc0, 0x6d, 0x45, 0x70, 0x5b, 0x22, 0x57, 0xca, 0xb8,
-	0x89, 0x45, 0x84, 0xfc, 0x81, 0xf0, 0xc2, 0xe8, 0xbc, 0x23, 0xb7, 0xf4, 0xb5, 0x9f, 0x9b, 0xf0,
-	0xc6, 0xfb, 0x93, 0x81, 0x81, 0xf6, 0x47, 0x82, 0xf6, 0x07, 0xe4, 0x76, 0xb5, 0x2c, 0x95, 0x0f,
-	0x0d, 0xd6, 0x93, 0xdc, 0x36, 0xf4, 0x94, 0xfc, 0x8a, 0xf0, 0x6b, 0xa3, 0x31, 0xe2, 0xeb, 0x75,
-	0x4b, 0xff, 0xaa, 0x4c, 0x92, 0x59, 0xc5, 0x32, 0xa2, 0xdb, 0x1b, 0x94, 0xcc, 0x64, 0xdb, 0x55,
-	0x86, 0xb2, 0x66, 0xdb, 0xcd, 0x6f, 0x10, 0x9a, 0x6d, 0xb7, 0x60, 0xb7, 0xd0, 0x68, 0xbb, 0xea,
-	0xb7, 0x99, 0xed, 0xdd, 0x17, 0x27, 0x0d, 0x74, 0x7c, 0xd2, 0x40, 0x7f, 0x9f, 0x34, 0xd0, 0x57,
-	0xa7, 0x8d, 0xa9, 0xe3, 0xd3, 0xc6, 0xd4, 0x9f, 0xa7, 0x8d, 0xa9, 0x87, 0x77, 0x5d, 0x2f, 0xfa,
-	0xfc, 0xa0, 0x63, 0x76, 0x59, 0xdf, 0x92, 0x64, 0xf6, 0xbd, 0x40, 0x3a, 0xdd, 0xcc, 0x39, 0xfd,
-	0x32, 0x1f, 0x27, 0x3a, 0x1a, 0x38, 0x61, 0x67, 0x56, 0x7c, 0x22, 0xb9, 0xf1, 0x6f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xfe, 0x19, 0x02, 0xd5, 0x5c, 0x13, 0x00, 0x00,
+func (m *QueryAllMintingDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllMintingDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllMintingDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllMintingDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMintingDenomRequest.Merge(m, src)
+}
+func (m *QueryAllMintingDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllMintingDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMintingDenomRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryAllMintingDenomRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryAllMintingDenomRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Queries a Blacklisted by index.
-	Blacklisted(ctx context.Context, in *QueryGetBlacklistedRequest, opts ...grpc.CallOption) (*QueryGetBlacklistedResponse, error)
-	// Queries a list of Blacklisted items.
-	BlacklistedAll(ctx context.Context, in *QueryAllBlacklistedRequest, opts ...grpc.CallOption) (*QueryAllBlacklistedResponse, error)
-	// Queries a Paused by index.
-	Paused(ctx context.Context, in *QueryGetPausedRequest, opts ...grpc.CallOption) (*QueryGetPausedResponse, error)
-	// Queries a MasterMinter by index.
-	MasterMinter(ctx context.Context, in *QueryGetMasterMinterRequest, opts ...grpc.CallOption) (*QueryGetMasterMinterResponse, error)
-	// Queries a Minters by index.
-	Minters(ctx context.Context, in *QueryGetMintersRequest, opts ...grpc.CallOption) (*QueryGetMintersResponse, error)
-	// Queries a list of Minters items.
-	MintersAll(ctx context.Context, in *QueryAllMintersRequest, opts ...grpc.CallOption) (*QueryAllMintersResponse, error)
-	// Queries a Pauser by index.
-	Pauser(ctx context.Context, in *QueryGetPauserRequest, opts ...grpc.CallOption) (*QueryGetPauserResponse, error)
-	// Queries a Blacklister by index.
-	Blacklister(ctx context.Context, in *QueryGetBlacklisterRequest, opts ...grpc.CallOption) (*QueryGetBlacklisterResponse, error)
-	// Queries a Owner by index.
-	Owner(ctx context.Context, in *QueryGetOwnerRequest, opts ...grpc.CallOption) (*QueryGetOwnerResponse, error)
-	// Queries a MinterController by index.
-	MinterController(ctx context.Context, in *QueryGetMinterControllerRequest, opts ...grpc.CallOption) (*QueryGetMinterControllerResponse, error)
-	// Queries a list of MinterController items.
-	MinterControllerAll(ctx context.Context, in *QueryAllMinterControllerRequest, opts ...grpc.CallOption) (*QueryAllMinterControllerResponse, error)
-	// Queries a MintingDenom by index.
-	MintingDenom(ctx context.Context, in *QueryGetMintingDenomRequest, opts ...grpc.CallOption) (*QueryGetMintingDenomResponse, error)
+type QueryAllMintingDenomResponse struct {
+	MintingDenom []MintingDenom      `protobuf:"bytes,1,rep,name=mintingDenom,proto3" json:"mintingDenom"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryAllMintingDenomResponse) Reset()         { *m = QueryAllMintingDenomResponse{} }
+func (m *QueryAllMintingDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMintingDenomResponse) ProtoMessage()    {}
+func (*QueryAllMintingDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{31}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryAllMintingDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Blacklisted(ctx context.Context, in *QueryGetBlacklistedRequest, opts ...grpc.CallOption) (*QueryGetBlacklistedResponse, error) {
-	out := new(QueryGetBlacklistedResponse)
-	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/Blacklisted", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllMintingDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllMintingDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllMintingDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMintingDenomResponse.Merge(m, src)
+}
+func (m *QueryAllMintingDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllMintingDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMintingDenomResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) BlacklistedAll(ctx context.Context, in *QueryAllBlacklistedRequest, opts ...grpc.CallOption) (*QueryAllBlacklistedResponse, error) {
-	out := new(QueryAllBlacklistedResponse)
-	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/BlacklistedAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllMintingDenomResponse proto.InternalMessageInfo
+
+func (m *QueryAllMintingDenomResponse) GetMintingDenom() []MintingDenom {
+	if m != nil {
+		return m.MintingDenom
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Paused(ctx context.Context, in *QueryGetPausedRequest, opts ...grpc.CallOption) (*QueryGetPausedResponse, error) {
-	out := new(QueryGetPausedResponse)
-	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/Paused", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllMintingDenomResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) MasterMinter(ctx context.Context, in *QueryGetMasterMinterRequest, opts ...grpc.CallOption) (*QueryGetMasterMinterResponse, error) {
-	out := new(QueryGetMasterMinterResponse)
-	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/MasterMinter", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryParamsRequest struct {
 }
 
-func (c *queryClient) Minters(ctx context.Context, in *QueryGetMintersRequest, opts ...grpc.CallOption) (*QueryGetMintersResponse, error) {
-	out := new(QueryGetMintersResponse)
-	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/Minters", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{32}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) MintersAll(ctx context.Context, in *QueryAllMintersRequest, opts ...grpc.CallOption) (*QueryAllMintersResponse, error) {
-	out := new(QueryAllMintersResponse)
-	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/MintersAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{33}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Pauser(ctx context.Context, in *QueryGetPauserRequest, opts ...grpc.CallOption) (*QueryGetPauserResponse, error) {
-	out := new(QueryGetPauserResponse)
-	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/Pauser", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return out, nil
+	return Params{}
 }
 
-func (c *queryClient) Blacklister(ctx context.Context, in *QueryGetBlacklisterRequest, opts ...grpc.CallOption) (*QueryGetBlacklisterResponse, error) {
-	out := new(QueryGetBlacklisterResponse)
-	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/Blacklister", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryAuditLogRequest filters the audit log. Empty filters match all entries,
+// and a zero height bound leaves that side of the range open. A page holds at
+// most 1000 entries.
+type QueryAuditLogRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Denom      string             `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Role       string             `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Action     string             `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	MinHeight  int64              `protobuf:"varint,5,opt,name=minHeight,proto3" json:"minHeight,omitempty"`
+	MaxHeight  int64              `protobuf:"varint,6,opt,name=maxHeight,proto3" json:"maxHeight,omitempty"`
+}
+
+func (m *QueryAuditLogRequest) Reset()         { *m = QueryAuditLogRequest{} }
+func (m *QueryAuditLogRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuditLogRequest) ProtoMessage()    {}
+func (*QueryAuditLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{34}
+}
+func (m *QueryAuditLogRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuditLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuditLogRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAuditLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuditLogRequest.Merge(m, src)
+}
+func (m *QueryAuditLogRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuditLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuditLogRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Owner(ctx context.Context, in *QueryGetOwnerRequest, opts ...grpc.CallOption) (*QueryGetOwnerResponse, error) {
-	out := new(QueryGetOwnerResponse)
-	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/Owner", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAuditLogRequest proto.InternalMessageInfo
+
+func (m *QueryAuditLogRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) MinterController(ctx context.Context, in *QueryGetMinterControllerRequest, opts ...grpc.CallOption) (*QueryGetMinterControllerResponse, error) {
-	out := new(QueryGetMinterControllerResponse)
-	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/MinterController", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAuditLogRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) MinterControllerAll(ctx context.Context, in *QueryAllMinterControllerRequest, opts ...grpc.CallOption) (*QueryAllMinterControllerResponse, error) {
-	out := new(QueryAllMinterControllerResponse)
-	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/MinterControllerAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAuditLogRequest) GetRole() string {
+	if m != nil {
+		return m.Role
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) MintingDenom(ctx context.Context, in *QueryGetMintingDenomRequest, opts ...grpc.CallOption) (*QueryGetMintingDenomResponse, error) {
-	out := new(QueryGetMintingDenomResponse)
-	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/MintingDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAuditLogRequest) GetAction() string {
+	if m != nil {
+		return m.Action
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Queries a Blacklisted by index.
-	Blacklisted(context.Context, *QueryGetBlacklistedRequest) (*QueryGetBlacklistedResponse, error)
-	// Queries a list of Blacklisted items.
-	BlacklistedAll(context.Context, *QueryAllBlacklistedRequest) (*QueryAllBlacklistedResponse, error)
-	// Queries a Paused by index.
-	Paused(context.Context, *QueryGetPausedRequest) (*QueryGetPausedResponse, error)
-	// Queries a MasterMinter by index.
-	MasterMinter(context.Context, *QueryGetMasterMinterRequest) (*QueryGetMasterMinterResponse, error)
-	// Queries a Minters by index.
-	Minters(context.Context, *QueryGetMintersRequest) (*QueryGetMintersResponse, error)
-	// Queries a list of Minters items.
-	MintersAll(context.Context, *QueryAllMintersRequest) (*QueryAllMintersResponse, error)
-	// Queries a Pauser by index.
-	Pauser(context.Context, *QueryGetPauserRequest) (*QueryGetPauserResponse, error)
-	// Queries a Blacklister by index.
-	Blacklister(context.Context, *QueryGetBlacklisterRequest) (*QueryGetBlacklisterResponse, error)
-	// Queries a Owner by index.
-	Owner(context.Context, *QueryGetOwnerRequest) (*QueryGetOwnerResponse, error)
-	// Queries a MinterController by index.
-	MinterController(context.Context, *QueryGetMinterControllerRequest) (*QueryGetMinterControllerResponse, error)
-	// Queries a list of MinterController items.
-	MinterControllerAll(context.Context, *QueryAllMinterControllerRequest) (*QueryAllMinterControllerResponse, error)
-	// Queries a MintingDenom by index.
-	MintingDenom(context.Context, *QueryGetMintingDenomRequest) (*QueryGetMintingDenomResponse, error)
+func (m *QueryAuditLogRequest) GetMinHeight() int64 {
+	if m != nil {
+		return m.MinHeight
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryAuditLogRequest) GetMaxHeight() int64 {
+	if m != nil {
+		return m.MaxHeight
+	}
+	return 0
 }
 
-func (*UnimplementedQueryServer) Blacklisted(ctx context.Context, req *QueryGetBlacklistedRequest) (*QueryGetBlacklistedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Blacklisted not implemented")
+type QueryAuditLogResponse struct {
+	Entries    []AuditEntry        `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) BlacklistedAll(ctx context.Context, req *QueryAllBlacklistedRequest) (*QueryAllBlacklistedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BlacklistedAll not implemented")
+
+func (m *QueryAuditLogResponse) Reset()         { *m = QueryAuditLogResponse{} }
+func (m *QueryAuditLogResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuditLogResponse) ProtoMessage()    {}
+func (*QueryAuditLogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{35}
 }
-func (*UnimplementedQueryServer) Paused(ctx context.Context, req *QueryGetPausedRequest) (*QueryGetPausedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Paused not implemented")
+func (m *QueryAuditLogResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) MasterMinter(ctx context.Context, req *QueryGetMasterMinterRequest) (*QueryGetMasterMinterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MasterMinter not implemented")
+func (m *QueryAuditLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuditLogResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Minters(ctx context.Context, req *QueryGetMintersRequest) (*QueryGetMintersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Minters not implemented")
+func (m *QueryAuditLogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuditLogResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) MintersAll(ctx context.Context, req *QueryAllMintersRequest) (*QueryAllMintersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MintersAll not implemented")
+func (m *QueryAuditLogResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Pauser(ctx context.Context, req *QueryGetPauserRequest) (*QueryGetPauserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pauser not implemented")
+func (m *QueryAuditLogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuditLogResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) Blacklister(ctx context.Context, req *QueryGetBlacklisterRequest) (*QueryGetBlacklisterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Blacklister not implemented")
+
+var xxx_messageInfo_QueryAuditLogResponse proto.InternalMessageInfo
+
+func (m *QueryAuditLogResponse) GetEntries() []AuditEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) Owner(ctx context.Context, req *QueryGetOwnerRequest) (*QueryGetOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Owner not implemented")
+
+func (m *QueryAuditLogResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) MinterController(ctx context.Context, req *QueryGetMinterControllerRequest) (*QueryGetMinterControllerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MinterController not implemented")
+
+type QueryGetSignerSetRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Role  string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
 }
-func (*UnimplementedQueryServer) MinterControllerAll(ctx context.Context, req *QueryAllMinterControllerRequest) (*QueryAllMinterControllerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MinterControllerAll not implemented")
+
+func (m *QueryGetSignerSetRequest) Reset()         { *m = QueryGetSignerSetRequest{} }
+func (m *QueryGetSignerSetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSignerSetRequest) ProtoMessage()    {}
+func (*QueryGetSignerSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{36}
 }
-func (*UnimplementedQueryServer) MintingDenom(ctx context.Context, req *QueryGetMintingDenomRequest) (*QueryGetMintingDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MintingDenom not implemented")
+func (m *QueryGetSignerSetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryGetSignerSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSignerSetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetSignerSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSignerSetRequest.Merge(m, src)
+}
+func (m *QueryGetSignerSetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSignerSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSignerSetRequest.DiscardUnknown(m)
 }
 
-func _Query_Blacklisted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetBlacklistedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Blacklisted(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/circle.fiattokenfactory.v1.Query/Blacklisted",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Blacklisted(ctx, req.(*QueryGetBlacklistedRequest))
+var xxx_messageInfo_QueryGetSignerSetRequest proto.InternalMessageInfo
+
+func (m *QueryGetSignerSetRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_BlacklistedAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllBlacklistedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BlacklistedAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/circle.fiattokenfactory.v1.Query/BlacklistedAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BlacklistedAll(ctx, req.(*QueryAllBlacklistedRequest))
+func (m *QueryGetSignerSetRequest) GetRole() string {
+	if m != nil {
+		return m.Role
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Paused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPausedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Paused(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/circle.fiattokenfactory.v1.Query/Paused",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Paused(ctx, req.(*QueryGetPausedRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetSignerSetResponse struct {
+	SignerSet SignerSet `protobuf:"bytes,1,opt,name=signerSet,proto3" json:"signerSet"`
 }
 
-func _Query_MasterMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetMasterMinterRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MasterMinter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/circle.fiattokenfactory.v1.Query/MasterMinter",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MasterMinter(ctx, req.(*QueryGetMasterMinterRequest))
+func (m *QueryGetSignerSetResponse) Reset()         { *m = QueryGetSignerSetResponse{} }
+func (m *QueryGetSignerSetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSignerSetResponse) ProtoMessage()    {}
+func (*QueryGetSignerSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{37}
+}
+func (m *QueryGetSignerSetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSignerSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSignerSetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetSignerSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSignerSetResponse.Merge(m, src)
+}
+func (m *QueryGetSignerSetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSignerSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSignerSetResponse.DiscardUnknown(m)
 }
 
-func _Query_Minters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetMintersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Minters(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/circle.fiattokenfactory.v1.Query/Minters",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Minters(ctx, req.(*QueryGetMintersRequest))
+var xxx_messageInfo_QueryGetSignerSetResponse proto.InternalMessageInfo
+
+func (m *QueryGetSignerSetResponse) GetSignerSet() SignerSet {
+	if m != nil {
+		return m.SignerSet
 	}
-	return interceptor(ctx, in, info, handler)
+	return SignerSet{}
 }
 
-func _Query_MintersAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllMintersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MintersAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/circle.fiattokenfactory.v1.Query/MintersAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MintersAll(ctx, req.(*QueryAllMintersRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetActionProposalRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func _Query_Pauser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPauserRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pauser(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/circle.fiattokenfactory.v1.Query/Pauser",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pauser(ctx, req.(*QueryGetPauserRequest))
+func (m *QueryGetActionProposalRequest) Reset()         { *m = QueryGetActionProposalRequest{} }
+func (m *QueryGetActionProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetActionProposalRequest) ProtoMessage()    {}
+func (*QueryGetActionProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{38}
+}
+func (m *QueryGetActionProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetActionProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetActionProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetActionProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetActionProposalRequest.Merge(m, src)
+}
+func (m *QueryGetActionProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetActionProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetActionProposalRequest.DiscardUnknown(m)
 }
 
-func _Query_Blacklister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetBlacklisterRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Blacklister(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/circle.fiattokenfactory.v1.Query/Blacklister",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Blacklister(ctx, req.(*QueryGetBlacklisterRequest))
+var xxx_messageInfo_QueryGetActionProposalRequest proto.InternalMessageInfo
+
+func (m *QueryGetActionProposalRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Owner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetOwnerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Owner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/circle.fiattokenfactory.v1.Query/Owner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Owner(ctx, req.(*QueryGetOwnerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetActionProposalResponse struct {
+	ActionProposal ActionProposal `protobuf:"bytes,1,opt,name=actionProposal,proto3" json:"actionProposal"`
 }
 
-func _Query_MinterController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetMinterControllerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MinterController(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/circle.fiattokenfactory.v1.Query/MinterController",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MinterController(ctx, req.(*QueryGetMinterControllerRequest))
+func (m *QueryGetActionProposalResponse) Reset()         { *m = QueryGetActionProposalResponse{} }
+func (m *QueryGetActionProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetActionProposalResponse) ProtoMessage()    {}
+func (*QueryGetActionProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{39}
+}
+func (m *QueryGetActionProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetActionProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetActionProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetActionProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetActionProposalResponse.Merge(m, src)
+}
+func (m *QueryGetActionProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetActionProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetActionProposalResponse.DiscardUnknown(m)
 }
 
-func _Query_MinterControllerAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllMinterControllerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MinterControllerAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/circle.fiattokenfactory.v1.Query/MinterControllerAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MinterControllerAll(ctx, req.(*QueryAllMinterControllerRequest))
+var xxx_messageInfo_QueryGetActionProposalResponse proto.InternalMessageInfo
+
+func (m *QueryGetActionProposalResponse) GetActionProposal() ActionProposal {
+	if m != nil {
+		return m.ActionProposal
 	}
-	return interceptor(ctx, in, info, handler)
+	return ActionProposal{}
 }
 
-func _Query_MintingDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetMintingDenomRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MintingDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/circle.fiattokenfactory.v1.Query/MintingDenom",
+type QueryAllActionProposalRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllActionProposalRequest) Reset()         { *m = QueryAllActionProposalRequest{} }
+func (m *QueryAllActionProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllActionProposalRequest) ProtoMessage()    {}
+func (*QueryAllActionProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{40}
+}
+func (m *QueryAllActionProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllActionProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllActionProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MintingDenom(ctx, req.(*QueryGetMintingDenomRequest))
+}
+func (m *QueryAllActionProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllActionProposalRequest.Merge(m, src)
+}
+func (m *QueryAllActionProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllActionProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllActionProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllActionProposalRequest proto.InternalMessageInfo
+
+func (m *QueryAllActionProposalRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "circle.fiattokenfactory.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Blacklisted",
-			Handler:    _Query_Blacklisted_Handler,
-		},
-		{
-			MethodName: "BlacklistedAll",
-			Handler:    _Query_BlacklistedAll_Handler,
-		},
-		{
-			MethodName: "Paused",
-			Handler:    _Query_Paused_Handler,
-		},
-		{
-			MethodName: "MasterMinter",
-			Handler:    _Query_MasterMinter_Handler,
-		},
-		{
-			MethodName: "Minters",
-			Handler:    _Query_Minters_Handler,
-		},
-		{
-			MethodName: "MintersAll",
-			Handler:    _Query_MintersAll_Handler,
-		},
-		{
-			MethodName: "Pauser",
-			Handler:    _Query_Pauser_Handler,
-		},
-		{
-			MethodName: "Blacklister",
-			Handler:    _Query_Blacklister_Handler,
-		},
-		{
-			MethodName: "Owner",
-			Handler:    _Query_Owner_Handler,
-		},
-		{
-			MethodName: "MinterController",
-			Handler:    _Query_MinterController_Handler,
-		},
-		{
-			MethodName: "MinterControllerAll",
-			Handler:    _Query_MinterControllerAll_Handler,
-		},
-		{
-			MethodName: "MintingDenom",
-			Handler:    _Query_MintingDenom_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "circle/fiattokenfactory/v1/query.proto",
+type QueryAllActionProposalResponse struct {
+	ActionProposal []ActionProposal    `protobuf:"bytes,1,rep,name=actionProposal,proto3" json:"actionProposal"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetBlacklistedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllActionProposalResponse) Reset()         { *m = QueryAllActionProposalResponse{} }
+func (m *QueryAllActionProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllActionProposalResponse) ProtoMessage()    {}
+func (*QueryAllActionProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{41}
+}
+func (m *QueryAllActionProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllActionProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllActionProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryGetBlacklistedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllActionProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllActionProposalResponse.Merge(m, src)
+}
+func (m *QueryAllActionProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllActionProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllActionProposalResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetBlacklistedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryAllActionProposalResponse proto.InternalMessageInfo
+
+func (m *QueryAllActionProposalResponse) GetActionProposal() []ActionProposal {
+	if m != nil {
+		return m.ActionProposal
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetBlacklistedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllActionProposalResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetBlacklistedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetQueuedActionRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryGetBlacklistedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Blacklisted.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetQueuedActionRequest) Reset()         { *m = QueryGetQueuedActionRequest{} }
+func (m *QueryGetQueuedActionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetQueuedActionRequest) ProtoMessage()    {}
+func (*QueryGetQueuedActionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{42}
+}
+func (m *QueryGetQueuedActionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetQueuedActionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetQueuedActionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetQueuedActionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetQueuedActionRequest.Merge(m, src)
+}
+func (m *QueryGetQueuedActionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetQueuedActionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetQueuedActionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllBlacklistedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetQueuedActionRequest proto.InternalMessageInfo
+
+func (m *QueryGetQueuedActionRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllBlacklistedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetQueuedActionResponse struct {
+	QueuedAction QueuedAction `protobuf:"bytes,1,opt,name=queuedAction,proto3" json:"queuedAction"`
 }
 
-func (m *QueryAllBlacklistedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetQueuedActionResponse) Reset()         { *m = QueryGetQueuedActionResponse{} }
+func (m *QueryGetQueuedActionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetQueuedActionResponse) ProtoMessage()    {}
+func (*QueryGetQueuedActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{43}
+}
+func (m *QueryGetQueuedActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetQueuedActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetQueuedActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetQueuedActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetQueuedActionResponse.Merge(m, src)
+}
+func (m *QueryGetQueuedActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetQueuedActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetQueuedActionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllBlacklistedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetQueuedActionResponse proto.InternalMessageInfo
+
+func (m *QueryGetQueuedActionResponse) GetQueuedAction() QueuedAction {
+	if m != nil {
+		return m.QueuedAction
 	}
-	return dAtA[:n], nil
+	return QueuedAction{}
 }
 
-func (m *QueryAllBlacklistedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllQueuedActionRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllBlacklistedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAllQueuedActionRequest) Reset()         { *m = QueryAllQueuedActionRequest{} }
+func (m *QueryAllQueuedActionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllQueuedActionRequest) ProtoMessage()    {}
+func (*QueryAllQueuedActionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{44}
+}
+func (m *QueryAllQueuedActionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllQueuedActionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllQueuedActionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Blacklisted) > 0 {
-		for iNdEx := len(m.Blacklisted) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Blacklisted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllQueuedActionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllQueuedActionRequest.Merge(m, src)
+}
+func (m *QueryAllQueuedActionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllQueuedActionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllQueuedActionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetPausedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllQueuedActionRequest proto.InternalMessageInfo
+
+func (m *QueryAllQueuedActionRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetPausedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllQueuedActionResponse struct {
+	QueuedAction []QueuedAction      `protobuf:"bytes,1,rep,name=queuedAction,proto3" json:"queuedAction"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetPausedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAllQueuedActionResponse) Reset()         { *m = QueryAllQueuedActionResponse{} }
+func (m *QueryAllQueuedActionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllQueuedActionResponse) ProtoMessage()    {}
+func (*QueryAllQueuedActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{45}
+}
+func (m *QueryAllQueuedActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllQueuedActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllQueuedActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllQueuedActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllQueuedActionResponse.Merge(m, src)
+}
+func (m *QueryAllQueuedActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllQueuedActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllQueuedActionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetPausedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllQueuedActionResponse proto.InternalMessageInfo
+
+func (m *QueryAllQueuedActionResponse) GetQueuedAction() []QueuedAction {
+	if m != nil {
+		return m.QueuedAction
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetPausedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllQueuedActionResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryGetPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Paused.MarshalToSizedBuffer(dAtA[:i])
+type QueryEscrowBalanceRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryEscrowBalanceRequest) Reset()         { *m = QueryEscrowBalanceRequest{} }
+func (m *QueryEscrowBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowBalanceRequest) ProtoMessage()    {}
+func (*QueryEscrowBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{46}
+}
+func (m *QueryEscrowBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryEscrowBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowBalanceRequest.Merge(m, src)
+}
+func (m *QueryEscrowBalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowBalanceRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetMasterMinterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEscrowBalanceRequest proto.InternalMessageInfo
+
+func (m *QueryEscrowBalanceRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetMasterMinterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryEscrowBalanceResponse struct {
+	Escrow  string     `protobuf:"bytes,1,opt,name=escrow,proto3" json:"escrow,omitempty"`
+	Balance types.Coin `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance"`
 }
 
-func (m *QueryGetMasterMinterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryEscrowBalanceResponse) Reset()         { *m = QueryEscrowBalanceResponse{} }
+func (m *QueryEscrowBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowBalanceResponse) ProtoMessage()    {}
+func (*QueryEscrowBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{47}
+}
+func (m *QueryEscrowBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEscrowBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowBalanceResponse.Merge(m, src)
+}
+func (m *QueryEscrowBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowBalanceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetMasterMinterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEscrowBalanceResponse proto.InternalMessageInfo
+
+func (m *QueryEscrowBalanceResponse) GetEscrow() string {
+	if m != nil {
+		return m.Escrow
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetMasterMinterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEscrowBalanceResponse) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
+	}
+	return types.Coin{}
 }
 
-func (m *QueryGetMasterMinterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.MasterMinter.MarshalToSizedBuffer(dAtA[:i])
+type QueryChannelPoliciesRequest struct {
+	Denom      string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryChannelPoliciesRequest) Reset()         { *m = QueryChannelPoliciesRequest{} }
+func (m *QueryChannelPoliciesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelPoliciesRequest) ProtoMessage()    {}
+func (*QueryChannelPoliciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{48}
+}
+func (m *QueryChannelPoliciesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelPoliciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelPoliciesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetMintersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryChannelPoliciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelPoliciesRequest.Merge(m, src)
 }
-
-func (m *QueryGetMintersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryChannelPoliciesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelPoliciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelPoliciesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetMintersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryChannelPoliciesRequest proto.InternalMessageInfo
+
+func (m *QueryChannelPoliciesRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryGetMintersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryChannelPoliciesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetMintersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryChannelPoliciesResponse struct {
+	ChannelPolicies []ChannelPolicy     `protobuf:"bytes,1,rep,name=channelPolicies,proto3" json:"channelPolicies"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetMintersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Minters.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryChannelPoliciesResponse) Reset()         { *m = QueryChannelPoliciesResponse{} }
+func (m *QueryChannelPoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelPoliciesResponse) ProtoMessage()    {}
+func (*QueryChannelPoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{49}
+}
+func (m *QueryChannelPoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelPoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelPoliciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryChannelPoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelPoliciesResponse.Merge(m, src)
+}
+func (m *QueryChannelPoliciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelPoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelPoliciesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllMintersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryChannelPoliciesResponse proto.InternalMessageInfo
+
+func (m *QueryChannelPoliciesResponse) GetChannelPolicies() []ChannelPolicy {
+	if m != nil {
+		return m.ChannelPolicies
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllMintersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryChannelPoliciesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllMintersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+type QueryChannelRateLimitsRequest struct {
+	Denom      string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryChannelRateLimitsRequest) Reset()         { *m = QueryChannelRateLimitsRequest{} }
+func (m *QueryChannelRateLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelRateLimitsRequest) ProtoMessage()    {}
+func (*QueryChannelRateLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{50}
+}
+func (m *QueryChannelRateLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelRateLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelRateLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryChannelRateLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelRateLimitsRequest.Merge(m, src)
+}
+func (m *QueryChannelRateLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelRateLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelRateLimitsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllMintersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryChannelRateLimitsRequest proto.InternalMessageInfo
+
+func (m *QueryChannelRateLimitsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllMintersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryChannelRateLimitsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllMintersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Minters) > 0 {
-		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Minters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+type QueryChannelRateLimitsResponse struct {
+	ChannelRateLimits []ChannelRateLimit  `protobuf:"bytes,1,rep,name=channelRateLimits,proto3" json:"channelRateLimits"`
+	Pagination        *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetPauserRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryChannelRateLimitsResponse) Reset()         { *m = QueryChannelRateLimitsResponse{} }
+func (m *QueryChannelRateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelRateLimitsResponse) ProtoMessage()    {}
+func (*QueryChannelRateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{51}
+}
+func (m *QueryChannelRateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelRateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelRateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryGetPauserRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryChannelRateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelRateLimitsResponse.Merge(m, src)
+}
+func (m *QueryChannelRateLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelRateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelRateLimitsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetPauserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryChannelRateLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryChannelRateLimitsResponse) GetChannelRateLimits() []ChannelRateLimit {
+	if m != nil {
+		return m.ChannelRateLimits
+	}
+	return nil
 }
 
-func (m *QueryGetPauserResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryChannelRateLimitsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetPauserResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetAllowlisterRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryGetPauserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Pauser.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetAllowlisterRequest) Reset()         { *m = QueryGetAllowlisterRequest{} }
+func (m *QueryGetAllowlisterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAllowlisterRequest) ProtoMessage()    {}
+func (*QueryGetAllowlisterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{52}
+}
+func (m *QueryGetAllowlisterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAllowlisterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAllowlisterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetAllowlisterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAllowlisterRequest.Merge(m, src)
+}
+func (m *QueryGetAllowlisterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAllowlisterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAllowlisterRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetBlacklisterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetAllowlisterRequest proto.InternalMessageInfo
+
+func (m *QueryGetAllowlisterRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetBlacklisterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetAllowlisterResponse struct {
+	Allowlister Allowlister `protobuf:"bytes,1,opt,name=allowlister,proto3" json:"allowlister"`
 }
 
-func (m *QueryGetBlacklisterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryGetAllowlisterResponse) Reset()         { *m = QueryGetAllowlisterResponse{} }
+func (m *QueryGetAllowlisterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAllowlisterResponse) ProtoMessage()    {}
+func (*QueryGetAllowlisterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{53}
+}
+func (m *QueryGetAllowlisterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAllowlisterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAllowlisterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetAllowlisterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAllowlisterResponse.Merge(m, src)
+}
+func (m *QueryGetAllowlisterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAllowlisterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAllowlisterResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetBlacklisterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetAllowlisterResponse proto.InternalMessageInfo
+
+func (m *QueryGetAllowlisterResponse) GetAllowlister() Allowlister {
+	if m != nil {
+		return m.Allowlister
 	}
-	return dAtA[:n], nil
+	return Allowlister{}
 }
 
-func (m *QueryGetBlacklisterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetAllowlistedRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryGetBlacklisterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Blacklister.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetAllowlistedRequest) Reset()         { *m = QueryGetAllowlistedRequest{} }
+func (m *QueryGetAllowlistedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAllowlistedRequest) ProtoMessage()    {}
+func (*QueryGetAllowlistedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{54}
+}
+func (m *QueryGetAllowlistedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAllowlistedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAllowlistedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetAllowlistedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAllowlistedRequest.Merge(m, src)
+}
+func (m *QueryGetAllowlistedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAllowlistedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAllowlistedRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetOwnerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetAllowlistedRequest proto.InternalMessageInfo
+
+func (m *QueryGetAllowlistedRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetAllowlistedRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *QueryGetOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type QueryGetAllowlistedResponse struct {
+	Allowlisted Allowlisted `protobuf:"bytes,1,opt,name=allowlisted,proto3" json:"allowlisted"`
 }
 
-func (m *QueryGetOwnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetAllowlistedResponse) Reset()         { *m = QueryGetAllowlistedResponse{} }
+func (m *QueryGetAllowlistedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAllowlistedResponse) ProtoMessage()    {}
+func (*QueryGetAllowlistedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{55}
 }
-
-func (m *QueryGetOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetAllowlistedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryGetOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Owner.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetAllowlistedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAllowlistedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetAllowlistedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAllowlistedResponse.Merge(m, src)
+}
+func (m *QueryGetAllowlistedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAllowlistedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAllowlistedResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetMinterControllerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetAllowlistedResponse proto.InternalMessageInfo
+
+func (m *QueryGetAllowlistedResponse) GetAllowlisted() Allowlisted {
+	if m != nil {
+		return m.Allowlisted
 	}
-	return dAtA[:n], nil
+	return Allowlisted{}
 }
 
-func (m *QueryGetMinterControllerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllAllowlistedRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Denom      string             `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryGetMinterControllerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ControllerAddress) > 0 {
-		i -= len(m.ControllerAddress)
-		copy(dAtA[i:], m.ControllerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ControllerAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryAllAllowlistedRequest) Reset()         { *m = QueryAllAllowlistedRequest{} }
+func (m *QueryAllAllowlistedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAllowlistedRequest) ProtoMessage()    {}
+func (*QueryAllAllowlistedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{56}
+}
+func (m *QueryAllAllowlistedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAllowlistedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAllowlistedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllAllowlistedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAllowlistedRequest.Merge(m, src)
+}
+func (m *QueryAllAllowlistedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAllowlistedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAllowlistedRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetMinterControllerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllAllowlistedRequest proto.InternalMessageInfo
+
+func (m *QueryAllAllowlistedRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetMinterControllerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllAllowlistedRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *QueryGetMinterControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.MinterController.MarshalToSizedBuffer(dAtA[:i])
+type QueryAllAllowlistedResponse struct {
+	Allowlisted []Allowlisted       `protobuf:"bytes,1,rep,name=allowlisted,proto3" json:"allowlisted"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllAllowlistedResponse) Reset()         { *m = QueryAllAllowlistedResponse{} }
+func (m *QueryAllAllowlistedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAllowlistedResponse) ProtoMessage()    {}
+func (*QueryAllAllowlistedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{57}
+}
+func (m *QueryAllAllowlistedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAllowlistedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAllowlistedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllMinterControllerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllAllowlistedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAllowlistedResponse.Merge(m, src)
 }
-
-func (m *QueryAllMinterControllerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllAllowlistedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAllowlistedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAllowlistedResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllMinterControllerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryAllAllowlistedResponse proto.InternalMessageInfo
+
+func (m *QueryAllAllowlistedResponse) GetAllowlisted() []Allowlisted {
+	if m != nil {
+		return m.Allowlisted
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAllMinterControllerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllAllowlistedResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllMinterControllerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllowlistModeRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryAllMinterControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.MinterController) > 0 {
-		for iNdEx := len(m.MinterController) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MinterController[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAllowlistModeRequest) Reset()         { *m = QueryAllowlistModeRequest{} }
+func (m *QueryAllowlistModeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowlistModeRequest) ProtoMessage()    {}
+func (*QueryAllowlistModeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{58}
+}
+func (m *QueryAllowlistModeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowlistModeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowlistModeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllowlistModeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowlistModeRequest.Merge(m, src)
+}
+func (m *QueryAllowlistModeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowlistModeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowlistModeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetMintingDenomRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllowlistModeRequest proto.InternalMessageInfo
+
+func (m *QueryAllowlistModeRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetMintingDenomRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllowlistModeResponse struct {
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func (m *QueryGetMintingDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAllowlistModeResponse) Reset()         { *m = QueryAllowlistModeResponse{} }
+func (m *QueryAllowlistModeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowlistModeResponse) ProtoMessage()    {}
+func (*QueryAllowlistModeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{59}
+}
+func (m *QueryAllowlistModeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowlistModeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowlistModeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowlistModeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowlistModeResponse.Merge(m, src)
+}
+func (m *QueryAllowlistModeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowlistModeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowlistModeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetMintingDenomResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllowlistModeResponse proto.InternalMessageInfo
+
+func (m *QueryAllowlistModeResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryGetMintingDenomResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryModuleExemptionsRequest struct {
 }
 
-func (m *QueryGetMintingDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.MintingDenom.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryModuleExemptionsRequest) Reset()         { *m = QueryModuleExemptionsRequest{} }
+func (m *QueryModuleExemptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleExemptionsRequest) ProtoMessage()    {}
+func (*QueryModuleExemptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{60}
+}
+func (m *QueryModuleExemptionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleExemptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleExemptionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryModuleExemptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleExemptionsRequest.Merge(m, src)
 }
-func (m *QueryGetBlacklistedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryModuleExemptionsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryGetBlacklistedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Blacklisted.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryModuleExemptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleExemptionsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllBlacklistedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+var xxx_messageInfo_QueryModuleExemptionsRequest proto.InternalMessageInfo
+
+type QueryModuleExemptionsResponse struct {
+	Exemptions []ModuleExemption `protobuf:"bytes,1,rep,name=exemptions,proto3" json:"exemptions"`
+	// addresses are the module account addresses, in the order of exemptions.
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
 }
 
-func (m *QueryAllBlacklistedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Blacklisted) > 0 {
-		for _, e := range m.Blacklisted {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryModuleExemptionsResponse) Reset()         { *m = QueryModuleExemptionsResponse{} }
+func (m *QueryModuleExemptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleExemptionsResponse) ProtoMessage()    {}
+func (*QueryModuleExemptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e79ac8fb1676620, []int{61}
+}
+func (m *QueryModuleExemptionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleExemptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleExemptionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryModuleExemptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleExemptionsResponse.Merge(m, src)
+}
+func (m *QueryModuleExemptionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleExemptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleExemptionsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetPausedRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryModuleExemptionsResponse proto.InternalMessageInfo
+
+func (m *QueryModuleExemptionsResponse) GetExemptions() []ModuleExemption {
+	if m != nil {
+		return m.Exemptions
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryGetPausedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryModuleExemptionsResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
 	}
-	var l int
-	_ = l
-	l = m.Paused.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryGetMasterMinterRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func init() {
+	proto.RegisterType((*QueryGetBlacklistedRequest)(nil), "circle.fiattokenfactory.v1.QueryGetBlacklistedRequest")
+	proto.RegisterType((*QueryGetBlacklistedResponse)(nil), "circle.fiattokenfactory.v1.QueryGetBlacklistedResponse")
+	proto.RegisterType((*QueryAllBlacklistedRequest)(nil), "circle.fiattokenfactory.v1.QueryAllBlacklistedRequest")
+	proto.RegisterType((*QueryAllBlacklistedResponse)(nil), "circle.fiattokenfactory.v1.QueryAllBlacklistedResponse")
+	proto.RegisterType((*QueryBlacklistedByBytesRequest)(nil), "circle.fiattokenfactory.v1.QueryBlacklistedByBytesRequest")
+	proto.RegisterType((*QueryBlacklistedByBytesResponse)(nil), "circle.fiattokenfactory.v1.QueryBlacklistedByBytesResponse")
+	proto.RegisterType((*QueryGetPausedRequest)(nil), "circle.fiattokenfactory.v1.QueryGetPausedRequest")
+	proto.RegisterType((*QueryGetPausedResponse)(nil), "circle.fiattokenfactory.v1.QueryGetPausedResponse")
+	proto.RegisterType((*QueryGetMasterMinterRequest)(nil), "circle.fiattokenfactory.v1.QueryGetMasterMinterRequest")
+	proto.RegisterType((*QueryGetMasterMinterResponse)(nil), "circle.fiattokenfactory.v1.QueryGetMasterMinterResponse")
+	proto.RegisterType((*QueryGetMintersRequest)(nil), "circle.fiattokenfactory.v1.QueryGetMintersRequest")
+	proto.RegisterType((*QueryGetMintersResponse)(nil), "circle.fiattokenfactory.v1.QueryGetMintersResponse")
+	proto.RegisterType((*QueryAllMintersRequest)(nil), "circle.fiattokenfactory.v1.QueryAllMintersRequest")
+	proto.RegisterType((*QueryAllMintersResponse)(nil), "circle.fiattokenfactory.v1.QueryAllMintersResponse")
+	proto.RegisterType((*QueryGetPauserRequest)(nil), "circle.fiattokenfactory.v1.QueryGetPauserRequest")
+	proto.RegisterType((*QueryGetPauserResponse)(nil), "circle.fiattokenfactory.v1.QueryGetPauserResponse")
+	proto.RegisterType((*QueryGetBlacklisterRequest)(nil), "circle.fiattokenfactory.v1.QueryGetBlacklisterRequest")
+	proto.RegisterType((*QueryGetBlacklisterResponse)(nil), "circle.fiattokenfactory.v1.QueryGetBlacklisterResponse")
+	proto.RegisterType((*QueryGetOwnerRequest)(nil), "circle.fiattokenfactory.v1.QueryGetOwnerRequest")
+	proto.RegisterType((*QueryGetOwnerResponse)(nil), "circle.fiattokenfactory.v1.QueryGetOwnerResponse")
+	proto.RegisterType((*QueryGetPendingOwnerRequest)(nil), "circle.fiattokenfactory.v1.QueryGetPendingOwnerRequest")
+	proto.RegisterType((*QueryGetPendingOwnerResponse)(nil), "circle.fiattokenfactory.v1.QueryGetPendingOwnerResponse")
+	proto.RegisterType((*QueryPendingRolesRequest)(nil), "circle.fiattokenfactory.v1.QueryPendingRolesRequest")
+	proto.RegisterType((*QueryPendingRolesResponse)(nil), "circle.fiattokenfactory.v1.QueryPendingRolesResponse")
+	proto.RegisterType((*QueryGetMinterControllerRequest)(nil), "circle.fiattokenfactory.v1.QueryGetMinterControllerRequest")
+	proto.RegisterType((*QueryGetMinterControllerResponse)(nil), "circle.fiattokenfactory.v1.QueryGetMinterControllerResponse")
+	proto.RegisterType((*QueryAllMinterControllerRequest)(nil), "circle.fiattokenfactory.v1.QueryAllMinterControllerRequest")
+	proto.RegisterType((*QueryAllMinterControllerResponse)(nil), "circle.fiattokenfactory.v1.QueryAllMinterControllerResponse")
+	proto.RegisterType((*QueryGetMintingDenomRequest)(nil), "circle.fiattokenfactory.v1.QueryGetMintingDenomRequest")
+	proto.RegisterType((*QueryGetMintingDenomResponse)(nil), "circle.fiattokenfactory.v1.QueryGetMintingDenomResponse")
+	proto.RegisterType((*QueryAllMintingDenomRequest)(nil), "circle.fiattokenfactory.v1.QueryAllMintingDenomRequest")
+	proto.RegisterType((*QueryAllMintingDenomResponse)(nil), "circle.fiattokenfactory.v1.QueryAllMintingDenomResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "circle.fiattokenfactory.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "circle.fiattokenfactory.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryAuditLogRequest)(nil), "circle.fiattokenfactory.v1.QueryAuditLogRequest")
+	proto.RegisterType((*QueryAuditLogResponse)(nil), "circle.fiattokenfactory.v1.QueryAuditLogResponse")
+	proto.RegisterType((*QueryGetSignerSetRequest)(nil), "circle.fiattokenfactory.v1.QueryGetSignerSetRequest")
+	proto.RegisterType((*QueryGetSignerSetResponse)(nil), "circle.fiattokenfactory.v1.QueryGetSignerSetResponse")
+	proto.RegisterType((*QueryGetActionProposalRequest)(nil), "circle.fiattokenfactory.v1.QueryGetActionProposalRequest")
+	proto.RegisterType((*QueryGetActionProposalResponse)(nil), "circle.fiattokenfactory.v1.QueryGetActionProposalResponse")
+	proto.RegisterType((*QueryAllActionProposalRequest)(nil), "circle.fiattokenfactory.v1.QueryAllActionProposalRequest")
+	proto.RegisterType((*QueryAllActionProposalResponse)(nil), "circle.fiattokenfactory.v1.QueryAllActionProposalResponse")
+	proto.RegisterType((*QueryGetQueuedActionRequest)(nil), "circle.fiattokenfactory.v1.QueryGetQueuedActionRequest")
+	proto.RegisterType((*QueryGetQueuedActionResponse)(nil), "circle.fiattokenfactory.v1.QueryGetQueuedActionResponse")
+	proto.RegisterType((*QueryAllQueuedActionRequest)(nil), "circle.fiattokenfactory.v1.QueryAllQueuedActionRequest")
+	proto.RegisterType((*QueryAllQueuedActionResponse)(nil), "circle.fiattokenfactory.v1.QueryAllQueuedActionResponse")
+	proto.RegisterType((*QueryEscrowBalanceRequest)(nil), "circle.fiattokenfactory.v1.QueryEscrowBalanceRequest")
+	proto.RegisterType((*QueryEscrowBalanceResponse)(nil), "circle.fiattokenfactory.v1.QueryEscrowBalanceResponse")
+	proto.RegisterType((*QueryChannelPoliciesRequest)(nil), "circle.fiattokenfactory.v1.QueryChannelPoliciesRequest")
+	proto.RegisterType((*QueryChannelPoliciesResponse)(nil), "circle.fiattokenfactory.v1.QueryChannelPoliciesResponse")
+	proto.RegisterType((*QueryChannelRateLimitsRequest)(nil), "circle.fiattokenfactory.v1.QueryChannelRateLimitsRequest")
+	proto.RegisterType((*QueryChannelRateLimitsResponse)(nil), "circle.fiattokenfactory.v1.QueryChannelRateLimitsResponse")
+	proto.RegisterType((*QueryGetAllowlisterRequest)(nil), "circle.fiattokenfactory.v1.QueryGetAllowlisterRequest")
+	proto.RegisterType((*QueryGetAllowlisterResponse)(nil), "circle.fiattokenfactory.v1.QueryGetAllowlisterResponse")
+	proto.RegisterType((*QueryGetAllowlistedRequest)(nil), "circle.fiattokenfactory.v1.QueryGetAllowlistedRequest")
+	proto.RegisterType((*QueryGetAllowlistedResponse)(nil), "circle.fiattokenfactory.v1.QueryGetAllowlistedResponse")
+	proto.RegisterType((*QueryAllAllowlistedRequest)(nil), "circle.fiattokenfactory.v1.QueryAllAllowlistedRequest")
+	proto.RegisterType((*QueryAllAllowlistedResponse)(nil), "circle.fiattokenfactory.v1.QueryAllAllowlistedResponse")
+	proto.RegisterType((*QueryAllowlistModeRequest)(nil), "circle.fiattokenfactory.v1.QueryAllowlistModeRequest")
+	proto.RegisterType((*QueryAllowlistModeResponse)(nil), "circle.fiattokenfactory.v1.QueryAllowlistModeResponse")
+	proto.RegisterType((*QueryModuleExemptionsRequest)(nil), "circle.fiattokenfactory.v1.QueryModuleExemptionsRequest")
+	proto.RegisterType((*QueryModuleExemptionsResponse)(nil), "circle.fiattokenfactory.v1.QueryModuleExemptionsResponse")
 }
 
-func (m *QueryGetMasterMinterResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.MasterMinter.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func init() {
+	proto.RegisterFile("circle/fiattokenfactory/v1/query.proto", fileDescriptor_0e79ac8fb1676620)
 }
 
-func (m *QueryGetMintersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+var fileDescriptor_0e79ac8fb1676620 = []byte{
+	// 2496 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5b, 0xcd, 0x6f, 0xdc, 0xc6,
+	0x15, 0x37, 0xb5, 0xb6, 0x1c, 0x8d, 0x1d, 0x3b, 0x9e, 0xd8, 0xae, 0x4c, 0xcb, 0x2b, 0x99, 0x86,
+	0x65, 0xd9, 0x92, 0x76, 0x2d, 0x29, 0x96, 0x64, 0xbb, 0x41, 0xbb, 0x92, 0x3f, 0x52, 0xd4, 0x42,
+	0xe4, 0x0d, 0xd0, 0x8f, 0x1c, 0xb2, 0xe1, 0x2e, 0xc7, 0x6b, 0xc2, 0x5c, 0xce, 0x7a, 0x48, 0x49,
+	0x56, 0x05, 0xa1, 0x40, 0x6e, 0xbd, 0x14, 0x29, 0x72, 0x28, 0xd0, 0x43, 0x2f, 0x45, 0xd1, 0x02,
+	0x05, 0x8a, 0xb6, 0x68, 0x1b, 0xa0, 0x0d, 0x82, 0xa2, 0x39, 0x34, 0x40, 0x2e, 0x01, 0xda, 0x43,
+	0x0f, 0x45, 0x51, 0xd8, 0xfd, 0x43, 0x0a, 0x0e, 0x1f, 0xc9, 0x21, 0x97, 0xe4, 0x0e, 0xd7, 0x6b,
+	0x03, 0xbd, 0x2d, 0x87, 0xef, 0xe3, 0xf7, 0xde, 0xcc, 0xbc, 0x37, 0xf3, 0x1e, 0x17, 0x4d, 0xb7,
+	0x4c, 0xd6, 0xb2, 0x48, 0xf5, 0x81, 0xa9, 0xbb, 0x2e, 0x7d, 0x44, 0xec, 0x07, 0x7a, 0xcb, 0xa5,
+	0x6c, 0xb7, 0xba, 0xbd, 0x50, 0x7d, 0xbc, 0x45, 0xd8, 0x6e, 0xa5, 0xcb, 0xa8, 0x4b, 0xb1, 0xea,
+	0xd3, 0x55, 0x92, 0x74, 0x95, 0xed, 0x05, 0x75, 0x2e, 0x47, 0x86, 0x6e, 0x59, 0x74, 0xc7, 0x32,
+	0x1d, 0x97, 0x18, 0xbe, 0x24, 0x49, 0x6a, 0x06, 0xd4, 0x97, 0xf3, 0xa8, 0xbb, 0x5d, 0x46, 0xb7,
+	0x75, 0x0b, 0x48, 0xf3, 0x4c, 0xd1, 0xb7, 0x0c, 0xd3, 0x95, 0x00, 0xd0, 0xb4, 0xf4, 0xd6, 0x23,
+	0x69, 0xb8, 0x11, 0x75, 0x00, 0xb7, 0x9a, 0x43, 0xdd, 0x7a, 0xa8, 0xdb, 0x36, 0xb1, 0x1a, 0x5d,
+	0x6a, 0x99, 0x2d, 0xf0, 0xab, 0xba, 0x24, 0xc1, 0xc0, 0x74, 0x97, 0x34, 0x2c, 0xb3, 0x13, 0x5a,
+	0x50, 0xc9, 0x61, 0xea, 0xe8, 0x1e, 0x9c, 0x46, 0xc7, 0xb4, 0x23, 0x54, 0x8b, 0x79, 0xf4, 0x9c,
+	0xb0, 0xd1, 0xa2, 0xb6, 0xcb, 0xa8, 0x65, 0x85, 0x3c, 0x33, 0x7d, 0x79, 0x1c, 0x19, 0x34, 0xa6,
+	0xed, 0x9a, 0x76, 0xbb, 0x61, 0x10, 0x9b, 0x76, 0x24, 0xe6, 0x89, 0xee, 0xd8, 0x21, 0x82, 0x4b,
+	0x39, 0x74, 0x5d, 0x9d, 0xe9, 0x1d, 0x47, 0x8a, 0x70, 0xcb, 0x21, 0x86, 0x2c, 0x61, 0xa0, 0x7a,
+	0x3e, 0x8f, 0x90, 0xd8, 0x86, 0x67, 0x12, 0xa3, 0x16, 0x91, 0x58, 0xa4, 0xae, 0xd9, 0x21, 0x16,
+	0x6d, 0x3d, 0x02, 0xd2, 0x2b, 0x2d, 0xea, 0x74, 0xa8, 0x53, 0x6d, 0xea, 0x0e, 0xf1, 0x37, 0x58,
+	0x75, 0x7b, 0xa1, 0x49, 0x5c, 0xdd, 0x43, 0xd0, 0x36, 0x6d, 0xdd, 0x35, 0xa9, 0x0d, 0xb4, 0x65,
+	0x91, 0x36, 0xa0, 0x6a, 0x51, 0x33, 0x78, 0x7f, 0xb2, 0x4d, 0xdb, 0x94, 0xff, 0xac, 0x7a, 0xbf,
+	0x60, 0x74, 0xa2, 0x4d, 0x69, 0xdb, 0x22, 0x55, 0xbd, 0x6b, 0x56, 0x75, 0xdb, 0xa6, 0x2e, 0x17,
+	0x09, 0xbe, 0xd2, 0xde, 0x47, 0xea, 0x7d, 0x4f, 0xeb, 0x5d, 0xe2, 0xae, 0x45, 0x6b, 0xbd, 0x4e,
+	0x1e, 0x6f, 0x11, 0xc7, 0xc5, 0xe3, 0xe8, 0xb0, 0x6e, 0x18, 0x8c, 0x38, 0xce, 0xb8, 0x32, 0xa5,
+	0xcc, 0x8c, 0xd5, 0x83, 0x47, 0x7c, 0x12, 0x1d, 0xe2, 0x73, 0x38, 0x3e, 0xc2, 0xc7, 0xfd, 0x07,
+	0x8c, 0xd1, 0xc1, 0x87, 0xac, 0xeb, 0x8c, 0x97, 0xa6, 0x4a, 0x33, 0x63, 0x75, 0xfe, 0x5b, 0xfb,
+	0x44, 0x41, 0x67, 0x53, 0x55, 0x38, 0x5d, 0x6a, 0x3b, 0x04, 0xbf, 0x8d, 0x8e, 0x08, 0xbb, 0x8c,
+	0xeb, 0x39, 0xb2, 0x78, 0xa9, 0x92, 0x1d, 0x5f, 0x2a, 0x82, 0x94, 0xb5, 0x83, 0x9f, 0xff, 0x7b,
+	0xf2, 0x40, 0x5d, 0x94, 0x80, 0xef, 0x45, 0xa0, 0x47, 0xb8, 0xb0, 0xb9, 0x3c, 0x61, 0x35, 0x9f,
+	0xb4, 0x4e, 0x6c, 0x83, 0x30, 0xd3, 0x6e, 0x83, 0xc4, 0x40, 0x84, 0xf6, 0x43, 0x05, 0x3c, 0x54,
+	0xb3, 0xac, 0x14, 0x0f, 0xdd, 0x41, 0x28, 0x9a, 0x27, 0x00, 0x3f, 0x5d, 0xf1, 0x27, 0xaa, 0xe2,
+	0x4d, 0x54, 0xc5, 0x8f, 0x9a, 0x30, 0x5d, 0x95, 0x4d, 0xbd, 0x4d, 0x80, 0xb7, 0x2e, 0x70, 0x16,
+	0xf0, 0xe7, 0x0f, 0x46, 0xd0, 0xd9, 0x54, 0x40, 0x59, 0xfe, 0x2c, 0x3d, 0xa7, 0x3f, 0xef, 0xc6,
+	0x4c, 0x1c, 0x09, 0xe6, 0xa7, 0x8f, 0x89, 0x3e, 0x9a, 0x98, 0x8d, 0x9b, 0x68, 0x0c, 0xbc, 0x4a,
+	0x7c, 0x93, 0x06, 0x9b, 0x9a, 0x48, 0x88, 0x66, 0xa0, 0x32, 0x77, 0x85, 0x68, 0xc1, 0xee, 0xda,
+	0xae, 0x4b, 0x9c, 0x61, 0xae, 0xe0, 0xbf, 0x28, 0x68, 0x32, 0x53, 0xcd, 0xff, 0xc7, 0x2a, 0x9e,
+	0x47, 0xa7, 0x82, 0x3d, 0xb8, 0xc9, 0x23, 0x60, 0xe0, 0x9f, 0xd0, 0x0b, 0x8a, 0xe0, 0x05, 0xed,
+	0x5d, 0x74, 0x3a, 0x49, 0x0e, 0x76, 0x7e, 0x1d, 0x8d, 0xfa, 0x21, 0x14, 0x4c, 0xd4, 0xf2, 0x50,
+	0xf9, 0xbc, 0x80, 0x05, 0xf8, 0xb4, 0xa5, 0x28, 0x1c, 0x6c, 0xf0, 0xdc, 0xb4, 0xc1, 0xb3, 0x47,
+	0x3e, 0x20, 0x86, 0x26, 0xd2, 0x99, 0x00, 0x56, 0x1d, 0x1d, 0xed, 0x08, 0xe3, 0x00, 0x6e, 0x26,
+	0x0f, 0x9c, 0x28, 0x07, 0x20, 0xc6, 0x64, 0x68, 0x6f, 0x45, 0x4e, 0xf0, 0x47, 0x06, 0x5d, 0x54,
+	0xda, 0xcf, 0x14, 0xf4, 0x95, 0x1e, 0x51, 0x80, 0x7c, 0x1d, 0x1d, 0x86, 0xf4, 0x09, 0xa0, 0x2f,
+	0xe4, 0x82, 0xf6, 0x49, 0x83, 0xe9, 0x05, 0x4e, 0xbc, 0x8e, 0x8e, 0xef, 0x98, 0xb6, 0x41, 0x77,
+	0xea, 0xa4, 0xa3, 0x9b, 0xb6, 0x69, 0xb7, 0x61, 0xd1, 0x9c, 0x89, 0xed, 0xd3, 0x60, 0x87, 0xae,
+	0x53, 0xd3, 0xae, 0x27, 0x39, 0xb4, 0x6d, 0xb0, 0xb7, 0x66, 0x59, 0x09, 0x7b, 0x5f, 0x68, 0x90,
+	0xd3, 0x7e, 0x11, 0x78, 0x47, 0x54, 0x9c, 0xe6, 0x9d, 0xd2, 0x80, 0xde, 0x19, 0x56, 0x00, 0xeb,
+	0xd9, 0x45, 0xac, 0xd8, 0x2e, 0x62, 0x3d, 0xbb, 0x88, 0x49, 0xef, 0x22, 0x16, 0xdb, 0x45, 0x4c,
+	0x5b, 0x4c, 0xcb, 0xdb, 0x7d, 0xf0, 0xd8, 0x69, 0x89, 0x98, 0xa5, 0x87, 0x30, 0x56, 0x2c, 0x84,
+	0xb1, 0xde, 0x10, 0xc6, 0xb4, 0x39, 0x74, 0x32, 0xd0, 0xf7, 0xf6, 0x8e, 0xdd, 0x0f, 0xdd, 0xb7,
+	0xd0, 0xa9, 0x04, 0x35, 0xe0, 0x7a, 0x13, 0x1d, 0xe2, 0xc7, 0x40, 0x40, 0x74, 0x3e, 0x0f, 0x11,
+	0xe7, 0x04, 0x2c, 0x3e, 0x97, 0x18, 0x6f, 0x36, 0xfd, 0xa3, 0x9a, 0x04, 0x98, 0x47, 0x68, 0x22,
+	0x9d, 0x09, 0x30, 0x7d, 0x13, 0x1d, 0xed, 0x0a, 0xe3, 0x45, 0xa1, 0xc5, 0x98, 0xb5, 0x27, 0x68,
+	0x9c, 0x2b, 0x03, 0x4d, 0x75, 0x6a, 0x11, 0x27, 0x17, 0x1e, 0xbe, 0x93, 0xb2, 0xa2, 0x07, 0xd8,
+	0x90, 0xda, 0xc7, 0x0a, 0x3a, 0x93, 0xa2, 0x1a, 0x8c, 0xbc, 0x1f, 0x1a, 0xc9, 0xc7, 0x65, 0x8e,
+	0x12, 0x82, 0x9c, 0x84, 0xa9, 0x5c, 0xc4, 0xf0, 0xb6, 0x22, 0x41, 0x93, 0xf1, 0x88, 0xba, 0x1e,
+	0x5e, 0x58, 0x02, 0xd7, 0xcd, 0xa1, 0x13, 0xd1, 0x2d, 0xa6, 0x16, 0x8b, 0xd7, 0xbd, 0x2f, 0x32,
+	0x62, 0xd3, 0x07, 0x0a, 0x9a, 0xca, 0xd6, 0x03, 0x7e, 0x7a, 0x0f, 0xbd, 0xd6, 0x49, 0xbc, 0x1b,
+	0x57, 0xfa, 0xe7, 0xec, 0xa4, 0x3c, 0x70, 0x58, 0x8f, 0x2c, 0xed, 0xfb, 0x68, 0x32, 0x1e, 0x1f,
+	0x7b, 0x6d, 0x7d, 0xb1, 0x11, 0xfa, 0x8b, 0xc0, 0x0b, 0xa9, 0x08, 0x72, 0xbd, 0x50, 0x1a, 0x96,
+	0x17, 0x86, 0xb7, 0x74, 0xc4, 0x03, 0x88, 0x7f, 0x1d, 0xbd, 0xe5, 0x59, 0x29, 0x7f, 0x00, 0x89,
+	0x31, 0x09, 0x07, 0x10, 0x61, 0x5c, 0xea, 0x00, 0x22, 0xd0, 0x87, 0x07, 0x10, 0x61, 0x4c, 0x23,
+	0xd1, 0x41, 0x3f, 0x0d, 0xe8, 0x90, 0xe6, 0x5c, 0xfb, 0xb3, 0x82, 0x26, 0xd2, 0xf5, 0x64, 0xda,
+	0x56, 0x7a, 0x5e, 0xdb, 0x86, 0x37, 0x9b, 0x27, 0x11, 0xf6, 0x23, 0x18, 0xaf, 0x00, 0x80, 0x7d,
+	0xda, 0xb7, 0xd1, 0xeb, 0xb1, 0x51, 0x31, 0xef, 0x7a, 0x23, 0x72, 0x79, 0xd7, 0xa3, 0x8c, 0xf2,
+	0xae, 0xf7, 0xa4, 0xfd, 0x4b, 0x81, 0xa4, 0x56, 0xf3, 0x2a, 0x48, 0xf7, 0x68, 0xfb, 0xa5, 0x5d,
+	0x04, 0x19, 0xb5, 0xc8, 0x78, 0x89, 0x0f, 0xf2, 0xdf, 0xf8, 0x34, 0x1a, 0xd5, 0x5b, 0x5c, 0xdb,
+	0x41, 0x3e, 0x0a, 0x4f, 0x78, 0x02, 0x8d, 0x75, 0x4c, 0xfb, 0x2d, 0x62, 0xb6, 0x1f, 0xba, 0xe3,
+	0x87, 0xa6, 0x94, 0x99, 0x52, 0x3d, 0x1a, 0xe0, 0x6f, 0xf5, 0x27, 0xf0, 0x76, 0x14, 0xde, 0x06,
+	0x03, 0xda, 0x2f, 0x15, 0x74, 0x2a, 0x61, 0x1e, 0xb8, 0xee, 0x0e, 0x3a, 0x4c, 0x6c, 0x97, 0x99,
+	0x61, 0x1e, 0x98, 0xce, 0xbd, 0x8f, 0x78, 0xec, 0xb7, 0x6d, 0x97, 0xed, 0x06, 0x87, 0x31, 0x60,
+	0x1e, 0xde, 0xc4, 0xdf, 0x82, 0xac, 0x79, 0x97, 0xb8, 0xef, 0x98, 0x6d, 0x9b, 0xb0, 0x77, 0x88,
+	0x9b, 0x9f, 0x35, 0x03, 0x27, 0x8e, 0x44, 0x4e, 0xd4, 0x1e, 0xa0, 0x33, 0x29, 0x52, 0xc0, 0xe6,
+	0x6f, 0xa0, 0x31, 0x27, 0x18, 0x84, 0x29, 0xbd, 0x98, 0x67, 0x75, 0x28, 0x21, 0xb8, 0xa9, 0x86,
+	0xdc, 0x5a, 0x15, 0x9d, 0x0b, 0xf4, 0xd4, 0xf8, 0x34, 0x6d, 0x32, 0xda, 0xa5, 0x8e, 0x6e, 0x05,
+	0x90, 0x8f, 0xa1, 0x11, 0xd3, 0xbf, 0x54, 0x1d, 0xac, 0x8f, 0x98, 0x86, 0xf6, 0x3d, 0x54, 0xce,
+	0x62, 0x00, 0x74, 0xdf, 0x41, 0xc7, 0xf4, 0xd8, 0x1b, 0x80, 0x78, 0x25, 0x77, 0x62, 0x62, 0x1c,
+	0x80, 0x33, 0x21, 0x47, 0x6b, 0x03, 0xd8, 0x9a, 0x65, 0xa5, 0x83, 0x1d, 0x56, 0xe8, 0xf9, 0x4c,
+	0x41, 0xe5, 0x2c, 0x4d, 0x39, 0x56, 0x96, 0x86, 0x61, 0xe5, 0x30, 0xaf, 0x05, 0x61, 0x42, 0xb9,
+	0xbf, 0x45, 0xb6, 0x88, 0xe1, 0xab, 0xcf, 0x9a, 0x59, 0x21, 0x95, 0xc4, 0xc9, 0xa3, 0x70, 0xfb,
+	0x58, 0x18, 0x97, 0x49, 0x25, 0xa2, 0x9c, 0x20, 0xdc, 0x8a, 0x32, 0xc4, 0x54, 0x92, 0x06, 0xf1,
+	0x45, 0xa4, 0x12, 0x49, 0xdb, 0x4a, 0xcf, 0x6b, 0xdb, 0xf0, 0xe6, 0x71, 0x01, 0x62, 0xc1, 0x6d,
+	0xa7, 0xc5, 0xe8, 0xce, 0x9a, 0x6e, 0xe9, 0x76, 0x8b, 0xe4, 0x1f, 0x0b, 0x28, 0x52, 0xd3, 0x58,
+	0xc0, 0xda, 0xd3, 0x68, 0x94, 0xf0, 0x17, 0xc0, 0x04, 0x4f, 0xf8, 0x3a, 0x3a, 0xdc, 0xf4, 0x49,
+	0xfb, 0x5e, 0xd3, 0x83, 0xf0, 0x09, 0xf4, 0xda, 0x1e, 0x4c, 0xe4, 0xba, 0xdf, 0x0b, 0xd8, 0xa4,
+	0x96, 0xd9, 0x32, 0x5f, 0xd6, 0x75, 0xe1, 0xaf, 0xc1, 0xf4, 0xf6, 0x68, 0x07, 0x83, 0xbf, 0x8b,
+	0x8e, 0xb7, 0xe2, 0xaf, 0x60, 0x86, 0x2f, 0xe7, 0xcd, 0xb0, 0x28, 0x2d, 0xc8, 0x17, 0x49, 0x39,
+	0xc3, 0x9b, 0xe5, 0x7d, 0x08, 0x6e, 0xa0, 0xb5, 0xae, 0xbb, 0xe4, 0x9e, 0xd7, 0x4b, 0x79, 0x49,
+	0x3e, 0xfc, 0x22, 0x08, 0x79, 0x29, 0xfa, 0xc1, 0x8b, 0xef, 0xa3, 0x13, 0xad, 0xe4, 0x4b, 0x99,
+	0xa3, 0x74, 0x52, 0x22, 0xb8, 0xb2, 0x57, 0xd8, 0xf0, 0x9c, 0x29, 0x94, 0x21, 0x6a, 0x51, 0xaf,
+	0x4e, 0xba, 0x0c, 0x11, 0xe3, 0x89, 0xca, 0x10, 0x42, 0xdb, 0x4f, 0xa6, 0x0c, 0x21, 0x48, 0x09,
+	0xca, 0x10, 0x82, 0x04, 0xed, 0x5e, 0x1a, 0xc6, 0x41, 0x5b, 0x1c, 0xe9, 0xe8, 0x8d, 0x74, 0xf4,
+	0x46, 0x31, 0xf4, 0x46, 0x2f, 0x7a, 0xef, 0x1c, 0x10, 0xb6, 0x1f, 0x52, 0xd0, 0xbf, 0xd8, 0x7b,
+	0xdf, 0xc7, 0x0a, 0x3a, 0x9b, 0xaa, 0x3c, 0xcb, 0xd8, 0xd2, 0xf3, 0x19, 0x3b, 0xfc, 0x50, 0x1e,
+	0xea, 0xdb, 0xa0, 0x46, 0x9f, 0x50, 0xbe, 0x8c, 0xd4, 0x34, 0x16, 0x30, 0x75, 0xdc, 0x3b, 0xfe,
+	0xea, 0x4d, 0x0b, 0xe6, 0xf4, 0x95, 0x7a, 0xf0, 0xa8, 0x95, 0x21, 0x26, 0x6e, 0x50, 0x63, 0xcb,
+	0x22, 0xb7, 0x9f, 0x90, 0x4e, 0xd7, 0x83, 0x10, 0x5e, 0x45, 0x3e, 0x54, 0xd0, 0xb9, 0x0c, 0x82,
+	0xb0, 0xce, 0x82, 0x48, 0x38, 0x0a, 0x5e, 0x9c, 0xcd, 0xbd, 0x5d, 0xc5, 0x25, 0x81, 0x27, 0x05,
+	0x21, 0xde, 0x29, 0x3f, 0x6a, 0xb5, 0x8c, 0xf0, 0x5e, 0x46, 0x34, 0xb0, 0xf8, 0xdb, 0x59, 0x74,
+	0x88, 0x43, 0xc2, 0x7f, 0x50, 0xd0, 0x11, 0xa1, 0x11, 0x81, 0x97, 0xfb, 0x64, 0xe2, 0x8c, 0x46,
+	0xa1, 0xba, 0x52, 0x98, 0xcf, 0xb7, 0x5d, 0xbb, 0xf6, 0xc1, 0xdf, 0xff, 0xfb, 0xd1, 0x48, 0x15,
+	0xcf, 0x57, 0x6d, 0xda, 0x4c, 0x6b, 0x99, 0x0a, 0x3d, 0x91, 0xea, 0x1e, 0x98, 0xb0, 0x8f, 0x7f,
+	0xad, 0xa0, 0x63, 0x82, 0xb8, 0x9a, 0x65, 0x49, 0x40, 0x4f, 0xed, 0xe0, 0xa9, 0x2b, 0x85, 0xf9,
+	0x00, 0xfa, 0x2c, 0x87, 0x7e, 0x11, 0x5f, 0x90, 0x80, 0x8e, 0x3f, 0x53, 0x10, 0xee, 0x6d, 0x1f,
+	0xe1, 0x1b, 0x7d, 0x95, 0x67, 0xb6, 0xb6, 0xd4, 0x9b, 0x03, 0xf1, 0x02, 0xf8, 0x37, 0x38, 0xf8,
+	0x0a, 0x9e, 0x93, 0x00, 0xdf, 0x68, 0xee, 0x36, 0x9a, 0x1c, 0xee, 0x8f, 0x15, 0x34, 0xea, 0x37,
+	0x75, 0xf0, 0x82, 0xcc, 0x8c, 0xc7, 0x7a, 0x4d, 0xea, 0x62, 0x11, 0x16, 0xc0, 0x39, 0xcd, 0x71,
+	0x4e, 0xe1, 0x72, 0x16, 0x4e, 0xbf, 0xab, 0x84, 0x7f, 0xa3, 0xa0, 0xa3, 0x62, 0x47, 0x07, 0x4b,
+	0xad, 0xc8, 0x94, 0x06, 0x94, 0xba, 0x5a, 0x9c, 0x11, 0xb0, 0xce, 0x73, 0xac, 0x97, 0xf0, 0xc5,
+	0x2c, 0xac, 0xb1, 0x6f, 0x31, 0xf0, 0xcf, 0x15, 0x74, 0x78, 0x03, 0x5a, 0x14, 0x52, 0xae, 0x89,
+	0x77, 0x65, 0xd4, 0xa5, 0x42, 0x3c, 0x80, 0x71, 0x81, 0x63, 0x9c, 0xc5, 0x97, 0x33, 0x31, 0xfa,
+	0x0c, 0xc2, 0x5e, 0xfb, 0xa9, 0x82, 0x10, 0x88, 0xf1, 0xf6, 0xd9, 0xa2, 0xcc, 0x7e, 0x29, 0x0c,
+	0xb5, 0xb7, 0xf7, 0xa3, 0x5d, 0xe2, 0x50, 0xcf, 0xe3, 0xc9, 0x3e, 0x50, 0xa3, 0x55, 0xc9, 0x0a,
+	0xac, 0x4a, 0x56, 0x7c, 0x55, 0xb2, 0x82, 0xab, 0x92, 0xe1, 0x5f, 0xc5, 0xc2, 0x2b, 0x2b, 0x1a,
+	0x5e, 0xd9, 0x80, 0xe1, 0x95, 0x0d, 0x12, 0xa3, 0x18, 0xfe, 0x48, 0x41, 0x87, 0x78, 0x47, 0x02,
+	0x5f, 0x95, 0xd1, 0x27, 0xb6, 0x51, 0xd4, 0x85, 0x02, 0x1c, 0x80, 0xed, 0x22, 0xc7, 0x36, 0x89,
+	0xcf, 0x65, 0x61, 0xe3, 0xfd, 0x1b, 0xbe, 0xb3, 0xc5, 0x1e, 0x8c, 0xdc, 0xce, 0x4e, 0x69, 0xf5,
+	0xa8, 0xab, 0xc5, 0x19, 0x65, 0x77, 0x76, 0xf0, 0x11, 0x90, 0x0f, 0xf9, 0x77, 0x11, 0x64, 0xbf,
+	0xed, 0xf1, 0x46, 0x5f, 0xcd, 0x29, 0xbd, 0x1f, 0xf5, 0x5a, 0x41, 0x2e, 0xd9, 0x94, 0x2a, 0x7e,
+	0xb1, 0xe4, 0x54, 0xf7, 0xf8, 0xf1, 0x67, 0x1f, 0xff, 0x43, 0x41, 0xaf, 0x25, 0x8b, 0xf1, 0xf8,
+	0xa6, 0x7c, 0x8c, 0xe9, 0x69, 0x4a, 0xa8, 0x5f, 0x1d, 0x8c, 0x19, 0xcc, 0xb8, 0xcd, 0xcd, 0xf8,
+	0x1a, 0x7e, 0x33, 0x7f, 0xfb, 0x0b, 0x5f, 0xaa, 0x55, 0xf7, 0x7a, 0xda, 0x3a, 0xfb, 0x5e, 0xe2,
+	0x7d, 0x3d, 0xa9, 0xc3, 0x0b, 0x63, 0x37, 0xe5, 0x43, 0xd2, 0x20, 0x96, 0xe5, 0x74, 0x4a, 0x64,
+	0x63, 0xb0, 0x60, 0x99, 0x9f, 0xde, 0xc4, 0xfa, 0xf9, 0x8a, 0xac, 0x6f, 0x13, 0x5d, 0x03, 0x75,
+	0xb5, 0x38, 0xa3, 0x74, 0x7a, 0x13, 0x3f, 0xee, 0xc3, 0xbf, 0x57, 0xd0, 0x71, 0x51, 0x8e, 0xe7,
+	0xf4, 0x15, 0x59, 0xbf, 0x15, 0x47, 0x9d, 0xd1, 0xbc, 0xd0, 0x2a, 0x1c, 0xf5, 0x0c, 0x9e, 0x96,
+	0x42, 0xed, 0xe0, 0x1f, 0xf1, 0x64, 0xe2, 0xd5, 0xfa, 0x71, 0xa5, 0xff, 0xfe, 0x13, 0x9b, 0x0e,
+	0x6a, 0x55, 0x9a, 0x5e, 0x3e, 0x8d, 0x70, 0x20, 0x3f, 0x51, 0xd0, 0x2b, 0x41, 0x41, 0x5e, 0x22,
+	0x36, 0x27, 0x5a, 0x13, 0xea, 0x42, 0x01, 0x0e, 0x40, 0x76, 0x99, 0x23, 0xbb, 0x80, 0xcf, 0x67,
+	0x21, 0xe3, 0x1f, 0xd0, 0x36, 0x2c, 0xda, 0xf6, 0x72, 0xdc, 0x58, 0x58, 0xf8, 0x96, 0x88, 0x74,
+	0x29, 0xf5, 0x7a, 0xf5, 0x5a, 0x41, 0x2e, 0xd9, 0x8d, 0xe4, 0xd7, 0xdf, 0x1b, 0x0e, 0x71, 0xab,
+	0x7b, 0x8c, 0x5a, 0x64, 0x1f, 0x7f, 0xaa, 0xa0, 0x63, 0xf1, 0xea, 0x30, 0xbe, 0x2e, 0xa3, 0x3c,
+	0xb5, 0x0e, 0xae, 0xde, 0x18, 0x84, 0x55, 0x36, 0x4c, 0xfb, 0xe5, 0xea, 0x46, 0x17, 0x18, 0x9d,
+	0xea, 0x9e, 0x69, 0xec, 0xe3, 0x4f, 0x14, 0x74, 0x22, 0x2e, 0xb1, 0x66, 0xc9, 0xd8, 0x90, 0x55,
+	0xcb, 0x57, 0x6f, 0x0c, 0xc2, 0x0a, 0x36, 0x5c, 0xe5, 0x36, 0x5c, 0xc1, 0x33, 0xb2, 0x36, 0xe0,
+	0x3f, 0x2a, 0xe8, 0xa8, 0x58, 0xd1, 0x95, 0x0b, 0x64, 0x29, 0x35, 0x6b, 0x75, 0xb5, 0x38, 0x23,
+	0xa0, 0x5e, 0xe2, 0xa8, 0xe7, 0xf1, 0x6c, 0x16, 0x6a, 0xbf, 0xbc, 0xdc, 0xf0, 0xc1, 0x83, 0xdf,
+	0xbd, 0x70, 0x26, 0x4a, 0x93, 0x0e, 0x67, 0x83, 0x61, 0xcf, 0x28, 0xa0, 0xf7, 0x0f, 0x67, 0x71,
+	0xec, 0x9e, 0xbf, 0x5f, 0x8d, 0x15, 0xa7, 0x71, 0xff, 0xbd, 0x96, 0x56, 0xff, 0x56, 0x97, 0x8b,
+	0xb2, 0x01, 0xe0, 0x65, 0x0e, 0xf8, 0x2a, 0xae, 0x64, 0x01, 0xf6, 0x6b, 0xe2, 0x0d, 0x28, 0x70,
+	0x87, 0xc7, 0x91, 0x4f, 0x15, 0x74, 0x3c, 0x51, 0x66, 0x96, 0xf0, 0x77, 0x7a, 0x59, 0x5c, 0x5d,
+	0x2d, 0xce, 0x08, 0xf0, 0x57, 0x39, 0xfc, 0x45, 0x7c, 0x35, 0x0b, 0x7e, 0xec, 0x2b, 0x7e, 0x53,
+	0x38, 0x4f, 0xfd, 0x4d, 0x41, 0x27, 0x7a, 0x6a, 0xbc, 0x12, 0x1b, 0x35, 0xab, 0x2e, 0xad, 0xde,
+	0x18, 0x84, 0x15, 0xcc, 0xb8, 0xc9, 0xcd, 0xb8, 0x86, 0x97, 0xfa, 0x99, 0x11, 0xfd, 0xb7, 0x20,
+	0xb2, 0xc4, 0xbb, 0xc5, 0x08, 0x35, 0x56, 0xb9, 0x5b, 0x4c, 0x6f, 0x39, 0x58, 0x5d, 0x29, 0xcc,
+	0x27, 0x7b, 0x8b, 0x11, 0xca, 0xbd, 0xbc, 0xa4, 0x55, 0x13, 0x6a, 0x8a, 0x05, 0xd1, 0x1a, 0x03,
+	0xa2, 0x2d, 0x50, 0xd2, 0x8a, 0xd0, 0x26, 0x4b, 0x5a, 0x82, 0x38, 0xe9, 0x92, 0xd6, 0x40, 0xd0,
+	0xd3, 0x0b, 0xba, 0x45, 0x1c, 0x6d, 0x78, 0x80, 0x5f, 0x8d, 0x15, 0x4b, 0x25, 0x42, 0x4b, 0x5a,
+	0x3d, 0x56, 0x5d, 0x2e, 0xca, 0x26, 0x1b, 0x0b, 0x43, 0xb4, 0x8d, 0x8e, 0x07, 0xef, 0x4f, 0xde,
+	0x0d, 0x27, 0x51, 0x84, 0xc5, 0xfd, 0x43, 0x43, 0x46, 0x61, 0x57, 0xbd, 0x3e, 0x00, 0xa7, 0xf4,
+	0x0d, 0x80, 0x73, 0x36, 0xa2, 0x8a, 0xee, 0xda, 0x7b, 0x9f, 0x3f, 0x2d, 0x2b, 0x5f, 0x3e, 0x2d,
+	0x2b, 0xff, 0x79, 0x5a, 0x56, 0x3e, 0x7c, 0x56, 0x3e, 0xf0, 0xe5, 0xb3, 0xf2, 0x81, 0x7f, 0x3e,
+	0x2b, 0x1f, 0x78, 0xf7, 0x56, 0xdb, 0x74, 0x1f, 0x6e, 0x35, 0x2b, 0x2d, 0xda, 0x81, 0xbf, 0x1b,
+	0x3d, 0x30, 0x6d, 0x5f, 0xf0, 0x7c, 0x8f, 0xe0, 0x27, 0xbd, 0xba, 0xdc, 0xdd, 0x2e, 0x71, 0x9a,
+	0xa3, 0xfc, 0xff, 0x20, 0x4b, 0xff, 0x1b, 0x00, 0xf5, 0xf1, 0x5f, 0x95, 0x01, 0x36, 0x00, 0x00,
 }
 
-func (m *QueryGetMintersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Minters.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Queries a Blacklisted by index.
+	Blacklisted(ctx context.Context, in *QueryGetBlacklistedRequest, opts ...grpc.CallOption) (*QueryGetBlacklistedResponse, error)
+	// Queries a list of Blacklisted items.
+	BlacklistedAll(ctx context.Context, in *QueryAllBlacklistedRequest, opts ...grpc.CallOption) (*QueryAllBlacklistedResponse, error)
+	// Queries a Blacklisted by its hex or base64 encoded address bytes.
+	BlacklistedByBytes(ctx context.Context, in *QueryBlacklistedByBytesRequest, opts ...grpc.CallOption) (*QueryBlacklistedByBytesResponse, error)
+	// Queries a Paused by index.
+	Paused(ctx context.Context, in *QueryGetPausedRequest, opts ...grpc.CallOption) (*QueryGetPausedResponse, error)
+	// Queries a MasterMinter by index.
+	MasterMinter(ctx context.Context, in *QueryGetMasterMinterRequest, opts ...grpc.CallOption) (*QueryGetMasterMinterResponse, error)
+	// Queries a Minters by index.
+	Minters(ctx context.Context, in *QueryGetMintersRequest, opts ...grpc.CallOption) (*QueryGetMintersResponse, error)
+	// Queries a list of Minters items.
+	MintersAll(ctx context.Context, in *QueryAllMintersRequest, opts ...grpc.CallOption) (*QueryAllMintersResponse, error)
+	// Queries a Pauser by index.
+	Pauser(ctx context.Context, in *QueryGetPauserRequest, opts ...grpc.CallOption) (*QueryGetPauserResponse, error)
+	// Queries a Blacklister by index.
+	Blacklister(ctx context.Context, in *QueryGetBlacklisterRequest, opts ...grpc.CallOption) (*QueryGetBlacklisterResponse, error)
+	// Queries a Owner by index.
+	Owner(ctx context.Context, in *QueryGetOwnerRequest, opts ...grpc.CallOption) (*QueryGetOwnerResponse, error)
+	// Queries the pending owner of a minting denom.
+	PendingOwner(ctx context.Context, in *QueryGetPendingOwnerRequest, opts ...grpc.CallOption) (*QueryGetPendingOwnerResponse, error)
+	// Queries the pending roles of a minting denom.
+	PendingRoles(ctx context.Context, in *QueryPendingRolesRequest, opts ...grpc.CallOption) (*QueryPendingRolesResponse, error)
+	// Queries a MinterController by index.
+	MinterController(ctx context.Context, in *QueryGetMinterControllerRequest, opts ...grpc.CallOption) (*QueryGetMinterControllerResponse, error)
+	// Queries a list of MinterController items.
+	MinterControllerAll(ctx context.Context, in *QueryAllMinterControllerRequest, opts ...grpc.CallOption) (*QueryAllMinterControllerResponse, error)
+	// Queries a MintingDenom by index.
+	MintingDenom(ctx context.Context, in *QueryGetMintingDenomRequest, opts ...grpc.CallOption) (*QueryGetMintingDenomResponse, error)
+	// Queries a list of MintingDenom items.
+	MintingDenomAll(ctx context.Context, in *QueryAllMintingDenomRequest, opts ...grpc.CallOption) (*QueryAllMintingDenomResponse, error)
+	// Queries the module params.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries the audit log of privileged role actions.
+	AuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error)
+	// Queries the signer set of a role.
+	SignerSet(ctx context.Context, in *QueryGetSignerSetRequest, opts ...grpc.CallOption) (*QueryGetSignerSetResponse, error)
+	// Queries an ActionProposal by id.
+	ActionProposal(ctx context.Context, in *QueryGetActionProposalRequest, opts ...grpc.CallOption) (*QueryGetActionProposalResponse, error)
+	// Queries a list of ActionProposal items.
+	ActionProposalAll(ctx context.Context, in *QueryAllActionProposalRequest, opts ...grpc.CallOption) (*QueryAllActionProposalResponse, error)
+	// Queries a QueuedAction by id.
+	QueuedAction(ctx context.Context, in *QueryGetQueuedActionRequest, opts ...grpc.CallOption) (*QueryGetQueuedActionResponse, error)
+	// Queries a list of QueuedAction items.
+	QueuedActionAll(ctx context.Context, in *QueryAllQueuedActionRequest, opts ...grpc.CallOption) (*QueryAllQueuedActionResponse, error)
+	// Queries the seized funds held in the escrow account for a minting denom.
+	EscrowBalance(ctx context.Context, in *QueryEscrowBalanceRequest, opts ...grpc.CallOption) (*QueryEscrowBalanceResponse, error)
+	// Queries the IBC channel policies of a minting denom.
+	ChannelPolicies(ctx context.Context, in *QueryChannelPoliciesRequest, opts ...grpc.CallOption) (*QueryChannelPoliciesResponse, error)
+	// Queries the IBC channel rate limits of a minting denom.
+	ChannelRateLimits(ctx context.Context, in *QueryChannelRateLimitsRequest, opts ...grpc.CallOption) (*QueryChannelRateLimitsResponse, error)
+	// Queries an Allowlister by index.
+	Allowlister(ctx context.Context, in *QueryGetAllowlisterRequest, opts ...grpc.CallOption) (*QueryGetAllowlisterResponse, error)
+	// Queries an Allowlisted by index.
+	Allowlisted(ctx context.Context, in *QueryGetAllowlistedRequest, opts ...grpc.CallOption) (*QueryGetAllowlistedResponse, error)
+	// Queries a list of Allowlisted items.
+	AllowlistedAll(ctx context.Context, in *QueryAllAllowlistedRequest, opts ...grpc.CallOption) (*QueryAllAllowlistedResponse, error)
+	// Queries whether the allowlist mode of a minting denom is enabled.
+	AllowlistMode(ctx context.Context, in *QueryAllowlistModeRequest, opts ...grpc.CallOption) (*QueryAllowlistModeResponse, error)
+	// Queries the module accounts exempted from the send restrictions.
+	ModuleExemptions(ctx context.Context, in *QueryModuleExemptionsRequest, opts ...grpc.CallOption) (*QueryModuleExemptionsResponse, error)
 }
 
-func (m *QueryAllMintersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryAllMintersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Minters) > 0 {
-		for _, e := range m.Minters {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryGetPauserRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Blacklisted(ctx context.Context, in *QueryGetBlacklistedRequest, opts ...grpc.CallOption) (*QueryGetBlacklistedResponse, error) {
+	out := new(QueryGetBlacklistedResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/Blacklisted", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryGetPauserResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) BlacklistedAll(ctx context.Context, in *QueryAllBlacklistedRequest, opts ...grpc.CallOption) (*QueryAllBlacklistedResponse, error) {
+	out := new(QueryAllBlacklistedResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/BlacklistedAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Pauser.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryGetBlacklisterRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) BlacklistedByBytes(ctx context.Context, in *QueryBlacklistedByBytesRequest, opts ...grpc.CallOption) (*QueryBlacklistedByBytesResponse, error) {
+	out := new(QueryBlacklistedByBytesResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/BlacklistedByBytes", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryGetBlacklisterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Paused(ctx context.Context, in *QueryGetPausedRequest, opts ...grpc.CallOption) (*QueryGetPausedResponse, error) {
+	out := new(QueryGetPausedResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/Paused", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Blacklister.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryGetOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) MasterMinter(ctx context.Context, in *QueryGetMasterMinterRequest, opts ...grpc.CallOption) (*QueryGetMasterMinterResponse, error) {
+	out := new(QueryGetMasterMinterResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/MasterMinter", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryGetOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Minters(ctx context.Context, in *QueryGetMintersRequest, opts ...grpc.CallOption) (*QueryGetMintersResponse, error) {
+	out := new(QueryGetMintersResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/Minters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintersAll(ctx context.Context, in *QueryAllMintersRequest, opts ...grpc.CallOption) (*QueryAllMintersResponse, error) {
+	out := new(QueryAllMintersResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/MintersAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Pauser(ctx context.Context, in *QueryGetPauserRequest, opts ...grpc.CallOption) (*QueryGetPauserResponse, error) {
+	out := new(QueryGetPauserResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/Pauser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Blacklister(ctx context.Context, in *QueryGetBlacklisterRequest, opts ...grpc.CallOption) (*QueryGetBlacklisterResponse, error) {
+	out := new(QueryGetBlacklisterResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/Blacklister", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Owner(ctx context.Context, in *QueryGetOwnerRequest, opts ...grpc.CallOption) (*QueryGetOwnerResponse, error) {
+	out := new(QueryGetOwnerResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/Owner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingOwner(ctx context.Context, in *QueryGetPendingOwnerRequest, opts ...grpc.CallOption) (*QueryGetPendingOwnerResponse, error) {
+	out := new(QueryGetPendingOwnerResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/PendingOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingRoles(ctx context.Context, in *QueryPendingRolesRequest, opts ...grpc.CallOption) (*QueryPendingRolesResponse, error) {
+	out := new(QueryPendingRolesResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/PendingRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MinterController(ctx context.Context, in *QueryGetMinterControllerRequest, opts ...grpc.CallOption) (*QueryGetMinterControllerResponse, error) {
+	out := new(QueryGetMinterControllerResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/MinterController", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MinterControllerAll(ctx context.Context, in *QueryAllMinterControllerRequest, opts ...grpc.CallOption) (*QueryAllMinterControllerResponse, error) {
+	out := new(QueryAllMinterControllerResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/MinterControllerAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintingDenom(ctx context.Context, in *QueryGetMintingDenomRequest, opts ...grpc.CallOption) (*QueryGetMintingDenomResponse, error) {
+	out := new(QueryGetMintingDenomResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/MintingDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintingDenomAll(ctx context.Context, in *QueryAllMintingDenomRequest, opts ...grpc.CallOption) (*QueryAllMintingDenomResponse, error) {
+	out := new(QueryAllMintingDenomResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/MintingDenomAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error) {
+	out := new(QueryAuditLogResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/AuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SignerSet(ctx context.Context, in *QueryGetSignerSetRequest, opts ...grpc.CallOption) (*QueryGetSignerSetResponse, error) {
+	out := new(QueryGetSignerSetResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/SignerSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ActionProposal(ctx context.Context, in *QueryGetActionProposalRequest, opts ...grpc.CallOption) (*QueryGetActionProposalResponse, error) {
+	out := new(QueryGetActionProposalResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/ActionProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ActionProposalAll(ctx context.Context, in *QueryAllActionProposalRequest, opts ...grpc.CallOption) (*QueryAllActionProposalResponse, error) {
+	out := new(QueryAllActionProposalResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/ActionProposalAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedAction(ctx context.Context, in *QueryGetQueuedActionRequest, opts ...grpc.CallOption) (*QueryGetQueuedActionResponse, error) {
+	out := new(QueryGetQueuedActionResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/QueuedAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedActionAll(ctx context.Context, in *QueryAllQueuedActionRequest, opts ...grpc.CallOption) (*QueryAllQueuedActionResponse, error) {
+	out := new(QueryAllQueuedActionResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/QueuedActionAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EscrowBalance(ctx context.Context, in *QueryEscrowBalanceRequest, opts ...grpc.CallOption) (*QueryEscrowBalanceResponse, error) {
+	out := new(QueryEscrowBalanceResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/EscrowBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelPolicies(ctx context.Context, in *QueryChannelPoliciesRequest, opts ...grpc.CallOption) (*QueryChannelPoliciesResponse, error) {
+	out := new(QueryChannelPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/ChannelPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelRateLimits(ctx context.Context, in *QueryChannelRateLimitsRequest, opts ...grpc.CallOption) (*QueryChannelRateLimitsResponse, error) {
+	out := new(QueryChannelRateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/ChannelRateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Allowlister(ctx context.Context, in *QueryGetAllowlisterRequest, opts ...grpc.CallOption) (*QueryGetAllowlisterResponse, error) {
+	out := new(QueryGetAllowlisterResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/Allowlister", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Allowlisted(ctx context.Context, in *QueryGetAllowlistedRequest, opts ...grpc.CallOption) (*QueryGetAllowlistedResponse, error) {
+	out := new(QueryGetAllowlistedResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/Allowlisted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowlistedAll(ctx context.Context, in *QueryAllAllowlistedRequest, opts ...grpc.CallOption) (*QueryAllAllowlistedResponse, error) {
+	out := new(QueryAllAllowlistedResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/AllowlistedAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowlistMode(ctx context.Context, in *QueryAllowlistModeRequest, opts ...grpc.CallOption) (*QueryAllowlistModeResponse, error) {
+	out := new(QueryAllowlistModeResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/AllowlistMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModuleExemptions(ctx context.Context, in *QueryModuleExemptionsRequest, opts ...grpc.CallOption) (*QueryModuleExemptionsResponse, error) {
+	out := new(QueryModuleExemptionsResponse)
+	err := c.cc.Invoke(ctx, "/circle.fiattokenfactory.v1.Query/ModuleExemptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Queries a Blacklisted by index.
+	Blacklisted(context.Context, *QueryGetBlacklistedRequest) (*QueryGetBlacklistedResponse, error)
+	// Queries a list of Blacklisted items.
+	BlacklistedAll(context.Context, *QueryAllBlacklistedRequest) (*QueryAllBlacklistedResponse, error)
+	// Queries a Blacklisted by its hex or base64 encoded address bytes.
+	BlacklistedByBytes(context.Context, *QueryBlacklistedByBytesRequest) (*QueryBlacklistedByBytesResponse, error)
+	// Queries a Paused by index.
+	Paused(context.Context, *QueryGetPausedRequest) (*QueryGetPausedResponse, error)
+	// Queries a MasterMinter by index.
+	MasterMinter(context.Context, *QueryGetMasterMinterRequest) (*QueryGetMasterMinterResponse, error)
+	// Queries a Minters by index.
+	Minters(context.Context, *QueryGetMintersRequest) (*QueryGetMintersResponse, error)
+	// Queries a list of Minters items.
+	MintersAll(context.Context, *QueryAllMintersRequest) (*QueryAllMintersResponse, error)
+	// Queries a Pauser by index.
+	Pauser(context.Context, *QueryGetPauserRequest) (*QueryGetPauserResponse, error)
+	// Queries a Blacklister by index.
+	Blacklister(context.Context, *QueryGetBlacklisterRequest) (*QueryGetBlacklisterResponse, error)
+	// Queries a Owner by index.
+	Owner(context.Context, *QueryGetOwnerRequest) (*QueryGetOwnerResponse, error)
+	// Queries the pending owner of a minting denom.
+	PendingOwner(context.Context, *QueryGetPendingOwnerRequest) (*QueryGetPendingOwnerResponse, error)
+	// Queries the pending roles of a minting denom.
+	PendingRoles(context.Context, *QueryPendingRolesRequest) (*QueryPendingRolesResponse, error)
+	// Queries a MinterController by index.
+	MinterController(context.Context, *QueryGetMinterControllerRequest) (*QueryGetMinterControllerResponse, error)
+	// Queries a list of MinterController items.
+	MinterControllerAll(context.Context, *QueryAllMinterControllerRequest) (*QueryAllMinterControllerResponse, error)
+	// Queries a MintingDenom by index.
+	MintingDenom(context.Context, *QueryGetMintingDenomRequest) (*QueryGetMintingDenomResponse, error)
+	// Queries a list of MintingDenom items.
+	MintingDenomAll(context.Context, *QueryAllMintingDenomRequest) (*QueryAllMintingDenomResponse, error)
+	// Queries the module params.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries the audit log of privileged role actions.
+	AuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error)
+	// Queries the signer set of a role.
+	SignerSet(context.Context, *QueryGetSignerSetRequest) (*QueryGetSignerSetResponse, error)
+	// Queries an ActionProposal by id.
+	ActionProposal(context.Context, *QueryGetActionProposalRequest) (*QueryGetActionProposalResponse, error)
+	// Queries a list of ActionProposal items.
+	ActionProposalAll(context.Context, *QueryAllActionProposalRequest) (*QueryAllActionProposalResponse, error)
+	// Queries a QueuedAction by id.
+	QueuedAction(context.Context, *QueryGetQueuedActionRequest) (*QueryGetQueuedActionResponse, error)
+	// Queries a list of QueuedAction items.
+	QueuedActionAll(context.Context, *QueryAllQueuedActionRequest) (*QueryAllQueuedActionResponse, error)
+	// Queries the seized funds held in the escrow account for a minting denom.
+	EscrowBalance(context.Context, *QueryEscrowBalanceRequest) (*QueryEscrowBalanceResponse, error)
+	// Queries the IBC channel policies of a minting denom.
+	ChannelPolicies(context.Context, *QueryChannelPoliciesRequest) (*QueryChannelPoliciesResponse, error)
+	// Queries the IBC channel rate limits of a minting denom.
+	ChannelRateLimits(context.Context, *QueryChannelRateLimitsRequest) (*QueryChannelRateLimitsResponse, error)
+	// Queries an Allowlister by index.
+	Allowlister(context.Context, *QueryGetAllowlisterRequest) (*QueryGetAllowlisterResponse, error)
+	// Queries an Allowlisted by index.
+	Allowlisted(context.Context, *QueryGetAllowlistedRequest) (*QueryGetAllowlistedResponse, error)
+	// Queries a list of Allowlisted items.
+	AllowlistedAll(context.Context, *QueryAllAllowlistedRequest) (*QueryAllAllowlistedResponse, error)
+	// Queries whether the allowlist mode of a minting denom is enabled.
+	AllowlistMode(context.Context, *QueryAllowlistModeRequest) (*QueryAllowlistModeResponse, error)
+	// Queries the module accounts exempted from the send restrictions.
+	ModuleExemptions(context.Context, *QueryModuleExemptionsRequest) (*QueryModuleExemptionsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Blacklisted(ctx context.Context, req *QueryGetBlacklistedRequest) (*QueryGetBlacklistedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Blacklisted not implemented")
+}
+func (*UnimplementedQueryServer) BlacklistedAll(ctx context.Context, req *QueryAllBlacklistedRequest) (*QueryAllBlacklistedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlacklistedAll not implemented")
+}
+func (*UnimplementedQueryServer) BlacklistedByBytes(ctx context.Context, req *QueryBlacklistedByBytesRequest) (*QueryBlacklistedByBytesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlacklistedByBytes not implemented")
+}
+func (*UnimplementedQueryServer) Paused(ctx context.Context, req *QueryGetPausedRequest) (*QueryGetPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Paused not implemented")
+}
+func (*UnimplementedQueryServer) MasterMinter(ctx context.Context, req *QueryGetMasterMinterRequest) (*QueryGetMasterMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MasterMinter not implemented")
+}
+func (*UnimplementedQueryServer) Minters(ctx context.Context, req *QueryGetMintersRequest) (*QueryGetMintersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Minters not implemented")
+}
+func (*UnimplementedQueryServer) MintersAll(ctx context.Context, req *QueryAllMintersRequest) (*QueryAllMintersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintersAll not implemented")
+}
+func (*UnimplementedQueryServer) Pauser(ctx context.Context, req *QueryGetPauserRequest) (*QueryGetPauserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pauser not implemented")
+}
+func (*UnimplementedQueryServer) Blacklister(ctx context.Context, req *QueryGetBlacklisterRequest) (*QueryGetBlacklisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Blacklister not implemented")
+}
+func (*UnimplementedQueryServer) Owner(ctx context.Context, req *QueryGetOwnerRequest) (*QueryGetOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Owner not implemented")
+}
+func (*UnimplementedQueryServer) PendingOwner(ctx context.Context, req *QueryGetPendingOwnerRequest) (*QueryGetPendingOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingOwner not implemented")
+}
+func (*UnimplementedQueryServer) PendingRoles(ctx context.Context, req *QueryPendingRolesRequest) (*QueryPendingRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRoles not implemented")
+}
+func (*UnimplementedQueryServer) MinterController(ctx context.Context, req *QueryGetMinterControllerRequest) (*QueryGetMinterControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinterController not implemented")
+}
+func (*UnimplementedQueryServer) MinterControllerAll(ctx context.Context, req *QueryAllMinterControllerRequest) (*QueryAllMinterControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinterControllerAll not implemented")
+}
+func (*UnimplementedQueryServer) MintingDenom(ctx context.Context, req *QueryGetMintingDenomRequest) (*QueryGetMintingDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintingDenom not implemented")
+}
+func (*UnimplementedQueryServer) MintingDenomAll(ctx context.Context, req *QueryAllMintingDenomRequest) (*QueryAllMintingDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintingDenomAll not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) AuditLog(ctx context.Context, req *QueryAuditLogRequest) (*QueryAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditLog not implemented")
+}
+func (*UnimplementedQueryServer) SignerSet(ctx context.Context, req *QueryGetSignerSetRequest) (*QueryGetSignerSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignerSet not implemented")
+}
+func (*UnimplementedQueryServer) ActionProposal(ctx context.Context, req *QueryGetActionProposalRequest) (*QueryGetActionProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActionProposal not implemented")
+}
+func (*UnimplementedQueryServer) ActionProposalAll(ctx context.Context, req *QueryAllActionProposalRequest) (*QueryAllActionProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActionProposalAll not implemented")
+}
+func (*UnimplementedQueryServer) QueuedAction(ctx context.Context, req *QueryGetQueuedActionRequest) (*QueryGetQueuedActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedAction not implemented")
+}
+func (*UnimplementedQueryServer) QueuedActionAll(ctx context.Context, req *QueryAllQueuedActionRequest) (*QueryAllQueuedActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedActionAll not implemented")
+}
+func (*UnimplementedQueryServer) EscrowBalance(ctx context.Context, req *QueryEscrowBalanceRequest) (*QueryEscrowBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowBalance not implemented")
+}
+func (*UnimplementedQueryServer) ChannelPolicies(ctx context.Context, req *QueryChannelPoliciesRequest) (*QueryChannelPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelPolicies not implemented")
+}
+func (*UnimplementedQueryServer) ChannelRateLimits(ctx context.Context, req *QueryChannelRateLimitsRequest) (*QueryChannelRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelRateLimits not implemented")
+}
+func (*UnimplementedQueryServer) Allowlister(ctx context.Context, req *QueryGetAllowlisterRequest) (*QueryGetAllowlisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Allowlister not implemented")
+}
+func (*UnimplementedQueryServer) Allowlisted(ctx context.Context, req *QueryGetAllowlistedRequest) (*QueryGetAllowlistedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Allowlisted not implemented")
+}
+func (*UnimplementedQueryServer) AllowlistedAll(ctx context.Context, req *QueryAllAllowlistedRequest) (*QueryAllAllowlistedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowlistedAll not implemented")
+}
+func (*UnimplementedQueryServer) AllowlistMode(ctx context.Context, req *QueryAllowlistModeRequest) (*QueryAllowlistModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowlistMode not implemented")
+}
+func (*UnimplementedQueryServer) ModuleExemptions(ctx context.Context, req *QueryModuleExemptionsRequest) (*QueryModuleExemptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleExemptions not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Blacklisted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetBlacklistedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Blacklisted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/Blacklisted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Blacklisted(ctx, req.(*QueryGetBlacklistedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlacklistedAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBlacklistedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlacklistedAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/BlacklistedAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlacklistedAll(ctx, req.(*QueryAllBlacklistedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlacklistedByBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlacklistedByBytesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlacklistedByBytes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/BlacklistedByBytes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlacklistedByBytes(ctx, req.(*QueryBlacklistedByBytesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Paused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Paused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/Paused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Paused(ctx, req.(*QueryGetPausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MasterMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMasterMinterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MasterMinter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/MasterMinter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MasterMinter(ctx, req.(*QueryGetMasterMinterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Minters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMintersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Minters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/Minters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Minters(ctx, req.(*QueryGetMintersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintersAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMintersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintersAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/MintersAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintersAll(ctx, req.(*QueryAllMintersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Pauser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPauserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Pauser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/Pauser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pauser(ctx, req.(*QueryGetPauserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Blacklister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetBlacklisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Blacklister(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/Blacklister",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Blacklister(ctx, req.(*QueryGetBlacklisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Owner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Owner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/Owner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Owner(ctx, req.(*QueryGetOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPendingOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/PendingOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingOwner(ctx, req.(*QueryGetPendingOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/PendingRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingRoles(ctx, req.(*QueryPendingRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MinterController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMinterControllerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinterController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/MinterController",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinterController(ctx, req.(*QueryGetMinterControllerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MinterControllerAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMinterControllerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinterControllerAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/MinterControllerAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinterControllerAll(ctx, req.(*QueryAllMinterControllerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintingDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMintingDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintingDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/MintingDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintingDenom(ctx, req.(*QueryGetMintingDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintingDenomAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMintingDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintingDenomAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/MintingDenomAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintingDenomAll(ctx, req.(*QueryAllMintingDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/AuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuditLog(ctx, req.(*QueryAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignerSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSignerSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignerSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/SignerSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignerSet(ctx, req.(*QueryGetSignerSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ActionProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetActionProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActionProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/ActionProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActionProposal(ctx, req.(*QueryGetActionProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ActionProposalAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllActionProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActionProposalAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/ActionProposalAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActionProposalAll(ctx, req.(*QueryAllActionProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetQueuedActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/QueuedAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedAction(ctx, req.(*QueryGetQueuedActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedActionAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllQueuedActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedActionAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/QueuedActionAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedActionAll(ctx, req.(*QueryAllQueuedActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EscrowBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/EscrowBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowBalance(ctx, req.(*QueryEscrowBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/ChannelPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelPolicies(ctx, req.(*QueryChannelPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelRateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelRateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/ChannelRateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelRateLimits(ctx, req.(*QueryChannelRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Allowlister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAllowlisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Allowlister(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/Allowlister",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Allowlister(ctx, req.(*QueryGetAllowlisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Allowlisted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAllowlistedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Allowlisted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/Allowlisted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Allowlisted(ctx, req.(*QueryGetAllowlistedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowlistedAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAllowlistedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowlistedAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/AllowlistedAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowlistedAll(ctx, req.(*QueryAllAllowlistedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowlistMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowlistModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowlistMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/AllowlistMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowlistMode(ctx, req.(*QueryAllowlistModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModuleExemptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleExemptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleExemptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.fiattokenfactory.v1.Query/ModuleExemptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleExemptions(ctx, req.(*QueryModuleExemptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "circle.fiattokenfactory.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Blacklisted",
+			Handler:    _Query_Blacklisted_Handler,
+		},
+		{
+			MethodName: "BlacklistedAll",
+			Handler:    _Query_BlacklistedAll_Handler,
+		},
+		{
+			MethodName: "BlacklistedByBytes",
+			Handler:    _Query_BlacklistedByBytes_Handler,
+		},
+		{
+			MethodName: "Paused",
+			Handler:    _Query_Paused_Handler,
+		},
+		{
+			MethodName: "MasterMinter",
+			Handler:    _Query_MasterMinter_Handler,
+		},
+		{
+			MethodName: "Minters",
+			Handler:    _Query_Minters_Handler,
+		},
+		{
+			MethodName: "MintersAll",
+			Handler:    _Query_MintersAll_Handler,
+		},
+		{
+			MethodName: "Pauser",
+			Handler:    _Query_Pauser_Handler,
+		},
+		{
+			MethodName: "Blacklister",
+			Handler:    _Query_Blacklister_Handler,
+		},
+		{
+			MethodName: "Owner",
+			Handler:    _Query_Owner_Handler,
+		},
+		{
+			MethodName: "PendingOwner",
+			Handler:    _Query_PendingOwner_Handler,
+		},
+		{
+			MethodName: "PendingRoles",
+			Handler:    _Query_PendingRoles_Handler,
+		},
+		{
+			MethodName: "MinterController",
+			Handler:    _Query_MinterController_Handler,
+		},
+		{
+			MethodName: "MinterControllerAll",
+			Handler:    _Query_MinterControllerAll_Handler,
+		},
+		{
+			MethodName: "MintingDenom",
+			Handler:    _Query_MintingDenom_Handler,
+		},
+		{
+			MethodName: "MintingDenomAll",
+			Handler:    _Query_MintingDenomAll_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "AuditLog",
+			Handler:    _Query_AuditLog_Handler,
+		},
+		{
+			MethodName: "SignerSet",
+			Handler:    _Query_SignerSet_Handler,
+		},
+		{
+			MethodName: "ActionProposal",
+			Handler:    _Query_ActionProposal_Handler,
+		},
+		{
+			MethodName: "ActionProposalAll",
+			Handler:    _Query_ActionProposalAll_Handler,
+		},
+		{
+			MethodName: "QueuedAction",
+			Handler:    _Query_QueuedAction_Handler,
+		},
+		{
+			MethodName: "QueuedActionAll",
+			Handler:    _Query_QueuedActionAll_Handler,
+		},
+		{
+			MethodName: "EscrowBalance",
+			Handler:    _Query_EscrowBalance_Handler,
+		},
+		{
+			MethodName: "ChannelPolicies",
+			Handler:    _Query_ChannelPolicies_Handler,
+		},
+		{
+			MethodName: "ChannelRateLimits",
+			Handler:    _Query_ChannelRateLimits_Handler,
+		},
+		{
+			MethodName: "Allowlister",
+			Handler:    _Query_Allowlister_Handler,
+		},
+		{
+			MethodName: "Allowlisted",
+			Handler:    _Query_Allowlisted_Handler,
+		},
+		{
+			MethodName: "AllowlistedAll",
+			Handler:    _Query_AllowlistedAll_Handler,
+		},
+		{
+			MethodName: "AllowlistMode",
+			Handler:    _Query_AllowlistMode_Handler,
+		},
+		{
+			MethodName: "ModuleExemptions",
+			Handler:    _Query_ModuleExemptions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "circle/fiattokenfactory/v1/query.proto",
+}
+
+func (m *QueryGetBlacklistedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBlacklistedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBlacklistedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hrps) > 0 {
+		for iNdEx := len(m.Hrps) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Hrps[iNdEx])
+			copy(dAtA[i:], m.Hrps[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Hrps[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBlacklistedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBlacklistedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBlacklistedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Address.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Blacklisted.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBlacklistedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBlacklistedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlacklistedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hrps) > 0 {
+		for iNdEx := len(m.Hrps) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Hrps[iNdEx])
+			copy(dAtA[i:], m.Hrps[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Hrps[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBlacklistedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBlacklistedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlacklistedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Addresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Blacklisted) > 0 {
+		for iNdEx := len(m.Blacklisted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blacklisted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlacklistedByBytesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlacklistedByBytesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlacklistedByBytesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hrps) > 0 {
+		for iNdEx := len(m.Hrps) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Hrps[iNdEx])
+			copy(dAtA[i:], m.Hrps[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Hrps[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlacklistedByBytesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlacklistedByBytesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlacklistedByBytesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Address.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Blacklisted.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPausedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPausedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPausedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Paused.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMasterMinterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMasterMinterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMasterMinterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMasterMinterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMasterMinterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMasterMinterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MasterMinter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMintersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMintersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMintersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMintersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMintersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMintersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowRemaining != nil {
+		{
+			size, err := m.WindowRemaining.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Minters.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMintersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMintersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMintersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMintersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMintersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMintersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPauserRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPauserRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPauserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPauserResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPauserResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPauserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pauser.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBlacklisterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBlacklisterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBlacklisterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBlacklisterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBlacklisterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBlacklisterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Blacklister.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Owner.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPendingOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPendingOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPendingOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPendingOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPendingOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPendingOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PendingOwner.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingRolesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRolesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRolesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingRolesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRolesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRolesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingRoles) > 0 {
+		for iNdEx := len(m.PendingRoles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRoles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMinterControllerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMinterControllerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMinterControllerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ControllerAddress) > 0 {
+		i -= len(m.ControllerAddress)
+		copy(dAtA[i:], m.ControllerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ControllerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMinterControllerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMinterControllerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMinterControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MinterController.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMinterControllerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMinterControllerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMinterControllerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMinterControllerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMinterControllerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMinterControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MinterController) > 0 {
+		for iNdEx := len(m.MinterController) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinterController[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMintingDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMintingDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMintingDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMintingDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMintingDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMintingDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MintingDenom.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMintingDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMintingDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMintingDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMintingDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMintingDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMintingDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MintingDenom) > 0 {
+		for iNdEx := len(m.MintingDenom) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintingDenom[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuditLogRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuditLogRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuditLogRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MinHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuditLogResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuditLogResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuditLogResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSignerSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSignerSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSignerSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSignerSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSignerSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSignerSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SignerSet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetActionProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetActionProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetActionProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetActionProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetActionProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetActionProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ActionProposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllActionProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllActionProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllActionProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllActionProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllActionProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllActionProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ActionProposal) > 0 {
+		for iNdEx := len(m.ActionProposal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActionProposal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetQueuedActionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetQueuedActionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetQueuedActionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetQueuedActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetQueuedActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetQueuedActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.QueuedAction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllQueuedActionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllQueuedActionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllQueuedActionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllQueuedActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllQueuedActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllQueuedActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.QueuedAction) > 0 {
+		for iNdEx := len(m.QueuedAction) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedAction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Escrow) > 0 {
+		i -= len(m.Escrow)
+		copy(dAtA[i:], m.Escrow)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Escrow)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelPoliciesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelPoliciesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelPoliciesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelPoliciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelPoliciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelPoliciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelPolicies) > 0 {
+		for iNdEx := len(m.ChannelPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelRateLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelRateLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelRateLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelRateLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelRateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelRateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelRateLimits) > 0 {
+		for iNdEx := len(m.ChannelRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAllowlisterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllowlisterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllowlisterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAllowlisterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllowlisterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllowlisterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Allowlister.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAllowlistedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllowlistedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllowlistedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAllowlistedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllowlistedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllowlistedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Allowlisted.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAllowlistedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAllowlistedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAllowlistedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAllowlistedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAllowlistedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAllowlistedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Allowlisted) > 0 {
+		for iNdEx := len(m.Allowlisted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowlisted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowlistModeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowlistModeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowlistModeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowlistModeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowlistModeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowlistModeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleExemptionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleExemptionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleExemptionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleExemptionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleExemptionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleExemptionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Exemptions) > 0 {
+		for iNdEx := len(m.Exemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Exemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGetBlacklistedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Hrps) > 0 {
+		for _, s := range m.Hrps {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetBlacklistedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Blacklisted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Address.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllBlacklistedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Hrps) > 0 {
+		for _, s := range m.Hrps {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllBlacklistedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blacklisted) > 0 {
+		for _, e := range m.Blacklisted {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, e := range m.Addresses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBlacklistedByBytesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Hrps) > 0 {
+		for _, s := range m.Hrps {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBlacklistedByBytesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Blacklisted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Address.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetPausedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Paused.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetMasterMinterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMasterMinterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MasterMinter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetMintersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMintersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Minters.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.WindowRemaining != nil {
+		l = m.WindowRemaining.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMintersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMintersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Minters) > 0 {
+		for _, e := range m.Minters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPauserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPauserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pauser.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetBlacklisterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetBlacklisterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Blacklister.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Owner.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetPendingOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPendingOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PendingOwner.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPendingRolesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingRolesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingRoles) > 0 {
+		for _, e := range m.PendingRoles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMinterControllerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ControllerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMinterControllerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinterController.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllMinterControllerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMinterControllerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinterController) > 0 {
+		for _, e := range m.MinterController {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMintingDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMintingDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MintingDenom.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllMintingDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMintingDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MintingDenom) > 0 {
+		for _, e := range m.MintingDenom {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAuditLogRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MinHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinHeight))
+	}
+	if m.MaxHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxHeight))
+	}
+	return n
+}
+
+func (m *QueryAuditLogResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSignerSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSignerSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SignerSet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetActionProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetActionProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ActionProposal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllActionProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllActionProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ActionProposal) > 0 {
+		for _, e := range m.ActionProposal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetQueuedActionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetQueuedActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.QueuedAction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllQueuedActionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllQueuedActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.QueuedAction) > 0 {
+		for _, e := range m.QueuedAction {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Escrow)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryChannelPoliciesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelPoliciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChannelPolicies) > 0 {
+		for _, e := range m.ChannelPolicies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelRateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelRateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChannelRateLimits) > 0 {
+		for _, e := range m.ChannelRateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllowlisterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllowlisterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Allowlister.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetAllowlistedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllowlistedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Allowlisted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllAllowlistedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllAllowlistedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowlisted) > 0 {
+		for _, e := range m.Allowlisted {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowlistModeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowlistModeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryModuleExemptionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryModuleExemptionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Exemptions) > 0 {
+		for _, e := range m.Exemptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGetBlacklistedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetBlacklistedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetBlacklistedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hrps", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hrps = append(m.Hrps, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetBlacklistedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetBlacklistedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetBlacklistedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blacklisted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Blacklisted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllBlacklistedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllBlacklistedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllBlacklistedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hrps", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hrps = append(m.Hrps, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllBlacklistedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllBlacklistedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllBlacklistedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blacklisted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blacklisted = append(m.Blacklisted, Blacklisted{})
+			if err := m.Blacklisted[len(m.Blacklisted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, AddressRendering{})
+			if err := m.Addresses[len(m.Addresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlacklistedByBytesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlacklistedByBytesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlacklistedByBytesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hrps", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hrps = append(m.Hrps, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlacklistedByBytesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlacklistedByBytesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlacklistedByBytesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blacklisted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Blacklisted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPausedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPausedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPausedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPausedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPausedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Paused.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMasterMinterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMasterMinterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMasterMinterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMasterMinterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMasterMinterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMasterMinterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterMinter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MasterMinter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMintersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMintersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMintersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMintersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMintersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMintersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowRemaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WindowRemaining == nil {
+				m.WindowRemaining = &types.Coin{}
+			}
+			if err := m.WindowRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMintersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMintersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMintersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMintersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMintersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMintersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minters = append(m.Minters, Minters{})
+			if err := m.Minters[len(m.Minters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPauserRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPauserRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPauserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPauserResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPauserResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPauserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pauser", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pauser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetBlacklisterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetBlacklisterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetBlacklisterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetBlacklisterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetBlacklisterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetBlacklisterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blacklister", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Blacklister.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPendingOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPendingOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingOwner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingRolesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingRolesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingRolesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingRolesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingRolesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingRolesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRoles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRoles = append(m.PendingRoles, PendingRole{})
+			if err := m.PendingRoles[len(m.PendingRoles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMinterControllerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMinterControllerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMinterControllerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ControllerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMinterControllerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMinterControllerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMinterControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterController", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinterController.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMinterControllerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMinterControllerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMinterControllerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMinterControllerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMinterControllerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMinterControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterController", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterController = append(m.MinterController, MinterController{})
+			if err := m.MinterController[len(m.MinterController)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMintingDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMintingDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMintingDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMintingDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMintingDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMintingDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingDenom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintingDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMintingDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMintingDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMintingDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMintingDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMintingDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMintingDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingDenom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintingDenom = append(m.MintingDenom, MintingDenom{})
+			if err := m.MintingDenom[len(m.MintingDenom)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuditLogRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuditLogRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuditLogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHeight", wireType)
+			}
+			m.MinHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeight", wireType)
+			}
+			m.MaxHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuditLogResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuditLogResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuditLogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, AuditEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSignerSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSignerSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSignerSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.Owner.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func (m *QueryGetMinterControllerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ControllerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryGetMinterControllerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetSignerSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSignerSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSignerSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SignerSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.MinterController.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func (m *QueryAllMinterControllerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryAllMinterControllerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.MinterController) > 0 {
-		for _, e := range m.MinterController {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetActionProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetActionProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetActionProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryGetMintingDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryGetMintingDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.MintingDenom.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryGetBlacklistedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetActionProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2770,17 +11946,17 @@ func (m *QueryGetBlacklistedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetBlacklistedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetActionProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetBlacklistedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetActionProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionProposal", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2790,23 +11966,24 @@ func (m *QueryGetBlacklistedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.ActionProposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2829,7 +12006,7 @@ func (m *QueryGetBlacklistedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetBlacklistedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllActionProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2852,15 +12029,15 @@ func (m *QueryGetBlacklistedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetBlacklistedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllActionProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetBlacklistedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllActionProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blacklisted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2887,7 +12064,10 @@ func (m *QueryGetBlacklistedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Blacklisted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2912,7 +12092,7 @@ func (m *QueryGetBlacklistedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBlacklistedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllActionProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2935,13 +12115,47 @@ func (m *QueryAllBlacklistedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBlacklistedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllActionProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBlacklistedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllActionProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionProposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionProposal = append(m.ActionProposal, ActionProposal{})
+			if err := m.ActionProposal[len(m.ActionProposal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2971,7 +12185,7 @@ func (m *QueryAllBlacklistedRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2998,7 +12212,7 @@ func (m *QueryAllBlacklistedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBlacklistedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetQueuedActionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3021,17 +12235,17 @@ func (m *QueryAllBlacklistedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBlacklistedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetQueuedActionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBlacklistedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetQueuedActionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blacklisted", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3041,29 +12255,64 @@ func (m *QueryAllBlacklistedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Blacklisted = append(m.Blacklisted, Blacklisted{})
-			if err := m.Blacklisted[len(m.Blacklisted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetQueuedActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 2:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetQueuedActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetQueuedActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedAction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3090,10 +12339,7 @@ func (m *QueryAllBlacklistedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QueuedAction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3118,7 +12364,7 @@ func (m *QueryAllBlacklistedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPausedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllQueuedActionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3141,12 +12387,48 @@ func (m *QueryGetPausedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPausedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllQueuedActionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPausedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllQueuedActionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3168,7 +12450,7 @@ func (m *QueryGetPausedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPausedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllQueuedActionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3191,15 +12473,15 @@ func (m *QueryGetPausedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPausedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllQueuedActionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllQueuedActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedAction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3226,7 +12508,44 @@ func (m *QueryGetPausedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Paused.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.QueuedAction = append(m.QueuedAction, QueuedAction{})
+			if err := m.QueuedAction[len(m.QueuedAction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3251,7 +12570,7 @@ func (m *QueryGetPausedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMasterMinterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEscrowBalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3274,12 +12593,44 @@ func (m *QueryGetMasterMinterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMasterMinterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEscrowBalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMasterMinterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEscrowBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3301,7 +12652,7 @@ func (m *QueryGetMasterMinterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMasterMinterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEscrowBalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3324,15 +12675,47 @@ func (m *QueryGetMasterMinterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMasterMinterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEscrowBalanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMasterMinterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEscrowBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterMinter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrow = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3359,7 +12742,7 @@ func (m *QueryGetMasterMinterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MasterMinter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3384,7 +12767,7 @@ func (m *QueryGetMasterMinterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMintersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryChannelPoliciesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3407,15 +12790,15 @@ func (m *QueryGetMintersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMintersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChannelPoliciesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMintersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChannelPoliciesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3443,7 +12826,43 @@ func (m *QueryGetMintersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3466,7 +12885,7 @@ func (m *QueryGetMintersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMintersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryChannelPoliciesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3489,15 +12908,15 @@ func (m *QueryGetMintersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMintersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChannelPoliciesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMintersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChannelPoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelPolicies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3524,7 +12943,44 @@ func (m *QueryGetMintersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Minters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChannelPolicies = append(m.ChannelPolicies, ChannelPolicy{})
+			if err := m.ChannelPolicies[len(m.ChannelPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3549,7 +13005,7 @@ func (m *QueryGetMintersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMintersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryChannelRateLimitsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3572,13 +13028,45 @@ func (m *QueryAllMintersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMintersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChannelRateLimitsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMintersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChannelRateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -3635,7 +13123,7 @@ func (m *QueryAllMintersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMintersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryChannelRateLimitsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3658,15 +13146,15 @@ func (m *QueryAllMintersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMintersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChannelRateLimitsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMintersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChannelRateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelRateLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3693,8 +13181,8 @@ func (m *QueryAllMintersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Minters = append(m.Minters, Minters{})
-			if err := m.Minters[len(m.Minters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChannelRateLimits = append(m.ChannelRateLimits, ChannelRateLimit{})
+			if err := m.ChannelRateLimits[len(m.ChannelRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3755,7 +13243,7 @@ func (m *QueryAllMintersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPauserRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAllowlisterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3778,12 +13266,44 @@ func (m *QueryGetPauserRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPauserRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAllowlisterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPauserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAllowlisterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3805,7 +13325,7 @@ func (m *QueryGetPauserRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPauserResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAllowlisterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3828,15 +13348,15 @@ func (m *QueryGetPauserResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPauserResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAllowlisterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPauserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAllowlisterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pauser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlister", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3863,7 +13383,7 @@ func (m *QueryGetPauserResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pauser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Allowlister.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3888,7 +13408,7 @@ func (m *QueryGetPauserResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetBlacklisterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAllowlistedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3911,67 +13431,49 @@ func (m *QueryGetBlacklisterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetBlacklisterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAllowlistedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetBlacklisterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAllowlistedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetBlacklisterResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetBlacklisterResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetBlacklisterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blacklister", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3981,24 +13483,23 @@ func (m *QueryGetBlacklisterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Blacklister.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4021,57 +13522,7 @@ func (m *QueryGetBlacklisterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetOwnerRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOwnerRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAllowlistedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4094,15 +13545,15 @@ func (m *QueryGetOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAllowlistedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAllowlistedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlisted", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4129,7 +13580,7 @@ func (m *QueryGetOwnerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Allowlisted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4154,7 +13605,7 @@ func (m *QueryGetOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMinterControllerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllAllowlistedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4177,15 +13628,51 @@ func (m *QueryGetMinterControllerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMinterControllerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllAllowlistedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMinterControllerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllAllowlistedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ControllerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4213,7 +13700,7 @@ func (m *QueryGetMinterControllerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ControllerAddress = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4236,7 +13723,7 @@ func (m *QueryGetMinterControllerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMinterControllerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllAllowlistedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4259,15 +13746,15 @@ func (m *QueryGetMinterControllerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMinterControllerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllAllowlistedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMinterControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllAllowlistedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinterController", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlisted", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4294,7 +13781,44 @@ func (m *QueryGetMinterControllerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinterController.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Allowlisted = append(m.Allowlisted, Allowlisted{})
+			if err := m.Allowlisted[len(m.Allowlisted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4319,7 +13843,7 @@ func (m *QueryGetMinterControllerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMinterControllerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowlistModeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4342,17 +13866,17 @@ func (m *QueryAllMinterControllerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMinterControllerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowlistModeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMinterControllerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowlistModeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4362,27 +13886,23 @@ func (m *QueryAllMinterControllerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4405,7 +13925,7 @@ func (m *QueryAllMinterControllerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMinterControllerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowlistModeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4428,51 +13948,17 @@ func (m *QueryAllMinterControllerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMinterControllerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowlistModeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMinterControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowlistModeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinterController", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MinterController = append(m.MinterController, MinterController{})
-			if err := m.MinterController[len(m.MinterController)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4482,28 +13968,12 @@ func (m *QueryAllMinterControllerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4525,7 +13995,7 @@ func (m *QueryAllMinterControllerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMintingDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleExemptionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4548,10 +14018,10 @@ func (m *QueryGetMintingDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMintingDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleExemptionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMintingDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleExemptionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4575,7 +14045,7 @@ func (m *QueryGetMintingDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMintingDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleExemptionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4598,15 +14068,15 @@ func (m *QueryGetMintingDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMintingDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleExemptionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMintingDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleExemptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintingDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exemptions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4633,10 +14103,43 @@ func (m *QueryGetMintingDenomResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MintingDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Exemptions = append(m.Exemptions, ModuleExemption{})
+			if err := m.Exemptions[len(m.Exemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
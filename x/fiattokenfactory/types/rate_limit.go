@@ -0,0 +1,201 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// RateLimitBuckets is the number of buckets a rate limit window is split
+// into. Usage is tracked per bucket and leaves the window one bucket at a
+// time, so the window slides in steps of a tenth of its length.
+const RateLimitBuckets = 10
+
+// NewRateLimit returns a rate limit without any usage.
+func NewRateLimit(limit math.Int, windowBlocks int64, windowDuration time.Duration) *RateLimit {
+	return &RateLimit{
+		Limit:          limit,
+		WindowBlocks:   windowBlocks,
+		WindowDuration: windowDuration,
+	}
+}
+
+// ValidateRateLimitConfig checks that a rate limit has a positive limit and
+// exactly one window length.
+func ValidateRateLimitConfig(limit math.Int, windowBlocks int64, windowDuration time.Duration) error {
+	if limit.IsNil() || !limit.IsPositive() {
+		return errors.Wrap(ErrInvalidRateLimit, "limit must be positive")
+	}
+
+	return validateRateLimitWindow(windowBlocks, windowDuration)
+}
+
+// validateRateLimitWindow checks that exactly one window length is set.
+func validateRateLimitWindow(windowBlocks int64, windowDuration time.Duration) error {
+	if windowBlocks < 0 || windowDuration < 0 {
+		return errors.Wrap(ErrInvalidRateLimit, "window cannot be negative")
+	}
+
+	if (windowBlocks > 0) == (windowDuration > 0) {
+		return errors.Wrap(ErrInvalidRateLimit, "exactly one of window blocks and window duration must be set")
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the rate limit.
+func (r RateLimit) Validate() error {
+	if err := ValidateRateLimitConfig(r.Limit, r.WindowBlocks, r.WindowDuration); err != nil {
+		return err
+	}
+
+	for i, bucket := range r.Buckets {
+		if i > 0 && bucket.Index <= r.Buckets[i-1].Index {
+			return errors.Wrap(ErrInvalidRateLimit, "buckets must be ordered by index")
+		}
+		if bucket.Amount.IsNil() || bucket.Amount.IsNegative() {
+			return errors.Wrap(ErrInvalidRateLimit, "minted amount cannot be nil or negative")
+		}
+	}
+
+	return nil
+}
+
+// rateLimitBucketLength returns the length of a bucket of a window, in blocks
+// or nanoseconds. It is rounded up, so that a window never spans more than
+// RateLimitBuckets buckets.
+func rateLimitBucketLength(windowBlocks int64, windowDuration time.Duration) int64 {
+	window := windowBlocks
+	if window <= 0 {
+		window = int64(windowDuration)
+	}
+	return (window + RateLimitBuckets - 1) / RateLimitBuckets
+}
+
+// rateLimitBucket returns the index of the bucket of a window at the given
+// block.
+func rateLimitBucket(windowBlocks int64, windowDuration time.Duration, height int64, blockTime time.Time) int64 {
+	length := rateLimitBucketLength(windowBlocks, windowDuration)
+	if windowBlocks > 0 {
+		return height / length
+	}
+	return blockTime.UnixNano() / length
+}
+
+// rateLimitFirstBucket returns the index of the oldest bucket still within a
+// window at the given block.
+func rateLimitFirstBucket(windowBlocks int64, windowDuration time.Duration, height int64, blockTime time.Time) int64 {
+	window := windowBlocks
+	if window <= 0 {
+		window = int64(windowDuration)
+	}
+	length := rateLimitBucketLength(windowBlocks, windowDuration)
+	buckets := (window + length - 1) / length
+	return rateLimitBucket(windowBlocks, windowDuration, height, blockTime) - buckets + 1
+}
+
+// rateLimitWindow returns a readable description of a window length.
+func rateLimitWindow(windowBlocks int64, windowDuration time.Duration) string {
+	if windowBlocks > 0 {
+		return fmt.Sprintf("%d blocks", windowBlocks)
+	}
+	return windowDuration.String()
+}
+
+// Minted returns the amount minted within the window at the given block.
+func (r RateLimit) Minted(height int64, blockTime time.Time) math.Int {
+	first := rateLimitFirstBucket(r.WindowBlocks, r.WindowDuration, height, blockTime)
+
+	minted := math.ZeroInt()
+	for _, bucket := range r.Buckets {
+		if bucket.Index >= first {
+			minted = minted.Add(bucket.Amount)
+		}
+	}
+	return minted
+}
+
+// Remaining returns the amount that can still be minted within the window at
+// the given block.
+func (r RateLimit) Remaining(height int64, blockTime time.Time) math.Int {
+	remaining := r.Limit.Sub(r.Minted(height, blockTime))
+	if remaining.IsNegative() {
+		return math.ZeroInt()
+	}
+	return remaining
+}
+
+// Consume records an amount minted at the given block. The caller must check
+// the amount against Remaining beforehand.
+func (r *RateLimit) Consume(amount math.Int, height int64, blockTime time.Time) {
+	bucket := r.currentBucket(height, blockTime)
+	bucket.Amount = bucket.Amount.Add(amount)
+}
+
+// currentBucket returns the bucket of the window at the given block, opening
+// it if needed, and drops the buckets that left the window.
+func (r *RateLimit) currentBucket(height int64, blockTime time.Time) *RateLimitBucket {
+	first := rateLimitFirstBucket(r.WindowBlocks, r.WindowDuration, height, blockTime)
+	current := rateLimitBucket(r.WindowBlocks, r.WindowDuration, height, blockTime)
+
+	buckets := r.Buckets[:0]
+	for _, bucket := range r.Buckets {
+		if bucket.Index >= first {
+			buckets = append(buckets, bucket)
+		}
+	}
+	if n := len(buckets); n == 0 || buckets[n-1].Index != current {
+		buckets = append(buckets, RateLimitBucket{Index: current, Amount: math.ZeroInt()})
+	}
+	r.Buckets = buckets
+
+	return &r.Buckets[len(r.Buckets)-1]
+}
+
+// Reconfigure updates the limit and window of the rate limit at the given
+// block while keeping its usage. When the window length changes, the amount
+// minted within the previous window is carried into the current bucket of
+// the new window.
+func (r *RateLimit) Reconfigure(limit math.Int, windowBlocks int64, windowDuration time.Duration, height int64, blockTime time.Time) {
+	minted := r.Minted(height, blockTime)
+	sameWindow := r.WindowBlocks == windowBlocks && r.WindowDuration == windowDuration
+
+	r.Limit = limit
+	r.WindowBlocks = windowBlocks
+	r.WindowDuration = windowDuration
+	if sameWindow {
+		return
+	}
+
+	r.Buckets = nil
+	if minted.IsPositive() {
+		r.Consume(minted, height, blockTime)
+	}
+}
+
+// Config returns a readable description of the rate limit configuration,
+// or an empty string for a nil rate limit.
+func (r *RateLimit) Config() string {
+	if r == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s per %s", r.Limit, rateLimitWindow(r.WindowBlocks, r.WindowDuration))
+}
@@ -0,0 +1,109 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+func TestRateLimit_WindowBlocks(t *testing.T) {
+	start := time.Unix(1000, 0).UTC()
+	rateLimit := types.NewRateLimit(math.NewInt(100), 10, 0)
+
+	require.Equal(t, math.NewInt(100), rateLimit.Remaining(5, start))
+
+	rateLimit.Consume(math.NewInt(60), 6, start)
+	require.Equal(t, math.NewInt(40), rateLimit.Remaining(15, start))
+
+	// the amount leaves the window 10 blocks after it was minted
+	require.Equal(t, math.NewInt(100), rateLimit.Remaining(16, start))
+}
+
+func TestRateLimit_SlidingWindow(t *testing.T) {
+	start := time.Unix(1000, 0).UTC()
+	rateLimit := types.NewRateLimit(math.NewInt(100), 10, 0)
+
+	rateLimit.Consume(math.NewInt(50), 10, start)
+	rateLimit.Consume(math.NewInt(50), 14, start)
+	require.True(t, rateLimit.Remaining(19, start).IsZero())
+
+	// each amount leaves the window on its own, there is no window reset
+	require.Equal(t, math.NewInt(50), rateLimit.Remaining(20, start))
+	require.Equal(t, math.NewInt(50), rateLimit.Remaining(23, start))
+	require.Equal(t, math.NewInt(100), rateLimit.Remaining(24, start))
+
+	// buckets that left the window are dropped
+	rateLimit.Consume(math.NewInt(10), 24, start)
+	require.Len(t, rateLimit.Buckets, 1)
+	require.Equal(t, math.NewInt(10), rateLimit.Minted(24, start))
+}
+
+func TestRateLimit_WindowDuration(t *testing.T) {
+	start := time.Unix(1000, 0).UTC()
+	rateLimit := types.NewRateLimit(math.NewInt(100), 0, 24*time.Hour)
+
+	rateLimit.Consume(math.NewInt(100), 2, start.Add(time.Hour))
+	require.True(t, rateLimit.Remaining(1000, start.Add(21*time.Hour)).IsZero())
+	require.Equal(t, math.NewInt(100), rateLimit.Remaining(1001, start.Add(25*time.Hour)))
+
+	rateLimit.Consume(math.NewInt(1), 1002, start.Add(25*time.Hour))
+	require.Equal(t, math.NewInt(99), rateLimit.Remaining(1003, start.Add(26*time.Hour)))
+}
+
+func TestRateLimit_Reconfigure(t *testing.T) {
+	start := time.Unix(1000, 0).UTC()
+	rateLimit := types.NewRateLimit(math.NewInt(100), 10, 0)
+	rateLimit.Consume(math.NewInt(60), 6, start)
+
+	// changing the limit keeps the amount minted within the window
+	rateLimit.Reconfigure(math.NewInt(80), 10, 0, 7, start)
+	require.Equal(t, math.NewInt(20), rateLimit.Remaining(7, start))
+	require.Equal(t, math.NewInt(80), rateLimit.Remaining(16, start))
+
+	// changing the window carries the amount into the new window
+	rateLimit.Reconfigure(math.NewInt(80), 20, 0, 8, start)
+	require.Equal(t, math.NewInt(20), rateLimit.Remaining(8, start))
+	require.Equal(t, math.NewInt(20), rateLimit.Remaining(26, start))
+	require.Equal(t, math.NewInt(80), rateLimit.Remaining(28, start))
+}
+
+func TestRateLimit_Validate(t *testing.T) {
+	require.NoError(t, types.NewRateLimit(math.NewInt(1), 1, 0).Validate())
+
+	negative := types.NewRateLimit(math.NewInt(1), 1, 0)
+	negative.Buckets = []types.RateLimitBucket{{Index: 1, Amount: math.NewInt(-1)}}
+	require.ErrorIs(t, negative.Validate(), types.ErrInvalidRateLimit)
+
+	unordered := types.NewRateLimit(math.NewInt(1), 1, 0)
+	unordered.Buckets = []types.RateLimitBucket{{Index: 2, Amount: math.OneInt()}, {Index: 1, Amount: math.OneInt()}}
+	require.ErrorIs(t, unordered.Validate(), types.ErrInvalidRateLimit)
+
+	require.ErrorIs(t, types.NewRateLimit(math.NewInt(1), 0, 0).Validate(), types.ErrInvalidRateLimit)
+}
+
+func TestRateLimit_Config(t *testing.T) {
+	var rateLimit *types.RateLimit
+	require.Equal(t, "", rateLimit.Config())
+	require.Equal(t, "100 per 10 blocks", types.NewRateLimit(math.NewInt(100), 10, 0).Config())
+	require.Equal(t, "100 per 24h0m0s", types.NewRateLimit(math.NewInt(100), 0, 24*time.Hour).Config())
+}
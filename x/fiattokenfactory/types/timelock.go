@@ -0,0 +1,85 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import "fmt"
+
+// ActionName returns the audit log action of the queued action.
+func (a QueuedAction) ActionName() string {
+	switch a.Action.(type) {
+	case *QueuedAction_UpdateOwner:
+		return ActionUpdateOwner
+	case *QueuedAction_UpdateMasterMinter:
+		return ActionUpdateMasterMinter
+	case *QueuedAction_UpdatePauser:
+		return ActionUpdatePauser
+	case *QueuedAction_UpdateBlacklister:
+		return ActionUpdateBlacklister
+	case *QueuedAction_ConfigureMinterController:
+		return ActionConfigureMinterController
+	case *QueuedAction_RemoveMinterController:
+		return ActionRemoveMinterController
+	case *QueuedAction_UpdateAllowlister:
+		return ActionUpdateAllowlister
+	case *QueuedAction_SetSignerSet:
+		return ActionSetSignerSet
+	default:
+		return ""
+	}
+}
+
+// Role returns the role that queued the action.
+func (a QueuedAction) Role() string {
+	switch a.Action.(type) {
+	case *QueuedAction_ConfigureMinterController, *QueuedAction_RemoveMinterController:
+		return RoleMasterMinter
+	default:
+		return RoleOwner
+	}
+}
+
+// From returns the address that queued the action.
+func (a QueuedAction) From() string {
+	switch action := a.Action.(type) {
+	case *QueuedAction_UpdateOwner:
+		return action.UpdateOwner.From
+	case *QueuedAction_UpdateMasterMinter:
+		return action.UpdateMasterMinter.From
+	case *QueuedAction_UpdatePauser:
+		return action.UpdatePauser.From
+	case *QueuedAction_UpdateBlacklister:
+		return action.UpdateBlacklister.From
+	case *QueuedAction_ConfigureMinterController:
+		return action.ConfigureMinterController.From
+	case *QueuedAction_RemoveMinterController:
+		return action.RemoveMinterController.From
+	case *QueuedAction_UpdateAllowlister:
+		return action.UpdateAllowlister.From
+	case *QueuedAction_SetSignerSet:
+		return action.SetSignerSet.From
+	default:
+		return ""
+	}
+}
+
+// Validate performs a basic validation of the queued action.
+func (a QueuedAction) Validate() error {
+	if a.ActionName() == "" {
+		return fmt.Errorf("queued action %d has no action", a.Id)
+	}
+	return nil
+}
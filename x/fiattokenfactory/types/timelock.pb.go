@@ -0,0 +1,1119 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: circle/fiattokenfactory/v1/timelock.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueuedAction is a role change or signer set change of a minting denom
+// awaiting the role change delay before it is executed. The owner can cancel
+// it in the meantime.
+type QueuedAction struct {
+	Id        uint64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Denom     string    `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	ExecuteAt time.Time `protobuf:"bytes,3,opt,name=executeAt,proto3,stdtime" json:"executeAt"`
+	// Types that are valid to be assigned to Action:
+	//	*QueuedAction_UpdateOwner
+	//	*QueuedAction_UpdateMasterMinter
+	//	*QueuedAction_UpdatePauser
+	//	*QueuedAction_UpdateBlacklister
+	//	*QueuedAction_ConfigureMinterController
+	//	*QueuedAction_RemoveMinterController
+	//	*QueuedAction_UpdateAllowlister
+	//	*QueuedAction_SetSignerSet
+	Action isQueuedAction_Action `protobuf_oneof:"action"`
+}
+
+func (m *QueuedAction) Reset()         { *m = QueuedAction{} }
+func (m *QueuedAction) String() string { return proto.CompactTextString(m) }
+func (*QueuedAction) ProtoMessage()    {}
+func (*QueuedAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2777f9cd8834a302, []int{0}
+}
+func (m *QueuedAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedAction.Merge(m, src)
+}
+func (m *QueuedAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedAction proto.InternalMessageInfo
+
+type isQueuedAction_Action interface {
+	isQueuedAction_Action()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type QueuedAction_UpdateOwner struct {
+	UpdateOwner *MsgUpdateOwner `protobuf:"bytes,10,opt,name=updateOwner,proto3,oneof" json:"updateOwner,omitempty"`
+}
+type QueuedAction_UpdateMasterMinter struct {
+	UpdateMasterMinter *MsgUpdateMasterMinter `protobuf:"bytes,11,opt,name=updateMasterMinter,proto3,oneof" json:"updateMasterMinter,omitempty"`
+}
+type QueuedAction_UpdatePauser struct {
+	UpdatePauser *MsgUpdatePauser `protobuf:"bytes,12,opt,name=updatePauser,proto3,oneof" json:"updatePauser,omitempty"`
+}
+type QueuedAction_UpdateBlacklister struct {
+	UpdateBlacklister *MsgUpdateBlacklister `protobuf:"bytes,13,opt,name=updateBlacklister,proto3,oneof" json:"updateBlacklister,omitempty"`
+}
+type QueuedAction_ConfigureMinterController struct {
+	ConfigureMinterController *MsgConfigureMinterController `protobuf:"bytes,14,opt,name=configureMinterController,proto3,oneof" json:"configureMinterController,omitempty"`
+}
+type QueuedAction_RemoveMinterController struct {
+	RemoveMinterController *MsgRemoveMinterController `protobuf:"bytes,15,opt,name=removeMinterController,proto3,oneof" json:"removeMinterController,omitempty"`
+}
+type QueuedAction_UpdateAllowlister struct {
+	UpdateAllowlister *MsgUpdateAllowlister `protobuf:"bytes,16,opt,name=updateAllowlister,proto3,oneof" json:"updateAllowlister,omitempty"`
+}
+type QueuedAction_SetSignerSet struct {
+	SetSignerSet *MsgSetSignerSet `protobuf:"bytes,17,opt,name=setSignerSet,proto3,oneof" json:"setSignerSet,omitempty"`
+}
+
+func (*QueuedAction_UpdateOwner) isQueuedAction_Action()               {}
+func (*QueuedAction_UpdateMasterMinter) isQueuedAction_Action()        {}
+func (*QueuedAction_UpdatePauser) isQueuedAction_Action()              {}
+func (*QueuedAction_UpdateBlacklister) isQueuedAction_Action()         {}
+func (*QueuedAction_ConfigureMinterController) isQueuedAction_Action() {}
+func (*QueuedAction_RemoveMinterController) isQueuedAction_Action()    {}
+func (*QueuedAction_UpdateAllowlister) isQueuedAction_Action()         {}
+func (*QueuedAction_SetSignerSet) isQueuedAction_Action()              {}
+
+func (m *QueuedAction) GetAction() isQueuedAction_Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (m *QueuedAction) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QueuedAction) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueuedAction) GetExecuteAt() time.Time {
+	if m != nil {
+		return m.ExecuteAt
+	}
+	return time.Time{}
+}
+
+func (m *QueuedAction) GetUpdateOwner() *MsgUpdateOwner {
+	if x, ok := m.GetAction().(*QueuedAction_UpdateOwner); ok {
+		return x.UpdateOwner
+	}
+	return nil
+}
+
+func (m *QueuedAction) GetUpdateMasterMinter() *MsgUpdateMasterMinter {
+	if x, ok := m.GetAction().(*QueuedAction_UpdateMasterMinter); ok {
+		return x.UpdateMasterMinter
+	}
+	return nil
+}
+
+func (m *QueuedAction) GetUpdatePauser() *MsgUpdatePauser {
+	if x, ok := m.GetAction().(*QueuedAction_UpdatePauser); ok {
+		return x.UpdatePauser
+	}
+	return nil
+}
+
+func (m *QueuedAction) GetUpdateBlacklister() *MsgUpdateBlacklister {
+	if x, ok := m.GetAction().(*QueuedAction_UpdateBlacklister); ok {
+		return x.UpdateBlacklister
+	}
+	return nil
+}
+
+func (m *QueuedAction) GetConfigureMinterController() *MsgConfigureMinterController {
+	if x, ok := m.GetAction().(*QueuedAction_ConfigureMinterController); ok {
+		return x.ConfigureMinterController
+	}
+	return nil
+}
+
+func (m *QueuedAction) GetRemoveMinterController() *MsgRemoveMinterController {
+	if x, ok := m.GetAction().(*QueuedAction_RemoveMinterController); ok {
+		return x.RemoveMinterController
+	}
+	return nil
+}
+
+func (m *QueuedAction) GetUpdateAllowlister() *MsgUpdateAllowlister {
+	if x, ok := m.GetAction().(*QueuedAction_UpdateAllowlister); ok {
+		return x.UpdateAllowlister
+	}
+	return nil
+}
+
+func (m *QueuedAction) GetSetSignerSet() *MsgSetSignerSet {
+	if x, ok := m.GetAction().(*QueuedAction_SetSignerSet); ok {
+		return x.SetSignerSet
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*QueuedAction) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*QueuedAction_UpdateOwner)(nil),
+		(*QueuedAction_UpdateMasterMinter)(nil),
+		(*QueuedAction_UpdatePauser)(nil),
+		(*QueuedAction_UpdateBlacklister)(nil),
+		(*QueuedAction_ConfigureMinterController)(nil),
+		(*QueuedAction_RemoveMinterController)(nil),
+		(*QueuedAction_UpdateAllowlister)(nil),
+		(*QueuedAction_SetSignerSet)(nil),
+	}
+}
+
+func init() {
+	proto.RegisterType((*QueuedAction)(nil), "circle.fiattokenfactory.v1.QueuedAction")
+}
+
+func init() {
+	proto.RegisterFile("circle/fiattokenfactory/v1/timelock.proto", fileDescriptor_2777f9cd8834a302)
+}
+
+var fileDescriptor_2777f9cd8834a302 = []byte{
+	// 496 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x9d, 0x01, 0x63, 0x73, 0xcb, 0x60, 0xd6, 0x84, 0x42, 0x0f, 0x69, 0x05, 0x97, 0x02,
+	0x22, 0xa1, 0x20, 0x24, 0xae, 0xed, 0x38, 0xe4, 0x52, 0x60, 0x2e, 0x5c, 0x38, 0x20, 0x52, 0xf7,
+	0x35, 0x58, 0x75, 0xec, 0xca, 0x71, 0xba, 0xee, 0x3b, 0x70, 0xd8, 0xc7, 0xda, 0x71, 0x47, 0x4e,
+	0x80, 0xda, 0x2f, 0x82, 0x6a, 0x6f, 0x6a, 0xa0, 0x2b, 0x2b, 0xb7, 0x3c, 0xeb, 0xfd, 0x7f, 0x3f,
+	0xe5, 0xe9, 0xd9, 0xf8, 0x31, 0xe3, 0x9a, 0x09, 0x88, 0x86, 0x3c, 0x31, 0x46, 0x8d, 0x40, 0x0e,
+	0x13, 0x66, 0x94, 0x3e, 0x89, 0x26, 0xad, 0xc8, 0xf0, 0x0c, 0x84, 0x62, 0xa3, 0x70, 0xac, 0x95,
+	0x51, 0xa4, 0xe6, 0x5a, 0xc3, 0xbf, 0x5b, 0xc3, 0x49, 0xab, 0xf6, 0xe8, 0x5f, 0x98, 0xa9, 0x03,
+	0xd4, 0x0e, 0x52, 0x95, 0x2a, 0xfb, 0x19, 0x2d, 0xbe, 0x2e, 0x4e, 0xeb, 0xa9, 0x52, 0xa9, 0x80,
+	0xc8, 0x56, 0xfd, 0x62, 0x68, 0xb5, 0xb9, 0x49, 0xb2, 0xb1, 0x6b, 0x78, 0xf8, 0xed, 0x36, 0xae,
+	0x1e, 0x15, 0x50, 0xc0, 0xa0, 0xcd, 0x0c, 0x57, 0x92, 0xec, 0xe1, 0x2d, 0x3e, 0xf0, 0xbd, 0x86,
+	0xd7, 0xbc, 0x49, 0xb7, 0xf8, 0x80, 0x1c, 0xe0, 0x5b, 0x03, 0x90, 0x2a, 0xf3, 0xb7, 0x1a, 0x5e,
+	0x73, 0x97, 0xba, 0x82, 0x74, 0xf0, 0x2e, 0x4c, 0x81, 0x15, 0x06, 0xda, 0xc6, 0xbf, 0xd1, 0xf0,
+	0x9a, 0x95, 0x17, 0xb5, 0xd0, 0xb9, 0xc2, 0x4b, 0x57, 0xf8, 0xe1, 0xd2, 0xd5, 0xd9, 0x39, 0xfb,
+	0x51, 0x47, 0xa7, 0x3f, 0xeb, 0x1e, 0x5d, 0xc6, 0xc8, 0x5b, 0x5c, 0x29, 0xc6, 0x83, 0xc4, 0xc0,
+	0xbb, 0x63, 0x09, 0xda, 0xc7, 0x96, 0xf2, 0x24, 0x5c, 0x3f, 0x88, 0xb0, 0x9b, 0xa7, 0x1f, 0x97,
+	0x89, 0x18, 0xd1, 0x32, 0x80, 0x30, 0x4c, 0x5c, 0xd9, 0x4d, 0x72, 0x03, 0xba, 0xcb, 0xa5, 0x01,
+	0xed, 0x57, 0x2c, 0xb6, 0xb5, 0x11, 0xb6, 0x1c, 0x8c, 0x11, 0xbd, 0x02, 0x47, 0x8e, 0x70, 0xd5,
+	0x9d, 0xbe, 0x4f, 0x8a, 0x1c, 0xb4, 0x5f, 0xb5, 0xf8, 0xa7, 0x1b, 0xe1, 0x5d, 0x24, 0x46, 0xf4,
+	0x0f, 0x04, 0xf9, 0x82, 0xf7, 0x5d, 0xdd, 0x11, 0x09, 0x1b, 0x09, 0xbe, 0xb0, 0xf9, 0x77, 0x2c,
+	0xf7, 0xf9, 0x46, 0xdc, 0x52, 0x2e, 0x46, 0x74, 0x15, 0x46, 0xa6, 0xf8, 0x01, 0x53, 0x72, 0xc8,
+	0xd3, 0x42, 0x83, 0xfb, 0x8f, 0x43, 0x25, 0x8d, 0x56, 0x42, 0x80, 0xf6, 0xf7, 0xac, 0xe9, 0xf5,
+	0x35, 0xa6, 0xc3, 0x75, 0xf9, 0x18, 0xd1, 0xf5, 0x70, 0xa2, 0xf0, 0x7d, 0x0d, 0x99, 0x9a, 0xac,
+	0x6a, 0xef, 0x5a, 0xed, 0xab, 0x6b, 0xb4, 0xf4, 0xca, 0x70, 0x8c, 0xe8, 0x1a, 0xec, 0x72, 0x98,
+	0x6d, 0x21, 0xd4, 0xf1, 0xc5, 0x30, 0xef, 0xfd, 0xc7, 0x30, 0x4b, 0xb9, 0xe5, 0x30, 0x4b, 0x87,
+	0x8b, 0x0d, 0xc8, 0xc1, 0xf4, 0x78, 0x2a, 0x41, 0xf7, 0xc0, 0xf8, 0xfb, 0x1b, 0x6d, 0x40, 0xaf,
+	0x14, 0x59, 0x6c, 0x40, 0x19, 0xd1, 0xd9, 0xc1, 0xdb, 0x89, 0xbd, 0x7d, 0x9d, 0xcf, 0x67, 0xb3,
+	0xc0, 0x3b, 0x9f, 0x05, 0xde, 0xaf, 0x59, 0xe0, 0x9d, 0xce, 0x03, 0x74, 0x3e, 0x0f, 0xd0, 0xf7,
+	0x79, 0x80, 0x3e, 0xbd, 0x49, 0xb9, 0xf9, 0x5a, 0xf4, 0x43, 0xa6, 0xb2, 0xc8, 0xa9, 0x86, 0x5c,
+	0x46, 0x52, 0xf5, 0x05, 0x3c, 0x5b, 0x79, 0x18, 0xa6, 0xab, 0x6f, 0x85, 0x39, 0x19, 0x43, 0xde,
+	0xdf, 0xb6, 0x97, 0xf3, 0xe5, 0xef, 0x01, 0x00, 0x43, 0x33, 0xdf, 0x08, 0x9a, 0x04, 0x00, 0x00,
+}
+
+func (m *QueuedAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Action != nil {
+		{
+			size := m.Action.Size()
+			i -= size
+			if _, err := m.Action.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExecuteAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecuteAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTimelock(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTimelock(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTimelock(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedAction_UpdateOwner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedAction_UpdateOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UpdateOwner != nil {
+		{
+			size, err := m.UpdateOwner.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTimelock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
+func (m *QueuedAction_UpdateMasterMinter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedAction_UpdateMasterMinter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UpdateMasterMinter != nil {
+		{
+			size, err := m.UpdateMasterMinter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTimelock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *QueuedAction_UpdatePauser) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedAction_UpdatePauser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UpdatePauser != nil {
+		{
+			size, err := m.UpdatePauser.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTimelock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	return len(dAtA) - i, nil
+}
+func (m *QueuedAction_UpdateBlacklister) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedAction_UpdateBlacklister) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UpdateBlacklister != nil {
+		{
+			size, err := m.UpdateBlacklister.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTimelock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *QueuedAction_ConfigureMinterController) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedAction_ConfigureMinterController) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ConfigureMinterController != nil {
+		{
+			size, err := m.ConfigureMinterController.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTimelock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	return len(dAtA) - i, nil
+}
+func (m *QueuedAction_RemoveMinterController) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedAction_RemoveMinterController) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RemoveMinterController != nil {
+		{
+			size, err := m.RemoveMinterController.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTimelock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *QueuedAction_UpdateAllowlister) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedAction_UpdateAllowlister) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UpdateAllowlister != nil {
+		{
+			size, err := m.UpdateAllowlister.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTimelock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	return len(dAtA) - i, nil
+}
+func (m *QueuedAction_SetSignerSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedAction_SetSignerSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SetSignerSet != nil {
+		{
+			size, err := m.SetSignerSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTimelock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	return len(dAtA) - i, nil
+}
+func encodeVarintTimelock(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTimelock(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueuedAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTimelock(uint64(m.Id))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecuteAt)
+	n += 1 + l + sovTimelock(uint64(l))
+	if m.Action != nil {
+		n += m.Action.Size()
+	}
+	return n
+}
+
+func (m *QueuedAction_UpdateOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdateOwner != nil {
+		l = m.UpdateOwner.Size()
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	return n
+}
+func (m *QueuedAction_UpdateMasterMinter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdateMasterMinter != nil {
+		l = m.UpdateMasterMinter.Size()
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	return n
+}
+func (m *QueuedAction_UpdatePauser) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdatePauser != nil {
+		l = m.UpdatePauser.Size()
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	return n
+}
+func (m *QueuedAction_UpdateBlacklister) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdateBlacklister != nil {
+		l = m.UpdateBlacklister.Size()
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	return n
+}
+func (m *QueuedAction_ConfigureMinterController) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConfigureMinterController != nil {
+		l = m.ConfigureMinterController.Size()
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	return n
+}
+func (m *QueuedAction_RemoveMinterController) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RemoveMinterController != nil {
+		l = m.RemoveMinterController.Size()
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	return n
+}
+func (m *QueuedAction_UpdateAllowlister) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdateAllowlister != nil {
+		l = m.UpdateAllowlister.Size()
+		n += 2 + l + sovTimelock(uint64(l))
+	}
+	return n
+}
+func (m *QueuedAction_SetSignerSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SetSignerSet != nil {
+		l = m.SetSignerSet.Size()
+		n += 2 + l + sovTimelock(uint64(l))
+	}
+	return n
+}
+
+func sovTimelock(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTimelock(x uint64) (n int) {
+	return sovTimelock(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueuedAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTimelock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExecuteAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateOwner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgUpdateOwner{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &QueuedAction_UpdateOwner{v}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMasterMinter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgUpdateMasterMinter{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &QueuedAction_UpdateMasterMinter{v}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatePauser", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgUpdatePauser{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &QueuedAction_UpdatePauser{v}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateBlacklister", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgUpdateBlacklister{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &QueuedAction_UpdateBlacklister{v}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigureMinterController", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgConfigureMinterController{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &QueuedAction_ConfigureMinterController{v}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveMinterController", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgRemoveMinterController{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &QueuedAction_RemoveMinterController{v}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateAllowlister", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgUpdateAllowlister{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &QueuedAction_UpdateAllowlister{v}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetSignerSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgSetSignerSet{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &QueuedAction_SetSignerSet{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTimelock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTimelock(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTimelock
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTimelock
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTimelock
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTimelock
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTimelock        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTimelock          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTimelock = fmt.Errorf("proto: unexpected end of group")
+)